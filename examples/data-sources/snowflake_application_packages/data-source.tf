@@ -0,0 +1,69 @@
+# Simple usage
+data "snowflake_application_packages" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_application_packages.simple.application_packages
+}
+
+# Filtering (like)
+data "snowflake_application_packages" "like" {
+  like = "application-package-name"
+}
+
+output "like_output" {
+  value = data.snowflake_application_packages.like.application_packages
+}
+
+# Filtering by prefix (like)
+data "snowflake_application_packages" "like_prefix" {
+  like = "prefix%"
+}
+
+output "like_prefix_output" {
+  value = data.snowflake_application_packages.like_prefix.application_packages
+}
+
+# Filtering (starts_with)
+data "snowflake_application_packages" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_application_packages.starts_with.application_packages
+}
+
+# Filtering (limit)
+data "snowflake_application_packages" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_application_packages.limit.application_packages
+}
+
+# Ensure the number of application packages is equal to at least one element (with the use of postcondition)
+data "snowflake_application_packages" "assert_with_postcondition" {
+  like = "application-package-name%"
+  lifecycle {
+    postcondition {
+      condition     = length(self.application_packages) > 0
+      error_message = "there should be at least one application package"
+    }
+  }
+}
+
+# Ensure the number of application packages is equal to exactly one element (with the use of check block)
+check "application_package_check" {
+  data "snowflake_application_packages" "assert_with_check_block" {
+    like = "application-package-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_application_packages.assert_with_check_block.application_packages) == 1
+    error_message = "application packages filtered by '${data.snowflake_application_packages.assert_with_check_block.like}' returned ${length(data.snowflake_application_packages.assert_with_check_block.application_packages)} application packages where one was expected"
+  }
+}
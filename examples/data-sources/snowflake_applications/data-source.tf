@@ -0,0 +1,79 @@
+# Simple usage
+data "snowflake_applications" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_applications.simple.applications
+}
+
+# Filtering (like)
+data "snowflake_applications" "like" {
+  like = "application-name"
+}
+
+output "like_output" {
+  value = data.snowflake_applications.like.applications
+}
+
+# Filtering by prefix (like)
+data "snowflake_applications" "like_prefix" {
+  like = "prefix%"
+}
+
+output "like_prefix_output" {
+  value = data.snowflake_applications.like_prefix.applications
+}
+
+# Filtering (starts_with)
+data "snowflake_applications" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_applications.starts_with.applications
+}
+
+# Filtering (limit)
+data "snowflake_applications" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_applications.limit.applications
+}
+
+# Without additional data (to limit the number of calls make for every found application)
+data "snowflake_applications" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE APPLICATION for every application found and attaches its output to applications.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_applications.only_show.applications
+}
+
+# Ensure the number of applications is equal to at least one element (with the use of postcondition)
+data "snowflake_applications" "assert_with_postcondition" {
+  like = "application-name%"
+  lifecycle {
+    postcondition {
+      condition     = length(self.applications) > 0
+      error_message = "there should be at least one application"
+    }
+  }
+}
+
+# Ensure the number of applications is equal to exactly one element (with the use of check block)
+check "application_check" {
+  data "snowflake_applications" "assert_with_check_block" {
+    like = "application-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_applications.assert_with_check_block.applications) == 1
+    error_message = "applications filtered by '${data.snowflake_applications.assert_with_check_block.like}' returned ${length(data.snowflake_applications.assert_with_check_block.applications)} applications where one was expected"
+  }
+}
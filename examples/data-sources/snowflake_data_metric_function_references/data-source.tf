@@ -0,0 +1,8 @@
+data "snowflake_data_metric_function_references" "example" {
+  object_type = "TABLE"
+  object_name = snowflake_table.table.fully_qualified_name
+}
+
+output "data_metric_function_references" {
+  value = data.snowflake_data_metric_function_references.example.data_metric_function_references
+}
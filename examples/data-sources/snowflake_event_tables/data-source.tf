@@ -0,0 +1,106 @@
+# Simple usage
+data "snowflake_event_tables" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_event_tables.simple.event_tables
+}
+
+# Filtering (like)
+data "snowflake_event_tables" "like" {
+  like = "event-table-name"
+}
+
+output "like_output" {
+  value = data.snowflake_event_tables.like.event_tables
+}
+
+# Filtering by prefix (like)
+data "snowflake_event_tables" "like_prefix" {
+  like = "prefix%"
+}
+
+output "like_prefix_output" {
+  value = data.snowflake_event_tables.like_prefix.event_tables
+}
+
+# Filtering (starts_with)
+data "snowflake_event_tables" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_event_tables.starts_with.event_tables
+}
+
+# Filtering (limit)
+data "snowflake_event_tables" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_event_tables.limit.event_tables
+}
+
+# Filtering (in)
+data "snowflake_event_tables" "in_account" {
+  in {
+    account = true
+  }
+}
+
+data "snowflake_event_tables" "in_database" {
+  in {
+    database = "<database_name>"
+  }
+}
+
+data "snowflake_event_tables" "in_schema" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = {
+    "account" : data.snowflake_event_tables.in_account.event_tables,
+    "database" : data.snowflake_event_tables.in_database.event_tables,
+    "schema" : data.snowflake_event_tables.in_schema.event_tables,
+  }
+}
+
+# Without additional data (to limit the number of calls make for every found event table)
+data "snowflake_event_tables" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE EVENT TABLE for every event table found and attaches its output to event_tables.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_event_tables.only_show.event_tables
+}
+
+# Ensure the number of event tables is equal to at least one element (with the use of postcondition)
+data "snowflake_event_tables" "assert_with_postcondition" {
+  like = "event-table-name%"
+  lifecycle {
+    postcondition {
+      condition     = length(self.event_tables) > 0
+      error_message = "there should be at least one event table"
+    }
+  }
+}
+
+# Ensure the number of event tables is equal to exactly one element (with the use of check block)
+check "event_table_check" {
+  data "snowflake_event_tables" "assert_with_check_block" {
+    like = "event-table-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_event_tables.assert_with_check_block.event_tables) == 1
+    error_message = "event tables filtered by '${data.snowflake_event_tables.assert_with_check_block.like}' returned ${length(data.snowflake_event_tables.assert_with_check_block.event_tables)} event tables where one was expected"
+  }
+}
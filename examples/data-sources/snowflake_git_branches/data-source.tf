@@ -0,0 +1,18 @@
+# Simple usage
+data "snowflake_git_branches" "simple" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+}
+
+output "simple_output" {
+  value = data.snowflake_git_branches.simple.branches
+}
+
+# Filtering (like)
+data "snowflake_git_branches" "like" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+  like           = "release-%"
+}
+
+output "like_output" {
+  value = data.snowflake_git_branches.like.branches
+}
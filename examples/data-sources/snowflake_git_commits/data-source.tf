@@ -0,0 +1,8 @@
+# Simple usage
+data "snowflake_git_commits" "simple" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+}
+
+output "simple_output" {
+  value = data.snowflake_git_commits.simple.commits
+}
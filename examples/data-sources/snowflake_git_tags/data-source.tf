@@ -0,0 +1,18 @@
+# Simple usage
+data "snowflake_git_tags" "simple" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+}
+
+output "simple_output" {
+  value = data.snowflake_git_tags.simple.tags
+}
+
+# Filtering (like)
+data "snowflake_git_tags" "like" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+  like           = "v1.%"
+}
+
+output "like_output" {
+  value = data.snowflake_git_tags.like.tags
+}
@@ -124,6 +124,14 @@ data "snowflake_grants" "example_future_in_database" {
   }
 }
 
+# database and all its schemas
+data "snowflake_grants" "example_future_in_database_including_schemas" {
+  future_grants_in {
+    database        = "some_database"
+    include_schemas = true
+  }
+}
+
 # schema
 data "snowflake_grants" "example_future_in_schema" {
   future_grants_in {
@@ -0,0 +1,110 @@
+# Simple usage
+data "snowflake_network_rules" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_network_rules.simple.network_rules
+}
+
+# Filtering (like)
+data "snowflake_network_rules" "like" {
+  like = "network-rule-name"
+}
+
+output "like_output" {
+  value = data.snowflake_network_rules.like.network_rules
+}
+
+# Filtering by prefix (like)
+data "snowflake_network_rules" "like_prefix" {
+  like = "prefix%"
+}
+
+output "like_prefix_output" {
+  value = data.snowflake_network_rules.like_prefix.network_rules
+}
+
+# Filtering (in)
+data "snowflake_network_rules" "in_account" {
+  in {
+    account = true
+  }
+}
+
+output "in_account_output" {
+  value = data.snowflake_network_rules.in_account.network_rules
+}
+
+data "snowflake_network_rules" "in_database" {
+  in {
+    database = "<database_name>"
+  }
+}
+
+output "in_database_output" {
+  value = data.snowflake_network_rules.in_database.network_rules
+}
+
+data "snowflake_network_rules" "in_schema" {
+  in {
+    schema = "\"<database_name>\".\"<schema_name>\""
+  }
+}
+
+output "in_schema_output" {
+  value = data.snowflake_network_rules.in_schema.network_rules
+}
+
+# Filtering (starts_with)
+data "snowflake_network_rules" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_network_rules.starts_with.network_rules
+}
+
+# Filtering (limit)
+data "snowflake_network_rules" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_network_rules.limit.network_rules
+}
+
+# Without additional data (to limit the number of calls make for every found network rule)
+data "snowflake_network_rules" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE NETWORK RULE for every network rule found and attaches its output to network_rules.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_network_rules.only_show.network_rules
+}
+
+# Ensure the number of network rules is equal to at least one element (with the use of postcondition)
+data "snowflake_network_rules" "assert_with_postcondition" {
+  like = "network-rule-name%"
+  lifecycle {
+    postcondition {
+      condition     = length(self.network_rules) > 0
+      error_message = "there should be at least one network rule"
+    }
+  }
+}
+
+# Ensure the number of network rules is equal to exactly one element (with the use of check block)
+check "network_rule_check" {
+  data "snowflake_network_rules" "assert_with_check_block" {
+    like = "network-rule-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_network_rules.assert_with_check_block.network_rules) == 1
+    error_message = "network rules filtered by '${data.snowflake_network_rules.assert_with_check_block.like}' returned ${length(data.snowflake_network_rules.assert_with_check_block.network_rules)} network rules where one was expected"
+  }
+}
@@ -0,0 +1,58 @@
+# Simple usage
+data "snowflake_notification_integrations" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_notification_integrations.simple.notification_integrations
+}
+
+# Filtering (like)
+data "snowflake_notification_integrations" "like" {
+  like = "notification-integration-name"
+}
+
+output "like_output" {
+  value = data.snowflake_notification_integrations.like.notification_integrations
+}
+
+# Filtering by prefix (like)
+data "snowflake_notification_integrations" "like_prefix" {
+  like = "prefix%"
+}
+
+output "like_prefix_output" {
+  value = data.snowflake_notification_integrations.like_prefix.notification_integrations
+}
+
+# Without additional data (to limit the number of calls make for every found notification integration)
+data "snowflake_notification_integrations" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE NOTIFICATION INTEGRATION for every notification integration found and attaches its output to notification_integrations.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_notification_integrations.only_show.notification_integrations
+}
+
+# Ensure the number of notification integrations is equal to at least one element (with the use of postcondition)
+data "snowflake_notification_integrations" "assert_with_postcondition" {
+  like = "notification-integration-name%"
+  lifecycle {
+    postcondition {
+      condition     = length(self.notification_integrations) > 0
+      error_message = "there should be at least one notification integration"
+    }
+  }
+}
+
+# Ensure the number of notification integrations is equal to exactly one element (with the use of check block)
+check "notification_integration_check" {
+  data "snowflake_notification_integrations" "assert_with_check_block" {
+    like = "notification-integration-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_notification_integrations.assert_with_check_block.notification_integrations) == 1
+    error_message = "notification integrations filtered by '${data.snowflake_notification_integrations.assert_with_check_block.like}' returned ${length(data.snowflake_notification_integrations.assert_with_check_block.notification_integrations)} notification integrations where one was expected"
+  }
+}
@@ -0,0 +1,19 @@
+# Simple usage
+data "snowflake_stage_files" "simple" {
+  stage = snowflake_stage.example.fully_qualified_name
+}
+
+output "simple_output" {
+  value = data.snowflake_stage_files.simple.files
+}
+
+# Filtering (path and pattern)
+data "snowflake_stage_files" "filtered" {
+  stage   = snowflake_stage.example.fully_qualified_name
+  path    = "libs/python"
+  pattern = ".*[.]py"
+}
+
+output "filtered_output" {
+  value = data.snowflake_stage_files.filtered.files
+}
@@ -0,0 +1 @@
+terraform import snowflake_account_role_grants.example '"<account_role_name>"'
\ No newline at end of file
@@ -0,0 +1,65 @@
+## Minimal
+resource "snowflake_account_role_grants" "minimal" {
+  account_role_name = snowflake_account_role.role.fully_qualified_name
+
+  grant {
+    privileges  = ["USAGE"]
+    object_type = "WAREHOUSE"
+    object_name = snowflake_warehouse.warehouse.fully_qualified_name
+  }
+}
+
+## Complete (with every optional set)
+resource "snowflake_account_role_grants" "complete" {
+  account_role_name = snowflake_account_role.role.fully_qualified_name
+
+  grant {
+    privileges  = ["CREATE DATABASE", "CREATE WAREHOUSE"]
+    object_type = "ACCOUNT"
+  }
+
+  grant {
+    privileges        = ["USAGE", "MONITOR"]
+    object_type       = "DATABASE"
+    object_name       = snowflake_database.database.fully_qualified_name
+    with_grant_option = true
+  }
+
+  grant {
+    privileges  = ["USAGE", "CREATE TABLE"]
+    object_type = "SCHEMA"
+    object_name = snowflake_schema.schema.fully_qualified_name
+  }
+
+  grant {
+    privileges  = ["SELECT", "INSERT"]
+    object_type = "TABLE"
+    object_name = snowflake_table.table.fully_qualified_name
+  }
+
+  grant {
+    privileges  = ["USAGE"]
+    object_type = "FUNCTION"
+    object_name = "\"database\".\"schema\".\"function\"(NUMBER, VARCHAR)"
+  }
+
+  # grants on the SNOWFLAKE database are managed by Snowflake
+  exclude {
+    in_database = "SNOWFLAKE"
+  }
+
+  # grants on all the integrations are managed elsewhere
+  exclude {
+    object_type = "INTEGRATION"
+  }
+
+  exclude {
+    object_type = "WAREHOUSE"
+    object_name = "\"shared_warehouse\""
+  }
+}
+
+## Revoke all the privileges
+resource "snowflake_account_role_grants" "empty" {
+  account_role_name = snowflake_account_role.role.fully_qualified_name
+}
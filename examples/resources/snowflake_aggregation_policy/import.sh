@@ -0,0 +1 @@
+terraform import snowflake_aggregation_policy.example '"<db_name>"."<schema_name>"."<aggregation_policy_name>"'
\ No newline at end of file
@@ -0,0 +1,38 @@
+# basic resource
+resource "snowflake_aggregation_policy" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "AGGREGATION_POLICY"
+  body     = "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+}
+
+# complete resource
+resource "snowflake_aggregation_policy" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "AGGREGATION_POLICY"
+  body     = <<-EOT
+  CASE
+    WHEN IS_ROLE_IN_SESSION('ADMIN') THEN NO_AGGREGATION_CONSTRAINT()
+    ELSE AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)
+  END
+  EOT
+  comment  = "comment"
+}
+
+# attaching the policy to a table
+resource "snowflake_table" "table" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "TABLE"
+
+  column {
+    name = "ID"
+    type = "NUMBER"
+  }
+
+  aggregation_policy {
+    policy_name = snowflake_aggregation_policy.basic.fully_qualified_name
+    entity_key  = ["ID"]
+  }
+}
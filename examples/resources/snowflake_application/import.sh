@@ -0,0 +1 @@
+terraform import snowflake_application.example '"<application_name>"'
\ No newline at end of file
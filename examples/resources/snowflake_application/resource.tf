@@ -0,0 +1,22 @@
+# application using the default release directive of the application package
+resource "snowflake_application" "basic" {
+  name                = "application_name"
+  application_package = snowflake_application_package.example.fully_qualified_name
+}
+
+# application installed from a specific version and patch
+resource "snowflake_application" "complete" {
+  name                       = "application_name"
+  application_package        = snowflake_application_package.example.fully_qualified_name
+  version                    = "V1"
+  patch                      = 1
+  debug_mode                 = "true"
+  share_events_with_provider = "true"
+  comment                    = "comment"
+}
+
+# application installed from a listing
+resource "snowflake_application" "from_listing" {
+  name    = "application_name"
+  listing = "\"listing_name\""
+}
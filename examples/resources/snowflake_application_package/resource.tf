@@ -0,0 +1,35 @@
+# basic resource
+resource "snowflake_application_package" "basic" {
+  name = "application_package_name"
+}
+
+# complete resource
+resource "snowflake_application_package" "complete" {
+  name                    = "application_package_name"
+  distribution            = "INTERNAL"
+  enable_release_channels = "false"
+  comment                 = "comment"
+
+  version {
+    name  = "V1"
+    using = "@\"database_name\".\"schema_name\".\"stage_name\"/v1"
+    label = "Version 1"
+
+    patch {
+      using = "@\"database_name\".\"schema_name\".\"stage_name\"/v1_1"
+      label = "Version 1, patch 1"
+    }
+  }
+
+  default_release_directive {
+    version = "V1"
+    patch   = 1
+  }
+
+  release_directive {
+    name     = "early_access"
+    accounts = ["ORGANIZATION_NAME.ACCOUNT_NAME"]
+    version  = "V1"
+    patch    = 0
+  }
+}
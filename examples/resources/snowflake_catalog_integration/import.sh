@@ -0,0 +1 @@
+terraform import snowflake_catalog_integration.example '"<catalog_integration_name>"'
\ No newline at end of file
@@ -0,0 +1,40 @@
+# basic resource (object storage catalog)
+resource "snowflake_catalog_integration" "basic" {
+  name           = "CATALOG_INTEGRATION"
+  catalog_source = "OBJECT_STORE"
+  table_format   = "ICEBERG"
+  enabled        = true
+}
+
+# AWS Glue catalog
+resource "snowflake_catalog_integration" "glue" {
+  name                     = "GLUE_CATALOG_INTEGRATION"
+  catalog_source           = "GLUE"
+  table_format             = "ICEBERG"
+  catalog_namespace        = "my_glue_database"
+  glue_aws_role_arn        = "arn:aws:iam::123456789012:role/myGlueRole"
+  glue_catalog_id          = "123456789012"
+  glue_region              = "us-east-2"
+  enabled                  = true
+  refresh_interval_seconds = 60
+  comment                  = "comment"
+}
+
+# Polaris catalog
+resource "snowflake_catalog_integration" "polaris" {
+  name              = "POLARIS_CATALOG_INTEGRATION"
+  catalog_source    = "POLARIS"
+  table_format      = "ICEBERG"
+  catalog_namespace = "my_namespace"
+  rest_config {
+    catalog_uri      = "https://my_account.snowflakecomputing.com/polaris/api/catalog"
+    catalog_api_type = "PUBLIC"
+    catalog_name     = "my_catalog"
+  }
+  rest_authentication {
+    oauth_client_id      = var.oauth_client_id
+    oauth_client_secret  = var.oauth_client_secret
+    oauth_allowed_scopes = ["PRINCIPAL_ROLE:ALL"]
+  }
+  enabled = true
+}
@@ -0,0 +1 @@
+terraform import snowflake_data_metric_function.example '"<database_name>"."<schema_name>"."<data_metric_function_name>"'
@@ -0,0 +1,35 @@
+# basic resource
+resource "snowflake_data_metric_function" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "name"
+  argument {
+    table_name = "arg_t"
+    column {
+      name      = "arg_c"
+      data_type = "NUMBER"
+    }
+  }
+  expression = "SELECT COUNT(*) FROM arg_t WHERE arg_c IS NULL"
+}
+
+# complete resource
+resource "snowflake_data_metric_function" "complete" {
+  database  = "database"
+  schema    = "schema"
+  name      = "name"
+  is_secure = "true"
+  argument {
+    table_name = "arg_t"
+    column {
+      name      = "arg_c1"
+      data_type = "NUMBER"
+    }
+    column {
+      name      = "arg_c2"
+      data_type = "VARCHAR"
+    }
+  }
+  expression = "SELECT COUNT(*) FROM arg_t WHERE arg_c1 IS NULL OR arg_c2 = ''"
+  comment    = "comment"
+}
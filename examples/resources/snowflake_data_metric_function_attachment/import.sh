@@ -0,0 +1,2 @@
+# format is <object_type>|<object_name>|<function_name>|<comma_separated_columns>
+terraform import snowflake_data_metric_function_attachment.example 'TABLE|"<database_name>"."<schema_name>"."<table_name>"|"<database_name>"."<schema_name>"."<data_metric_function_name>"|<column_name_1>,<column_name_2>'
@@ -0,0 +1,22 @@
+# attach a system data metric function
+resource "snowflake_data_metric_function_attachment" "system" {
+  object_type   = "TABLE"
+  object_name   = snowflake_table.table.fully_qualified_name
+  function_name = "SNOWFLAKE.CORE.NULL_COUNT"
+  on            = ["ID"]
+  data_metric_schedule {
+    using_cron = "15 * * * * UTC"
+  }
+}
+
+# attach a custom data metric function and suspend it
+resource "snowflake_data_metric_function_attachment" "custom" {
+  object_type     = "DYNAMIC TABLE"
+  object_name     = snowflake_dynamic_table.dynamic_table.fully_qualified_name
+  function_name   = snowflake_data_metric_function.dmf.fully_qualified_name
+  on              = ["ID"]
+  schedule_status = "SUSPENDED"
+  data_metric_schedule {
+    minutes = 60
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_database_role_grants.example '"<database_name>"."<database_role_name>"'
\ No newline at end of file
@@ -0,0 +1,39 @@
+## Minimal
+resource "snowflake_database_role_grants" "minimal" {
+  database_role_name = snowflake_database_role.database_role.fully_qualified_name
+
+  grant {
+    privileges  = ["USAGE"]
+    object_type = "SCHEMA"
+    object_name = snowflake_schema.schema.fully_qualified_name
+  }
+}
+
+## Complete (with every optional set)
+resource "snowflake_database_role_grants" "complete" {
+  database_role_name = snowflake_database_role.database_role.fully_qualified_name
+
+  grant {
+    privileges        = ["CREATE SCHEMA", "MONITOR"]
+    object_type       = "DATABASE"
+    object_name       = snowflake_database.database.fully_qualified_name
+    with_grant_option = true
+  }
+
+  grant {
+    privileges  = ["USAGE", "CREATE TABLE"]
+    object_type = "SCHEMA"
+    object_name = snowflake_schema.schema.fully_qualified_name
+  }
+
+  grant {
+    privileges  = ["SELECT"]
+    object_type = "VIEW"
+    object_name = snowflake_view.view.fully_qualified_name
+  }
+
+  exclude {
+    object_type = "SCHEMA"
+    object_name = "\"database\".\"shared_schema\""
+  }
+}
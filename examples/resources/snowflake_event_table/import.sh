@@ -0,0 +1 @@
+terraform import snowflake_event_table.example '"<db_name>"."<schema_name>"."<event_table_name>"'
\ No newline at end of file
@@ -0,0 +1,30 @@
+# basic resource
+resource "snowflake_event_table" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "EVENT_TABLE"
+}
+
+# complete resource
+resource "snowflake_event_table" "complete" {
+  database                        = "DATABASE"
+  schema                          = "SCHEMA"
+  name                            = "EVENT_TABLE"
+  cluster_by                      = ["TIMESTAMP"]
+  change_tracking                 = true
+  data_retention_time_in_days     = 2
+  max_data_extension_time_in_days = 10
+  comment                         = "comment"
+
+  row_access_policy {
+    policy_name = snowflake_row_access_policy.example.fully_qualified_name
+    on          = ["TIMESTAMP"]
+  }
+
+  tag {
+    name     = "tag_name"
+    value    = "tag_value"
+    database = "DATABASE"
+    schema   = "SCHEMA"
+  }
+}
@@ -0,0 +1,26 @@
+# basic resource
+resource "snowflake_execute_immediate_from" "basic" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+  branch         = "main"
+  file_path      = "deploy/setup.sql"
+}
+
+# complete resource
+resource "snowflake_execute_immediate_from" "complete" {
+  git_repository   = snowflake_git_repository.example.fully_qualified_name
+  tag              = "v1.0.0"
+  file_path        = "deploy/setup.sql"
+  revert_file_path = "deploy/teardown.sql"
+  using = {
+    environment    = "prod"
+    retention_days = "7"
+  }
+}
+
+# inline revert statement
+resource "snowflake_execute_immediate_from" "inline_revert" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+  commit         = "0123456789abcdef0123456789abcdef01234567"
+  file_path      = "deploy/create_database.sql"
+  revert         = "DROP DATABASE IF EXISTS ABC"
+}
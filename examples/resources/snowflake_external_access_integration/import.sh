@@ -0,0 +1 @@
+terraform import snowflake_external_access_integration.example '"<external_access_integration_name>"'
\ No newline at end of file
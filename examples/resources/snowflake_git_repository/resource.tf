@@ -16,4 +16,24 @@ resource "snowflake_git_repository" "complete" {
   api_integration = "API_INTEGRATION"
   git_credentials = snowflake_secret_with_basic_authentication.secret_name.fully_qualified_name
   comment         = "comment"
-}
\ No newline at end of file
+}
+
+# fetching the git repository on every apply
+resource "snowflake_git_repository" "fetch_on_apply" {
+  name            = "GIT_REPOSITORY"
+  database        = "DATABASE"
+  schema          = "SCHEMA"
+  origin          = "https://github.com/user/repo"
+  api_integration = "API_INTEGRATION"
+  fetch_on_apply  = true
+}
+
+# fetching the git repository on a change of the trigger (e.g. the commit hash pushed by the CI pipeline)
+resource "snowflake_git_repository" "fetch_trigger" {
+  name            = "GIT_REPOSITORY"
+  database        = "DATABASE"
+  schema          = "SCHEMA"
+  origin          = "https://github.com/user/repo"
+  api_integration = "API_INTEGRATION"
+  fetch_trigger   = var.commit_sha
+}
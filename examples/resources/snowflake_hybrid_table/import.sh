@@ -0,0 +1 @@
+terraform import snowflake_hybrid_table.example '"<db_name>"."<schema_name>"."<hybrid_table_name>"'
\ No newline at end of file
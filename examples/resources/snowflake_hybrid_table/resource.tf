@@ -0,0 +1,68 @@
+# basic resource
+resource "snowflake_hybrid_table" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "HYBRID_TABLE"
+
+  column {
+    name      = "id"
+    data_type = "NUMBER(38, 0)"
+  }
+
+  primary_key {
+    columns = ["id"]
+  }
+}
+
+# complete resource
+resource "snowflake_hybrid_table" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "ORDERS"
+
+  column {
+    name      = "id"
+    data_type = "NUMBER(38, 0)"
+  }
+  column {
+    name      = "customer_id"
+    data_type = "NUMBER(38, 0)"
+    nullable  = false
+  }
+  column {
+    name      = "status"
+    data_type = "VARCHAR(20)"
+    default   = "'NEW'"
+    collate   = "en-ci"
+    comment   = "order status"
+  }
+  column {
+    name      = "external_reference"
+    data_type = "VARCHAR(100)"
+  }
+
+  primary_key {
+    name    = "PK_ORDERS"
+    columns = ["id"]
+  }
+
+  unique_key {
+    name    = "UQ_ORDERS_EXTERNAL_REFERENCE"
+    columns = ["external_reference"]
+  }
+
+  foreign_key {
+    name               = "FK_ORDERS_CUSTOMER"
+    columns            = ["customer_id"]
+    references_table   = snowflake_hybrid_table.basic.fully_qualified_name
+    references_columns = ["id"]
+  }
+
+  index {
+    name    = "IDX_ORDERS_STATUS"
+    columns = ["status"]
+    include = ["customer_id"]
+  }
+
+  comment = "comment"
+}
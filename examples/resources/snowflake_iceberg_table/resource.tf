@@ -0,0 +1,45 @@
+# Snowflake-managed Iceberg table
+resource "snowflake_iceberg_table" "managed" {
+  database        = "DATABASE"
+  schema          = "SCHEMA"
+  name            = "ICEBERG_TABLE"
+  external_volume = snowflake_external_volume.volume.name
+  catalog         = "SNOWFLAKE"
+  base_location   = "iceberg_table/"
+
+  column {
+    name      = "id"
+    data_type = "NUMBER(38, 0)"
+    nullable  = false
+  }
+  column {
+    name      = "name"
+    data_type = "VARCHAR"
+    comment   = "column comment"
+  }
+
+  catalog_sync                 = snowflake_catalog_integration.polaris.name
+  storage_serialization_policy = "OPTIMIZED"
+  comment                      = "comment"
+}
+
+# Iceberg table using an external catalog (AWS Glue)
+resource "snowflake_iceberg_table" "glue" {
+  database           = "DATABASE"
+  schema             = "SCHEMA"
+  name               = "GLUE_ICEBERG_TABLE"
+  external_volume    = snowflake_external_volume.volume.name
+  catalog            = snowflake_catalog_integration.glue.name
+  catalog_table_name = "my_glue_table"
+  auto_refresh       = "true"
+}
+
+# Iceberg table created from Iceberg files in object storage
+resource "snowflake_iceberg_table" "object_store" {
+  database           = "DATABASE"
+  schema             = "SCHEMA"
+  name               = "OBJECT_STORE_ICEBERG_TABLE"
+  external_volume    = snowflake_external_volume.volume.name
+  catalog            = snowflake_catalog_integration.object_store.name
+  metadata_file_path = "path/to/metadata/v1.metadata.json"
+}
@@ -0,0 +1 @@
+terraform import snowflake_join_policy.example '"<db_name>"."<schema_name>"."<join_policy_name>"'
\ No newline at end of file
@@ -0,0 +1,37 @@
+# basic resource
+resource "snowflake_join_policy" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "JOIN_POLICY"
+  body     = "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)"
+}
+
+# complete resource
+resource "snowflake_join_policy" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "JOIN_POLICY"
+  body     = <<-EOT
+  CASE
+    WHEN IS_ROLE_IN_SESSION('ADMIN') THEN JOIN_CONSTRAINT(JOIN_REQUIRED => FALSE)
+    ELSE JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)
+  END
+  EOT
+  comment  = "comment"
+}
+
+# attaching the policy to a table
+resource "snowflake_table" "table" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "TABLE"
+
+  column {
+    name = "ID"
+    type = "NUMBER"
+  }
+
+  join_policy {
+    policy_name = snowflake_join_policy.basic.fully_qualified_name
+  }
+}
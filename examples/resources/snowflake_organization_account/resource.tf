@@ -0,0 +1,71 @@
+## Minimal
+resource "snowflake_organization_account" "minimal" {
+  name           = "ORGANIZATION_ACCOUNT_NAME"
+  admin_name     = var.admin_name
+  admin_password = var.admin_password
+  email          = var.email
+  edition        = "ENTERPRISE"
+}
+
+## Complete
+resource "snowflake_organization_account" "complete" {
+  name                 = "ORGANIZATION_ACCOUNT_NAME"
+  save_old_url         = "true"
+  admin_name           = var.admin_name
+  admin_password       = var.admin_password
+  first_name           = var.first_name
+  last_name            = var.last_name
+  email                = var.email
+  must_change_password = "false"
+  edition              = "ENTERPRISE"
+  region_group         = "PUBLIC"
+  region               = "AWS_US_WEST_2"
+  comment              = "some comment"
+}
+
+## With policies (set after the organization account is created, using a connection to it)
+provider "snowflake" {
+  profile = "orgadmin"
+
+  connections {
+    name    = "organization_account"
+    profile = "organization_account"
+  }
+}
+
+resource "snowflake_organization_account" "with_policies" {
+  connection_name = "organization_account"
+
+  name            = "ORGANIZATION_ACCOUNT_NAME"
+  admin_name      = var.admin_name
+  admin_password  = var.admin_password
+  email           = var.email
+  edition         = "ENTERPRISE"
+  password_policy = "\"<database_name>\".\"<schema_name>\".\"<password_policy_name>\""
+  session_policy  = "\"<database_name>\".\"<schema_name>\".\"<session_policy_name>\""
+}
+
+variable "admin_name" {
+  type      = string
+  sensitive = true
+}
+
+variable "email" {
+  type      = string
+  sensitive = true
+}
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "first_name" {
+  type      = string
+  sensitive = true
+}
+
+variable "last_name" {
+  type      = string
+  sensitive = true
+}
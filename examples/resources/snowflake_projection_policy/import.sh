@@ -0,0 +1 @@
+terraform import snowflake_projection_policy.example '"<db_name>"."<schema_name>"."<projection_policy_name>"'
\ No newline at end of file
@@ -0,0 +1,34 @@
+# basic resource
+resource "snowflake_projection_policy" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "PROJECTION_POLICY"
+  body     = "PROJECTION_CONSTRAINT(ALLOW => false)"
+}
+
+# complete resource
+resource "snowflake_projection_policy" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "PROJECTION_POLICY"
+  body     = <<-EOT
+  CASE
+    WHEN IS_ROLE_IN_SESSION('ADMIN') THEN PROJECTION_CONSTRAINT(ALLOW => true)
+    ELSE PROJECTION_CONSTRAINT(ALLOW => false)
+  END
+  EOT
+  comment  = "comment"
+}
+
+# attaching the policy to a table column
+resource "snowflake_table" "table" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "TABLE"
+
+  column {
+    name              = "SSN"
+    type              = "VARCHAR"
+    projection_policy = snowflake_projection_policy.basic.fully_qualified_name
+  }
+}
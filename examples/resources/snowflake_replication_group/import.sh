@@ -0,0 +1 @@
+terraform import snowflake_replication_group.example '"<replication_group_name>"'
\ No newline at end of file
@@ -0,0 +1,40 @@
+# basic resource
+resource "snowflake_replication_group" "basic" {
+  name         = "replication_group_name"
+  object_types = ["DATABASES"]
+}
+
+# complete resource
+resource "snowflake_replication_group" "complete" {
+  name                      = "replication_group_name"
+  object_types              = ["DATABASES", "SHARES", "INTEGRATIONS"]
+  allowed_accounts          = ["<org_name>.<target_account_name1>", "<org_name>.<target_account_name2>"]
+  allowed_databases         = [snowflake_database.example.name]
+  allowed_shares            = [snowflake_share.example.name]
+  allowed_integration_types = ["SECURITY INTEGRATIONS"]
+  ignore_edition_check      = true
+  replication_schedule {
+    cron {
+      expression = "0 0 10-20 * TUE,THU"
+      time_zone  = "UTC"
+    }
+
+    # replication_schedule could also be specified with interval instead of cron
+    # interval = 10
+  }
+}
+
+# secondary replication group in the target account
+provider "snowflake" {
+  alias = "target_account"
+}
+
+resource "snowflake_replication_group" "replica" {
+  provider = snowflake.target_account
+  name     = "replication_group_name"
+  from_replica {
+    organization_name   = "<org_name>"
+    source_account_name = "<source_account_name>"
+    name                = snowflake_replication_group.complete.name
+  }
+}
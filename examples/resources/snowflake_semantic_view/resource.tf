@@ -0,0 +1,67 @@
+# basic resource
+resource "snowflake_semantic_view" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "SEMANTIC_VIEW"
+
+  tables {
+    table_alias = "orders"
+    table_name  = "\"DATABASE\".\"SCHEMA\".\"ORDERS\""
+  }
+
+  metrics {
+    qualified_expression_name = "orders.order_count"
+    sql_expression            = "COUNT(orders.id)"
+  }
+}
+
+# complete resource
+resource "snowflake_semantic_view" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "SEMANTIC_VIEW"
+
+  tables {
+    table_alias = "orders"
+    table_name  = "\"DATABASE\".\"SCHEMA\".\"ORDERS\""
+    primary_key = ["ID"]
+    synonym     = ["sales"]
+    comment     = "orders table"
+  }
+
+  tables {
+    table_alias = "customers"
+    table_name  = "\"DATABASE\".\"SCHEMA\".\"CUSTOMERS\""
+    primary_key = ["ID"]
+    unique {
+      columns = ["NAME"]
+    }
+  }
+
+  relationships {
+    relationship_identifier = "orders_to_customers"
+    table_alias             = "orders"
+    columns                 = ["CUSTOMER_ID"]
+    referenced_table_alias  = "customers"
+    referenced_columns      = ["ID"]
+  }
+
+  facts {
+    qualified_expression_name = "orders.order_amount"
+    sql_expression            = "orders.amount"
+    comment                   = "amount of the order"
+  }
+
+  dimensions {
+    qualified_expression_name = "customers.customer_name"
+    sql_expression            = "customers.name"
+    synonym                   = ["client name"]
+  }
+
+  metrics {
+    qualified_expression_name = "orders.total_amount"
+    sql_expression            = "SUM(orders.order_amount)"
+  }
+
+  comment = "comment"
+}
@@ -0,0 +1 @@
+terraform import snowflake_session_policy.example '"<db_name>"."<schema_name>"."<session_policy_name>"'
\ No newline at end of file
@@ -0,0 +1,33 @@
+# basic resource
+resource "snowflake_session_policy" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "SESSION_POLICY"
+}
+
+# complete resource
+resource "snowflake_session_policy" "complete" {
+  database                     = "DATABASE"
+  schema                       = "SCHEMA"
+  name                         = "SESSION_POLICY"
+  session_idle_timeout_mins    = 30
+  session_ui_idle_timeout_mins = 60
+  allowed_secondary_roles      = [snowflake_account_role.role.name]
+  comment                      = "comment"
+}
+
+# allow all secondary roles
+resource "snowflake_session_policy" "all_secondary_roles" {
+  database                = "DATABASE"
+  schema                  = "SCHEMA"
+  name                    = "SESSION_POLICY"
+  allowed_secondary_roles = ["ALL"]
+}
+
+# allow no secondary roles
+resource "snowflake_session_policy" "no_secondary_roles" {
+  database                = "DATABASE"
+  schema                  = "SCHEMA"
+  name                    = "SESSION_POLICY"
+  allowed_secondary_roles = ["NONE"]
+}
@@ -0,0 +1,2 @@
+# format is <stage_name>|<path>|<file_name>, where the path can be empty for the files in the root of the stage
+terraform import snowflake_stage_file.example '"<database_name>"."<schema_name>"."<stage_name>"|libs/python|handler.py'
@@ -0,0 +1,38 @@
+# Upload a local file
+resource "snowflake_stage_file" "from_source" {
+  stage     = snowflake_stage.example.fully_qualified_name
+  path      = "libs/python"
+  file_name = "handler.py"
+  source    = "${path.module}/src/handler.py"
+}
+
+# Upload inline content
+resource "snowflake_stage_file" "from_content" {
+  stage     = snowflake_stage.example.fully_qualified_name
+  file_name = "spec.yaml"
+  content   = <<-EOT
+    spec:
+      containers:
+      - name: main
+        image: /db/schema/repository/image:latest
+  EOT
+}
+
+# Upload a compressed file
+resource "snowflake_stage_file" "compressed" {
+  stage         = snowflake_stage.example.fully_qualified_name
+  path          = "data"
+  file_name     = "data.csv"
+  source        = "${path.module}/data/data.csv"
+  auto_compress = true
+}
+
+# Reference the uploaded file
+resource "snowflake_function_python" "example" {
+  # ...
+  imports {
+    stage_location = snowflake_stage.example.fully_qualified_name
+    path_on_stage  = "libs/python/handler.py"
+  }
+  depends_on = [snowflake_stage_file.from_source]
+}
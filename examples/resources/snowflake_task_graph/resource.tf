@@ -0,0 +1,57 @@
+# basic resource
+resource "snowflake_task_graph" "basic" {
+  database = snowflake_database.database.name
+  schema   = snowflake_schema.schema.name
+  started  = false
+
+  root {
+    name          = "ROOT"
+    sql_statement = "SELECT 1"
+  }
+}
+
+# complete resource
+resource "snowflake_task_graph" "complete" {
+  database = snowflake_database.database.name
+  schema   = snowflake_schema.schema.name
+  started  = true
+
+  root {
+    name          = "ROOT"
+    sql_statement = "SELECT 1"
+    warehouse     = snowflake_warehouse.warehouse.name
+    schedule {
+      using_cron = "0 * * * * UTC"
+    }
+    config                      = "{\"environment\": \"prod\"}"
+    allow_overlapping_execution = false
+    error_integration           = snowflake_notification_integration.error_integration.name
+    comment                     = "root task"
+  }
+
+  task {
+    name          = "EXTRACT"
+    sql_statement = "CALL extract()"
+    after         = ["ROOT"]
+  }
+
+  task {
+    name          = "TRANSFORM"
+    sql_statement = "CALL transform()"
+    after         = ["EXTRACT"]
+    warehouse     = snowflake_warehouse.warehouse.name
+    when          = "SYSTEM$GET_PREDECESSOR_RETURN_VALUE('EXTRACT') = 'OK'"
+  }
+
+  task {
+    name          = "REPORT"
+    sql_statement = "CALL report()"
+    after         = ["EXTRACT", "TRANSFORM"]
+    comment       = "runs after both extract and transform"
+  }
+
+  finalizer {
+    name          = "CLEANUP"
+    sql_statement = "CALL cleanup()"
+  }
+}
@@ -0,0 +1,59 @@
+package tracking
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// queryTagMaxLength is the maximum length of the QUERY_TAG parameter value in Snowflake.
+const queryTagMaxLength = 2000
+
+// QueryTagConfig holds the static parts of the query tag, set in the provider configuration.
+type QueryTagConfig struct {
+	Tag       string
+	Workspace string
+	Module    string
+}
+
+// QueryTag is the value set as the QUERY_TAG session parameter for the statements run by the provider.
+// It contains the same information as the metadata appended to the statements, so the queries can be attributed
+// in QUERY_HISTORY without parsing the comments.
+type QueryTag struct {
+	Source     string    `json:"source"`
+	Version    string    `json:"version,omitempty"`
+	Resource   string    `json:"resource,omitempty"`
+	Datasource string    `json:"datasource,omitempty"`
+	Operation  Operation `json:"operation,omitempty"`
+	Workspace  string    `json:"workspace,omitempty"`
+	Module     string    `json:"module,omitempty"`
+	Tag        string    `json:"tag,omitempty"`
+}
+
+func NewQueryTag(config QueryTagConfig, metadata Metadata) QueryTag {
+	version := metadata.Version
+	if version == "" {
+		version = ProviderVersion
+	}
+	return QueryTag{
+		Source:     MetadataPrefix,
+		Version:    version,
+		Resource:   metadata.Resource,
+		Datasource: metadata.Datasource,
+		Operation:  metadata.Operation,
+		Workspace:  config.Workspace,
+		Module:     config.Module,
+		Tag:        config.Tag,
+	}
+}
+
+// String returns the query tag as JSON, so it can be parsed in Snowflake with PARSE_JSON(QUERY_TAG).
+func (q QueryTag) String() (string, error) {
+	bytes, err := json.Marshal(q)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the query tag: %w", err)
+	}
+	if len(bytes) > queryTagMaxLength {
+		return "", fmt.Errorf("query tag is longer than %d characters: %s", queryTagMaxLength, string(bytes))
+	}
+	return string(bytes), nil
+}
@@ -0,0 +1,38 @@
+package tracking
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryTag(t *testing.T) {
+	config := QueryTagConfig{Tag: "finops", Workspace: "prod", Module: "module.databases"}
+
+	t.Run("resource", func(t *testing.T) {
+		queryTag, err := NewQueryTag(config, newTestMetadata("123", resources.Database, CreateOperation)).String()
+		require.NoError(t, err)
+		assert.Equal(t, `{"source":"terraform_provider_usage_tracking","version":"123","resource":"snowflake_database","operation":"create","workspace":"prod","module":"module.databases","tag":"finops"}`, queryTag)
+	})
+
+	t.Run("data source", func(t *testing.T) {
+		queryTag, err := NewQueryTag(QueryTagConfig{Tag: "finops"}, NewVersionedDatasourceMetadata(datasources.Databases)).String()
+		require.NoError(t, err)
+		assert.Equal(t, `{"source":"terraform_provider_usage_tracking","version":"`+ProviderVersion+`","datasource":"snowflake_databases","operation":"read","tag":"finops"}`, queryTag)
+	})
+
+	t.Run("no metadata", func(t *testing.T) {
+		queryTag, err := NewQueryTag(config, Metadata{}).String()
+		require.NoError(t, err)
+		assert.Equal(t, `{"source":"terraform_provider_usage_tracking","version":"`+ProviderVersion+`","workspace":"prod","module":"module.databases","tag":"finops"}`, queryTag)
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		_, err := NewQueryTag(QueryTagConfig{Tag: strings.Repeat("a", queryTagMaxLength)}, Metadata{}).String()
+		require.ErrorContains(t, err, "query tag is longer than 2000 characters")
+	})
+}
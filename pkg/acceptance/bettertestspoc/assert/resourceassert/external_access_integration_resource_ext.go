@@ -0,0 +1,27 @@
+package resourceassert
+
+import (
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func (e *ExternalAccessIntegrationResourceAssert) HasAllowedNetworkRules(networkRules ...string) *ExternalAccessIntegrationResourceAssert {
+	return e.hasIdentifierSet("allowed_network_rules", networkRules)
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) HasAllowedApiAuthenticationIntegrations(integrations ...string) *ExternalAccessIntegrationResourceAssert {
+	return e.hasIdentifierSet("allowed_api_authentication_integrations", integrations)
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) HasAllowedAuthenticationSecrets(secrets ...string) *ExternalAccessIntegrationResourceAssert {
+	return e.hasIdentifierSet("allowed_authentication_secrets", secrets)
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) hasIdentifierSet(attributeName string, identifiers []string) *ExternalAccessIntegrationResourceAssert {
+	e.AddAssertion(assert.ValueSet(fmt.Sprintf("%s.#", attributeName), fmt.Sprintf("%d", len(identifiers))))
+	for _, identifier := range identifiers {
+		e.AddAssertion(assert.SetElem(fmt.Sprintf("%s.*", attributeName), identifier))
+	}
+	return e
+}
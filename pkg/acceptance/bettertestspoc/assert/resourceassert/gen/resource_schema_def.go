@@ -33,6 +33,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "AccountRole",
 		schema: resources.AccountRole().Schema,
 	},
+	{
+		name:   "AggregationPolicy",
+		schema: resources.AggregationPolicy().Schema,
+	},
 	{
 		name:   "ApiAuthenticationIntegrationWithAuthorizationCodeGrant",
 		schema: resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant().Schema,
@@ -41,6 +45,18 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ApiAuthenticationIntegrationWithClientCredentials",
 		schema: resources.ApiAuthenticationIntegrationWithClientCredentials().Schema,
 	},
+	{
+		name:   "Application",
+		schema: resources.Application().Schema,
+	},
+	{
+		name:   "ApplicationPackage",
+		schema: resources.ApplicationPackage().Schema,
+	},
+	{
+		name:   "CatalogIntegration",
+		schema: resources.CatalogIntegration().Schema,
+	},
 	{
 		name:   "ComputePool",
 		schema: resources.ComputePool().Schema,
@@ -53,6 +69,14 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "CurrentOrganizationAccount",
 		schema: resources.CurrentOrganizationAccount().Schema,
 	},
+	{
+		name:   "DataMetricFunction",
+		schema: resources.DataMetricFunction().Schema,
+	},
+	{
+		name:   "DataMetricFunctionAttachment",
+		schema: resources.DataMetricFunctionAttachment().Schema,
+	},
 	{
 		name:   "Database",
 		schema: resources.Database().Schema,
@@ -61,6 +85,14 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "DatabaseRole",
 		schema: resources.DatabaseRole().Schema,
 	},
+	{
+		name:   "EventTable",
+		schema: resources.EventTable().Schema,
+	},
+	{
+		name:   "ExternalAccessIntegration",
+		schema: resources.ExternalAccessIntegration().Schema,
+	},
 	{
 		name:   "ExternalVolume",
 		schema: resources.ExternalVolume().Schema,
@@ -97,10 +129,26 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "GitRepository",
 		schema: resources.GitRepository().Schema,
 	},
+	{
+		name:   "ExecuteImmediateFrom",
+		schema: resources.ExecuteImmediateFrom().Schema,
+	},
+	{
+		name:   "HybridTable",
+		schema: resources.HybridTable().Schema,
+	},
+	{
+		name:   "IcebergTable",
+		schema: resources.IcebergTable().Schema,
+	},
 	{
 		name:   "JobService",
 		schema: resources.JobService().Schema,
 	},
+	{
+		name:   "JoinPolicy",
+		schema: resources.JoinPolicy().Schema,
+	},
 	{
 		name:   "LegacyServiceUser",
 		schema: resources.LegacyServiceUser().Schema,
@@ -134,6 +182,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "OauthIntegrationForPartnerApplications",
 		schema: resources.OauthIntegrationForPartnerApplications().Schema,
 	},
+	{
+		name:   "OrganizationAccount",
+		schema: resources.OrganizationAccount().Schema,
+	},
 	{
 		name:   "PrimaryConnection",
 		schema: resources.PrimaryConnection().Schema,
@@ -158,6 +210,14 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ProcedureSql",
 		schema: resources.ProcedureSql().Schema,
 	},
+	{
+		name:   "ProjectionPolicy",
+		schema: resources.ProjectionPolicy().Schema,
+	},
+	{
+		name:   "ReplicationGroup",
+		schema: resources.ReplicationGroup().Schema,
+	},
 	{
 		name:   "ResourceMonitor",
 		schema: resources.ResourceMonitor().Schema,
@@ -202,6 +262,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "SecretWithGenericString",
 		schema: resources.SecretWithGenericString().Schema,
 	},
+	{
+		name:   "SemanticView",
+		schema: resources.SemanticView().Schema,
+	},
 	{
 		name:   "Service",
 		schema: resources.Service().Schema,
@@ -210,10 +274,18 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ServiceUser",
 		schema: resources.ServiceUser().Schema,
 	},
+	{
+		name:   "SessionPolicy",
+		schema: resources.SessionPolicy().Schema,
+	},
 	{
 		name:   "SharedDatabase",
 		schema: resources.SharedDatabase().Schema,
 	},
+	{
+		name:   "StageFile",
+		schema: resources.StageFile().Schema,
+	},
 	{
 		name:   "Streamlit",
 		schema: resources.Streamlit().Schema,
@@ -250,10 +322,18 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Task",
 		schema: resources.Task().Schema,
 	},
+	{
+		name:   "TaskGraph",
+		schema: resources.TaskGraph().Schema,
+	},
 	{
 		name:   "User",
 		schema: resources.User().Schema,
 	},
+	{
+		name:   "UserSessionPolicyAttachment",
+		schema: resources.UserSessionPolicyAttachment().Schema,
+	},
 	{
 		name:   "UserProgrammaticAccessToken",
 		schema: resources.UserProgrammaticAccessToken().Schema,
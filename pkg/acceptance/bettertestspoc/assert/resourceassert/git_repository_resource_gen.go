@@ -57,6 +57,16 @@ func (g *GitRepositoryResourceAssert) HasCommentString(expected string) *GitRepo
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasFetchOnApplyString(expected string) *GitRepositoryResourceAssert {
+	g.AddAssertion(assert.ValueSet("fetch_on_apply", expected))
+	return g
+}
+
+func (g *GitRepositoryResourceAssert) HasFetchTriggerString(expected string) *GitRepositoryResourceAssert {
+	g.AddAssertion(assert.ValueSet("fetch_trigger", expected))
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasFullyQualifiedNameString(expected string) *GitRepositoryResourceAssert {
 	g.AddAssertion(assert.ValueSet("fully_qualified_name", expected))
 	return g
@@ -101,6 +111,16 @@ func (g *GitRepositoryResourceAssert) HasNoComment() *GitRepositoryResourceAsser
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasNoFetchOnApply() *GitRepositoryResourceAssert {
+	g.AddAssertion(assert.ValueNotSet("fetch_on_apply"))
+	return g
+}
+
+func (g *GitRepositoryResourceAssert) HasNoFetchTrigger() *GitRepositoryResourceAssert {
+	g.AddAssertion(assert.ValueNotSet("fetch_trigger"))
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasNoFullyQualifiedName() *GitRepositoryResourceAssert {
 	g.AddAssertion(assert.ValueNotSet("fully_qualified_name"))
 	return g
@@ -125,6 +145,16 @@ func (g *GitRepositoryResourceAssert) HasCommentEmpty() *GitRepositoryResourceAs
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasFetchOnApplyEmpty() *GitRepositoryResourceAssert {
+	g.AddAssertion(assert.ValueSet("fetch_on_apply", ""))
+	return g
+}
+
+func (g *GitRepositoryResourceAssert) HasFetchTriggerEmpty() *GitRepositoryResourceAssert {
+	g.AddAssertion(assert.ValueSet("fetch_trigger", ""))
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasFullyQualifiedNameEmpty() *GitRepositoryResourceAssert {
 	g.AddAssertion(assert.ValueSet("fully_qualified_name", ""))
 	return g
@@ -164,6 +194,16 @@ func (g *GitRepositoryResourceAssert) HasCommentNotEmpty() *GitRepositoryResourc
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasFetchOnApplyNotEmpty() *GitRepositoryResourceAssert {
+	g.AddAssertion(assert.ValuePresent("fetch_on_apply"))
+	return g
+}
+
+func (g *GitRepositoryResourceAssert) HasFetchTriggerNotEmpty() *GitRepositoryResourceAssert {
+	g.AddAssertion(assert.ValuePresent("fetch_trigger"))
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasFullyQualifiedNameNotEmpty() *GitRepositoryResourceAssert {
 	g.AddAssertion(assert.ValuePresent("fully_qualified_name"))
 	return g
@@ -0,0 +1,15 @@
+package resourceassert
+
+import (
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func (s *SessionPolicyResourceAssert) HasAllowedSecondaryRoles(roles ...string) *SessionPolicyResourceAssert {
+	s.AddAssertion(assert.ValueSet("allowed_secondary_roles.#", fmt.Sprintf("%d", len(roles))))
+	for _, role := range roles {
+		s.AddAssertion(assert.SetElem("allowed_secondary_roles.*", role))
+	}
+	return s
+}
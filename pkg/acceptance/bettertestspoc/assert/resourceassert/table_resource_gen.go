@@ -47,6 +47,11 @@ func (t *TableResourceAssert) HasNameString(expected string) *TableResourceAsser
 	return t
 }
 
+func (t *TableResourceAssert) HasAggregationPolicyString(expected string) *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("aggregation_policy", expected))
+	return t
+}
+
 func (t *TableResourceAssert) HasChangeTrackingString(expected string) *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("change_tracking", expected))
 	return t
@@ -77,6 +82,11 @@ func (t *TableResourceAssert) HasFullyQualifiedNameString(expected string) *Tabl
 	return t
 }
 
+func (t *TableResourceAssert) HasJoinPolicyString(expected string) *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("join_policy", expected))
+	return t
+}
+
 func (t *TableResourceAssert) HasOwnerString(expected string) *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("owner", expected))
 	return t
@@ -140,6 +150,11 @@ func (t *TableResourceAssert) HasNoOwner() *TableResourceAssert {
 // Attribute empty checks //
 ////////////////////////////
 
+func (t *TableResourceAssert) HasAggregationPolicyEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("aggregation_policy.#", "0"))
+	return t
+}
+
 func (t *TableResourceAssert) HasChangeTrackingEmpty() *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("change_tracking", ""))
 	return t
@@ -165,6 +180,11 @@ func (t *TableResourceAssert) HasFullyQualifiedNameEmpty() *TableResourceAssert
 	return t
 }
 
+func (t *TableResourceAssert) HasJoinPolicyEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("join_policy.#", "0"))
+	return t
+}
+
 func (t *TableResourceAssert) HasOwnerEmpty() *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("owner", ""))
 	return t
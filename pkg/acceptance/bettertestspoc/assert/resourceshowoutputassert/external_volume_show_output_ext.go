@@ -0,0 +1,18 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+// ExternalVolumesDatasourceShowOutput is a temporary workaround to have better show output assertions in data source acceptance tests.
+func ExternalVolumesDatasourceShowOutput(t *testing.T, name string) *ExternalVolumeShowOutputAssert {
+	t.Helper()
+
+	e := ExternalVolumeShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "external_volumes.0."),
+	}
+	e.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &e
+}
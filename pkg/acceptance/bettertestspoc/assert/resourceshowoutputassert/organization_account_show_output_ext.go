@@ -0,0 +1,17 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func OrganizationAccountDatasourceShowOutput(t *testing.T, name string) *OrganizationAccountShowOutputAssert {
+	t.Helper()
+
+	o := OrganizationAccountShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "organization_accounts.0."),
+	}
+	o.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &o
+}
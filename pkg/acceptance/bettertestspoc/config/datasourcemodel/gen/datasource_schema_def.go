@@ -26,10 +26,26 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Accounts",
 		schema: datasources.Accounts().Schema,
 	},
+	{
+		name:   "AggregationPolicies",
+		schema: datasources.AggregationPolicies().Schema,
+	},
+	{
+		name:   "ApplicationPackages",
+		schema: datasources.ApplicationPackages().Schema,
+	},
+	{
+		name:   "Applications",
+		schema: datasources.Applications().Schema,
+	},
 	{
 		name:   "ComputePools",
 		schema: datasources.ComputePools().Schema,
 	},
+	{
+		name:   "DataMetricFunctionReferences",
+		schema: datasources.DataMetricFunctionReferences().Schema,
+	},
 	{
 		name:   "Database",
 		schema: datasources.Database().Schema,
@@ -46,6 +62,18 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Databases",
 		schema: datasources.Databases().Schema,
 	},
+	{
+		name:   "EventTables",
+		schema: datasources.EventTables().Schema,
+	},
+	{
+		name:   "ExternalAccessIntegrations",
+		schema: datasources.ExternalAccessIntegrations().Schema,
+	},
+	{
+		name:   "ExternalVolumes",
+		schema: datasources.ExternalVolumes().Schema,
+	},
 	{
 		name:   "Functions",
 		schema: datasources.Functions().Schema,
@@ -54,6 +82,18 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "GitRepositories",
 		schema: datasources.GitRepositories().Schema,
 	},
+	{
+		name:   "GitBranches",
+		schema: datasources.GitBranches().Schema,
+	},
+	{
+		name:   "GitCommits",
+		schema: datasources.GitCommits().Schema,
+	},
+	{
+		name:   "GitTags",
+		schema: datasources.GitTags().Schema,
+	},
 	{
 		name:   "Grants",
 		schema: datasources.Grants().Schema,
@@ -62,6 +102,14 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "ImageRepositories",
 		schema: datasources.ImageRepositories().Schema,
 	},
+	{
+		name:   "JoinPolicies",
+		schema: datasources.JoinPolicies().Schema,
+	},
+	{
+		name:   "Listings",
+		schema: datasources.Listings().Schema,
+	},
 	{
 		name:   "MaskingPolicies",
 		schema: datasources.MaskingPolicies().Schema,
@@ -70,10 +118,26 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "NetworkPolicies",
 		schema: datasources.NetworkPolicies().Schema,
 	},
+	{
+		name:   "NetworkRules",
+		schema: datasources.NetworkRules().Schema,
+	},
+	{
+		name:   "NotificationIntegrations",
+		schema: datasources.NotificationIntegrations().Schema,
+	},
+	{
+		name:   "OrganizationAccounts",
+		schema: datasources.OrganizationAccounts().Schema,
+	},
 	{
 		name:   "Procedures",
 		schema: datasources.Procedures().Schema,
 	},
+	{
+		name:   "ProjectionPolicies",
+		schema: datasources.ProjectionPolicies().Schema,
+	},
 	{
 		name:   "ResourceMonitors",
 		schema: datasources.ResourceMonitors().Schema,
@@ -90,10 +154,22 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "SecurityIntegrations",
 		schema: datasources.SecurityIntegrations().Schema,
 	},
+	{
+		name:   "SemanticViews",
+		schema: datasources.SemanticViews().Schema,
+	},
+	{
+		name:   "SessionPolicies",
+		schema: datasources.SessionPolicies().Schema,
+	},
 	{
 		name:   "Services",
 		schema: datasources.Services().Schema,
 	},
+	{
+		name:   "StageFiles",
+		schema: datasources.StageFiles().Schema,
+	},
 	{
 		name:   "Streamlits",
 		schema: datasources.Streamlits().Schema,
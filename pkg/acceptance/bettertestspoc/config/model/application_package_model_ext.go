@@ -0,0 +1,35 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+// WithVersion sets a single version block; every additional location is added as a consecutive patch of the version.
+func (a *ApplicationPackageModel) WithVersion(name string, using string, patchesUsing ...string) *ApplicationPackageModel {
+	return a.WithVersionValue(
+		tfconfig.ListVariable(
+			tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+				"name":  tfconfig.StringVariable(name),
+				"using": tfconfig.StringVariable(using),
+				"patch": tfconfig.ListVariable(
+					collections.Map(patchesUsing, func(patchUsing string) tfconfig.Variable {
+						return tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+							"using": tfconfig.StringVariable(patchUsing),
+						})
+					})...,
+				),
+			}),
+		),
+	)
+}
+
+func (a *ApplicationPackageModel) WithDefaultReleaseDirective(version string, patch int) *ApplicationPackageModel {
+	return a.WithDefaultReleaseDirectiveValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"version": tfconfig.StringVariable(version),
+			"patch":   tfconfig.IntegerVariable(patch),
+		}),
+	)
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+func (d *DataMetricFunctionAttachmentModel) WithOn(on []string) *DataMetricFunctionAttachmentModel {
+	return d.WithOnValue(tfconfig.ListVariable(collections.Map(on, func(column string) tfconfig.Variable { return tfconfig.StringVariable(column) })...))
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithDataMetricScheduleMinutes(minutes int) *DataMetricFunctionAttachmentModel {
+	return d.WithDataMetricScheduleValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"minutes": tfconfig.IntegerVariable(minutes),
+	}))
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithDataMetricScheduleUsingCron(cron string) *DataMetricFunctionAttachmentModel {
+	return d.WithDataMetricScheduleValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"using_cron": tfconfig.StringVariable(cron),
+	}))
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func DataMetricFunctionFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	argument []sdk.DataMetricFunctionTableArgumentRequest,
+	expression string,
+) *DataMetricFunctionModel {
+	d := &DataMetricFunctionModel{ResourceModelMeta: config.Meta(resourceName, resources.DataMetricFunction)}
+	d.WithDatabase(id.DatabaseName())
+	d.WithSchema(id.SchemaName())
+	d.WithName(id.Name())
+	d.WithArgument(argument)
+	d.WithExpression(expression)
+	return d
+}
+
+func (d *DataMetricFunctionModel) WithArgument(argument []sdk.DataMetricFunctionTableArgumentRequest) *DataMetricFunctionModel {
+	return d.WithArgumentValue(tfconfig.ListVariable(
+		collections.Map(argument, func(arg sdk.DataMetricFunctionTableArgumentRequest) tfconfig.Variable {
+			return tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+				"table_name": tfconfig.StringVariable(arg.TableName),
+				"column": tfconfig.ListVariable(
+					collections.Map(arg.Columns, func(column sdk.DataMetricFunctionColumnRequest) tfconfig.Variable {
+						return tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+							"name":      tfconfig.StringVariable(column.ColumnName),
+							"data_type": tfconfig.StringVariable(column.ColumnDataType.ToSql()),
+						})
+					})...,
+				),
+			})
+		})...,
+	))
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (e *EventTableModel) WithClusterBy(clusterBy ...string) *EventTableModel {
+	return e.WithClusterByValue(
+		tfconfig.ListVariable(
+			collections.Map(clusterBy, func(expression string) tfconfig.Variable { return tfconfig.StringVariable(expression) })...,
+		),
+	)
+}
+
+func (e *EventTableModel) WithRowAccessPolicy(rap sdk.SchemaObjectIdentifier, on string) *EventTableModel {
+	return e.WithRowAccessPolicyValue(
+		tfconfig.ObjectVariable(
+			map[string]tfconfig.Variable{
+				"policy_name": tfconfig.StringVariable(rap.FullyQualifiedName()),
+				"on":          tfconfig.SetVariable(tfconfig.StringVariable(on)),
+			},
+		),
+	)
+}
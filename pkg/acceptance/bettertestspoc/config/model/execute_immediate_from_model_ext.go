@@ -0,0 +1,14 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (e *ExecuteImmediateFromModel) WithUsing(using map[string]string) *ExecuteImmediateFromModel {
+	variables := make(map[string]tfconfig.Variable, len(using))
+	for k, v := range using {
+		variables[k] = tfconfig.StringVariable(v)
+	}
+	e.Using = tfconfig.MapVariable(variables)
+	return e
+}
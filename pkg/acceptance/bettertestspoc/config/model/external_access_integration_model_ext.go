@@ -0,0 +1,25 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+func (e *ExternalAccessIntegrationModel) WithAllowedNetworkRules(allowedNetworkRules []string) *ExternalAccessIntegrationModel {
+	return e.WithAllowedNetworkRulesValue(externalAccessIntegrationIdentifiersVariable(allowedNetworkRules))
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedApiAuthenticationIntegrations(allowedApiAuthenticationIntegrations []string) *ExternalAccessIntegrationModel {
+	return e.WithAllowedApiAuthenticationIntegrationsValue(externalAccessIntegrationIdentifiersVariable(allowedApiAuthenticationIntegrations))
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedAuthenticationSecrets(allowedAuthenticationSecrets []string) *ExternalAccessIntegrationModel {
+	return e.WithAllowedAuthenticationSecretsValue(externalAccessIntegrationIdentifiersVariable(allowedAuthenticationSecrets))
+}
+
+func externalAccessIntegrationIdentifiersVariable(identifiers []string) tfconfig.Variable {
+	return tfconfig.SetVariable(
+		collections.Map(identifiers, func(identifier string) tfconfig.Variable { return tfconfig.StringVariable(identifier) })...,
+	)
+}
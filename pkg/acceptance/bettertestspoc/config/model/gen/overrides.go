@@ -23,10 +23,14 @@ var multilineAttributesOverrides = map[string][]string{
 }
 
 var complexListAttributesOverrides = map[string]map[string]string{
-	"ExternalVolume":  {"storage_location": "sdk.ExternalVolumeStorageLocation"},
-	"MaskingPolicy":   {"argument": "sdk.TableColumnSignature"},
-	"RowAccessPolicy": {"argument": "sdk.TableColumnSignature"},
-	"TagAssociation":  {"object_identifiers": "sdk.ObjectIdentifier"},
+	"DataMetricFunction": {"argument": "sdk.DataMetricFunctionTableArgumentRequest"},
+	"ExternalVolume":     {"storage_location": "sdk.ExternalVolumeStorageLocation"},
+	"HybridTable":        {"column": "sdk.HybridTableColumnRequest", "primary_key": "sdk.Column"},
+	"MaskingPolicy":      {"argument": "sdk.TableColumnSignature"},
+	"RowAccessPolicy":    {"argument": "sdk.TableColumnSignature"},
+	"SemanticView":       {"tables": "sdk.SemanticViewTableRequest"},
+	"TagAssociation":     {"object_identifiers": "sdk.ObjectIdentifier"},
+	"TaskGraph":          {"root": "sdk.Task"},
 	// TODO [SNOW-1348114]: use better type for override (not null and default are currently not supported)
 	"Table": {"column": "sdk.TableColumnSignature"},
 }
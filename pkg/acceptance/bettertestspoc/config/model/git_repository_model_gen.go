@@ -17,6 +17,8 @@ type GitRepositoryModel struct {
 	Name               tfconfig.Variable `json:"name,omitempty"`
 	ApiIntegration     tfconfig.Variable `json:"api_integration,omitempty"`
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
+	FetchOnApply       tfconfig.Variable `json:"fetch_on_apply,omitempty"`
+	FetchTrigger       tfconfig.Variable `json:"fetch_trigger,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	GitCredentials     tfconfig.Variable `json:"git_credentials,omitempty"`
 	Origin             tfconfig.Variable `json:"origin,omitempty"`
@@ -117,6 +119,16 @@ func (g *GitRepositoryModel) WithComment(comment string) *GitRepositoryModel {
 	return g
 }
 
+func (g *GitRepositoryModel) WithFetchOnApply(fetchOnApply bool) *GitRepositoryModel {
+	g.FetchOnApply = tfconfig.BoolVariable(fetchOnApply)
+	return g
+}
+
+func (g *GitRepositoryModel) WithFetchTrigger(fetchTrigger string) *GitRepositoryModel {
+	g.FetchTrigger = tfconfig.StringVariable(fetchTrigger)
+	return g
+}
+
 func (g *GitRepositoryModel) WithFullyQualifiedName(fullyQualifiedName string) *GitRepositoryModel {
 	g.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
 	return g
@@ -161,6 +173,16 @@ func (g *GitRepositoryModel) WithCommentValue(value tfconfig.Variable) *GitRepos
 	return g
 }
 
+func (g *GitRepositoryModel) WithFetchOnApplyValue(value tfconfig.Variable) *GitRepositoryModel {
+	g.FetchOnApply = value
+	return g
+}
+
+func (g *GitRepositoryModel) WithFetchTriggerValue(value tfconfig.Variable) *GitRepositoryModel {
+	g.FetchTrigger = value
+	return g
+}
+
 func (g *GitRepositoryModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *GitRepositoryModel {
 	g.FullyQualifiedName = value
 	return g
@@ -0,0 +1,84 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func HybridTableFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	columns []sdk.HybridTableColumnRequest,
+	primaryKey []sdk.Column,
+) *HybridTableModel {
+	h := &HybridTableModel{ResourceModelMeta: config.Meta(resourceName, resources.HybridTable)}
+	h.WithDatabase(id.DatabaseName())
+	h.WithSchema(id.SchemaName())
+	h.WithName(id.Name())
+	h.WithColumn(columns)
+	h.WithPrimaryKey(primaryKey)
+	return h
+}
+
+func (h *HybridTableModel) WithColumn(columns []sdk.HybridTableColumnRequest) *HybridTableModel {
+	return h.WithColumnValue(tfconfig.ListVariable(
+		collections.Map(columns, func(column sdk.HybridTableColumnRequest) tfconfig.Variable {
+			variables := map[string]tfconfig.Variable{
+				"name":      tfconfig.StringVariable(column.Name),
+				"data_type": tfconfig.StringVariable(column.DataType.ToSql()),
+			}
+			if column.NotNull != nil {
+				variables["nullable"] = tfconfig.BoolVariable(!*column.NotNull)
+			}
+			if column.Default != nil {
+				variables["default"] = tfconfig.StringVariable(*column.Default)
+			}
+			if column.Collate != nil {
+				variables["collate"] = tfconfig.StringVariable(*column.Collate)
+			}
+			if column.Comment != nil {
+				variables["comment"] = tfconfig.StringVariable(*column.Comment)
+			}
+			return tfconfig.ObjectVariable(variables)
+		})...,
+	))
+}
+
+func (h *HybridTableModel) WithPrimaryKey(columns []sdk.Column) *HybridTableModel {
+	return h.WithPrimaryKeyValue(tfconfig.ListVariable(hybridTableKeyVariable(columns)))
+}
+
+func (h *HybridTableModel) WithUniqueKey(columns ...sdk.Column) *HybridTableModel {
+	return h.WithUniqueKeyValue(tfconfig.ListVariable(hybridTableKeyVariable(columns)))
+}
+
+func (h *HybridTableModel) WithIndexes(indexes ...sdk.HybridTableOutOfLineIndexRequest) *HybridTableModel {
+	return h.WithIndexValue(tfconfig.SetVariable(
+		collections.Map(indexes, func(index sdk.HybridTableOutOfLineIndexRequest) tfconfig.Variable {
+			variables := map[string]tfconfig.Variable{
+				"name":    tfconfig.StringVariable(index.Name),
+				"columns": hybridTableColumnsVariable(index.Columns),
+			}
+			if len(index.Include) > 0 {
+				variables["include"] = hybridTableColumnsVariable(index.Include)
+			}
+			return tfconfig.ObjectVariable(variables)
+		})...,
+	))
+}
+
+func hybridTableKeyVariable(columns []sdk.Column) tfconfig.Variable {
+	return tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"columns": hybridTableColumnsVariable(columns),
+	})
+}
+
+func hybridTableColumnsVariable(columns []sdk.Column) tfconfig.Variable {
+	return tfconfig.ListVariable(collections.Map(columns, func(column sdk.Column) tfconfig.Variable {
+		return tfconfig.StringVariable(column.Value)
+	})...)
+}
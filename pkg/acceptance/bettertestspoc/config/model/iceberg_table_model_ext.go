@@ -0,0 +1,39 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func IcebergTableFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+) *IcebergTableModel {
+	i := &IcebergTableModel{ResourceModelMeta: config.Meta(resourceName, resources.IcebergTable)}
+	i.WithDatabase(id.DatabaseName())
+	i.WithSchema(id.SchemaName())
+	i.WithName(id.Name())
+	return i
+}
+
+func (i *IcebergTableModel) WithColumns(columns ...sdk.IcebergTableColumnRequest) *IcebergTableModel {
+	return i.WithColumnValue(tfconfig.ListVariable(
+		collections.Map(columns, func(column sdk.IcebergTableColumnRequest) tfconfig.Variable {
+			variables := map[string]tfconfig.Variable{
+				"name":      tfconfig.StringVariable(column.Name),
+				"data_type": tfconfig.StringVariable(column.DataType.ToSql()),
+			}
+			if column.NotNull != nil {
+				variables["nullable"] = tfconfig.BoolVariable(!*column.NotNull)
+			}
+			if column.Comment != nil {
+				variables["comment"] = tfconfig.StringVariable(*column.Comment)
+			}
+			return tfconfig.ObjectVariable(variables)
+		})...,
+	))
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func ReplicationGroupWithObjectTypes(
+	resourceName string,
+	name string,
+	objectTypes []sdk.PluralObjectType,
+	allowedAccounts ...sdk.AccountIdentifier,
+) *ReplicationGroupModel {
+	return ReplicationGroup(resourceName, name).
+		WithObjectTypes(objectTypes...).
+		WithAllowedAccounts(allowedAccounts...)
+}
+
+func (r *ReplicationGroupModel) WithObjectTypes(objectTypes ...sdk.PluralObjectType) *ReplicationGroupModel {
+	return r.WithObjectTypesValue(tfconfig.SetVariable(
+		collections.Map(objectTypes, func(objectType sdk.PluralObjectType) tfconfig.Variable {
+			return tfconfig.StringVariable(string(objectType))
+		})...,
+	))
+}
+
+func (r *ReplicationGroupModel) WithAllowedAccounts(allowedAccounts ...sdk.AccountIdentifier) *ReplicationGroupModel {
+	return r.WithAllowedAccountsValue(tfconfig.SetVariable(
+		collections.Map(allowedAccounts, func(accountId sdk.AccountIdentifier) tfconfig.Variable {
+			return tfconfig.StringVariable(accountId.Name())
+		})...,
+	))
+}
+
+func (r *ReplicationGroupModel) WithAllowedDatabases(allowedDatabases ...sdk.AccountObjectIdentifier) *ReplicationGroupModel {
+	return r.WithAllowedDatabasesValue(replicationGroupIdentifiersVariable(allowedDatabases))
+}
+
+func (r *ReplicationGroupModel) WithAllowedShares(allowedShares ...sdk.AccountObjectIdentifier) *ReplicationGroupModel {
+	return r.WithAllowedSharesValue(replicationGroupIdentifiersVariable(allowedShares))
+}
+
+func (r *ReplicationGroupModel) WithReplicationScheduleInterval(interval int) *ReplicationGroupModel {
+	return r.WithReplicationScheduleValue(tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"interval": tfconfig.IntegerVariable(interval),
+	})))
+}
+
+func (r *ReplicationGroupModel) WithReplicationScheduleCron(expression string, timeZone string) *ReplicationGroupModel {
+	return r.WithReplicationScheduleValue(tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"cron": tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"expression": tfconfig.StringVariable(expression),
+			"time_zone":  tfconfig.StringVariable(timeZone),
+		})),
+	})))
+}
+
+func (r *ReplicationGroupModel) WithFromReplica(primaryId sdk.ExternalObjectIdentifier) *ReplicationGroupModel {
+	accountId := primaryId.AccountIdentifier()
+	return r.WithFromReplicaValue(tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"organization_name":   tfconfig.StringVariable(accountId.OrganizationName()),
+		"source_account_name": tfconfig.StringVariable(accountId.AccountName()),
+		"name":                tfconfig.StringVariable(primaryId.Name()),
+	})))
+}
+
+func replicationGroupIdentifiersVariable(identifiers []sdk.AccountObjectIdentifier) tfconfig.Variable {
+	return tfconfig.SetVariable(
+		collections.Map(identifiers, func(identifier sdk.AccountObjectIdentifier) tfconfig.Variable {
+			return tfconfig.StringVariable(identifier.Name())
+		})...,
+	)
+}
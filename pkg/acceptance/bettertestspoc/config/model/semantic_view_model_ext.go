@@ -0,0 +1,120 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func SemanticViewFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	tables []sdk.SemanticViewTableRequest,
+) *SemanticViewModel {
+	s := &SemanticViewModel{ResourceModelMeta: config.Meta(resourceName, resources.SemanticView)}
+	s.WithDatabase(id.DatabaseName())
+	s.WithSchema(id.SchemaName())
+	s.WithName(id.Name())
+	s.WithTables(tables)
+	return s
+}
+
+func semanticViewColumnsVariable(columns []sdk.Column) tfconfig.Variable {
+	return tfconfig.ListVariable(
+		collections.Map(columns, func(column sdk.Column) tfconfig.Variable {
+			return tfconfig.StringVariable(column.Value)
+		})...,
+	)
+}
+
+func semanticViewSynonymsVariable(synonyms []sdk.SemanticViewSynonym) tfconfig.Variable {
+	return tfconfig.SetVariable(
+		collections.Map(synonyms, func(synonym sdk.SemanticViewSynonym) tfconfig.Variable {
+			return tfconfig.StringVariable(synonym.Value)
+		})...,
+	)
+}
+
+func (s *SemanticViewModel) WithTables(tables []sdk.SemanticViewTableRequest) *SemanticViewModel {
+	return s.WithTablesValue(tfconfig.ListVariable(
+		collections.Map(tables, func(table sdk.SemanticViewTableRequest) tfconfig.Variable {
+			variables := map[string]tfconfig.Variable{
+				"table_name": tfconfig.StringVariable(table.TableName.FullyQualifiedName()),
+			}
+			if table.TableAlias != nil {
+				variables["table_alias"] = tfconfig.StringVariable(*table.TableAlias)
+			}
+			if len(table.PrimaryKey) > 0 {
+				variables["primary_key"] = semanticViewColumnsVariable(table.PrimaryKey)
+			}
+			if len(table.UniqueKeys) > 0 {
+				variables["unique"] = tfconfig.ListVariable(
+					collections.Map(table.UniqueKeys, func(uniqueKey sdk.SemanticViewUniqueKeyRequest) tfconfig.Variable {
+						return tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+							"columns": semanticViewColumnsVariable(uniqueKey.Unique),
+						})
+					})...,
+				)
+			}
+			if len(table.WithSynonyms) > 0 {
+				variables["synonym"] = semanticViewSynonymsVariable(table.WithSynonyms)
+			}
+			if table.Comment != nil {
+				variables["comment"] = tfconfig.StringVariable(*table.Comment)
+			}
+			return tfconfig.ObjectVariable(variables)
+		})...,
+	))
+}
+
+func (s *SemanticViewModel) WithRelationships(relationships []sdk.SemanticViewRelationshipRequest) *SemanticViewModel {
+	return s.WithRelationshipsValue(tfconfig.ListVariable(
+		collections.Map(relationships, func(relationship sdk.SemanticViewRelationshipRequest) tfconfig.Variable {
+			variables := map[string]tfconfig.Variable{
+				"table_alias":            tfconfig.StringVariable(relationship.TableAlias),
+				"columns":                semanticViewColumnsVariable(relationship.Columns),
+				"referenced_table_alias": tfconfig.StringVariable(relationship.RefTableAlias),
+			}
+			if relationship.RelationshipIdentifier != nil {
+				variables["relationship_identifier"] = tfconfig.StringVariable(*relationship.RelationshipIdentifier)
+			}
+			if len(relationship.RefColumns) > 0 {
+				variables["referenced_columns"] = semanticViewColumnsVariable(relationship.RefColumns)
+			}
+			return tfconfig.ObjectVariable(variables)
+		})...,
+	))
+}
+
+func semanticViewExpressionsVariable(expressions []sdk.SemanticViewExpressionRequest) tfconfig.Variable {
+	return tfconfig.ListVariable(
+		collections.Map(expressions, func(expression sdk.SemanticViewExpressionRequest) tfconfig.Variable {
+			variables := map[string]tfconfig.Variable{
+				"qualified_expression_name": tfconfig.StringVariable(expression.QualifiedExpressionName),
+				"sql_expression":            tfconfig.StringVariable(expression.SqlExpression),
+			}
+			if len(expression.WithSynonyms) > 0 {
+				variables["synonym"] = semanticViewSynonymsVariable(expression.WithSynonyms)
+			}
+			if expression.Comment != nil {
+				variables["comment"] = tfconfig.StringVariable(*expression.Comment)
+			}
+			return tfconfig.ObjectVariable(variables)
+		})...,
+	)
+}
+
+func (s *SemanticViewModel) WithFacts(facts []sdk.SemanticViewExpressionRequest) *SemanticViewModel {
+	return s.WithFactsValue(semanticViewExpressionsVariable(facts))
+}
+
+func (s *SemanticViewModel) WithDimensions(dimensions []sdk.SemanticViewExpressionRequest) *SemanticViewModel {
+	return s.WithDimensionsValue(semanticViewExpressionsVariable(dimensions))
+}
+
+func (s *SemanticViewModel) WithMetrics(metrics []sdk.SemanticViewExpressionRequest) *SemanticViewModel {
+	return s.WithMetricsValue(semanticViewExpressionsVariable(metrics))
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+func (s *SessionPolicyModel) WithAllowedSecondaryRoles(roles ...string) *SessionPolicyModel {
+	return s.WithAllowedSecondaryRolesValue(
+		tfconfig.SetVariable(
+			collections.Map(roles, func(role string) tfconfig.Variable { return tfconfig.StringVariable(role) })...,
+		),
+	)
+}
@@ -16,12 +16,14 @@ type TableModel struct {
 	Database                tfconfig.Variable `json:"database,omitempty"`
 	Schema                  tfconfig.Variable `json:"schema,omitempty"`
 	Name                    tfconfig.Variable `json:"name,omitempty"`
+	AggregationPolicy       tfconfig.Variable `json:"aggregation_policy,omitempty"`
 	ChangeTracking          tfconfig.Variable `json:"change_tracking,omitempty"`
 	ClusterBy               tfconfig.Variable `json:"cluster_by,omitempty"`
 	Column                  tfconfig.Variable `json:"column,omitempty"`
 	Comment                 tfconfig.Variable `json:"comment,omitempty"`
 	DataRetentionTimeInDays tfconfig.Variable `json:"data_retention_time_in_days,omitempty"`
 	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	JoinPolicy              tfconfig.Variable `json:"join_policy,omitempty"`
 	Owner                   tfconfig.Variable `json:"owner,omitempty"`
 	PrimaryKey              tfconfig.Variable `json:"primary_key,omitempty"`
 	Tag                     tfconfig.Variable `json:"tag,omitempty"`
@@ -108,6 +110,8 @@ func (t *TableModel) WithName(name string) *TableModel {
 	return t
 }
 
+// aggregation_policy attribute type is not yet supported, so WithAggregationPolicy can't be generated
+
 func (t *TableModel) WithChangeTracking(changeTracking bool) *TableModel {
 	t.ChangeTracking = tfconfig.BoolVariable(changeTracking)
 	return t
@@ -132,6 +136,8 @@ func (t *TableModel) WithFullyQualifiedName(fullyQualifiedName string) *TableMod
 	return t
 }
 
+// join_policy attribute type is not yet supported, so WithJoinPolicy can't be generated
+
 func (t *TableModel) WithOwner(owner string) *TableModel {
 	t.Owner = tfconfig.StringVariable(owner)
 	return t
@@ -160,6 +166,11 @@ func (t *TableModel) WithNameValue(value tfconfig.Variable) *TableModel {
 	return t
 }
 
+func (t *TableModel) WithAggregationPolicyValue(value tfconfig.Variable) *TableModel {
+	t.AggregationPolicy = value
+	return t
+}
+
 func (t *TableModel) WithChangeTrackingValue(value tfconfig.Variable) *TableModel {
 	t.ChangeTracking = value
 	return t
@@ -190,6 +201,11 @@ func (t *TableModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *Table
 	return t
 }
 
+func (t *TableModel) WithJoinPolicyValue(value tfconfig.Variable) *TableModel {
+	t.JoinPolicy = value
+	return t
+}
+
 func (t *TableModel) WithOwnerValue(value tfconfig.Variable) *TableModel {
 	t.Owner = value
 	return t
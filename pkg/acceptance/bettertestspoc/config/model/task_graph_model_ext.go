@@ -0,0 +1,75 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// taskGraphTaskVariables returns the common attributes of the root task, child tasks, and the finalizer task.
+// The task is described by sdk.Task: Definition is used as sql_statement, Condition as when, and Predecessors (by name) as after.
+func taskGraphTaskVariables(task sdk.Task) map[string]tfconfig.Variable {
+	m := map[string]tfconfig.Variable{
+		"name":          tfconfig.StringVariable(task.Name),
+		"sql_statement": tfconfig.StringVariable(task.Definition),
+	}
+	if task.Warehouse != nil {
+		m["warehouse"] = tfconfig.StringVariable(task.Warehouse.Name())
+	}
+	if task.Condition != "" {
+		m["when"] = tfconfig.StringVariable(task.Condition)
+	}
+	if task.Comment != "" {
+		m["comment"] = tfconfig.StringVariable(task.Comment)
+	}
+	return m
+}
+
+func (t *TaskGraphModel) WithRoot(root []sdk.Task) *TaskGraphModel {
+	maps := make([]tfconfig.Variable, len(root))
+	for i, task := range root {
+		m := taskGraphTaskVariables(task)
+		if task.Schedule != "" {
+			if schedule, err := sdk.ParseTaskSchedule(task.Schedule); err == nil {
+				if schedule.Cron != "" {
+					m["schedule"] = tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{"using_cron": tfconfig.StringVariable(schedule.Cron)}))
+				} else {
+					m["schedule"] = tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{"minutes": tfconfig.IntegerVariable(schedule.Minutes)}))
+				}
+			}
+		}
+		if task.Config != "" {
+			m["config"] = tfconfig.StringVariable(task.Config)
+		}
+		if task.AllowOverlappingExecution {
+			m["allow_overlapping_execution"] = tfconfig.BoolVariable(true)
+		}
+		if task.ErrorIntegration != nil {
+			m["error_integration"] = tfconfig.StringVariable(task.ErrorIntegration.Name())
+		}
+		maps[i] = tfconfig.MapVariable(m)
+	}
+	t.Root = tfconfig.ListVariable(maps...)
+	return t
+}
+
+func (t *TaskGraphModel) WithTasks(tasks ...sdk.Task) *TaskGraphModel {
+	maps := make([]tfconfig.Variable, len(tasks))
+	for i, task := range tasks {
+		m := taskGraphTaskVariables(task)
+		m["after"] = tfconfig.SetVariable(collections.Map(task.Predecessors, func(id sdk.SchemaObjectIdentifier) tfconfig.Variable {
+			return tfconfig.StringVariable(id.Name())
+		})...)
+		maps[i] = tfconfig.MapVariable(m)
+	}
+	t.Task = tfconfig.SetVariable(maps...)
+	return t
+}
+
+func (t *TaskGraphModel) WithFinalizer(finalizer sdk.Task) *TaskGraphModel {
+	m := taskGraphTaskVariables(finalizer)
+	delete(m, "when")
+	t.Finalizer = tfconfig.ListVariable(tfconfig.MapVariable(m))
+	return t
+}
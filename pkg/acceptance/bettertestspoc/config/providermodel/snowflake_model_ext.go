@@ -130,3 +130,12 @@ func PatConfig(h helpers.TmpServiceUserWithPat) *SnowflakeModel {
 		WithAccountName(h.AccountId.AccountName()).
 		WithWarehouseId(h.WarehouseId)
 }
+
+func (m *SnowflakeModel) WithQueryTag(tag string, workspace string, module string) *SnowflakeModel {
+	m.QueryTag = tfconfig.ListVariable(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"tag":       tfconfig.StringVariable(tag),
+		"workspace": tfconfig.StringVariable(workspace),
+		"module":    tfconfig.StringVariable(module),
+	}))
+	return m
+}
@@ -15,10 +15,12 @@ type SnowflakeModel struct {
 	ClientRequestMfaToken              tfconfig.Variable `json:"client_request_mfa_token,omitempty"`
 	ClientStoreTemporaryCredential     tfconfig.Variable `json:"client_store_temporary_credential,omitempty"`
 	ClientTimeout                      tfconfig.Variable `json:"client_timeout,omitempty"`
+	Connections                        tfconfig.Variable `json:"connections,omitempty"`
 	DisableConsoleLogin                tfconfig.Variable `json:"disable_console_login,omitempty"`
 	DisableQueryContextCache           tfconfig.Variable `json:"disable_query_context_cache,omitempty"`
 	DisableTelemetry                   tfconfig.Variable `json:"disable_telemetry,omitempty"`
 	DriverTracing                      tfconfig.Variable `json:"driver_tracing,omitempty"`
+	EnableShowResultCache              tfconfig.Variable `json:"enable_show_result_cache,omitempty"`
 	ExternalBrowserTimeout             tfconfig.Variable `json:"external_browser_timeout,omitempty"`
 	Host                               tfconfig.Variable `json:"host,omitempty"`
 	IncludeRetryReason                 tfconfig.Variable `json:"include_retry_reason,omitempty"`
@@ -27,8 +29,10 @@ type SnowflakeModel struct {
 	JwtExpireTimeout                   tfconfig.Variable `json:"jwt_expire_timeout,omitempty"`
 	KeepSessionAlive                   tfconfig.Variable `json:"keep_session_alive,omitempty"`
 	LoginTimeout                       tfconfig.Variable `json:"login_timeout,omitempty"`
+	MaxRetries                         tfconfig.Variable `json:"max_retries,omitempty"`
 	MaxRetryCount                      tfconfig.Variable `json:"max_retry_count,omitempty"`
 	OcspFailOpen                       tfconfig.Variable `json:"ocsp_fail_open,omitempty"`
+	OidcTokenFilePath                  tfconfig.Variable `json:"oidc_token_file_path,omitempty"`
 	OktaUrl                            tfconfig.Variable `json:"okta_url,omitempty"`
 	OrganizationName                   tfconfig.Variable `json:"organization_name,omitempty"`
 	Params                             tfconfig.Variable `json:"params,omitempty"`
@@ -41,9 +45,12 @@ type SnowflakeModel struct {
 	PrivateKeyPassphrase               tfconfig.Variable `json:"private_key_passphrase,omitempty"`
 	Profile                            tfconfig.Variable `json:"profile,omitempty"`
 	Protocol                           tfconfig.Variable `json:"protocol,omitempty"`
+	QueryTag                           tfconfig.Variable `json:"query_tag,omitempty"`
 	RequestTimeout                     tfconfig.Variable `json:"request_timeout,omitempty"`
+	RetryBackoff                       tfconfig.Variable `json:"retry_backoff,omitempty"`
 	Role                               tfconfig.Variable `json:"role,omitempty"`
 	SkipTomlFilePermissionVerification tfconfig.Variable `json:"skip_toml_file_permission_verification,omitempty"`
+	SqlPreview                         tfconfig.Variable `json:"sql_preview,omitempty"`
 	TmpDirectoryPath                   tfconfig.Variable `json:"tmp_directory_path,omitempty"`
 	Token                              tfconfig.Variable `json:"token,omitempty"`
 	TokenAccessor                      tfconfig.Variable `json:"token_accessor,omitempty"`
@@ -51,6 +58,8 @@ type SnowflakeModel struct {
 	User                               tfconfig.Variable `json:"user,omitempty"`
 	ValidateDefaultParameters          tfconfig.Variable `json:"validate_default_parameters,omitempty"`
 	Warehouse                          tfconfig.Variable `json:"warehouse,omitempty"`
+	WorkloadIdentityEntraResource      tfconfig.Variable `json:"workload_identity_entra_resource,omitempty"`
+	WorkloadIdentityProvider           tfconfig.Variable `json:"workload_identity_provider,omitempty"`
 
 	*config.ProviderModelMeta
 }
@@ -105,6 +114,8 @@ func (s *SnowflakeModel) WithClientTimeout(clientTimeout int) *SnowflakeModel {
 	return s
 }
 
+// connections attribute type is not yet supported, so WithConnections can't be generated
+
 func (s *SnowflakeModel) WithDisableConsoleLogin(disableConsoleLogin string) *SnowflakeModel {
 	s.DisableConsoleLogin = tfconfig.StringVariable(disableConsoleLogin)
 	return s
@@ -125,6 +136,11 @@ func (s *SnowflakeModel) WithDriverTracing(driverTracing string) *SnowflakeModel
 	return s
 }
 
+func (s *SnowflakeModel) WithEnableShowResultCache(enableShowResultCache bool) *SnowflakeModel {
+	s.EnableShowResultCache = tfconfig.BoolVariable(enableShowResultCache)
+	return s
+}
+
 func (s *SnowflakeModel) WithExternalBrowserTimeout(externalBrowserTimeout int) *SnowflakeModel {
 	s.ExternalBrowserTimeout = tfconfig.IntegerVariable(externalBrowserTimeout)
 	return s
@@ -165,6 +181,11 @@ func (s *SnowflakeModel) WithLoginTimeout(loginTimeout int) *SnowflakeModel {
 	return s
 }
 
+func (s *SnowflakeModel) WithMaxRetries(maxRetries int) *SnowflakeModel {
+	s.MaxRetries = tfconfig.IntegerVariable(maxRetries)
+	return s
+}
+
 func (s *SnowflakeModel) WithMaxRetryCount(maxRetryCount int) *SnowflakeModel {
 	s.MaxRetryCount = tfconfig.IntegerVariable(maxRetryCount)
 	return s
@@ -175,6 +196,11 @@ func (s *SnowflakeModel) WithOcspFailOpen(ocspFailOpen string) *SnowflakeModel {
 	return s
 }
 
+func (s *SnowflakeModel) WithOidcTokenFilePath(oidcTokenFilePath string) *SnowflakeModel {
+	s.OidcTokenFilePath = tfconfig.StringVariable(oidcTokenFilePath)
+	return s
+}
+
 func (s *SnowflakeModel) WithOktaUrl(oktaUrl string) *SnowflakeModel {
 	s.OktaUrl = tfconfig.StringVariable(oktaUrl)
 	return s
@@ -229,11 +255,18 @@ func (s *SnowflakeModel) WithProtocol(protocol string) *SnowflakeModel {
 	return s
 }
 
+// query_tag attribute type is not yet supported, so WithQueryTag can't be generated
+
 func (s *SnowflakeModel) WithRequestTimeout(requestTimeout int) *SnowflakeModel {
 	s.RequestTimeout = tfconfig.IntegerVariable(requestTimeout)
 	return s
 }
 
+func (s *SnowflakeModel) WithRetryBackoff(retryBackoff int) *SnowflakeModel {
+	s.RetryBackoff = tfconfig.IntegerVariable(retryBackoff)
+	return s
+}
+
 func (s *SnowflakeModel) WithRole(role string) *SnowflakeModel {
 	s.Role = tfconfig.StringVariable(role)
 	return s
@@ -244,6 +277,11 @@ func (s *SnowflakeModel) WithSkipTomlFilePermissionVerification(skipTomlFilePerm
 	return s
 }
 
+func (s *SnowflakeModel) WithSqlPreview(sqlPreview bool) *SnowflakeModel {
+	s.SqlPreview = tfconfig.BoolVariable(sqlPreview)
+	return s
+}
+
 func (s *SnowflakeModel) WithTmpDirectoryPath(tmpDirectoryPath string) *SnowflakeModel {
 	s.TmpDirectoryPath = tfconfig.StringVariable(tmpDirectoryPath)
 	return s
@@ -276,6 +314,16 @@ func (s *SnowflakeModel) WithWarehouse(warehouse string) *SnowflakeModel {
 	return s
 }
 
+func (s *SnowflakeModel) WithWorkloadIdentityEntraResource(workloadIdentityEntraResource string) *SnowflakeModel {
+	s.WorkloadIdentityEntraResource = tfconfig.StringVariable(workloadIdentityEntraResource)
+	return s
+}
+
+func (s *SnowflakeModel) WithWorkloadIdentityProvider(workloadIdentityProvider string) *SnowflakeModel {
+	s.WorkloadIdentityProvider = tfconfig.StringVariable(workloadIdentityProvider)
+	return s
+}
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -310,6 +358,11 @@ func (s *SnowflakeModel) WithClientTimeoutValue(value tfconfig.Variable) *Snowfl
 	return s
 }
 
+func (s *SnowflakeModel) WithConnectionsValue(value tfconfig.Variable) *SnowflakeModel {
+	s.Connections = value
+	return s
+}
+
 func (s *SnowflakeModel) WithDisableConsoleLoginValue(value tfconfig.Variable) *SnowflakeModel {
 	s.DisableConsoleLogin = value
 	return s
@@ -330,6 +383,11 @@ func (s *SnowflakeModel) WithDriverTracingValue(value tfconfig.Variable) *Snowfl
 	return s
 }
 
+func (s *SnowflakeModel) WithEnableShowResultCacheValue(value tfconfig.Variable) *SnowflakeModel {
+	s.EnableShowResultCache = value
+	return s
+}
+
 func (s *SnowflakeModel) WithExternalBrowserTimeoutValue(value tfconfig.Variable) *SnowflakeModel {
 	s.ExternalBrowserTimeout = value
 	return s
@@ -370,6 +428,11 @@ func (s *SnowflakeModel) WithLoginTimeoutValue(value tfconfig.Variable) *Snowfla
 	return s
 }
 
+func (s *SnowflakeModel) WithMaxRetriesValue(value tfconfig.Variable) *SnowflakeModel {
+	s.MaxRetries = value
+	return s
+}
+
 func (s *SnowflakeModel) WithMaxRetryCountValue(value tfconfig.Variable) *SnowflakeModel {
 	s.MaxRetryCount = value
 	return s
@@ -380,6 +443,11 @@ func (s *SnowflakeModel) WithOcspFailOpenValue(value tfconfig.Variable) *Snowfla
 	return s
 }
 
+func (s *SnowflakeModel) WithOidcTokenFilePathValue(value tfconfig.Variable) *SnowflakeModel {
+	s.OidcTokenFilePath = value
+	return s
+}
+
 func (s *SnowflakeModel) WithOktaUrlValue(value tfconfig.Variable) *SnowflakeModel {
 	s.OktaUrl = value
 	return s
@@ -440,11 +508,21 @@ func (s *SnowflakeModel) WithProtocolValue(value tfconfig.Variable) *SnowflakeMo
 	return s
 }
 
+func (s *SnowflakeModel) WithQueryTagValue(value tfconfig.Variable) *SnowflakeModel {
+	s.QueryTag = value
+	return s
+}
+
 func (s *SnowflakeModel) WithRequestTimeoutValue(value tfconfig.Variable) *SnowflakeModel {
 	s.RequestTimeout = value
 	return s
 }
 
+func (s *SnowflakeModel) WithRetryBackoffValue(value tfconfig.Variable) *SnowflakeModel {
+	s.RetryBackoff = value
+	return s
+}
+
 func (s *SnowflakeModel) WithRoleValue(value tfconfig.Variable) *SnowflakeModel {
 	s.Role = value
 	return s
@@ -455,6 +533,11 @@ func (s *SnowflakeModel) WithSkipTomlFilePermissionVerificationValue(value tfcon
 	return s
 }
 
+func (s *SnowflakeModel) WithSqlPreviewValue(value tfconfig.Variable) *SnowflakeModel {
+	s.SqlPreview = value
+	return s
+}
+
 func (s *SnowflakeModel) WithTmpDirectoryPathValue(value tfconfig.Variable) *SnowflakeModel {
 	s.TmpDirectoryPath = value
 	return s
@@ -489,3 +572,13 @@ func (s *SnowflakeModel) WithWarehouseValue(value tfconfig.Variable) *SnowflakeM
 	s.Warehouse = value
 	return s
 }
+
+func (s *SnowflakeModel) WithWorkloadIdentityEntraResourceValue(value tfconfig.Variable) *SnowflakeModel {
+	s.WorkloadIdentityEntraResource = value
+	return s
+}
+
+func (s *SnowflakeModel) WithWorkloadIdentityProviderValue(value tfconfig.Variable) *SnowflakeModel {
+	s.WorkloadIdentityProvider = value
+	return s
+}
@@ -2,14 +2,12 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/require"
 )
 
-// TODO(SNOW-1564954): change raw sqls to proper client
 type AggregationPolicyClient struct {
 	context *TestClientContext
 	ids     *IdsGenerator
@@ -22,18 +20,53 @@ func NewAggregationPolicyClient(context *TestClientContext, idsGenerator *IdsGen
 	}
 }
 
-func (c *AggregationPolicyClient) client() *sdk.Client {
-	return c.context.client
+func (c *AggregationPolicyClient) client() sdk.AggregationPolicies {
+	return c.context.client.AggregationPolicies
 }
 
 func (c *AggregationPolicyClient) CreateAggregationPolicy(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
+	t.Helper()
+	id := c.ids.RandomSchemaObjectIdentifier()
+	aggregationPolicy, cleanup := c.CreateAggregationPolicyWithOptions(t, sdk.NewCreateAggregationPolicyRequest(id, "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"))
+	return aggregationPolicy.ID(), cleanup
+}
+
+func (c *AggregationPolicyClient) CreateAggregationPolicyWithOptions(t *testing.T, request *sdk.CreateAggregationPolicyRequest) (*sdk.AggregationPolicy, func()) {
 	t.Helper()
 	ctx := context.Background()
 
-	id := c.ids.RandomSchemaObjectIdentifier()
-	_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`CREATE AGGREGATION POLICY %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)`, id.FullyQualifiedName()))
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	aggregationPolicy, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return aggregationPolicy, c.DropAggregationPolicyFunc(t, request.GetName())
+}
+
+func (c *AggregationPolicyClient) Alter(t *testing.T, req *sdk.AlterAggregationPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
+func (c *AggregationPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) *sdk.AggregationPolicyDescription {
+	t.Helper()
+	ctx := context.Background()
+
+	aggregationPolicyDescription, err := c.client().Describe(ctx, id)
 	require.NoError(t, err)
-	return id, c.DropAggregationPolicyFunc(t, id)
+
+	return aggregationPolicyDescription
+}
+
+func (c *AggregationPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.AggregationPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
 }
 
 func (c *AggregationPolicyClient) DropAggregationPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
@@ -41,7 +74,7 @@ func (c *AggregationPolicyClient) DropAggregationPolicyFunc(t *testing.T, id sdk
 	ctx := context.Background()
 
 	return func() {
-		_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`DROP AGGREGATION POLICY IF EXISTS %s`, id.FullyQualifiedName()))
+		err := c.client().Drop(ctx, sdk.NewDropAggregationPolicyRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
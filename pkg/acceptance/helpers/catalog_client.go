@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -21,32 +20,51 @@ func NewCatalogIntegrationClient(context *TestClientContext, idsGenerator *IdsGe
 	}
 }
 
-func (c *CatalogIntegrationClient) exec(sql string) error {
-	ctx := context.Background()
-	_, err := c.context.client.ExecForTests(ctx, sql)
-	return err
+func (c *CatalogIntegrationClient) client() sdk.CatalogIntegrations {
+	return c.context.client.CatalogIntegrations
 }
 
-// TODO(SNOW-999142): Use SDK implementation for Catalog once it's available
 func (c *CatalogIntegrationClient) Create(t *testing.T) (sdk.AccountObjectIdentifier, func()) {
 	t.Helper()
 	id := c.ids.RandomAccountObjectIdentifier()
-	err := c.exec(fmt.Sprintf(`
-create catalog integration %s
-  catalog_source=object_store
-  table_format=iceberg
-  enabled=true
-`, id.FullyQualifiedName()))
+	catalogIntegration, cleanup := c.CreateWithRequest(t, sdk.NewCreateCatalogIntegrationRequest(id, sdk.CatalogIntegrationCatalogSourceObjectStore, sdk.CatalogIntegrationTableFormatIceberg, true))
+	return catalogIntegration.ID(), cleanup
+}
+
+func (c *CatalogIntegrationClient) CreateWithRequest(t *testing.T, request *sdk.CreateCatalogIntegrationRequest) (*sdk.CatalogIntegration, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	catalogIntegration, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return catalogIntegration, c.DropFunc(t, request.GetName())
+}
+
+func (c *CatalogIntegrationClient) Alter(t *testing.T, request *sdk.AlterCatalogIntegrationRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
 	require.NoError(t, err)
+}
 
-	return id, c.DropFunc(t, id)
+func (c *CatalogIntegrationClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.CatalogIntegration, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
 }
 
 func (c *CatalogIntegrationClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
 	t.Helper()
+	ctx := context.Background()
 
 	return func() {
-		err := c.exec(fmt.Sprintf(`drop catalog integration if exists %s`, id.FullyQualifiedName()))
+		err := c.client().Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
@@ -48,3 +48,24 @@ func (c *DataMetricFunctionClient) DropDataMetricFunctionFunc(t *testing.T, id s
 		require.NoError(t, err)
 	}
 }
+
+func (c *DataMetricFunctionClient) Set(t *testing.T, id sdk.SchemaObjectIdentifier, set sdk.DataMetricFunctionSetRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	dataMetricFunction, err := c.client().DataMetricFunctions.ShowByID(ctx, id)
+	require.NoError(t, err)
+	signature, err := dataMetricFunction.Signature()
+	require.NoError(t, err)
+
+	err = c.client().DataMetricFunctions.Alter(ctx, sdk.NewAlterDataMetricFunctionRequest(id, signature).WithSet(set))
+	require.NoError(t, err)
+}
+
+func (c *DataMetricFunctionClient) AlterOnObject(t *testing.T, req *sdk.AlterOnObjectDataMetricFunctionRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().DataMetricFunctions.AlterOnObject(ctx, req)
+	require.NoError(t, err)
+}
@@ -56,6 +56,18 @@ func (c *ExternalVolumeClient) Create(t *testing.T) (sdk.AccountObjectIdentifier
 	return id, c.DropFunc(t, id)
 }
 
+// CreateWithStorageLocation creates an external volume pointing to the given (usually real) storage location, so that it can be used by objects writing data to it, like Iceberg tables.
+func (c *ExternalVolumeClient) CreateWithStorageLocation(t *testing.T, storageLocation sdk.ExternalVolumeStorageLocation) (sdk.AccountObjectIdentifier, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	err := c.client().Create(ctx, sdk.NewCreateExternalVolumeRequest(id, []sdk.ExternalVolumeStorageLocation{storageLocation}).WithAllowWrites(true))
+	require.NoError(t, err)
+
+	return id, c.DropFunc(t, id)
+}
+
 func (c *ExternalVolumeClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.ExternalVolume, error) {
 	t.Helper()
 	ctx := context.Background()
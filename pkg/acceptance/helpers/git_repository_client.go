@@ -65,3 +65,24 @@ func (c *GitRepositoryClient) Alter(t *testing.T, req *sdk.AlterGitRepositoryReq
 	err := c.client().Alter(ctx, req)
 	require.NoError(t, err)
 }
+
+func (c *GitRepositoryClient) ShowGitBranches(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.GitBranch {
+	t.Helper()
+	ctx := context.Background()
+
+	branches, err := c.client().ShowGitBranches(ctx, sdk.NewShowGitBranchesGitRepositoryRequest(id).WithGitRepository(true))
+	require.NoError(t, err)
+	return branches
+}
+
+func (c *GitRepositoryClient) BranchCommitHash(t *testing.T, id sdk.SchemaObjectIdentifier, branch string) string {
+	t.Helper()
+
+	for _, b := range c.ShowGitBranches(t, id) {
+		if b.Name == branch {
+			return b.CommitHash
+		}
+	}
+	t.Fatalf("branch %s not found in git repository %s", branch, id.FullyQualifiedName())
+	return ""
+}
@@ -2,10 +2,10 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,31 +21,86 @@ func NewHybridTableClient(context *TestClientContext, idsGenerator *IdsGenerator
 	}
 }
 
-func (c *HybridTableClient) exec(sql string) error {
-	ctx := context.Background()
-	_, err := c.context.client.ExecForTests(ctx, sql)
-	return err
+func (c *HybridTableClient) client() sdk.HybridTables {
+	return c.context.client.HybridTables
 }
 
-// TODO(SNOW-999142): Use SDK implementation for Hybrid Table once it's available
+// Create creates a hybrid table with a single ID column being its primary key.
 func (c *HybridTableClient) Create(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
+	numberDataType, err := datatypes.ParseDataType("NUMBER")
+	require.NoError(t, err)
+
 	id := c.ids.RandomSchemaObjectIdentifier()
-	err := c.exec(fmt.Sprintf(`
-create hybrid table %s (
-  id INT AUTOINCREMENT PRIMARY KEY
-)
-`, id.FullyQualifiedName()))
+	request := sdk.NewCreateHybridTableRequest(id, *sdk.NewHybridTableColumnsConstraintsAndIndexesRequest(
+		[]sdk.HybridTableColumnRequest{*sdk.NewHybridTableColumnRequest("ID", numberDataType)},
+	).WithOutOfLineConstraints([]sdk.HybridTableOutOfLineConstraintRequest{
+		*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.ColumnConstraintTypePrimaryKey, []sdk.Column{{Value: "ID"}}),
+	}))
+	hybridTable, cleanup := c.CreateWithRequest(t, request)
+	return hybridTable.ID(), cleanup
+}
+
+func (c *HybridTableClient) CreateWithRequest(t *testing.T, request *sdk.CreateHybridTableRequest) (*sdk.HybridTable, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	hybridTable, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return hybridTable, c.DropFunc(t, request.GetName())
+}
+
+func (c *HybridTableClient) Alter(t *testing.T, request *sdk.AlterHybridTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *HybridTableClient) CreateIndex(t *testing.T, request *sdk.CreateIndexHybridTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().CreateIndex(ctx, request)
 	require.NoError(t, err)
+}
 
-	return id, c.DropFunc(t, id)
+func (c *HybridTableClient) DropIndex(t *testing.T, indexId sdk.TableColumnIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().DropIndex(ctx, sdk.NewDropIndexHybridTableRequest(indexId))
+	require.NoError(t, err)
+}
+
+func (c *HybridTableClient) ShowIndexes(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.HybridTableIndex {
+	t.Helper()
+	ctx := context.Background()
+
+	indexes, err := c.client().ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithIn(id))
+	require.NoError(t, err)
+
+	return indexes
+}
+
+func (c *HybridTableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.HybridTable, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
 }
 
 func (c *HybridTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
 	t.Helper()
+	ctx := context.Background()
 
 	return func() {
-		err := c.exec(fmt.Sprintf(`drop table if exists %s`, id.FullyQualifiedName()))
+		err := c.client().Drop(ctx, sdk.NewDropHybridTableRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/stretchr/testify/require"
+)
+
+type IcebergTableClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewIcebergTableClient(context *TestClientContext, idsGenerator *IdsGenerator) *IcebergTableClient {
+	return &IcebergTableClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *IcebergTableClient) client() sdk.IcebergTables {
+	return c.context.client.IcebergTables
+}
+
+// CreateManaged creates a Snowflake-managed Iceberg table with a single column in the given external volume.
+func (c *IcebergTableClient) CreateManaged(t *testing.T, externalVolumeId sdk.AccountObjectIdentifier) (*sdk.IcebergTable, func()) {
+	t.Helper()
+	numberDataType, err := datatypes.ParseDataType("NUMBER")
+	require.NoError(t, err)
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	request := sdk.NewCreateIcebergTableRequest(id).
+		WithColumns([]sdk.IcebergTableColumnRequest{*sdk.NewIcebergTableColumnRequest("id", numberDataType)}).
+		WithExternalVolume(externalVolumeId).
+		WithCatalog(sdk.NewAccountObjectIdentifier("SNOWFLAKE")).
+		WithBaseLocation(id.Name())
+	return c.CreateWithRequest(t, request)
+}
+
+func (c *IcebergTableClient) CreateWithRequest(t *testing.T, request *sdk.CreateIcebergTableRequest) (*sdk.IcebergTable, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	icebergTable, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return icebergTable, c.DropFunc(t, request.GetName())
+}
+
+func (c *IcebergTableClient) Alter(t *testing.T, request *sdk.AlterIcebergTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *IcebergTableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.IcebergTable, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *IcebergTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropIcebergTableRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,80 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type JoinPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewJoinPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *JoinPolicyClient {
+	return &JoinPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *JoinPolicyClient) client() sdk.JoinPolicies {
+	return c.context.client.JoinPolicies
+}
+
+func (c *JoinPolicyClient) CreateJoinPolicy(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
+	t.Helper()
+	id := c.ids.RandomSchemaObjectIdentifier()
+	joinPolicy, cleanup := c.CreateJoinPolicyWithOptions(t, sdk.NewCreateJoinPolicyRequest(id, "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)"))
+	return joinPolicy.ID(), cleanup
+}
+
+func (c *JoinPolicyClient) CreateJoinPolicyWithOptions(t *testing.T, request *sdk.CreateJoinPolicyRequest) (*sdk.JoinPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	joinPolicy, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return joinPolicy, c.DropJoinPolicyFunc(t, request.GetName())
+}
+
+func (c *JoinPolicyClient) Alter(t *testing.T, req *sdk.AlterJoinPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
+func (c *JoinPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) *sdk.JoinPolicyDescription {
+	t.Helper()
+	ctx := context.Background()
+
+	joinPolicyDescription, err := c.client().Describe(ctx, id)
+	require.NoError(t, err)
+
+	return joinPolicyDescription
+}
+
+func (c *JoinPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.JoinPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *JoinPolicyClient) DropJoinPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropJoinPolicyRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
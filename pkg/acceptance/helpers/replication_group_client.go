@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type ReplicationGroupClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewReplicationGroupClient(context *TestClientContext, idsGenerator *IdsGenerator) *ReplicationGroupClient {
+	return &ReplicationGroupClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *ReplicationGroupClient) client() sdk.ReplicationGroups {
+	return c.context.client.ReplicationGroups
+}
+
+func (c *ReplicationGroupClient) CreateReplicationGroup(t *testing.T) (*sdk.ReplicationGroup, func()) {
+	t.Helper()
+	objectTypes := []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}
+	accountID := c.ids.AccountIdentifierWithLocator()
+	allowedAccounts := []sdk.AccountIdentifier{accountID}
+	return c.CreateReplicationGroupWithOptions(t, objectTypes, allowedAccounts, nil)
+}
+
+func (c *ReplicationGroupClient) CreateReplicationGroupWithOptions(t *testing.T, objectTypes []sdk.PluralObjectType, allowedAccounts []sdk.AccountIdentifier, opts *sdk.CreateReplicationGroupOptions) (*sdk.ReplicationGroup, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+
+	err := c.client().Create(ctx, id, objectTypes, allowedAccounts, opts)
+	require.NoError(t, err)
+
+	replicationGroup, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return replicationGroup, c.DropReplicationGroupFunc(t, id)
+}
+
+func (c *ReplicationGroupClient) AlterSource(t *testing.T, id sdk.AccountObjectIdentifier, opts *sdk.AlterSourceReplicationGroupOptions) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().AlterSource(ctx, id, opts)
+	require.NoError(t, err)
+}
+
+func (c *ReplicationGroupClient) DropReplicationGroupFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, id, &sdk.DropReplicationGroupOptions{IfExists: sdk.Bool(true)})
+		require.NoError(t, err)
+	}
+}
+
+func (c *ReplicationGroupClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.ReplicationGroup, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
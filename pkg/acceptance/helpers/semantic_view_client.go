@@ -0,0 +1,84 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type SemanticViewClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewSemanticViewClient(context *TestClientContext, idsGenerator *IdsGenerator) *SemanticViewClient {
+	return &SemanticViewClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *SemanticViewClient) client() sdk.SemanticViews {
+	return c.context.client.SemanticViews
+}
+
+func (c *SemanticViewClient) CreateSemanticView(t *testing.T, tableId sdk.SchemaObjectIdentifier) (*sdk.SemanticView, func()) {
+	t.Helper()
+	id := c.ids.RandomSchemaObjectIdentifier()
+	request := sdk.NewCreateSemanticViewRequest(id, []sdk.SemanticViewTableRequest{
+		*sdk.NewSemanticViewTableRequest(tableId).WithTableAlias("t1"),
+	}).WithMetrics([]sdk.SemanticViewExpressionRequest{
+		*sdk.NewSemanticViewExpressionRequest("t1.row_count", "COUNT(*)"),
+	})
+	return c.CreateSemanticViewWithRequest(t, request)
+}
+
+func (c *SemanticViewClient) CreateSemanticViewWithRequest(t *testing.T, request *sdk.CreateSemanticViewRequest) (*sdk.SemanticView, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	semanticView, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return semanticView, c.DropSemanticViewFunc(t, request.GetName())
+}
+
+func (c *SemanticViewClient) Alter(t *testing.T, req *sdk.AlterSemanticViewRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
+func (c *SemanticViewClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.SemanticViewDetails {
+	t.Helper()
+	ctx := context.Background()
+
+	details, err := c.client().Describe(ctx, id)
+	require.NoError(t, err)
+
+	return details
+}
+
+func (c *SemanticViewClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.SemanticView, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *SemanticViewClient) DropSemanticViewFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropSemanticViewRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
@@ -43,12 +43,37 @@ func (c *SessionPolicyClient) CreateSessionPolicyWithOptions(t *testing.T, id sd
 	return sessionPolicy, c.DropSessionPolicyFunc(t, id)
 }
 
+func (c *SessionPolicyClient) Alter(t *testing.T, req *sdk.AlterSessionPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
+func (c *SessionPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) *sdk.SessionPolicyDescription {
+	t.Helper()
+	ctx := context.Background()
+
+	sessionPolicyDescription, err := c.client().Describe(ctx, id)
+	require.NoError(t, err)
+
+	return sessionPolicyDescription
+}
+
+func (c *SessionPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.SessionPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
 func (c *SessionPolicyClient) DropSessionPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
 	t.Helper()
 	ctx := context.Background()
 
 	return func() {
-		err := c.client().Drop(ctx, sdk.NewDropSessionPolicyRequest(id).WithIfExists(sdk.Bool(true)))
+		err := c.client().Drop(ctx, sdk.NewDropSessionPolicyRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
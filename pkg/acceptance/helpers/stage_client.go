@@ -177,6 +177,15 @@ func (c *StageClient) PutOnStageWithContent(t *testing.T, id sdk.SchemaObjectIde
 	})
 }
 
+func (c *StageClient) ListFiles(t *testing.T, id sdk.SchemaObjectIdentifier, path string) []sdk.StageFile {
+	t.Helper()
+	ctx := context.Background()
+
+	files, err := c.context.client.StageFiles.List(ctx, sdk.NewStageLocation(id, path), nil)
+	require.NoError(t, err)
+	return files
+}
+
 func (c *StageClient) CopyIntoTableFromFile(t *testing.T, table, stage sdk.SchemaObjectIdentifier, filename string) {
 	t.Helper()
 	ctx := context.Background()
@@ -132,6 +132,14 @@ func (c *TableClient) SetDataRetentionTime(t *testing.T, id sdk.SchemaObjectIden
 	require.NoError(t, err)
 }
 
+func (c *TableClient) Alter(t *testing.T, req *sdk.AlterTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
 // GetTableColumnsFor is based on https://docs.snowflake.com/en/sql-reference/info-schema/columns.
 // TODO: extract getting table columns as resource (like getting tag in system functions)
 func (c *TableClient) GetTableColumnsFor(t *testing.T, tableId sdk.SchemaObjectIdentifier) []InformationSchemaColumns {
@@ -44,8 +44,10 @@ type TestClient struct {
 	GitRepository                *GitRepositoryClient
 	Grant                        *GrantClient
 	HybridTable                  *HybridTableClient
+	IcebergTable                 *IcebergTableClient
 	ImageRepository              *ImageRepositoryClient
 	InformationSchema            *InformationSchemaClient
+	JoinPolicy                   *JoinPolicyClient
 	Listing                      *ListingClient
 	MaskingPolicy                *MaskingPolicyClient
 	MaterializedView             *MaterializedViewClient
@@ -60,12 +62,14 @@ type TestClient struct {
 	Procedure                    *ProcedureClient
 	ProjectionPolicy             *ProjectionPolicyClient
 	PolicyReferences             *PolicyReferencesClient
+	ReplicationGroup             *ReplicationGroupClient
 	ResourceMonitor              *ResourceMonitorClient
 	Role                         *RoleClient
 	RowAccessPolicy              *RowAccessPolicyClient
 	Schema                       *SchemaClient
 	Secret                       *SecretClient
 	SecurityIntegration          *SecurityIntegrationClient
+	SemanticView                 *SemanticViewClient
 	Service                      *ServiceClient
 	Sequence                     *SequenceClient
 	SessionPolicy                *SessionPolicyClient
@@ -128,8 +132,10 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 		GitRepository:                NewGitRepositoryClient(context, idsGenerator),
 		Grant:                        NewGrantClient(context, idsGenerator),
 		HybridTable:                  NewHybridTableClient(context, idsGenerator),
+		IcebergTable:                 NewIcebergTableClient(context, idsGenerator),
 		ImageRepository:              NewImageRepositoryClient(context, idsGenerator),
 		InformationSchema:            NewInformationSchemaClient(context, idsGenerator),
+		JoinPolicy:                   NewJoinPolicyClient(context, idsGenerator),
 		Listing:                      NewListingClient(context, idsGenerator),
 		MaskingPolicy:                NewMaskingPolicyClient(context, idsGenerator),
 		MaterializedView:             NewMaterializedViewClient(context, idsGenerator),
@@ -144,12 +150,14 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 		Procedure:                    NewProcedureClient(context, idsGenerator),
 		ProjectionPolicy:             NewProjectionPolicyClient(context, idsGenerator),
 		PolicyReferences:             NewPolicyReferencesClient(context),
+		ReplicationGroup:             NewReplicationGroupClient(context, idsGenerator),
 		ResourceMonitor:              NewResourceMonitorClient(context, idsGenerator),
 		Role:                         NewRoleClient(context, idsGenerator),
 		RowAccessPolicy:              NewRowAccessPolicyClient(context, idsGenerator),
 		Schema:                       NewSchemaClient(context, idsGenerator),
 		Secret:                       NewSecretClient(context, idsGenerator),
 		SecurityIntegration:          NewSecurityIntegrationClient(context, idsGenerator),
+		SemanticView:                 NewSemanticViewClient(context, idsGenerator),
 		Snapshot:                     NewSnapshotClient(context, idsGenerator),
 		Service:                      NewServiceClient(context, idsGenerator),
 		Sequence:                     NewSequenceClient(context, idsGenerator),
@@ -1,3 +1,11 @@
 package testvars
 
 var ExampleGitRepositoryOrigin = "https://github.com/octocat/hello-world"
+
+// ProviderGitRepositoryOrigin points to this repository; its SQL files are executed in the git repository file tests.
+var ProviderGitRepositoryOrigin = "https://github.com/Snowflake-Labs/terraform-provider-snowflake"
+
+var (
+	ExecuteImmediateFromCreateTableFilePath = "pkg/testacc/testdata/git_repository_files/create_table.sql"
+	ExecuteImmediateFromDropTableFilePath   = "pkg/testacc/testdata/git_repository_files/drop_table.sql"
+)
@@ -0,0 +1,94 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationsSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESC APPLICATION for each application returned by SHOW APPLICATIONS. The output of describe is saved to the description field. By default this value is set to true.",
+	},
+	"like":        likeSchema,
+	"starts_with": startsWithSchema,
+	"limit":       limitFromSchema,
+	"applications": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all applications details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW APPLICATIONS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowApplicationSchema,
+					},
+				},
+				resources.DescribeOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE APPLICATION.",
+					Elem: &schema.Resource{
+						Schema: schemas.ApplicationDescribeSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func Applications() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.ApplicationsDatasource), TrackingReadWrapper(datasources.Applications, ReadApplications)),
+		Schema:      applicationsSchema,
+		Description: "Data source used to get details of filtered applications. Filtering is aligned with the current possibilities for [SHOW APPLICATIONS](https://docs.snowflake.com/en/sql-reference/sql/show-applications) query. The results of SHOW and DESCRIBE are encapsulated in one output collection `applications`.",
+	}
+}
+
+func ReadApplications(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowApplicationRequest{}
+
+	handleLike(d, &req.Like)
+	handleStartsWith(d, &req.StartsWith)
+	handleLimitFrom(d, &req.Limit)
+
+	applications, err := client.Applications.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("applications_read")
+
+	flattenedApplications := make([]map[string]any, len(applications))
+	for i, application := range applications {
+		var applicationProperties []map[string]any
+		if d.Get("with_describe").(bool) {
+			properties, err := client.Applications.Describe(ctx, application.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			applicationProperties = schemas.ApplicationPropertiesToSchema(properties)
+		}
+		flattenedApplications[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{schemas.ApplicationToSchema(&application)},
+			resources.DescribeOutputAttributeName: applicationProperties,
+		}
+	}
+	if err := d.Set("applications", flattenedApplications); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
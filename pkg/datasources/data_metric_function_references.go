@@ -0,0 +1,81 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dataMetricFunctionReferencesSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: resources.StringInSlice(collections.Map(sdk.AllDataMetricFunctionReferenceObjectTypes, func(o sdk.ObjectType) string { return o.String() }), true),
+		Description:      fmt.Sprintf("Type of the object for which the data metric function references are listed. Valid values are (case-insensitive): %s.", docs.PossibleValuesListed(sdk.AllDataMetricFunctionReferenceObjectTypes)),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		Description:      "Fully qualified name of the object for which the data metric function references are listed.",
+	},
+	"data_metric_function_references": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of DATA_METRIC_FUNCTION_REFERENCES for the given object.",
+		Elem: &schema.Resource{
+			Schema: schemas.DataMetricFunctionReferenceSchema,
+		},
+	},
+}
+
+func DataMetricFunctionReferences() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.DataMetricFunctionReferencesDatasource), TrackingReadWrapper(datasources.DataMetricFunctionReferences, ReadDataMetricFunctionReferences)),
+		Schema:      dataMetricFunctionReferencesSchema,
+		Description: "Data source used to get data metric functions attached to the given object. It is based on the [DATA_METRIC_FUNCTION_REFERENCES](https://docs.snowflake.com/en/sql-reference/functions/data_metric_function_references) table function.",
+	}
+}
+
+func ReadDataMetricFunctionReferences(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, err := sdk.ToObjectType(d.Get("object_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectId, err := sdk.ParseSchemaObjectIdentifier(d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	domain, err := sdk.DataMetricFunctionRefEntityDomainForObjectType(objectType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	references, err := client.DataMetricFunctionReferences.GetForEntity(ctx, sdk.NewGetForEntityDataMetricFunctionReferenceRequest(objectId, domain))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("data_metric_function_references_read")
+
+	flattenedReferences := make([]map[string]any, len(references))
+	for i, reference := range references {
+		reference := reference
+		flattenedReferences[i] = schemas.DataMetricFunctionReferenceToSchema(&reference)
+	}
+	if err := d.Set("data_metric_function_references", flattenedReferences); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitBranchesSchema = map[string]*schema.Schema{
+	"git_repository": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		Description:      "Fully qualified name of the git repository to list the branches from.",
+	},
+	"like": likeSchema,
+	"branches": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all git branches details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW GIT BRANCHES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowGitBranchSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func GitBranches() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.GitBranchesDatasource), TrackingReadWrapper(datasources.GitBranches, ReadGitBranches)),
+		Schema:      gitBranchesSchema,
+		Description: "Data source used to get details of the branches of a git repository, as of its last fetch. Filtering is aligned with the current possibilities for [SHOW GIT BRANCHES](https://docs.snowflake.com/en/sql-reference/sql/show-git-branches) query. The results of SHOW are encapsulated in one output collection `branches`.",
+	}
+}
+
+func ReadGitBranches(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Get("git_repository").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := sdk.NewShowGitBranchesGitRepositoryRequest(id).WithGitRepository(true)
+
+	handleLike(d, &req.Like)
+
+	branches, err := client.GitRepositories.ShowGitBranches(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("git_branches_read")
+
+	flattenedBranches := make([]map[string]any, len(branches))
+	for i, branch := range branches {
+		branch := branch
+		flattenedBranches[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.GitBranchToSchema(&branch)},
+		}
+	}
+	if err := d.Set("branches", flattenedBranches); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
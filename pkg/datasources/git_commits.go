@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitCommitsSchema = map[string]*schema.Schema{
+	"git_repository": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		Description:      "Fully qualified name of the git repository to list the commits from.",
+	},
+	"commits": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the commits pointed to by the branches and tags of the git repository. Each commit is listed once, in the order of SHOW GIT BRANCHES and SHOW GIT TAGS.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"commit_hash": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Hash of the commit. It can be used to reference the files of the commit, e.g. `@<repository>/commits/<commit_hash>/<path>`.",
+				},
+				"branches": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Names of the branches pointing to the commit.",
+				},
+				"tags": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Names of the tags pointing to the commit.",
+				},
+			},
+		},
+	},
+}
+
+func GitCommits() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.GitCommitsDatasource), TrackingReadWrapper(datasources.GitCommits, ReadGitCommits)),
+		Schema:      gitCommitsSchema,
+		Description: "Data source used to get the commits of a git repository pointed to by its branches and tags, as of the last fetch of the repository. The commits are aggregated from the [SHOW GIT BRANCHES](https://docs.snowflake.com/en/sql-reference/sql/show-git-branches) and [SHOW GIT TAGS](https://docs.snowflake.com/en/sql-reference/sql/show-git-tags) queries.",
+	}
+}
+
+func ReadGitCommits(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Get("git_repository").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	branches, err := client.GitRepositories.ShowGitBranches(ctx, sdk.NewShowGitBranchesGitRepositoryRequest(id).WithGitRepository(true))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tags, err := client.GitRepositories.ShowGitTags(ctx, sdk.NewShowGitTagsGitRepositoryRequest(id).WithGitRepository(true))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("git_commits_read")
+
+	var commitHashes []string
+	commitRefs := make(map[string]map[string][]string)
+	addRef := func(commitHash string, refType string, name string) {
+		if _, ok := commitRefs[commitHash]; !ok {
+			commitHashes = append(commitHashes, commitHash)
+			commitRefs[commitHash] = map[string][]string{"branches": {}, "tags": {}}
+		}
+		commitRefs[commitHash][refType] = append(commitRefs[commitHash][refType], name)
+	}
+	for _, branch := range branches {
+		addRef(branch.CommitHash, "branches", branch.Name)
+	}
+	for _, tag := range tags {
+		addRef(tag.CommitHash, "tags", tag.Name)
+	}
+
+	flattenedCommits := make([]map[string]any, len(commitHashes))
+	for i, commitHash := range commitHashes {
+		flattenedCommits[i] = map[string]any{
+			"commit_hash": commitHash,
+			"branches":    commitRefs[commitHash]["branches"],
+			"tags":        commitRefs[commitHash]["tags"],
+		}
+	}
+	if err := d.Set("commits", flattenedCommits); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
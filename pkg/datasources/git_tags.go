@@ -0,0 +1,78 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitTagsSchema = map[string]*schema.Schema{
+	"git_repository": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		Description:      "Fully qualified name of the git repository to list the tags from.",
+	},
+	"like": likeSchema,
+	"tags": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all git tags details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW GIT TAGS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowGitTagSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func GitTags() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.GitTagsDatasource), TrackingReadWrapper(datasources.GitTags, ReadGitTags)),
+		Schema:      gitTagsSchema,
+		Description: "Data source used to get details of the tags of a git repository, as of its last fetch. Filtering is aligned with the current possibilities for [SHOW GIT TAGS](https://docs.snowflake.com/en/sql-reference/sql/show-git-tags) query. The results of SHOW are encapsulated in one output collection `tags`.",
+	}
+}
+
+func ReadGitTags(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Get("git_repository").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := sdk.NewShowGitTagsGitRepositoryRequest(id).WithGitRepository(true)
+
+	handleLike(d, &req.Like)
+
+	tags, err := client.GitRepositories.ShowGitTags(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("git_tags_read")
+
+	flattenedTags := make([]map[string]any, len(tags))
+	for i, tag := range tags {
+		tag := tag
+		flattenedTags[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.GitTagToSchema(&tag)},
+		}
+	}
+	if err := d.Set("tags", flattenedTags); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
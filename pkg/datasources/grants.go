@@ -236,6 +236,13 @@ var grantsSchema = map[string]*schema.Schema{
 					},
 					ValidateDiagFunc: resources.IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
 				},
+				"include_schemas": {
+					Type:         schema.TypeBool,
+					Optional:     true,
+					Default:      false,
+					Description:  "Can be used together with `database`. When set to true, the future grants defined in every schema of the database (excluding INFORMATION_SCHEMA) are listed as well. It allows auditing all the future grants in the database with a single data source.",
+					RequiredWith: []string{"future_grants_in.0.database"},
+				},
 			},
 		},
 	},
@@ -356,6 +363,14 @@ func ReadGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 		return diag.FromErr(err)
 	}
 
+	if futureGrantsIn, ok := d.GetOk("future_grants_in"); ok && futureGrantsIn.([]any)[0].(map[string]any)["include_schemas"].(bool) {
+		schemasGrants, err := showFutureGrantsInSchemas(ctx, client, opts.In.Database)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		grants = append(grants, schemasGrants...)
+	}
+
 	err = d.Set("grants", convertGrants(grants))
 	if err != nil {
 		return diag.FromErr(err)
@@ -542,6 +557,38 @@ func buildOptsForFutureGrantsIn(futureGrantsIn map[string]any) (*sdk.ShowGrantOp
 	return opts, nil
 }
 
+// showFutureGrantsInSchemas lists the future grants in every schema of the given database.
+// SHOW FUTURE GRANTS IN DATABASE returns only the future grants defined on the database level.
+func showFutureGrantsInSchemas(ctx context.Context, client *sdk.Client, databaseId *sdk.AccountObjectIdentifier) ([]sdk.Grant, error) {
+	schemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{
+		In: &sdk.SchemaIn{
+			Database: sdk.Bool(true),
+			Name:     *databaseId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]sdk.Grant, 0)
+	for _, s := range schemas {
+		if s.Name == "INFORMATION_SCHEMA" {
+			continue
+		}
+		schemaGrants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			Future: sdk.Bool(true),
+			In: &sdk.ShowGrantsIn{
+				Schema: sdk.Pointer(s.ID()),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, schemaGrants...)
+	}
+	return grants, nil
+}
+
 func buildOptsForFutureGrantsTo(futureGrantsTo map[string]any) (*sdk.ShowGrantOptions, error) {
 	opts := new(sdk.ShowGrantOptions)
 	opts.Future = sdk.Bool(true)
@@ -0,0 +1,98 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var projectionPoliciesSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESC PROJECTION POLICY for each projection policy returned by SHOW PROJECTION POLICIES. The output of describe is saved to the description field. By default this value is set to true.",
+	},
+	"like":  likeSchema,
+	"in":    extendedInSchema,
+	"limit": limitFromSchema,
+	"projection_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all projection policies details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW PROJECTION POLICIES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowProjectionPolicySchema,
+					},
+				},
+				resources.DescribeOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE PROJECTION POLICY.",
+					Elem: &schema.Resource{
+						Schema: schemas.DescribeProjectionPolicySchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func ProjectionPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.ProjectionPoliciesDatasource), TrackingReadWrapper(datasources.ProjectionPolicies, ReadProjectionPolicies)),
+		Schema:      projectionPoliciesSchema,
+		Description: "Data source used to get details of filtered projection policies. Filtering is aligned with the current possibilities for [SHOW PROJECTION POLICIES](https://docs.snowflake.com/en/sql-reference/sql/show-projection-policies) query. The results of SHOW and DESCRIBE are encapsulated in one output collection `projection_policies`.",
+	}
+}
+
+func ReadProjectionPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowProjectionPolicyRequest{}
+
+	handleLike(d, &req.Like)
+	handleLimitFrom(d, &req.Limit)
+	err := handleExtendedIn(d, &req.In)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectionPolicies, err := client.ProjectionPolicies.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("projection_policies_read")
+
+	flattenedProjectionPolicies := make([]map[string]any, len(projectionPolicies))
+	for i, projectionPolicy := range projectionPolicies {
+		projectionPolicy := projectionPolicy
+		var projectionPolicyDescription []map[string]any
+		if d.Get("with_describe").(bool) {
+			describeResult, err := client.ProjectionPolicies.Describe(ctx, projectionPolicy.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			projectionPolicyDescription = []map[string]any{schemas.ProjectionPolicyDescriptionToSchema(*describeResult)}
+		}
+		flattenedProjectionPolicies[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{schemas.ProjectionPolicyToSchema(&projectionPolicy)},
+			resources.DescribeOutputAttributeName: projectionPolicyDescription,
+		}
+	}
+	if err := d.Set("projection_policies", flattenedProjectionPolicies); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
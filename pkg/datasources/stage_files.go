@@ -0,0 +1,106 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageFilesSchema = map[string]*schema.Schema{
+	"stage": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		Description:      "Fully qualified name of the stage to list the files from.",
+	},
+	"path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Lists only the files with the paths starting with the given prefix (e.g. `libs/python`).",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Regular expression pattern for filtering the files (e.g. `.*[.]py`).",
+	},
+	"files": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of LIST.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the file, prefixed with the stage name for the internal stages or the storage URL for the external stages.",
+				},
+				"size": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Size of the file in bytes.",
+				},
+				"md5": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "MD5 hash of the file.",
+				},
+				"last_modified": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Last modification time of the file.",
+				},
+			},
+		},
+	},
+}
+
+func StageFiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.StageFilesDatasource), TrackingReadWrapper(datasources.StageFiles, ReadStageFiles)),
+		Schema:      stageFilesSchema,
+		Description: "Data source used to list the files on a stage. Filtering is aligned with the current possibilities for [LIST](https://docs.snowflake.com/en/sql-reference/sql/list) query. The results of LIST are encapsulated in one output collection `files`.",
+	}
+}
+
+func ReadStageFiles(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts := &sdk.ListStageFilesOptions{}
+	if v, ok := d.GetOk("pattern"); ok {
+		opts.Pattern = sdk.String(v.(string))
+	}
+
+	files, err := client.StageFiles.List(ctx, sdk.NewStageLocation(stageId, d.Get("path").(string)), opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("stage_files_read")
+
+	flattenedFiles := make([]map[string]any, len(files))
+	for i, file := range files {
+		var md5 string
+		if file.Md5 != nil {
+			md5 = *file.Md5
+		}
+		flattenedFiles[i] = map[string]any{
+			"name":          file.Name,
+			"size":          file.Size,
+			"md5":           md5,
+			"last_modified": file.LastModified,
+		}
+	}
+	if err := d.Set("files", flattenedFiles); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
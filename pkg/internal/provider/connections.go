@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// ConnectionOpener creates the client for a named connection.
+type ConnectionOpener func() (*sdk.Client, error)
+
+// Connections holds the named connections defined in the provider configuration.
+// The clients are created lazily, on the first use of the connection, and reused afterward.
+type Connections struct {
+	mu      sync.Mutex
+	openers map[string]ConnectionOpener
+	clients map[string]*sdk.Client
+}
+
+func NewConnections(openers map[string]ConnectionOpener) *Connections {
+	return &Connections{
+		openers: openers,
+		clients: make(map[string]*sdk.Client),
+	}
+}
+
+// Names returns the sorted names of the defined connections.
+func (c *Connections) Names() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, 0, len(c.openers))
+	for name := range c.openers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Client returns the client for the given connection, opening the connection if it was not used before.
+// The failed attempts are not cached, so the next use of the connection tries to open it again.
+func (c *Connections) Client(name string) (*sdk.Client, error) {
+	if c == nil {
+		return nil, fmt.Errorf(`connection "%s" is not defined in the provider configuration, no connections are defined`, name)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, ok := c.clients[name]; ok {
+		return client, nil
+	}
+	opener, ok := c.openers[name]
+	if !ok {
+		return nil, fmt.Errorf(`connection "%s" is not defined in the provider configuration, defined connections: %s`, name, strings.Join(c.Names(), ", "))
+	}
+	client, err := opener()
+	if err != nil {
+		return nil, fmt.Errorf(`could not open connection "%s": %w`, name, err)
+	}
+	c.clients[name] = client
+	return client, nil
+}
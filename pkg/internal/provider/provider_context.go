@@ -5,4 +5,23 @@ import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 type Context struct {
 	Client          *sdk.Client
 	EnabledFeatures []string
+	Connections     *Connections
+	SqlPreview      bool
+}
+
+// ForConnection returns the context with the client of the given named connection. For the empty name, the context itself is returned.
+func (c *Context) ForConnection(name string) (*Context, error) {
+	if name == "" {
+		return c, nil
+	}
+	client, err := c.Connections.Client(name)
+	if err != nil {
+		return nil, err
+	}
+	return &Context{
+		Client:          client,
+		EnabledFeatures: c.EnabledFeatures,
+		Connections:     c.Connections,
+		SqlPreview:      c.SqlPreview,
+	}, nil
 }
@@ -26,6 +26,7 @@ const (
 	OcspFailOpen              = "SNOWFLAKE_OCSP_FAIL_OPEN"
 
 	Token                      = "SNOWFLAKE_TOKEN"
+	OidcTokenFilePath          = "SNOWFLAKE_OIDC_TOKEN_FILE_PATH"
 	TokenAccessorTokenEndpoint = "SNOWFLAKE_TOKEN_ACCESSOR_TOKEN_ENDPOINT"
 	TokenAccessorRefreshToken  = "SNOWFLAKE_TOKEN_ACCESSOR_REFRESH_TOKEN"
 	TokenAccessorClientId      = "SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_ID"
@@ -47,6 +48,13 @@ const (
 	DisableConsoleLogin                = "SNOWFLAKE_DISABLE_CONSOLE_LOGIN"
 	SkipTomlFilePermissionVerification = "SNOWFLAKE_SKIP_TOML_FILE_PERMISSION_VERIFICATION"
 	UseLegacyTomlFile                  = "SNOWFLAKE_USE_LEGACY_TOML_FILE"
+	EnableShowResultCache              = "SNOWFLAKE_ENABLE_SHOW_RESULT_CACHE"
+	MaxRetries                         = "SNOWFLAKE_MAX_RETRIES"
+	RetryBackoff                       = "SNOWFLAKE_RETRY_BACKOFF"
+	QueryTag                           = "SNOWFLAKE_QUERY_TAG"
+	WorkloadIdentityProvider           = "SNOWFLAKE_WORKLOAD_IDENTITY_PROVIDER"
+	WorkloadIdentityEntraResource      = "SNOWFLAKE_WORKLOAD_IDENTITY_ENTRA_RESOURCE"
+	SqlPreview                         = "SNOWFLAKE_SQL_PREVIEW"
 
 	ConfigPath = "SNOWFLAKE_CONFIG_PATH"
 )
@@ -5,39 +5,58 @@ type datasource string
 const (
 	Accounts                       datasource = "snowflake_accounts"
 	AccountRoles                   datasource = "snowflake_account_roles"
+	AggregationPolicies            datasource = "snowflake_aggregation_policies"
 	Alerts                         datasource = "snowflake_alerts"
+	Applications                   datasource = "snowflake_applications"
+	ApplicationPackages            datasource = "snowflake_application_packages"
 	ComputePools                   datasource = "snowflake_compute_pools"
 	Connections                    datasource = "snowflake_connections"
 	CortexSearchServices           datasource = "snowflake_cortex_search_services"
 	CurrentAccount                 datasource = "snowflake_current_account"
 	CurrentRole                    datasource = "snowflake_current_role"
+	DataMetricFunctionReferences   datasource = "snowflake_data_metric_function_references"
 	Database                       datasource = "snowflake_database"
 	DatabaseRole                   datasource = "snowflake_database_role"
 	DatabaseRoles                  datasource = "snowflake_database_roles"
 	Databases                      datasource = "snowflake_databases"
 	DynamicTables                  datasource = "snowflake_dynamic_tables"
+	EventTables                    datasource = "snowflake_event_tables"
+	ExternalAccessIntegrations     datasource = "snowflake_external_access_integrations"
 	ExternalFunctions              datasource = "snowflake_external_functions"
 	ExternalTables                 datasource = "snowflake_external_tables"
+	ExternalVolumes                datasource = "snowflake_external_volumes"
 	FailoverGroups                 datasource = "snowflake_failover_groups"
 	FileFormats                    datasource = "snowflake_file_formats"
 	Functions                      datasource = "snowflake_functions"
+	GitBranches                    datasource = "snowflake_git_branches"
+	GitCommits                     datasource = "snowflake_git_commits"
 	GitRepositories                datasource = "snowflake_git_repositories"
+	GitTags                        datasource = "snowflake_git_tags"
 	Grants                         datasource = "snowflake_grants"
 	ImageRepositories              datasource = "snowflake_image_repositories"
+	JoinPolicies                   datasource = "snowflake_join_policies"
+	Listings                       datasource = "snowflake_listings"
 	MaskingPolicies                datasource = "snowflake_masking_policies"
 	MaterializedViews              datasource = "snowflake_materialized_views"
 	NetworkPolicies                datasource = "snowflake_network_policies"
+	NetworkRules                   datasource = "snowflake_network_rules"
+	NotificationIntegrations       datasource = "snowflake_notification_integrations"
+	OrganizationAccounts           datasource = "snowflake_organization_accounts"
 	Parameters                     datasource = "snowflake_parameters"
 	Pipes                          datasource = "snowflake_pipes"
 	Procedures                     datasource = "snowflake_procedures"
+	ProjectionPolicies             datasource = "snowflake_projection_policies"
 	ResourceMonitors               datasource = "snowflake_resource_monitors"
 	RowAccessPolicies              datasource = "snowflake_row_access_policies"
 	Schemas                        datasource = "snowflake_schemas"
 	Secrets                        datasource = "snowflake_secrets"
 	SecurityIntegrations           datasource = "snowflake_security_integrations"
+	SemanticViews                  datasource = "snowflake_semantic_views"
 	Services                       datasource = "snowflake_services"
+	SessionPolicies                datasource = "snowflake_session_policies"
 	Sequences                      datasource = "snowflake_sequences"
 	Shares                         datasource = "snowflake_shares"
+	StageFiles                     datasource = "snowflake_stage_files"
 	Stages                         datasource = "snowflake_stages"
 	StorageIntegrations            datasource = "snowflake_storage_integrations"
 	Streams                        datasource = "snowflake_streams"
@@ -13,10 +13,18 @@ type feature string
 const (
 	AccountAuthenticationPolicyAttachmentResource feature = "snowflake_account_authentication_policy_attachment_resource"
 	AccountPasswordPolicyAttachmentResource       feature = "snowflake_account_password_policy_attachment_resource"
+	AccountRoleGrantsResource                     feature = "snowflake_account_role_grants_resource"
+	AggregationPolicyResource                     feature = "snowflake_aggregation_policy_resource"
+	AggregationPoliciesDatasource                 feature = "snowflake_aggregation_policies_datasource"
 	AlertResource                                 feature = "snowflake_alert_resource"
 	AlertsDatasource                              feature = "snowflake_alerts_datasource"
 	ApiIntegrationResource                        feature = "snowflake_api_integration_resource"
+	ApplicationResource                           feature = "snowflake_application_resource"
+	ApplicationsDatasource                        feature = "snowflake_applications_datasource"
+	ApplicationPackageResource                    feature = "snowflake_application_package_resource"
+	ApplicationPackagesDatasource                 feature = "snowflake_application_packages_datasource"
 	AuthenticationPolicyResource                  feature = "snowflake_authentication_policy_resource"
+	CatalogIntegrationResource                    feature = "snowflake_catalog_integration_resource"
 	ComputePoolResource                           feature = "snowflake_compute_pool_resource"
 	ComputePoolsDatasource                        feature = "snowflake_compute_pools_datasource"
 	CortexSearchServiceResource                   feature = "snowflake_cortex_search_service_resource"
@@ -24,16 +32,26 @@ const (
 	CurrentAccountResource                        feature = "snowflake_current_account_resource"
 	CurrentAccountDatasource                      feature = "snowflake_current_account_datasource"
 	CurrentOrganizationAccountResource            feature = "snowflake_current_organization_account_resource"
+	DataMetricFunctionResource                    feature = "snowflake_data_metric_function_resource"
+	DataMetricFunctionAttachmentResource          feature = "snowflake_data_metric_function_attachment_resource"
+	DataMetricFunctionReferencesDatasource        feature = "snowflake_data_metric_function_references_datasource"
 	DatabaseDatasource                            feature = "snowflake_database_datasource"
 	DatabaseRoleDatasource                        feature = "snowflake_database_role_datasource"
+	DatabaseRoleGrantsResource                    feature = "snowflake_database_role_grants_resource"
 	DynamicTableResource                          feature = "snowflake_dynamic_table_resource"
 	DynamicTablesDatasource                       feature = "snowflake_dynamic_tables_datasource"
 	EmailNotificationIntegrationResource          feature = "snowflake_email_notification_integration_resource"
+	EventTableResource                            feature = "snowflake_event_table_resource"
+	EventTablesDatasource                         feature = "snowflake_event_tables_datasource"
+	ExecuteImmediateFromResource                  feature = "snowflake_execute_immediate_from_resource"
+	ExternalAccessIntegrationResource             feature = "snowflake_external_access_integration_resource"
+	ExternalAccessIntegrationsDatasource          feature = "snowflake_external_access_integrations_datasource"
 	ExternalFunctionResource                      feature = "snowflake_external_function_resource"
 	ExternalFunctionsDatasource                   feature = "snowflake_external_functions_datasource"
 	ExternalTableResource                         feature = "snowflake_external_table_resource"
 	ExternalTablesDatasource                      feature = "snowflake_external_tables_datasource"
 	ExternalVolumeResource                        feature = "snowflake_external_volume_resource"
+	ExternalVolumesDatasource                     feature = "snowflake_external_volumes_datasource"
 	FailoverGroupResource                         feature = "snowflake_failover_group_resource"
 	FailoverGroupsDatasource                      feature = "snowflake_failover_groups_datasource"
 	FileFormatResource                            feature = "snowflake_file_format_resource"
@@ -46,17 +64,29 @@ const (
 	FunctionsDatasource                           feature = "snowflake_functions_datasource"
 	GitRepositoryResource                         feature = "snowflake_git_repository_resource"
 	GitRepositoriesDatasource                     feature = "snowflake_git_repositories_datasource"
+	GitBranchesDatasource                         feature = "snowflake_git_branches_datasource"
+	GitCommitsDatasource                          feature = "snowflake_git_commits_datasource"
+	GitTagsDatasource                             feature = "snowflake_git_tags_datasource"
+	HybridTableResource                           feature = "snowflake_hybrid_table_resource"
+	IcebergTableResource                          feature = "snowflake_iceberg_table_resource"
 	ImageRepositoryResource                       feature = "snowflake_image_repository_resource"
 	ImageRepositoriesDatasource                   feature = "snowflake_image_repositories_datasource"
 	JobServiceResource                            feature = "snowflake_job_service_resource"
+	JoinPolicyResource                            feature = "snowflake_join_policy_resource"
+	JoinPoliciesDatasource                        feature = "snowflake_join_policies_datasource"
 	ListingResource                               feature = "snowflake_listing_resource"
+	ListingsDatasource                            feature = "snowflake_listings_datasource"
 	ManagedAccountResource                        feature = "snowflake_managed_account_resource"
 	MaterializedViewResource                      feature = "snowflake_materialized_view_resource"
 	MaterializedViewsDatasource                   feature = "snowflake_materialized_views_datasource"
 	NetworkPolicyAttachmentResource               feature = "snowflake_network_policy_attachment_resource"
 	NetworkRuleResource                           feature = "snowflake_network_rule_resource"
+	NetworkRulesDatasource                        feature = "snowflake_network_rules_datasource"
 	NotificationIntegrationResource               feature = "snowflake_notification_integration_resource"
+	NotificationIntegrationsDatasource            feature = "snowflake_notification_integrations_datasource"
 	ObjectParameterResource                       feature = "snowflake_object_parameter_resource"
+	OrganizationAccountResource                   feature = "snowflake_organization_account_resource"
+	OrganizationAccountsDatasource                feature = "snowflake_organization_accounts_datasource"
 	PasswordPolicyResource                        feature = "snowflake_password_policy_resource"
 	PipeResource                                  feature = "snowflake_pipe_resource"
 	PipesDatasource                               feature = "snowflake_pipes_datasource"
@@ -66,15 +96,24 @@ const (
 	ProcedureScalaResource                        feature = "snowflake_procedure_scala_resource"
 	ProcedureSqlResource                          feature = "snowflake_procedure_sql_resource"
 	ProceduresDatasource                          feature = "snowflake_procedures_datasource"
+	ProjectionPolicyResource                      feature = "snowflake_projection_policy_resource"
+	ProjectionPoliciesDatasource                  feature = "snowflake_projection_policies_datasource"
+	ReplicationGroupResource                      feature = "snowflake_replication_group_resource"
+	SemanticViewResource                          feature = "snowflake_semantic_view_resource"
+	SemanticViewsDatasource                       feature = "snowflake_semantic_views_datasource"
 	CurrentRoleDatasource                         feature = "snowflake_current_role_datasource"
 	ServiceResource                               feature = "snowflake_service_resource"
 	ServicesDatasource                            feature = "snowflake_services_datasource"
 	SequenceResource                              feature = "snowflake_sequence_resource"
 	SequencesDatasource                           feature = "snowflake_sequences_datasource"
+	SessionPolicyResource                         feature = "snowflake_session_policy_resource"
+	SessionPoliciesDatasource                     feature = "snowflake_session_policies_datasource"
 	ShareResource                                 feature = "snowflake_share_resource"
 	SharesDatasource                              feature = "snowflake_shares_datasource"
 	ParametersDatasource                          feature = "snowflake_parameters_datasource"
 	StageResource                                 feature = "snowflake_stage_resource"
+	StageFileResource                             feature = "snowflake_stage_file_resource"
+	StageFilesDatasource                          feature = "snowflake_stage_files_datasource"
 	StagesDatasource                              feature = "snowflake_stages_datasource"
 	StorageIntegrationResource                    feature = "snowflake_storage_integration_resource"
 	StorageIntegrationsDatasource                 feature = "snowflake_storage_integrations_datasource"
@@ -86,9 +125,11 @@ const (
 	TablesDatasource                              feature = "snowflake_tables_datasource"
 	TableColumnMaskingPolicyApplicationResource   feature = "snowflake_table_column_masking_policy_application_resource"
 	TableConstraintResource                       feature = "snowflake_table_constraint_resource"
+	TaskGraphResource                             feature = "snowflake_task_graph_resource"
 	UserAuthenticationPolicyAttachmentResource    feature = "snowflake_user_authentication_policy_attachment_resource"
 	UserPublicKeysResource                        feature = "snowflake_user_public_keys_resource"
 	UserPasswordPolicyAttachmentResource          feature = "snowflake_user_password_policy_attachment_resource"
+	UserSessionPolicyAttachmentResource           feature = "snowflake_user_session_policy_attachment_resource"
 	UserProgrammaticAccessTokenResource           feature = "snowflake_user_programmatic_access_token_resource"
 	UserProgrammaticAccessTokensDatasource        feature = "snowflake_user_programmatic_access_tokens_datasource"
 )
@@ -96,10 +137,18 @@ const (
 var allPreviewFeatures = []feature{
 	AccountAuthenticationPolicyAttachmentResource,
 	AccountPasswordPolicyAttachmentResource,
+	AccountRoleGrantsResource,
+	AggregationPolicyResource,
+	AggregationPoliciesDatasource,
 	AlertResource,
 	AlertsDatasource,
 	ApiIntegrationResource,
+	ApplicationResource,
+	ApplicationsDatasource,
+	ApplicationPackageResource,
+	ApplicationPackagesDatasource,
 	AuthenticationPolicyResource,
+	CatalogIntegrationResource,
 	ComputePoolResource,
 	ComputePoolsDatasource,
 	CortexSearchServiceResource,
@@ -107,8 +156,12 @@ var allPreviewFeatures = []feature{
 	CurrentAccountResource,
 	CurrentAccountDatasource,
 	CurrentOrganizationAccountResource,
+	DataMetricFunctionResource,
+	DataMetricFunctionAttachmentResource,
+	DataMetricFunctionReferencesDatasource,
 	DatabaseDatasource,
 	DatabaseRoleDatasource,
+	DatabaseRoleGrantsResource,
 	DynamicTableResource,
 	DynamicTablesDatasource,
 	ExternalFunctionResource,
@@ -116,6 +169,7 @@ var allPreviewFeatures = []feature{
 	ExternalTableResource,
 	ExternalTablesDatasource,
 	ExternalVolumeResource,
+	ExternalVolumesDatasource,
 	FailoverGroupResource,
 	FailoverGroupsDatasource,
 	FileFormatResource,
@@ -128,18 +182,35 @@ var allPreviewFeatures = []feature{
 	FunctionsDatasource,
 	GitRepositoryResource,
 	GitRepositoriesDatasource,
+	GitBranchesDatasource,
+	GitCommitsDatasource,
+	GitTagsDatasource,
+	HybridTableResource,
+	IcebergTableResource,
 	ImageRepositoryResource,
 	ImageRepositoriesDatasource,
 	JobServiceResource,
+	JoinPolicyResource,
+	JoinPoliciesDatasource,
 	ListingResource,
+	ListingsDatasource,
 	ManagedAccountResource,
 	MaterializedViewResource,
 	MaterializedViewsDatasource,
 	NetworkPolicyAttachmentResource,
 	NetworkRuleResource,
+	NetworkRulesDatasource,
 	EmailNotificationIntegrationResource,
+	EventTableResource,
+	EventTablesDatasource,
+	ExecuteImmediateFromResource,
+	ExternalAccessIntegrationResource,
+	ExternalAccessIntegrationsDatasource,
 	NotificationIntegrationResource,
+	NotificationIntegrationsDatasource,
 	ObjectParameterResource,
+	OrganizationAccountResource,
+	OrganizationAccountsDatasource,
 	PasswordPolicyResource,
 	PipeResource,
 	PipesDatasource,
@@ -148,6 +219,8 @@ var allPreviewFeatures = []feature{
 	ServicesDatasource,
 	SequenceResource,
 	SequencesDatasource,
+	SessionPolicyResource,
+	SessionPoliciesDatasource,
 	ShareResource,
 	SharesDatasource,
 	ParametersDatasource,
@@ -157,7 +230,14 @@ var allPreviewFeatures = []feature{
 	ProcedureScalaResource,
 	ProcedureSqlResource,
 	ProceduresDatasource,
+	ProjectionPolicyResource,
+	ProjectionPoliciesDatasource,
+	ReplicationGroupResource,
+	SemanticViewResource,
+	SemanticViewsDatasource,
 	StageResource,
+	StageFileResource,
+	StageFilesDatasource,
 	StagesDatasource,
 	StorageIntegrationResource,
 	StorageIntegrationsDatasource,
@@ -167,11 +247,13 @@ var allPreviewFeatures = []feature{
 	SystemGetSnowflakePlatformInfoDatasource,
 	TableColumnMaskingPolicyApplicationResource,
 	TableConstraintResource,
+	TaskGraphResource,
 	TableResource,
 	TablesDatasource,
 	UserAuthenticationPolicyAttachmentResource,
 	UserPublicKeysResource,
 	UserPasswordPolicyAttachmentResource,
+	UserSessionPolicyAttachmentResource,
 	UserProgrammaticAccessTokenResource,
 	UserProgrammaticAccessTokensDatasource,
 }
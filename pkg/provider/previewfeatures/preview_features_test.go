@@ -18,9 +18,17 @@ func Test_StringToFeature(t *testing.T) {
 
 		// Supported Values.
 		{input: "snowflake_account_password_policy_attachment_resource", want: AccountPasswordPolicyAttachmentResource},
+		{input: "snowflake_account_role_grants_resource", want: AccountRoleGrantsResource},
+		{input: "snowflake_aggregation_policy_resource", want: AggregationPolicyResource},
+		{input: "snowflake_aggregation_policies_datasource", want: AggregationPoliciesDatasource},
 		{input: "snowflake_alert_resource", want: AlertResource},
 		{input: "snowflake_alerts_datasource", want: AlertsDatasource},
 		{input: "snowflake_api_integration_resource", want: ApiIntegrationResource},
+		{input: "snowflake_application_resource", want: ApplicationResource},
+		{input: "snowflake_applications_datasource", want: ApplicationsDatasource},
+		{input: "snowflake_application_package_resource", want: ApplicationPackageResource},
+		{input: "snowflake_application_packages_datasource", want: ApplicationPackagesDatasource},
+		{input: "snowflake_catalog_integration_resource", want: CatalogIntegrationResource},
 		{input: "snowflake_compute_pool_resource", want: ComputePoolResource},
 		{input: "snowflake_compute_pools_datasource", want: ComputePoolsDatasource},
 		{input: "snowflake_cortex_search_service_resource", want: CortexSearchServiceResource},
@@ -28,8 +36,12 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_current_account_resource", want: CurrentAccountResource},
 		{input: "snowflake_current_account_datasource", want: CurrentAccountDatasource},
 		{input: "snowflake_current_organization_account_resource", want: CurrentOrganizationAccountResource},
+		{input: "snowflake_data_metric_function_resource", want: DataMetricFunctionResource},
+		{input: "snowflake_data_metric_function_attachment_resource", want: DataMetricFunctionAttachmentResource},
+		{input: "snowflake_data_metric_function_references_datasource", want: DataMetricFunctionReferencesDatasource},
 		{input: "snowflake_database_datasource", want: DatabaseDatasource},
 		{input: "snowflake_database_role_datasource", want: DatabaseRoleDatasource},
+		{input: "snowflake_database_role_grants_resource", want: DatabaseRoleGrantsResource},
 		{input: "snowflake_dynamic_table_resource", want: DynamicTableResource},
 		{input: "snowflake_dynamic_tables_datasource", want: DynamicTablesDatasource},
 		{input: "snowflake_external_function_resource", want: ExternalFunctionResource},
@@ -37,36 +49,63 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_external_table_resource", want: ExternalTableResource},
 		{input: "snowflake_external_tables_datasource", want: ExternalTablesDatasource},
 		{input: "snowflake_external_volume_resource", want: ExternalVolumeResource},
+		{input: "snowflake_external_volumes_datasource", want: ExternalVolumesDatasource},
 		{input: "snowflake_failover_group_resource", want: FailoverGroupResource},
 		{input: "snowflake_failover_groups_datasource", want: FailoverGroupsDatasource},
 		{input: "snowflake_file_format_resource", want: FileFormatResource},
 		{input: "snowflake_file_formats_datasource", want: FileFormatsDatasource},
 		{input: "snowflake_git_repository_resource", want: GitRepositoryResource},
 		{input: "snowflake_git_repositories_datasource", want: GitRepositoriesDatasource},
+		{input: "snowflake_git_branches_datasource", want: GitBranchesDatasource},
+		{input: "snowflake_git_commits_datasource", want: GitCommitsDatasource},
+		{input: "snowflake_git_tags_datasource", want: GitTagsDatasource},
+		{input: "snowflake_hybrid_table_resource", want: HybridTableResource},
+		{input: "snowflake_iceberg_table_resource", want: IcebergTableResource},
 		{input: "snowflake_image_repository_resource", want: ImageRepositoryResource},
 		{input: "snowflake_image_repositories_datasource", want: ImageRepositoriesDatasource},
 		{input: "snowflake_job_service_resource", want: JobServiceResource},
+		{input: "snowflake_join_policy_resource", want: JoinPolicyResource},
+		{input: "snowflake_join_policies_datasource", want: JoinPoliciesDatasource},
 		{input: "snowflake_listing_resource", want: ListingResource},
+		{input: "snowflake_listings_datasource", want: ListingsDatasource},
 		{input: "snowflake_managed_account_resource", want: ManagedAccountResource},
 		{input: "snowflake_materialized_view_resource", want: MaterializedViewResource},
 		{input: "snowflake_materialized_views_datasource", want: MaterializedViewsDatasource},
 		{input: "snowflake_network_policy_attachment_resource", want: NetworkPolicyAttachmentResource},
 		{input: "snowflake_network_rule_resource", want: NetworkRuleResource},
+		{input: "snowflake_network_rules_datasource", want: NetworkRulesDatasource},
 		{input: "snowflake_email_notification_integration_resource", want: EmailNotificationIntegrationResource},
+		{input: "snowflake_event_table_resource", want: EventTableResource},
+		{input: "snowflake_event_tables_datasource", want: EventTablesDatasource},
+		{input: "snowflake_execute_immediate_from_resource", want: ExecuteImmediateFromResource},
+		{input: "snowflake_external_access_integration_resource", want: ExternalAccessIntegrationResource},
+		{input: "snowflake_external_access_integrations_datasource", want: ExternalAccessIntegrationsDatasource},
 		{input: "snowflake_notification_integration_resource", want: NotificationIntegrationResource},
+		{input: "snowflake_notification_integrations_datasource", want: NotificationIntegrationsDatasource},
 		{input: "snowflake_object_parameter_resource", want: ObjectParameterResource},
+		{input: "snowflake_organization_account_resource", want: OrganizationAccountResource},
+		{input: "snowflake_organization_accounts_datasource", want: OrganizationAccountsDatasource},
 		{input: "snowflake_password_policy_resource", want: PasswordPolicyResource},
 		{input: "snowflake_pipe_resource", want: PipeResource},
 		{input: "snowflake_pipes_datasource", want: PipesDatasource},
+		{input: "snowflake_projection_policy_resource", want: ProjectionPolicyResource},
+		{input: "snowflake_projection_policies_datasource", want: ProjectionPoliciesDatasource},
+		{input: "snowflake_replication_group_resource", want: ReplicationGroupResource},
+		{input: "snowflake_semantic_view_resource", want: SemanticViewResource},
+		{input: "snowflake_semantic_views_datasource", want: SemanticViewsDatasource},
 		{input: "snowflake_current_role_datasource", want: CurrentRoleDatasource},
 		{input: "snowflake_service_resource", want: ServiceResource},
 		{input: "snowflake_services_datasource", want: ServicesDatasource},
 		{input: "snowflake_sequence_resource", want: SequenceResource},
 		{input: "snowflake_sequences_datasource", want: SequencesDatasource},
+		{input: "snowflake_session_policy_resource", want: SessionPolicyResource},
+		{input: "snowflake_session_policies_datasource", want: SessionPoliciesDatasource},
 		{input: "snowflake_share_resource", want: ShareResource},
 		{input: "snowflake_shares_datasource", want: SharesDatasource},
 		{input: "snowflake_parameters_datasource", want: ParametersDatasource},
 		{input: "snowflake_stage_resource", want: StageResource},
+		{input: "snowflake_stage_file_resource", want: StageFileResource},
+		{input: "snowflake_stage_files_datasource", want: StageFilesDatasource},
 		{input: "snowflake_stages_datasource", want: StagesDatasource},
 		{input: "snowflake_storage_integration_resource", want: StorageIntegrationResource},
 		{input: "snowflake_storage_integrations_datasource", want: StorageIntegrationsDatasource},
@@ -76,9 +115,11 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_system_get_snowflake_platform_info_datasource", want: SystemGetSnowflakePlatformInfoDatasource},
 		{input: "snowflake_table_column_masking_policy_application_resource", want: TableColumnMaskingPolicyApplicationResource},
 		{input: "snowflake_table_constraint_resource", want: TableConstraintResource},
+		{input: "snowflake_task_graph_resource", want: TaskGraphResource},
 		{input: "snowflake_user_authentication_policy_attachment_resource", want: UserAuthenticationPolicyAttachmentResource},
 		{input: "snowflake_user_public_keys_resource", want: UserPublicKeysResource},
 		{input: "snowflake_user_password_policy_attachment_resource", want: UserPasswordPolicyAttachmentResource},
+		{input: "snowflake_user_session_policy_attachment_resource", want: UserSessionPolicyAttachmentResource},
 		{input: "snowflake_user_programmatic_access_token_resource", want: UserProgrammaticAccessTokenResource},
 		{input: "snowflake_user_programmatic_access_tokens_datasource", want: UserProgrammaticAccessTokensDatasource},
 	}
@@ -8,8 +8,11 @@ import (
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/oswrapper"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/validators"
@@ -63,8 +66,8 @@ func init() {
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema:               GetProviderSchema(),
-		ResourcesMap:         getResources(),
-		DataSourcesMap:       getDataSources(),
+		ResourcesMap:         withConnectionRouting(withSqlPreview(getResources(), resources.SqlPreviews())),
+		DataSourcesMap:       withConnectionRouting(getDataSources()),
 		ConfigureContextFunc: ConfigureProvider,
 		ProviderMetaSchema:   map[string]*schema.Schema{},
 	}
@@ -246,6 +249,25 @@ func GetProviderSchema() map[string]*schema.Schema {
 			Optional:    true,
 			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.Token, nil),
 		},
+		"oidc_token_file_path": {
+			Type:        schema.TypeString,
+			Description: envNameFieldDescription(fmt.Sprintf("Path to the file with the OIDC token (e.g. a Kubernetes service account token) used when `authenticator` is `%s` and `workload_identity_provider` is `%s`. The file is read when the provider is configured. It can't be set together with `token`.", sdk.AuthenticationTypeWorkloadIdentity, sdk.WorkloadIdentityProviderOidc), snowflakeenvs.OidcTokenFilePath),
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.OidcTokenFilePath, nil),
+		},
+		"workload_identity_provider": {
+			Type:             schema.TypeString,
+			Description:      envNameFieldDescription(fmt.Sprintf("The platform providing the workload identity used when `authenticator` is `%s`. Valid options are: %v. For `%s`, set the token with `token` or `oidc_token_file_path`. Read more in Snowflake [docs](https://docs.snowflake.com/en/user-guide/workload-identity-federation).", sdk.AuthenticationTypeWorkloadIdentity, docs.PossibleValuesListed(sdk.AllWorkloadIdentityProviders), sdk.WorkloadIdentityProviderOidc), snowflakeenvs.WorkloadIdentityProvider),
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.WorkloadIdentityProvider, nil),
+			ValidateDiagFunc: validators.NormalizeValidation(sdk.ToWorkloadIdentityProvider),
+		},
+		"workload_identity_entra_resource": {
+			Type:        schema.TypeString,
+			Description: envNameFieldDescription(fmt.Sprintf("The Microsoft Entra ID resource (application ID URI) for which the token is requested when `workload_identity_provider` is `%s`.", sdk.WorkloadIdentityProviderAzure), snowflakeenvs.WorkloadIdentityEntraResource),
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.WorkloadIdentityEntraResource, nil),
+		},
 		"token_accessor": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -403,14 +425,102 @@ func GetProviderSchema() map[string]*schema.Schema {
 			Optional:    true,
 			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.UseLegacyTomlFile, false),
 		},
+		"enable_show_result_cache": {
+			Type:        schema.TypeBool,
+			Description: envNameFieldDescription("False by default. When this is set to true, the provider caches the results of the SHOW commands used to read the objects: instead of running a separate `SHOW ... LIKE` command for every object, it runs one `SHOW` command per object type and container (account, database, or schema), and serves the reads from its result. The cache is cleared after every statement that can modify the objects (e.g. `CREATE`, `ALTER`, or `DROP`) run by the provider. Changes made outside of the provider during a single Terraform operation may not be visible until the cache is cleared. This significantly speeds up refreshing the states with many objects.", snowflakeenvs.EnableShowResultCache),
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.EnableShowResultCache, false),
+		},
+		"sql_preview": {
+			Type:        schema.TypeBool,
+			Description: envNameFieldDescription("False by default. When this is set to true, the plan contains the statements that the provider would run to create, update, or replace each object in the computed `planned_sql` field of the supported resources (`snowflake_account_role`, `snowflake_database_role`, and `snowflake_secret_with_generic_string`). The statements are built from the SDK requests without running the resource operations, and no queries are sent to Snowflake. The sensitive values are replaced with placeholders. For the replaced objects, the statements dropping the old object are listed before the statements creating the new one. When any of the values used in the statements is known only after the apply, `planned_sql` is also known only after the apply. Destroying the objects and the changes of the other resources are not previewed (a warning listing the supported resources is shown when the provider is configured).", snowflakeenvs.SqlPreview),
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.SqlPreview, false),
+		},
+		"max_retries": {
+			Type:             schema.TypeInt,
+			Description:      envNameFieldDescription("The maximum number of times the provider runs again an idempotent statement (e.g. `SHOW`, `DESCRIBE`, `CREATE OR REPLACE`, `DROP ... IF EXISTS`, `GRANT`, or `ALTER ... SET`) that failed with a transient error (e.g. an expired session token, a resuming warehouse, a lock conflict with a concurrent statement, or, in the queries run right after creating an object, the object that is not visible yet). 0 by default, which means that the statements are not retried. This is independent of `max_retry_count`, which controls the retries of the HTTP requests in the driver.", snowflakeenvs.MaxRetries),
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.MaxRetries, 0),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"retry_backoff": {
+			Type:             schema.TypeInt,
+			Description:      envNameFieldDescription("The delay in seconds before the first retry of a statement (see `max_retries`). The delay is doubled before every next retry, up to one minute. 1 by default.", snowflakeenvs.RetryBackoff),
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.RetryBackoff, 1),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"query_tag": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "When set, the provider sets the `QUERY_TAG` parameter for every statement it runs. The value is a JSON object with the provider version, the resource or data source name, the operation (e.g. `create` or `read`), and the fields of this block, e.g. `{\"source\":\"terraform_provider_usage_tracking\",\"version\":\"v2.6.0\",\"resource\":\"snowflake_database\",\"operation\":\"create\",\"workspace\":\"prod\",\"tag\":\"finops\"}`, so it can be parsed with `PARSE_JSON(QUERY_TAG)` in `QUERY_HISTORY`. The parameter is set only for the statements run by the provider, and it overrides the `QUERY_TAG` set for the user. When the block is not set, the query tag is enabled by setting the `SNOWFLAKE_QUERY_TAG` environment variable (then, the workspace is sourced from the `TF_WORKSPACE` environment variable).",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tag": {
+						Type:        schema.TypeString,
+						Description: envNameFieldDescription("A user-defined value added to the query tag (e.g. a team or a cost center).", snowflakeenvs.QueryTag),
+						Optional:    true,
+						DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.QueryTag, nil),
+					},
+					"workspace": {
+						Type:        schema.TypeString,
+						Description: "The Terraform workspace added to the query tag. Use `terraform.workspace` to set it. Can also be sourced from the `TF_WORKSPACE` environment variable.",
+						Optional:    true,
+						DefaultFunc: schema.EnvDefaultFunc("TF_WORKSPACE", nil),
+					},
+					"module": {
+						Type:        schema.TypeString,
+						Description: "The module address added to the query tag. The module address is not available to the providers, so it has to be set explicitly (e.g. `path.module`, or a literal module address when the provider is configured in a module).",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"connections": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Additional named connections (e.g. to the replicas of the primary account), selected with the `connection_name` field of the resources and data sources. Each connection is configured with a TOML profile and/or the fields of this block; the fields that are not set are inherited from the provider configuration, except for the credentials (e.g. `password`, `private_key`, or `token`) and `authenticator`. The connections are opened only when they are used by at least one resource or data source. This field can not be set with environmental variables.",
+			Elem: &schema.Resource{
+				Schema: connectionSchema,
+			},
+		},
 	}
 }
 
+// getQueryTagConfigFromTerraform returns the configuration of the query tag and whether it is enabled. The defaults of the nested
+// fields are applied only when the query_tag block is written, so without the block, the query tag is enabled by the SNOWFLAKE_QUERY_TAG
+// environment variable (with the workspace taken from TF_WORKSPACE).
+func getQueryTagConfigFromTerraform(s *schema.ResourceData) (tracking.QueryTagConfig, bool) {
+	if v, ok := s.GetOk("query_tag"); ok && len(v.([]any)) > 0 {
+		// The block may be empty, when all the values are set with the environment variables.
+		queryTag, _ := v.([]any)[0].(map[string]any)
+		tag, _ := queryTag["tag"].(string)
+		workspace, _ := queryTag["workspace"].(string)
+		module, _ := queryTag["module"].(string)
+		return tracking.QueryTagConfig{
+			Tag:       tag,
+			Workspace: workspace,
+			Module:    module,
+		}, true
+	}
+	if tag := oswrapper.Getenv(snowflakeenvs.QueryTag); tag != "" {
+		return tracking.QueryTagConfig{
+			Tag:       tag,
+			Workspace: oswrapper.Getenv("TF_WORKSPACE"),
+		}, true
+	}
+	return tracking.QueryTagConfig{}, false
+}
+
 func getResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"snowflake_account": resources.Account(),
 		"snowflake_account_authentication_policy_attachment":                     resources.AccountAuthenticationPolicyAttachment(),
 		"snowflake_account_role":                                                 resources.AccountRole(),
+		"snowflake_account_role_grants":                                          resources.AccountRoleGrants(),
+		"snowflake_aggregation_policy":                                           resources.AggregationPolicy(),
 		"snowflake_account_password_policy_attachment":                           resources.AccountPasswordPolicyAttachment(),
 		"snowflake_account_parameter":                                            resources.AccountParameter(),
 		"snowflake_alert":                                                        resources.Alert(),
@@ -418,16 +528,25 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_api_authentication_integration_with_client_credentials":       resources.ApiAuthenticationIntegrationWithClientCredentials(),
 		"snowflake_api_authentication_integration_with_jwt_bearer":               resources.ApiAuthenticationIntegrationWithJwtBearer(),
 		"snowflake_api_integration":                                              resources.APIIntegration(),
+		"snowflake_application":                                                  resources.Application(),
+		"snowflake_application_package":                                          resources.ApplicationPackage(),
 		"snowflake_authentication_policy":                                        resources.AuthenticationPolicy(),
+		"snowflake_catalog_integration":                                          resources.CatalogIntegration(),
 		"snowflake_compute_pool":                                                 resources.ComputePool(),
 		"snowflake_cortex_search_service":                                        resources.CortexSearchService(),
 		"snowflake_current_account":                                              resources.CurrentAccount(),
 		"snowflake_current_organization_account":                                 resources.CurrentOrganizationAccount(),
+		"snowflake_data_metric_function":                                         resources.DataMetricFunction(),
+		"snowflake_data_metric_function_attachment":                              resources.DataMetricFunctionAttachment(),
 		"snowflake_database":                                                     resources.Database(),
 		"snowflake_database_role":                                                resources.DatabaseRole(),
+		"snowflake_database_role_grants":                                         resources.DatabaseRoleGrants(),
 		"snowflake_dynamic_table":                                                resources.DynamicTable(),
 		"snowflake_email_notification_integration":                               resources.EmailNotificationIntegration(),
+		"snowflake_event_table":                                                  resources.EventTable(),
+		"snowflake_external_access_integration":                                  resources.ExternalAccessIntegration(),
 		"snowflake_execute":                                                      resources.Execute(),
+		"snowflake_execute_immediate_from":                                       resources.ExecuteImmediateFrom(),
 		"snowflake_external_function":                                            resources.ExternalFunction(),
 		"snowflake_external_oauth_integration":                                   resources.ExternalOauthIntegration(),
 		"snowflake_external_table":                                               resources.ExternalTable(),
@@ -447,8 +566,11 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_grant_privileges_to_database_role":                            resources.GrantPrivilegesToDatabaseRole(),
 		"snowflake_grant_privileges_to_share":                                    resources.GrantPrivilegesToShare(),
 		"snowflake_git_repository":                                               resources.GitRepository(),
+		"snowflake_hybrid_table":                                                 resources.HybridTable(),
+		"snowflake_iceberg_table":                                                resources.IcebergTable(),
 		"snowflake_image_repository":                                             resources.ImageRepository(),
 		"snowflake_job_service":                                                  resources.JobService(),
+		"snowflake_join_policy":                                                  resources.JoinPolicy(),
 		"snowflake_legacy_service_user":                                          resources.LegacyServiceUser(),
 		"snowflake_listing":                                                      resources.Listing(),
 		"snowflake_managed_account":                                              resources.ManagedAccount(),
@@ -461,6 +583,7 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_oauth_integration_for_partner_applications":                   resources.OauthIntegrationForPartnerApplications(),
 		"snowflake_oauth_integration_for_custom_clients":                         resources.OauthIntegrationForCustomClients(),
 		"snowflake_object_parameter":                                             resources.ObjectParameter(),
+		"snowflake_organization_account":                                         resources.OrganizationAccount(),
 		"snowflake_password_policy":                                              resources.PasswordPolicy(),
 		"snowflake_pipe":                                                         resources.Pipe(),
 		"snowflake_primary_connection":                                           resources.PrimaryConnection(),
@@ -469,6 +592,8 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_procedure_python":                                             resources.ProcedurePython(),
 		"snowflake_procedure_scala":                                              resources.ProcedureScala(),
 		"snowflake_procedure_sql":                                                resources.ProcedureSql(),
+		"snowflake_projection_policy":                                            resources.ProjectionPolicy(),
+		"snowflake_replication_group":                                            resources.ReplicationGroup(),
 		"snowflake_resource_monitor":                                             resources.ResourceMonitor(),
 		"snowflake_row_access_policy":                                            resources.RowAccessPolicy(),
 		"snowflake_saml2_integration":                                            resources.SAML2Integration(),
@@ -480,12 +605,15 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_secret_with_basic_authentication":                             resources.SecretWithBasicAuthentication(),
 		"snowflake_secret_with_client_credentials":                               resources.SecretWithClientCredentials(),
 		"snowflake_secret_with_generic_string":                                   resources.SecretWithGenericString(),
+		"snowflake_semantic_view":                                                resources.SemanticView(),
 		"snowflake_service":                                                      resources.Service(),
 		"snowflake_sequence":                                                     resources.Sequence(),
 		"snowflake_service_user":                                                 resources.ServiceUser(),
+		"snowflake_session_policy":                                               resources.SessionPolicy(),
 		"snowflake_share":                                                        resources.Share(),
 		"snowflake_shared_database":                                              resources.SharedDatabase(),
 		"snowflake_stage":                                                        resources.Stage(),
+		"snowflake_stage_file":                                                   resources.StageFile(),
 		"snowflake_storage_integration":                                          resources.StorageIntegration(),
 		"snowflake_stream_on_directory_table":                                    resources.StreamOnDirectoryTable(),
 		"snowflake_stream_on_external_table":                                     resources.StreamOnExternalTable(),
@@ -498,9 +626,11 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_tag":                                                          resources.Tag(),
 		"snowflake_tag_association":                                              resources.TagAssociation(),
 		"snowflake_task":                                                         resources.Task(),
+		"snowflake_task_graph":                                                   resources.TaskGraph(),
 		"snowflake_user":                                                         resources.User(),
 		"snowflake_user_authentication_policy_attachment":                        resources.UserAuthenticationPolicyAttachment(),
 		"snowflake_user_password_policy_attachment":                              resources.UserPasswordPolicyAttachment(),
+		"snowflake_user_session_policy_attachment":                               resources.UserSessionPolicyAttachment(),
 		"snowflake_user_programmatic_access_token":                               resources.UserProgrammaticAccessToken(),
 		"snowflake_user_public_keys":                                             resources.UserPublicKeys(),
 		"snowflake_view":                                                         resources.View(),
@@ -512,39 +642,58 @@ func getDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"snowflake_accounts":                           datasources.Accounts(),
 		"snowflake_account_roles":                      datasources.AccountRoles(),
+		"snowflake_aggregation_policies":               datasources.AggregationPolicies(),
 		"snowflake_alerts":                             datasources.Alerts(),
+		"snowflake_applications":                       datasources.Applications(),
+		"snowflake_application_packages":               datasources.ApplicationPackages(),
 		"snowflake_compute_pools":                      datasources.ComputePools(),
 		"snowflake_connections":                        datasources.Connections(),
 		"snowflake_cortex_search_services":             datasources.CortexSearchServices(),
 		"snowflake_current_account":                    datasources.CurrentAccount(),
 		"snowflake_current_role":                       datasources.CurrentRole(),
+		"snowflake_data_metric_function_references":    datasources.DataMetricFunctionReferences(),
 		"snowflake_database":                           datasources.Database(),
 		"snowflake_database_role":                      datasources.DatabaseRole(),
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_event_tables":                       datasources.EventTables(),
+		"snowflake_external_access_integrations":       datasources.ExternalAccessIntegrations(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
+		"snowflake_external_volumes":                   datasources.ExternalVolumes(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
+		"snowflake_git_branches":                       datasources.GitBranches(),
+		"snowflake_git_commits":                        datasources.GitCommits(),
 		"snowflake_git_repositories":                   datasources.GitRepositories(),
+		"snowflake_git_tags":                           datasources.GitTags(),
 		"snowflake_grants":                             datasources.Grants(),
 		"snowflake_image_repositories":                 datasources.ImageRepositories(),
+		"snowflake_join_policies":                      datasources.JoinPolicies(),
+		"snowflake_listings":                           datasources.Listings(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
 		"snowflake_network_policies":                   datasources.NetworkPolicies(),
+		"snowflake_network_rules":                      datasources.NetworkRules(),
+		"snowflake_notification_integrations":          datasources.NotificationIntegrations(),
+		"snowflake_organization_accounts":              datasources.OrganizationAccounts(),
 		"snowflake_parameters":                         datasources.Parameters(),
 		"snowflake_pipes":                              datasources.Pipes(),
 		"snowflake_procedures":                         datasources.Procedures(),
+		"snowflake_projection_policies":                datasources.ProjectionPolicies(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
 		"snowflake_secrets":                            datasources.Secrets(),
 		"snowflake_security_integrations":              datasources.SecurityIntegrations(),
+		"snowflake_semantic_views":                     datasources.SemanticViews(),
 		"snowflake_services":                           datasources.Services(),
+		"snowflake_session_policies":                   datasources.SessionPolicies(),
 		"snowflake_sequences":                          datasources.Sequences(),
 		"snowflake_shares":                             datasources.Shares(),
+		"snowflake_stage_files":                        datasources.StageFiles(),
 		"snowflake_stages":                             datasources.Stages(),
 		"snowflake_storage_integrations":               datasources.StorageIntegrations(),
 		"snowflake_streams":                            datasources.Streams(),
@@ -596,18 +745,45 @@ func ConfigureProvider(ctx context.Context, s *schema.ResourceData) (any, diag.D
 		}
 	}
 
+	configureClient := func(client *sdk.Client) {
+		if v := s.Get("enable_show_result_cache"); v.(bool) {
+			client.EnableShowResultCache()
+		}
+		if v := s.Get("max_retries").(int); v > 0 {
+			client.EnableRetries(v, time.Duration(s.Get("retry_backoff").(int))*time.Second)
+		}
+		if queryTagConfig, ok := getQueryTagConfigFromTerraform(s); ok {
+			client.EnableQueryTag(queryTagConfig)
+		}
+	}
+
 	providerCtx := &provider.Context{}
+	// The connections inherit the provider configuration, so it is resolved before the client modifies it.
+	connections, err := getConnectionsFromTerraform(s, *config, verifyPermissions, useLegacyTomlFile, configureClient)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	providerCtx.Connections = connections
+
 	if client, err := sdk.NewClient(config); err != nil {
 		return nil, diag.FromErr(err)
 	} else {
+		configureClient(client)
 		providerCtx.Client = client
 	}
 
+	providerCtx.SqlPreview = s.Get("sql_preview").(bool)
+
 	if v, ok := s.GetOk("preview_features_enabled"); ok {
 		providerCtx.EnabledFeatures = expandStringList(v.(*schema.Set).List())
 	}
 
-	return providerCtx, nil
+	var diags diag.Diagnostics
+	if providerCtx.SqlPreview {
+		diags = append(diags, sqlPreviewLimitedSupportWarning(resources.SqlPreviews()))
+	}
+
+	return providerCtx, diags
 }
 
 // TODO: reuse with the function from resources package
@@ -688,6 +864,32 @@ func getDriverConfigFromTerraform(s *schema.ResourceData) (*gosnowflake.Config,
 			}
 			return nil
 		}(),
+		// oidc token file path
+		func() error {
+			if v, ok := s.GetOk("oidc_token_file_path"); ok && v.(string) != "" {
+				if config.Token != "" {
+					return errors.New("token and oidc_token_file_path can't be set at the same time")
+				}
+				token, err := sdk.ReadOidcTokenFile(v.(string))
+				if err != nil {
+					return err
+				}
+				config.Token = token
+			}
+			return nil
+		}(),
+		// workload identity provider
+		func() error {
+			if v, ok := s.GetOk("workload_identity_provider"); ok && v.(string) != "" {
+				workloadIdentityProvider, err := sdk.ToWorkloadIdentityProvider(v.(string))
+				if err != nil {
+					return err
+				}
+				config.WorkloadIdentityProvider = string(workloadIdentityProvider)
+			}
+			return nil
+		}(),
+		handleStringField(s, "workload_identity_entra_resource", &config.WorkloadIdentityEntraResource),
 		// authenticator
 		func() error {
 			authType, err := sdk.ToExtendedAuthenticatorType(s.Get("authenticator").(string))
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/validators"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+const connectionAttributeName = "connection_name"
+
+var connectionSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Name of the connection, used in the `connection_name` field of the resources and data sources.",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+	},
+	"profile": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the profile from the TOML configuration file used for the connection. The fields set directly in the block take precedence over the profile.",
+	},
+	"organization_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the organization name of the account. Must be set together with `account_name`.",
+	},
+	"account_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the account name. Must be set together with `organization_name`.",
+	},
+	"user": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Username.",
+	},
+	"role": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the role to use by default for accessing Snowflake objects in the client session.",
+	},
+	"warehouse": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the virtual warehouse to use by default for queries, loading, etc. in the client session.",
+	},
+	"host": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a custom host value used by the driver for privatelink connections.",
+	},
+	"authenticator": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      fmt.Sprintf("Specifies the [authentication type](https://pkg.go.dev/github.com/snowflakedb/gosnowflake#AuthType) to use when connecting to Snowflake. Valid options are: %v.", docs.PossibleValuesListed(sdk.AllAuthenticationTypes)),
+		ValidateDiagFunc: validators.NormalizeValidation(sdk.ToExtendedAuthenticatorType),
+	},
+	"password": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Password for user + password auth.",
+	},
+	"private_key": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Private Key for username+private-key auth.",
+	},
+	"private_key_passphrase": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Supports the encryption ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc.",
+	},
+	"token": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Token to use for OAuth and other forms of token based auth.",
+	},
+}
+
+// getConnectionsFromTerraform prepares the named connections. The configuration of each connection is resolved (and validated) here,
+// but the connections are opened on the first use. The fields that are not set in the connection block nor in its profile
+// are inherited from the provider configuration, except for the credentials and the authenticator.
+func getConnectionsFromTerraform(s *schema.ResourceData, providerConfig gosnowflake.Config, verifyPermissions, useLegacyTomlFile bool, configureClient func(*sdk.Client)) (*provider.Connections, error) {
+	v, ok := s.GetOk("connections")
+	if !ok {
+		return provider.NewConnections(nil), nil
+	}
+
+	openers := make(map[string]provider.ConnectionOpener)
+	var errs []error
+	for _, raw := range v.([]any) {
+		connection, _ := raw.(map[string]any)
+		name, _ := connection["name"].(string)
+		if _, ok := openers[name]; ok {
+			errs = append(errs, fmt.Errorf(`connection "%s" is defined more than once`, name))
+			continue
+		}
+		config, err := getConnectionConfig(connection, providerConfig, verifyPermissions, useLegacyTomlFile)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(`invalid connection "%s": %w`, name, err))
+			continue
+		}
+		openers[name] = func() (*sdk.Client, error) {
+			client, err := sdk.NewClient(config)
+			if err != nil {
+				return nil, err
+			}
+			configureClient(client)
+			return client, nil
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return provider.NewConnections(openers), nil
+}
+
+func getConnectionConfig(connection map[string]any, providerConfig gosnowflake.Config, verifyPermissions, useLegacyTomlFile bool) (*gosnowflake.Config, error) {
+	stringValue := func(key string) string {
+		value, _ := connection[key].(string)
+		return value
+	}
+
+	config := &gosnowflake.Config{
+		Application: providerConfig.Application,
+		User:        stringValue("user"),
+		Password:    stringValue("password"),
+		Role:        stringValue("role"),
+		Warehouse:   stringValue("warehouse"),
+		Host:        stringValue("host"),
+		Token:       stringValue("token"),
+	}
+
+	organizationName, accountName := stringValue("organization_name"), stringValue("account_name")
+	switch {
+	case organizationName != "" && accountName != "":
+		config.Account = strings.Join([]string{organizationName, accountName}, "-")
+	case organizationName != "" || accountName != "":
+		return nil, errors.New("organization_name and account_name must be set together")
+	}
+
+	authenticator, err := sdk.ToExtendedAuthenticatorType(stringValue("authenticator"))
+	if err != nil {
+		return nil, err
+	}
+	config.Authenticator = authenticator
+
+	privateKey, err := GetPrivateKey(stringValue("private_key"), stringValue("private_key_passphrase"))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve private key: %w", err)
+	}
+	if privateKey != nil {
+		config.PrivateKey = privateKey
+	}
+
+	if profile := stringValue("profile"); profile != "" {
+		tomlConfig, err := GetDriverConfigFromTOML(profile, verifyPermissions, useLegacyTomlFile)
+		if err != nil {
+			return nil, err
+		}
+		config = sdk.MergeConfig(config, tomlConfig)
+	}
+	config = sdk.MergeConfig(config, withoutCredentials(providerConfig))
+
+	if config.Authenticator == sdk.GosnowflakeAuthTypeEmpty && config.Token != "" {
+		config.Authenticator = gosnowflake.AuthTypeOAuth
+	}
+	return config, nil
+}
+
+// withoutCredentials returns the copy of the provider configuration without the credentials, so that they are not sent
+// to the other accounts. The authenticator (and the workload identity provider) is removed too, as it is bound to the credentials.
+func withoutCredentials(config gosnowflake.Config) *gosnowflake.Config {
+	config.Authenticator = sdk.GosnowflakeAuthTypeEmpty
+	config.Password = ""
+	config.Passcode = ""
+	config.PasscodeInPassword = false
+	config.PrivateKey = nil
+	config.Token = ""
+	config.TokenAccessor = nil
+	config.OauthClientID = ""
+	config.OauthClientSecret = ""
+	config.MfaToken = ""
+	config.IDToken = ""
+	config.WorkloadIdentityProvider = ""
+	return &config
+}
+
+// withConnectionRouting adds the connection field to the given resources (or data sources) and wraps their operations,
+// so that they receive the provider context with the client of the connection set in the field.
+func withConnectionRouting(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, r := range resources {
+		isResource := r.CreateContext != nil
+		description := "Name of the connection from the `connections` block in the provider configuration used to manage this object. When not set, the default connection of the provider is used."
+		if isResource {
+			description += " Changing the connection recreates the object: it is dropped using the previous connection and created using the new one. To import the object using the connection, prefix the import ID with the connection name and a colon (e.g. `replica:<id>`)."
+		}
+		r.Schema[connectionAttributeName] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         isResource,
+			Description:      description,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		}
+
+		if r.CreateContext != nil {
+			r.CreateContext = connectionRoutingWrapper(r.CreateContext)
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = connectionRoutingWrapper(r.ReadContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = connectionRoutingWrapper(r.UpdateContext)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = connectionRoutingWrapper(r.DeleteContext)
+		}
+		if r.CustomizeDiff != nil {
+			r.CustomizeDiff = connectionRoutingCustomDiffWrapper(r.CustomizeDiff)
+		}
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			r.Importer.StateContext = connectionRoutingImportWrapper(r.Importer.StateContext)
+		}
+		for i := range r.StateUpgraders {
+			if r.StateUpgraders[i].Upgrade != nil {
+				r.StateUpgraders[i].Upgrade = connectionRoutingStateUpgradeWrapper(r.StateUpgraders[i].Upgrade)
+			}
+		}
+	}
+	return resources
+}
+
+func connectionRoutingWrapper(operation func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		providerCtx, ok := meta.(*provider.Context)
+		name, _ := d.Get(connectionAttributeName).(string)
+		if !ok || name == "" {
+			return operation(ctx, d, meta)
+		}
+		connectionCtx, err := providerCtx.ForConnection(name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return operation(ctx, d, connectionCtx)
+	}
+}
+
+// connectionRoutingCustomDiffWrapper routes the custom diffs that query Snowflake during the plan (e.g. to resolve the current state of the referenced objects).
+func connectionRoutingCustomDiffWrapper(customDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		providerCtx, ok := meta.(*provider.Context)
+		name, _ := diff.Get(connectionAttributeName).(string)
+		if !ok || name == "" {
+			return customDiff(ctx, diff, meta)
+		}
+		connectionCtx, err := providerCtx.ForConnection(name)
+		if err != nil {
+			return err
+		}
+		return customDiff(ctx, diff, connectionCtx)
+	}
+}
+
+// connectionRoutingImportWrapper routes the import when the import ID is prefixed with the name of one of the defined connections
+// and a colon (e.g. replica:"database"). The prefix is removed from the ID, and the connection is saved in the imported state.
+func connectionRoutingImportWrapper(importer schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		providerCtx, ok := meta.(*provider.Context)
+		if !ok {
+			return importer(ctx, d, meta)
+		}
+		name, id, found := parseConnectionImportId(d.Id(), providerCtx.Connections.Names())
+		if !found {
+			return importer(ctx, d, meta)
+		}
+		connectionCtx, err := providerCtx.ForConnection(name)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+		if err := d.Set(connectionAttributeName, name); err != nil {
+			return nil, err
+		}
+		imported, err := importer(ctx, d, connectionCtx)
+		if err != nil {
+			return nil, err
+		}
+		for _, data := range imported {
+			if err := data.Set(connectionAttributeName, name); err != nil {
+				return nil, err
+			}
+		}
+		return imported, nil
+	}
+}
+
+func parseConnectionImportId(importId string, connectionNames []string) (name string, id string, found bool) {
+	name, id, found = strings.Cut(importId, ":")
+	if !found || !slices.Contains(connectionNames, name) {
+		return "", importId, false
+	}
+	return name, id, true
+}
+
+// connectionRoutingStateUpgradeWrapper routes the state upgraders that query Snowflake, using the connection saved in the state.
+func connectionRoutingStateUpgradeWrapper(upgrade schema.StateUpgradeFunc) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+		providerCtx, ok := meta.(*provider.Context)
+		name, _ := rawState[connectionAttributeName].(string)
+		if !ok || name == "" {
+			return upgrade(ctx, rawState, meta)
+		}
+		connectionCtx, err := providerCtx.ForConnection(name)
+		if err != nil {
+			return nil, err
+		}
+		return upgrade(ctx, rawState, connectionCtx)
+	}
+}
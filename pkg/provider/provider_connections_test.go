@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConnectionsFromTerraform(t *testing.T) {
+	providerConfig := gosnowflake.Config{
+		Application:   "terraform-provider-snowflake",
+		Account:       "org-primary",
+		User:          "provider_user",
+		Password:      "provider_password",
+		Role:          "provider_role",
+		Authenticator: gosnowflake.AuthTypeSnowflake,
+	}
+
+	t.Run("inherits the provider configuration", func(t *testing.T) {
+		config, err := getConnectionConfig(map[string]any{
+			"organization_name": "org",
+			"account_name":      "replica",
+			"role":              "replica_role",
+		}, providerConfig, true, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, "terraform-provider-snowflake", config.Application)
+		assert.Equal(t, "org-replica", config.Account)
+		assert.Equal(t, "provider_user", config.User)
+		assert.Equal(t, "replica_role", config.Role)
+	})
+
+	t.Run("does not inherit the credentials", func(t *testing.T) {
+		providerConfig := providerConfig
+		providerConfig.Authenticator = gosnowflake.AuthTypeJwt
+		providerConfig.PrivateKey = &rsa.PrivateKey{}
+		providerConfig.Token = "provider_token"
+
+		config, err := getConnectionConfig(map[string]any{"organization_name": "org", "account_name": "replica"}, providerConfig, true, false)
+
+		require.NoError(t, err)
+		assert.Empty(t, config.Password)
+		assert.Nil(t, config.PrivateKey)
+		assert.Empty(t, config.Token)
+		assert.Equal(t, sdk.GosnowflakeAuthTypeEmpty, config.Authenticator)
+	})
+
+	t.Run("uses the credentials of the connection", func(t *testing.T) {
+		config, err := getConnectionConfig(map[string]any{"token": "replica_token"}, providerConfig, true, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, "replica_token", config.Token)
+		assert.Empty(t, config.Password)
+		assert.Equal(t, gosnowflake.AuthTypeOAuth, config.Authenticator)
+	})
+
+	t.Run("does not modify the provider configuration", func(t *testing.T) {
+		_, err := getConnectionConfig(map[string]any{"user": "replica_user"}, providerConfig, true, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, "provider_user", providerConfig.User)
+		assert.Equal(t, "provider_password", providerConfig.Password)
+	})
+
+	t.Run("only one of organization_name and account_name", func(t *testing.T) {
+		_, err := getConnectionConfig(map[string]any{"account_name": "replica"}, providerConfig, true, false)
+
+		require.ErrorContains(t, err, "organization_name and account_name must be set together")
+	})
+
+	t.Run("invalid authenticator", func(t *testing.T) {
+		_, err := getConnectionConfig(map[string]any{"authenticator": "invalid"}, providerConfig, true, false)
+
+		require.ErrorContains(t, err, "invalid authenticator type: invalid")
+	})
+
+	t.Run("duplicated names", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{
+			"connections": []any{
+				map[string]any{"name": "replica"},
+				map[string]any{"name": "replica"},
+			},
+		})
+
+		_, err := getConnectionsFromTerraform(d, providerConfig, true, false, func(*sdk.Client) {})
+
+		require.ErrorContains(t, err, `connection "replica" is defined more than once`)
+	})
+
+	t.Run("names", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{
+			"connections": []any{
+				map[string]any{"name": "replica_2"},
+				map[string]any{"name": "replica_1"},
+			},
+		})
+
+		connections, err := getConnectionsFromTerraform(d, providerConfig, true, false, func(*sdk.Client) {})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"replica_1", "replica_2"}, connections.Names())
+	})
+}
+
+func TestWithConnectionRouting(t *testing.T) {
+	defaultClient, replicaClient := &sdk.Client{}, &sdk.Client{}
+	opened := 0
+	meta := &provider.Context{
+		Client:          defaultClient,
+		EnabledFeatures: []string{"feature"},
+		Connections: provider.NewConnections(map[string]provider.ConnectionOpener{
+			"replica": func() (*sdk.Client, error) {
+				opened++
+				return replicaClient, nil
+			},
+		}),
+	}
+
+	var usedContext *provider.Context
+	resources := withConnectionRouting(map[string]*schema.Resource{
+		"snowflake_object": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+			CreateContext: func(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				usedContext = meta.(*provider.Context)
+				return nil
+			},
+			ReadContext: func(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				usedContext = meta.(*provider.Context)
+				return nil
+			},
+			DeleteContext: func(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				return nil
+			},
+			CustomizeDiff: func(_ context.Context, _ *schema.ResourceDiff, meta any) error {
+				usedContext = meta.(*provider.Context)
+				return nil
+			},
+			Importer: &schema.ResourceImporter{
+				StateContext: func(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+					usedContext = meta.(*provider.Context)
+					return []*schema.ResourceData{d}, d.Set("name", d.Id())
+				},
+			},
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				{
+					Version: 0,
+					Type:    cty.EmptyObject,
+					Upgrade: func(_ context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+						usedContext = meta.(*provider.Context)
+						return rawState, nil
+					},
+				},
+			},
+		},
+	})
+	r := resources["snowflake_object"]
+
+	require.Contains(t, r.Schema, "connection_name")
+	assert.True(t, r.Schema["connection_name"].ForceNew)
+	require.Nil(t, r.UpdateContext)
+	require.NoError(t, r.InternalValidate(nil, true))
+
+	t.Run("default connection", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"name": "object"})
+
+		require.Empty(t, r.CreateContext(context.Background(), d, meta))
+		assert.Same(t, defaultClient, usedContext.Client)
+		assert.Zero(t, opened)
+	})
+
+	t.Run("named connection", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"name": "object", "connection_name": "replica"})
+
+		require.Empty(t, r.CreateContext(context.Background(), d, meta))
+		assert.Same(t, replicaClient, usedContext.Client)
+		assert.Equal(t, []string{"feature"}, usedContext.EnabledFeatures)
+
+		require.Empty(t, r.ReadContext(context.Background(), d, meta))
+		assert.Same(t, replicaClient, usedContext.Client)
+		assert.Equal(t, 1, opened)
+	})
+
+	t.Run("named connection in custom diff", func(t *testing.T) {
+		usedContext = nil
+		_, err := r.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]any{"name": "object", "connection_name": "replica"}), meta)
+
+		require.NoError(t, err)
+		assert.Same(t, replicaClient, usedContext.Client)
+	})
+
+	t.Run("changing the connection recreates the object", func(t *testing.T) {
+		diff, err := r.SimpleDiff(context.Background(), &terraform.InstanceState{ID: "object", Attributes: map[string]string{"name": "object"}}, terraform.NewResourceConfigRaw(map[string]any{"name": "object", "connection_name": "replica"}), meta)
+
+		require.NoError(t, err)
+		assert.True(t, diff.RequiresNew())
+	})
+
+	t.Run("import with the default connection", func(t *testing.T) {
+		d := r.Data(nil)
+		d.SetId("replica_object")
+
+		imported, err := r.Importer.StateContext(context.Background(), d, meta)
+
+		require.NoError(t, err)
+		assert.Same(t, defaultClient, usedContext.Client)
+		assert.Equal(t, "replica_object", imported[0].Id())
+		assert.Empty(t, imported[0].Get("connection_name"))
+	})
+
+	t.Run("import with a named connection", func(t *testing.T) {
+		d := r.Data(nil)
+		d.SetId("replica:object")
+
+		imported, err := r.Importer.StateContext(context.Background(), d, meta)
+
+		require.NoError(t, err)
+		assert.Same(t, replicaClient, usedContext.Client)
+		assert.Equal(t, "object", imported[0].Id())
+		assert.Equal(t, "object", imported[0].Get("name"))
+		assert.Equal(t, "replica", imported[0].Get("connection_name"))
+	})
+
+	t.Run("import with an undefined connection prefix", func(t *testing.T) {
+		d := r.Data(nil)
+		d.SetId("unknown:object")
+
+		imported, err := r.Importer.StateContext(context.Background(), d, meta)
+
+		require.NoError(t, err)
+		assert.Same(t, defaultClient, usedContext.Client)
+		assert.Equal(t, "unknown:object", imported[0].Id())
+	})
+
+	t.Run("state upgrade with a named connection", func(t *testing.T) {
+		_, err := r.StateUpgraders[0].Upgrade(context.Background(), map[string]any{"name": "object", "connection_name": "replica"}, meta)
+
+		require.NoError(t, err)
+		assert.Same(t, replicaClient, usedContext.Client)
+
+		_, err = r.StateUpgraders[0].Upgrade(context.Background(), map[string]any{"name": "object"}, meta)
+
+		require.NoError(t, err)
+		assert.Same(t, defaultClient, usedContext.Client)
+	})
+
+	t.Run("unknown connection", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"name": "object", "connection_name": "unknown"})
+
+		diags := r.CreateContext(context.Background(), d, meta)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, `connection "unknown" is not defined in the provider configuration, defined connections: replica`)
+	})
+}
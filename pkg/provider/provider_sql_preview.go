@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	plannedSqlAttributeName = "planned_sql"
+
+	sqlPreviewSensitiveValue = "<sensitive>"
+)
+
+// withSqlPreview adds the planned_sql field to the resources having the SQL preview. When the SQL preview is enabled in the provider configuration,
+// the field is set during the plan to the statements built from the SDK requests matching the planned change (see resources.SqlPreview).
+// The statements are cleared in the state after the apply, so that they are not shown again in the next plan.
+func withSqlPreview(resourcesMap map[string]*schema.Resource, previews map[string]resources.SqlPreview) map[string]*schema.Resource {
+	for name, preview := range previews {
+		r, ok := resourcesMap[name]
+		if !ok {
+			continue
+		}
+		r.Schema[plannedSqlAttributeName] = &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The statements that the provider would run for the planned changes of this object. Set only when `sql_preview` is enabled in the provider configuration.",
+		}
+		r.CustomizeDiff = sqlPreviewCustomizeDiff(r.Schema, preview, r.CustomizeDiff)
+		if r.ReadContext != nil {
+			r.ReadContext = sqlPreviewClearingRead(r.ReadContext)
+		}
+	}
+	return resourcesMap
+}
+
+// sqlPreviewLimitedSupportWarning lists the resources having the SQL preview, as planned_sql is not set for any other resource.
+func sqlPreviewLimitedSupportWarning(previews map[string]resources.SqlPreview) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "SQL preview is available only for some resources",
+		Detail:   fmt.Sprintf("The statements are previewed in the %s field only for the following resources: %s. The changes of the other resources are applied without the preview.", plannedSqlAttributeName, strings.Join(slices.Sorted(maps.Keys(previews)), ", ")),
+	}
+}
+
+func sqlPreviewClearingRead(read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := read(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := d.Set(plannedSqlAttributeName, []string{}); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func sqlPreviewCustomizeDiff(resourceSchema map[string]*schema.Schema, preview resources.SqlPreview, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+		providerCtx, ok := meta.(*provider.Context)
+		if !ok || !providerCtx.SqlPreview {
+			return nil
+		}
+		statements, ok, err := previewSql(ctx, resourceSchema, preview, d)
+		switch {
+		case err != nil:
+			return fmt.Errorf("could not preview the statements: %w", err)
+		case !ok:
+			return nil
+		case statements == nil:
+			return d.SetNewComputed(plannedSqlAttributeName)
+		default:
+			return d.SetNew(plannedSqlAttributeName, statements)
+		}
+	}
+}
+
+// previewSql returns the statements for the planned change; the statements are empty when there are no changes planned, and nil
+// when any of the values used in the statements is not known until the apply. It returns false when the object is replaced and
+// the diff is computed with the prior state. The diff of the replaced object is computed again without the prior state
+// (but with its raw value), and only then the statements dropping the prior object and creating the new one are previewed.
+func previewSql(ctx context.Context, resourceSchema map[string]*schema.Schema, preview resources.SqlPreview, d *schema.ResourceDiff) ([]string, bool, error) {
+	client, recorded := sdk.NewSqlPreviewClient()
+	unknown := false
+	values := sqlPreviewValues{ResourceDiff: d, schema: resourceSchema, id: d.Id(), unknown: &unknown}
+
+	var err error
+	switch priorId := sqlPreviewPriorId(d); {
+	case d.Id() != "":
+		changed, replaced := sqlPreviewChanges(resourceSchema, d)
+		if replaced {
+			return nil, false, nil
+		}
+		if !changed {
+			return []string{}, true, nil
+		}
+		err = preview.Update(ctx, values, client)
+	case priorId != "":
+		if err = preview.Delete(ctx, sqlPreviewValues{ResourceDiff: d, schema: resourceSchema, id: priorId, unknown: &unknown}, client); err == nil {
+			err = preview.Create(ctx, values, client)
+		}
+	default:
+		err = preview.Create(ctx, values, client)
+	}
+	if unknown {
+		return nil, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return recorded.Statements(), true, nil
+}
+
+// sqlPreviewChanges returns whether any of the configurable fields changes, and whether any of the changed fields forces the replacement.
+func sqlPreviewChanges(resourceSchema map[string]*schema.Schema, d *schema.ResourceDiff) (changed bool, replaced bool) {
+	for k, s := range resourceSchema {
+		if s.Computed && !s.Optional {
+			continue
+		}
+		if d.HasChange(k) {
+			changed = true
+			replaced = replaced || s.ForceNew
+		}
+	}
+	return changed, replaced
+}
+
+// sqlPreviewPriorId returns the id from the raw prior state. It's set for the replaced objects also when the diff is computed without the prior state.
+func sqlPreviewPriorId(d *schema.ResourceDiff) string {
+	rawState := d.GetRawState()
+	if rawState.IsNull() || !rawState.IsKnown() || !rawState.Type().IsObjectType() || !rawState.Type().HasAttribute("id") {
+		return ""
+	}
+	id := rawState.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() {
+		return ""
+	}
+	return id.AsString()
+}
+
+// sqlPreviewValues returns the planned values of the object with the placeholders in place of the top-level string values
+// that are sensitive, so that they never get into the previewed statements. Reading any value that is not known until
+// the apply is recorded, because the statements can't be previewed then.
+type sqlPreviewValues struct {
+	*schema.ResourceDiff
+	schema  map[string]*schema.Schema
+	id      string
+	unknown *bool
+}
+
+func (v sqlPreviewValues) Id() string {
+	return v.id
+}
+
+func (v sqlPreviewValues) Get(key string) any {
+	value, _ := v.GetOk(key)
+	return value
+}
+
+func (v sqlPreviewValues) GetOk(key string) (any, bool) {
+	if !v.NewValueKnown(key) {
+		*v.unknown = true
+	}
+	value, ok := v.ResourceDiff.GetOk(key)
+	if s, found := v.schema[key]; found && s.Type == schema.TypeString && s.Sensitive && ok {
+		return sqlPreviewSensitiveValue, true
+	}
+	return value, ok
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSqlPreview(t *testing.T) {
+	failingOperation := func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		t.Fatal("the resource operations should not be called by the SQL preview")
+		return nil
+	}
+	databaseOptions := func(d resources.ResourceValueGetter) *sdk.CreateDatabaseOptions {
+		opts := &sdk.CreateDatabaseOptions{}
+		if v, ok := d.GetOk("comment"); ok {
+			opts.Comment = sdk.String(v.(string))
+		}
+		return opts
+	}
+	resourcesMap := withSqlPreview(map[string]*schema.Resource{
+		"snowflake_object": {
+			Schema: map[string]*schema.Schema{
+				"name":    {Type: schema.TypeString, Required: true, ForceNew: true},
+				"comment": {Type: schema.TypeString, Optional: true},
+				"secret":  {Type: schema.TypeString, Optional: true, Sensitive: true},
+			},
+			CreateContext: failingOperation,
+			ReadContext:   failingOperation,
+			UpdateContext: failingOperation,
+			DeleteContext: failingOperation,
+		},
+		"snowflake_object_without_preview": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+			CreateContext: failingOperation,
+			ReadContext:   failingOperation,
+			DeleteContext: failingOperation,
+		},
+	}, map[string]resources.SqlPreview{
+		"snowflake_object": {
+			Create: func(ctx context.Context, d resources.ResourceValueGetter, client *sdk.Client) error {
+				id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+				if err := client.Databases.Create(ctx, id, databaseOptions(d)); err != nil {
+					return err
+				}
+				if v, ok := d.GetOk("secret"); ok {
+					_, err := client.ExecUnsafe(ctx, fmt.Sprintf("ALTER DATABASE %s SET SECRET = '%s'", id.FullyQualifiedName(), v.(string)))
+					return err
+				}
+				return nil
+			},
+			Update: func(ctx context.Context, d resources.ResourceValueGetter, client *sdk.Client) error {
+				if d.HasChange("comment") {
+					id := sdk.NewAccountObjectIdentifier(d.Id())
+					return client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Set: &sdk.DatabaseSet{Comment: sdk.String(d.Get("comment").(string))}})
+				}
+				return nil
+			},
+			Delete: func(ctx context.Context, d resources.ResourceValueGetter, client *sdk.Client) error {
+				return client.Databases.Drop(ctx, sdk.NewAccountObjectIdentifier(d.Id()), &sdk.DropDatabaseOptions{IfExists: sdk.Bool(true)})
+			},
+		},
+	})
+	r := resourcesMap["snowflake_object"]
+	require.Contains(t, r.Schema, "planned_sql")
+	require.NoError(t, r.InternalValidate(nil, true))
+	require.NotContains(t, resourcesMap["snowflake_object_without_preview"].Schema, "planned_sql")
+
+	meta := &provider.Context{SqlPreview: true}
+	state := &terraform.InstanceState{
+		ID: "object",
+		Attributes: map[string]string{
+			"id":      "object",
+			"name":    "object",
+			"comment": "comment",
+			"secret":  "it's secret",
+
+			"planned_sql.#": "0",
+		},
+		RawState: cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("object"),
+			"name": cty.StringVal("object"),
+		}),
+	}
+	plannedSql := func(t *testing.T, diff *terraform.InstanceDiff) []string {
+		t.Helper()
+		require.NotNil(t, diff)
+		count, ok := diff.Attributes["planned_sql.#"]
+		require.True(t, ok)
+		statements := make([]string, 0)
+		for i := 0; ; i++ {
+			attribute, ok := diff.Attributes[fmt.Sprintf("planned_sql.%d", i)]
+			if !ok {
+				break
+			}
+			statements = append(statements, attribute.New)
+		}
+		require.Equal(t, fmt.Sprint(len(statements)), count.New)
+		return statements
+	}
+
+	t.Run("create", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{
+			"name":    "object",
+			"comment": "comment",
+			"secret":  "it's secret",
+		}), meta)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			`CREATE DATABASE "object" COMMENT = 'comment'`,
+			`ALTER DATABASE "object" SET SECRET = '<sensitive>'`,
+		}, plannedSql(t, diff))
+	})
+
+	t.Run("create with unknown values", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{
+			"name": "object",
+			// the value used by the plugin SDK for the unknown values in the raw configuration
+			"comment": "74D93920-ED26-11E3-AC10-0800200C9A66",
+		}), meta)
+
+		require.NoError(t, err)
+		require.Contains(t, diff.Attributes, "planned_sql.#")
+		assert.True(t, diff.Attributes["planned_sql.#"].NewComputed)
+		assert.NotContains(t, diff.Attributes, "planned_sql.0")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]any{
+			"name":    "object",
+			"comment": "new comment",
+			"secret":  "it's secret",
+		}), meta)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{`ALTER DATABASE "object" SET COMMENT = 'new comment'`}, plannedSql(t, diff))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]any{
+			"name":    "new_object",
+			"comment": "comment",
+		}), meta)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			`DROP DATABASE IF EXISTS "object"`,
+			`CREATE DATABASE "new_object" COMMENT = 'comment'`,
+		}, plannedSql(t, diff))
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]any{
+			"name":    "object",
+			"comment": "comment",
+			"secret":  "it's secret",
+		}), meta)
+
+		require.NoError(t, err)
+		assert.Nil(t, diff)
+	})
+
+	t.Run("no changes after the previewed apply", func(t *testing.T) {
+		appliedState := state.DeepCopy()
+		appliedState.Attributes["planned_sql.#"] = "1"
+		appliedState.Attributes["planned_sql.0"] = `ALTER DATABASE "object" SET COMMENT = 'comment'`
+
+		diff, err := r.Diff(context.Background(), appliedState, terraform.NewResourceConfigRaw(map[string]any{
+			"name":    "object",
+			"comment": "comment",
+			"secret":  "it's secret",
+		}), meta)
+
+		require.NoError(t, err)
+		require.NotNil(t, diff)
+		require.Contains(t, diff.Attributes, "planned_sql.#")
+		assert.Equal(t, "0", diff.Attributes["planned_sql.#"].New)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{
+			"name": "",
+		}), meta)
+
+		require.ErrorContains(t, err, "could not preview the statements")
+	})
+
+	t.Run("sql preview disabled", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{
+			"name": "object",
+		}), &provider.Context{})
+
+		require.NoError(t, err)
+		require.Contains(t, diff.Attributes, "planned_sql.#")
+		assert.True(t, diff.Attributes["planned_sql.#"].NewComputed)
+	})
+}
+
+func TestSqlPreviews(t *testing.T) {
+	meta := &provider.Context{SqlPreview: true}
+	resourcesMap := Provider().ResourcesMap
+
+	testCases := []struct {
+		resource string
+		state    *terraform.InstanceState
+		config   map[string]any
+		expected []string
+	}{
+		{
+			resource: "snowflake_account_role",
+			config:   map[string]any{"name": "role", "comment": "comment"},
+			expected: []string{`CREATE ROLE "role" COMMENT = 'comment'`},
+		},
+		{
+			resource: "snowflake_account_role",
+			state: &terraform.InstanceState{
+				ID:         `"role"`,
+				Attributes: map[string]string{"id": `"role"`, "name": "role", "comment": "comment"},
+			},
+			config: map[string]any{"name": "new_role"},
+			expected: []string{
+				`ALTER ROLE "role" RENAME TO "new_role"`,
+				`ALTER ROLE "new_role" UNSET COMMENT`,
+			},
+		},
+		{
+			resource: "snowflake_database_role",
+			config:   map[string]any{"database": "database", "name": "role", "comment": "comment"},
+			expected: []string{`CREATE DATABASE ROLE "database"."role" COMMENT = 'comment'`},
+		},
+		{
+			resource: "snowflake_secret_with_generic_string",
+			config:   map[string]any{"database": "database", "schema": "schema", "name": "secret", "secret_string": "it's secret"},
+			expected: []string{`CREATE SECRET "database"."schema"."secret" TYPE = GENERIC_STRING SECRET_STRING = '<sensitive>'`},
+		},
+		{
+			resource: "snowflake_secret_with_generic_string",
+			state: &terraform.InstanceState{
+				ID:         `"database"."schema"."secret"`,
+				Attributes: map[string]string{"id": `"database"."schema"."secret"`, "database": "database", "schema": "schema", "name": "secret", "secret_string": "it's secret", "secret_type": "GENERIC_STRING"},
+			},
+			config:   map[string]any{"database": "database", "schema": "schema", "name": "secret", "secret_string": "new secret"},
+			expected: []string{`ALTER SECRET "database"."schema"."secret" SET SECRET_STRING = '<sensitive>'`},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.resource, func(t *testing.T) {
+			diff, err := resourcesMap[tc.resource].Diff(context.Background(), tc.state, terraform.NewResourceConfigRaw(tc.config), meta)
+
+			require.NoError(t, err)
+			statements := make([]string, 0)
+			for i := 0; ; i++ {
+				attribute, ok := diff.Attributes[fmt.Sprintf("planned_sql.%d", i)]
+				if !ok {
+					break
+				}
+				statements = append(statements, attribute.New)
+			}
+			assert.Equal(t, tc.expected, statements)
+		})
+	}
+}
+
+func TestSqlPreviewClearingRead(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name":        {Type: schema.TypeString, Required: true},
+		"planned_sql": {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+	read := sqlPreviewClearingRead(func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil })
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{"name": "object"})
+	d.SetId("object")
+	require.NoError(t, d.Set("planned_sql", []string{`CREATE DATABASE "object"`}))
+
+	diags := read(context.Background(), d, nil)
+
+	require.Empty(t, diags)
+	assert.Empty(t, d.Get("planned_sql"))
+}
+
+func TestSqlPreviewLimitedSupportWarning(t *testing.T) {
+	warning := sqlPreviewLimitedSupportWarning(map[string]resources.SqlPreview{"snowflake_b": {}, "snowflake_a": {}})
+
+	assert.Equal(t, diag.Warning, warning.Severity)
+	assert.Contains(t, warning.Detail, "following resources: snowflake_a, snowflake_b.")
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/snowflakedb/gosnowflake"
@@ -145,3 +147,39 @@ func TestGetDriverConfigFromTerraform_AllFields(t *testing.T) {
 	assert.Equal(t, "test_tag", *config.Params["QUERY_TAG"])
 	assert.Equal(t, "UTC", *config.Params["TIMEZONE"])
 }
+
+func TestGetQueryTagConfigFromTerraform(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Setenv(snowflakeenvs.QueryTag, "")
+		t.Setenv("TF_WORKSPACE", "prod")
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{})
+
+		_, ok := getQueryTagConfigFromTerraform(d)
+
+		require.False(t, ok)
+	})
+
+	t.Run("enabled with the environment variables without the block", func(t *testing.T) {
+		t.Setenv(snowflakeenvs.QueryTag, "finops")
+		t.Setenv("TF_WORKSPACE", "prod")
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{})
+
+		config, ok := getQueryTagConfigFromTerraform(d)
+
+		require.True(t, ok)
+		require.Equal(t, tracking.QueryTagConfig{Tag: "finops", Workspace: "prod"}, config)
+	})
+
+	t.Run("enabled with the block", func(t *testing.T) {
+		t.Setenv(snowflakeenvs.QueryTag, "")
+		t.Setenv("TF_WORKSPACE", "")
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{
+			"query_tag": []any{map[string]any{"tag": "finops", "workspace": "prod", "module": "module.database"}},
+		})
+
+		config, ok := getQueryTagConfigFromTerraform(d)
+
+		require.True(t, ok)
+		require.Equal(t, tracking.QueryTagConfig{Tag: "finops", Workspace: "prod", Module: "module.database"}, config)
+	})
+}
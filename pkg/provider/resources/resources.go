@@ -8,21 +8,32 @@ const (
 	AccountParameter                                       resource = "snowflake_account_parameter"
 	AccountPasswordPolicyAttachment                        resource = "snowflake_account_password_policy_attachment"
 	AccountRole                                            resource = "snowflake_account_role"
+	AccountRoleGrants                                      resource = "snowflake_account_role_grants"
+	AggregationPolicy                                      resource = "snowflake_aggregation_policy"
 	Alert                                                  resource = "snowflake_alert"
 	ApiAuthenticationIntegrationWithAuthorizationCodeGrant resource = "snowflake_api_authentication_integration_with_authorization_code_grant"
 	ApiAuthenticationIntegrationWithClientCredentials      resource = "snowflake_api_authentication_integration_with_client_credentials"
 	ApiAuthenticationIntegrationWithJwtBearer              resource = "snowflake_api_authentication_integration_with_jwt_bearer"
 	ApiIntegration                                         resource = "snowflake_api_integration"
+	Application                                            resource = "snowflake_application"
+	ApplicationPackage                                     resource = "snowflake_application_package"
 	AuthenticationPolicy                                   resource = "snowflake_authentication_policy"
+	CatalogIntegration                                     resource = "snowflake_catalog_integration"
 	ComputePool                                            resource = "snowflake_compute_pool"
 	CortexSearchService                                    resource = "snowflake_cortex_search_service"
 	CurrentAccount                                         resource = "snowflake_current_account"
 	CurrentOrganizationAccount                             resource = "snowflake_current_organization_account"
+	DataMetricFunction                                     resource = "snowflake_data_metric_function"
+	DataMetricFunctionAttachment                           resource = "snowflake_data_metric_function_attachment"
 	Database                                               resource = "snowflake_database"
 	DatabaseRole                                           resource = "snowflake_database_role"
+	DatabaseRoleGrants                                     resource = "snowflake_database_role_grants"
 	DynamicTable                                           resource = "snowflake_dynamic_table"
 	EmailNotificationIntegration                           resource = "snowflake_email_notification_integration"
+	EventTable                                             resource = "snowflake_event_table"
+	ExternalAccessIntegration                              resource = "snowflake_external_access_integration"
 	Execute                                                resource = "snowflake_execute"
+	ExecuteImmediateFrom                                   resource = "snowflake_execute_immediate_from"
 	ExternalFunction                                       resource = "snowflake_external_function"
 	ExternalTable                                          resource = "snowflake_external_table"
 	ExternalOauthSecurityIntegration                       resource = "snowflake_external_oauth_integration"
@@ -42,8 +53,11 @@ const (
 	GrantPrivilegesToAccountRole                           resource = "snowflake_grant_privileges_to_account_role"
 	GrantPrivilegesToDatabaseRole                          resource = "snowflake_grant_privileges_to_database_role"
 	GrantPrivilegesToShare                                 resource = "snowflake_grant_privileges_to_share"
+	HybridTable                                            resource = "snowflake_hybrid_table"
+	IcebergTable                                           resource = "snowflake_iceberg_table"
 	ImageRepository                                        resource = "snowflake_image_repository"
 	JobService                                             resource = "snowflake_job_service"
+	JoinPolicy                                             resource = "snowflake_join_policy"
 	LegacyServiceUser                                      resource = "snowflake_legacy_service_user"
 	Listing                                                resource = "snowflake_listing"
 	ManagedAccount                                         resource = "snowflake_managed_account"
@@ -57,6 +71,7 @@ const (
 	OauthIntegrationForCustomClients                       resource = "snowflake_oauth_integration_for_custom_clients"
 	OauthIntegrationForPartnerApplications                 resource = "snowflake_oauth_integration_for_partner_applications"
 	ObjectParameter                                        resource = "snowflake_object_parameter"
+	OrganizationAccount                                    resource = "snowflake_organization_account"
 	PasswordPolicy                                         resource = "snowflake_password_policy"
 	Pipe                                                   resource = "snowflake_pipe"
 	PrimaryConnection                                      resource = "snowflake_primary_connection"
@@ -65,6 +80,8 @@ const (
 	ProcedurePython                                        resource = "snowflake_procedure_python"
 	ProcedureScala                                         resource = "snowflake_procedure_scala"
 	ProcedureSql                                           resource = "snowflake_procedure_sql"
+	ProjectionPolicy                                       resource = "snowflake_projection_policy"
+	ReplicationGroup                                       resource = "snowflake_replication_group"
 	ResourceMonitor                                        resource = "snowflake_resource_monitor"
 	RowAccessPolicy                                        resource = "snowflake_row_access_policy"
 	SamlSecurityIntegration                                resource = "snowflake_saml_integration"
@@ -77,13 +94,16 @@ const (
 	SecretWithBasicAuthentication                          resource = "snowflake_secret_with_basic_authentication"
 	SecretWithClientCredentials                            resource = "snowflake_secret_with_client_credentials"
 	SecretWithGenericString                                resource = "snowflake_secret_with_generic_string"
+	SemanticView                                           resource = "snowflake_semantic_view"
 	SessionParameter                                       resource = "snowflake_session_parameter"
+	SessionPolicy                                          resource = "snowflake_session_policy"
 	Sequence                                               resource = "snowflake_sequence"
 	Service                                                resource = "snowflake_service"
 	ServiceUser                                            resource = "snowflake_service_user"
 	Share                                                  resource = "snowflake_share"
 	SharedDatabase                                         resource = "snowflake_shared_database"
 	Stage                                                  resource = "snowflake_stage"
+	StageFile                                              resource = "snowflake_stage_file"
 	StorageIntegration                                     resource = "snowflake_storage_integration"
 	StreamOnDirectoryTable                                 resource = "snowflake_stream_on_directory_table"
 	StreamOnExternalTable                                  resource = "snowflake_stream_on_external_table"
@@ -97,9 +117,11 @@ const (
 	TagAssociation                                         resource = "snowflake_tag_association"
 	TagMaskingPolicyAssociation                            resource = "snowflake_tag_masking_policy_association"
 	Task                                                   resource = "snowflake_task"
+	TaskGraph                                              resource = "snowflake_task_graph"
 	User                                                   resource = "snowflake_user"
 	UserAuthenticationPolicyAttachment                     resource = "snowflake_user_authentication_policy_attachment"
 	UserPasswordPolicyAttachment                           resource = "snowflake_user_password_policy_attachment"
+	UserSessionPolicyAttachment                            resource = "snowflake_user_session_policy_attachment"
 	UserPublicKeys                                         resource = "snowflake_user_public_keys"
 	UserProgrammaticAccessToken                            resource = "snowflake_user_programmatic_access_token"
 	View                                                   resource = "snowflake_view"
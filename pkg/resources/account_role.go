@@ -75,13 +75,7 @@ func CreateAccountRole(ctx context.Context, d *schema.ResourceData, meta any) di
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	req := sdk.NewCreateRoleRequest(id)
-
-	if v, ok := d.GetOk("comment"); ok {
-		req.WithComment(v.(string))
-	}
-
-	err = client.Roles.Create(ctx, req)
+	err = client.Roles.Create(ctx, accountRoleCreateRequest(id, d))
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -97,6 +91,15 @@ func CreateAccountRole(ctx context.Context, d *schema.ResourceData, meta any) di
 	return ReadAccountRole(ctx, d, meta)
 }
 
+func accountRoleCreateRequest(id sdk.AccountObjectIdentifier, d ResourceValueGetter) *sdk.CreateRoleRequest {
+	req := sdk.NewCreateRoleRequest(id)
+
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(v.(string))
+	}
+	return req
+}
+
 func ReadAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
@@ -153,50 +156,73 @@ func UpdateAccountRole(ctx context.Context, d *schema.ResourceData, meta any) di
 		return diag.FromErr(err)
 	}
 
+	newId, err := alterAccountRole(ctx, client, id, d)
+	if newId != id {
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+	}
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update account role",
+				Detail:   fmt.Sprintf("Account role name: %s, err: %s", id.Name(), err),
+			},
+		}
+	}
+
+	return ReadAccountRole(ctx, d, meta)
+}
+
+// alterAccountRole runs the statements for the changed fields (it's also used by the SQL preview). It returns the identifier
+// of the role after the rename, also when one of the next statements fails.
+func alterAccountRole(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, d ResourceValueGetter) (sdk.AccountObjectIdentifier, error) {
 	if d.HasChange("name") {
 		newId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return id, err
 		}
 
 		if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithRenameTo(newId)); err != nil {
-			return diag.Diagnostics{
-				diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Failed to rename account role name",
-					Detail:   fmt.Sprintf("Previous account role name: %s, new account role name: %s, err: %s", id.Name(), newId.Name(), err),
-				},
-			}
+			return id, fmt.Errorf("failed to rename account role to %s: %w", newId.Name(), err)
 		}
-
 		id = newId
-		d.SetId(helpers.EncodeResourceIdentifier(newId))
 	}
 
 	if d.HasChange("comment") {
 		if v, ok := d.GetOk("comment"); ok {
 			if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithSetComment(v.(string))); err != nil {
-				return diag.Diagnostics{
-					diag.Diagnostic{
-						Severity: diag.Error,
-						Summary:  "Failed to set account role comment",
-						Detail:   fmt.Sprintf("Account role name: %s, comment: %s, err: %s", id.Name(), v, err),
-					},
-				}
+				return id, fmt.Errorf("failed to set account role comment: %w", err)
 			}
 		} else {
-			err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithUnsetComment(true))
-			if err != nil {
-				return diag.Diagnostics{
-					diag.Diagnostic{
-						Severity: diag.Error,
-						Summary:  "Failed to unset account role comment",
-						Detail:   fmt.Sprintf("Account role name: %s, err: %s", id.Name(), err),
-					},
-				}
+			if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithUnsetComment(true)); err != nil {
+				return id, fmt.Errorf("failed to unset account role comment: %w", err)
 			}
 		}
 	}
+	return id, nil
+}
 
-	return ReadAccountRole(ctx, d, meta)
+var accountRoleSqlPreview = SqlPreview{
+	Create: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+		if err != nil {
+			return err
+		}
+		return client.Roles.Create(ctx, accountRoleCreateRequest(id, d))
+	},
+	Update: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return err
+		}
+		_, err = alterAccountRole(ctx, client, id, d)
+		return err
+	},
+	Delete: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return err
+		}
+		return client.Roles.DropSafely(ctx, id)
+	},
 }
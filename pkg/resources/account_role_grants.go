@@ -0,0 +1,264 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountRoleGrantsAccountObjectTypes = []sdk.ObjectType{
+	sdk.ObjectTypeUser,
+	sdk.ObjectTypeResourceMonitor,
+	sdk.ObjectTypeWarehouse,
+	sdk.ObjectTypeComputePool,
+	sdk.ObjectTypeDatabase,
+	sdk.ObjectTypeIntegration,
+	sdk.ObjectTypeFailoverGroup,
+	sdk.ObjectTypeReplicationGroup,
+	sdk.ObjectTypeExternalVolume,
+}
+
+var accountRoleGrantsObjectTypes = slices.Concat(
+	[]string{sdk.ObjectTypeAccount.String()},
+	collections.Map(accountRoleGrantsAccountObjectTypes, sdk.ObjectType.String),
+	[]string{sdk.ObjectTypeSchema.String()},
+	sdk.ValidGrantToObjectTypesString,
+)
+
+var accountRoleGrantsSchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the account role to which the privileges are granted.", resources.AccountRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"grant": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        roleGrantsGrantSchema(accountRoleGrantsObjectTypes),
+		Description: "The complete set of privileges granted to the account role. Every privilege granted to the role that is not listed here (and is not excluded) is revoked. Leaving the set empty revokes all the privileges.",
+	},
+	"exclude": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        roleGrantsExcludeSchema,
+		Description: roleGrantsExcludeDescription,
+	},
+}
+
+func AccountRoleGrants() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AccountRoleGrantsResource), TrackingCreateWrapper(resources.AccountRoleGrants, CreateAccountRoleGrants)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AccountRoleGrantsResource), TrackingReadWrapper(resources.AccountRoleGrants, ReadAccountRoleGrants)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AccountRoleGrantsResource), TrackingUpdateWrapper(resources.AccountRoleGrants, UpdateAccountRoleGrants)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AccountRoleGrantsResource), TrackingDeleteWrapper(resources.AccountRoleGrants, DeleteAccountRoleGrants)),
+		Description: joinWithSpace(
+			"Resource used to manage all the privileges granted to an account role in an authoritative way; the privileges that are not declared in the configuration are revoked.",
+			"Ownership, account and database roles granted to the role, and the privileges granted by the system (e.g. on the SNOWFLAKE database) are not managed.",
+			"Future grants are not managed either, because they are not listed by `SHOW GRANTS TO ROLE`.",
+			"Don't use this resource together with the other resources granting privileges to the same role, as it will revoke them.",
+		),
+
+		Schema: accountRoleGrantsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AccountRoleGrants, ImportAccountRoleGrants),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportAccountRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("account_role_name", id.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateAccountRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("account_role_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := applyAccountRoleGrants(ctx, d, meta.(*provider.Context).Client, id); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadAccountRoleGrants(ctx, d, meta)
+}
+
+func ReadAccountRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.Roles.ShowByID(ctx, id); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query account role. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Account role id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	exclusions, err := roleGrantsExclusionsFromConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actual, err := showAccountRoleGrants(ctx, client, id, exclusions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actual = roleGrantsWithConfiguredNames(actual, roleGrantsFromState(d))
+
+	errs := errors.Join(
+		d.Set("account_role_name", id.FullyQualifiedName()),
+		d.Set("grant", collections.Map(actual, roleGrant.toMap)),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateAccountRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges("grant", "exclude") {
+		if err := applyAccountRoleGrants(ctx, d, meta.(*provider.Context).Client, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadAccountRoleGrants(ctx, d, meta)
+}
+
+func DeleteAccountRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.Roles.ShowByID(ctx, id); err != nil && errors.Is(err, sdk.ErrObjectNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	for _, grant := range roleGrantsFromState(d) {
+		if err := revokeAccountRoleGrant(ctx, client, id, grant); err != nil {
+			return diag.FromErr(fmt.Errorf("revoking privileges %v on %s %s failed: %w", grant.Privileges, grant.ObjectType, grant.ObjectName, err))
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// applyAccountRoleGrants reads the privileges currently granted to the role and grants or revokes the privileges to match the configuration.
+func applyAccountRoleGrants(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.AccountObjectIdentifier) error {
+	exclusions, err := roleGrantsExclusionsFromConfig(d)
+	if err != nil {
+		return err
+	}
+	expected, err := roleGrantsFromConfig(d.Get("grant").(*schema.Set).List(), exclusions)
+	if err != nil {
+		return err
+	}
+	current, err := showAccountRoleGrants(ctx, client, id, exclusions)
+	if err != nil {
+		return err
+	}
+	return applyRoleGrantsChanges(ctx, current, expected,
+		func(ctx context.Context, grant roleGrant) error {
+			return revokeAccountRoleGrant(ctx, client, id, grant)
+		},
+		func(ctx context.Context, grant roleGrant) error {
+			privileges, on, err := accountRoleGrantPrivilegesAndOn(grant)
+			if err != nil {
+				return err
+			}
+			var opts *sdk.GrantPrivilegesToAccountRoleOptions
+			if grant.WithGrantOption {
+				opts = &sdk.GrantPrivilegesToAccountRoleOptions{WithGrantOption: sdk.Bool(true)}
+			}
+			return client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, id, opts)
+		},
+	)
+}
+
+func showAccountRoleGrants(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, exclusions []roleGrantsExclusion) ([]roleGrant, error) {
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		To: &sdk.ShowGrantsTo{
+			Role: id,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roleGrantsFromShowGrants(grants, exclusions), nil
+}
+
+func revokeAccountRoleGrant(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, grant roleGrant) error {
+	privileges, on, err := accountRoleGrantPrivilegesAndOn(grant)
+	if err != nil {
+		return err
+	}
+	return client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, id, nil)
+}
+
+func accountRoleGrantPrivilegesAndOn(grant roleGrant) (*sdk.AccountRoleGrantPrivileges, *sdk.AccountRoleGrantOn, error) {
+	switch {
+	case grant.ObjectType == sdk.ObjectTypeAccount:
+		return getAccountRolePrivileges(false, grant.Privileges, true, false, false, false),
+			&sdk.AccountRoleGrantOn{Account: sdk.Bool(true)},
+			nil
+	case slices.Contains(accountRoleGrantsAccountObjectTypes, grant.ObjectType):
+		objectId, ok := grant.ObjectId.(sdk.AccountObjectIdentifier)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid identifier %s of %s", grant.ObjectName, grant.ObjectType)
+		}
+		return getAccountRolePrivileges(false, grant.Privileges, false, true, false, false),
+			&sdk.AccountRoleGrantOn{AccountObject: getGrantOnAccountObject(grant.ObjectType, objectId)},
+			nil
+	case grant.ObjectType == sdk.ObjectTypeSchema:
+		schemaId, ok := grant.ObjectId.(sdk.DatabaseObjectIdentifier)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid identifier %s of %s", grant.ObjectName, grant.ObjectType)
+		}
+		return getAccountRolePrivileges(false, grant.Privileges, false, false, true, false),
+			&sdk.AccountRoleGrantOn{Schema: &sdk.GrantOnSchema{Schema: &schemaId}},
+			nil
+	case slices.Contains(sdk.ValidGrantToObjectTypesString, strings.ToUpper(grant.ObjectType.String())):
+		return getAccountRolePrivileges(false, grant.Privileges, false, false, false, true),
+			&sdk.AccountRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{SchemaObject: &sdk.Object{ObjectType: grant.ObjectType, Name: grant.ObjectId}}},
+			nil
+	default:
+		return nil, nil, fmt.Errorf("privileges on %s cannot be managed by the %s resource", grant.ObjectType, resources.AccountRoleGrants)
+	}
+}
@@ -0,0 +1,221 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var aggregationPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the aggregation policy; must be unique for the schema in which the aggregation policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the aggregation policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the aggregation policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      diffSuppressStatementFieldDescription("Specifies the body of the aggregation policy. It must be an `AGGREGATION_CONSTRAINT` expression, e.g. `AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)` or `NO_AGGREGATION_CONSTRAINT()`."),
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the aggregation policy.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW AGGREGATION POLICIES` for the given aggregation policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAggregationPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE AGGREGATION POLICY` for the given aggregation policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeAggregationPolicySchema,
+		},
+	},
+}
+
+func AggregationPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.AggregationPolicies.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AggregationPolicyResource), TrackingCreateWrapper(resources.AggregationPolicy, CreateAggregationPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AggregationPolicyResource), TrackingReadWrapper(resources.AggregationPolicy, ReadAggregationPolicyFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AggregationPolicyResource), TrackingUpdateWrapper(resources.AggregationPolicy, UpdateAggregationPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AggregationPolicyResource), TrackingDeleteWrapper(resources.AggregationPolicy, deleteFunc)),
+		Description:   "Resource used to manage aggregation policies. For more information, check [aggregation policies documentation](https://docs.snowflake.com/en/sql-reference/sql/create-aggregation-policy).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.AggregationPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(aggregationPolicySchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(aggregationPolicySchema, DescribeOutputAttributeName, "name", "body"),
+			ComputedIfAnyAttributeChanged(aggregationPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: aggregationPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AggregationPolicy, ImportAggregationPolicy),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	aggregationPolicyDescription, err := client.AggregationPolicies.Describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("body", aggregationPolicyDescription.Body),
+	)
+	if errs != nil {
+		return nil, errs
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateAggregationPolicyRequest(id, d.Get("body").(string))
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.AggregationPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadAggregationPolicyFunc(false)(ctx, d, meta)
+}
+
+func ReadAggregationPolicyFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		aggregationPolicy, err := client.AggregationPolicies.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query aggregation policy. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Aggregation policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		aggregationPolicyDescription, err := client.AggregationPolicies.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		errs := errors.Join(
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.AggregationPolicyToSchema(aggregationPolicy)}),
+			d.Set(DescribeOutputAttributeName, []map[string]any{schemas.AggregationPolicyDescriptionToSchema(*aggregationPolicyDescription)}),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set("body", aggregationPolicyDescription.Body),
+			d.Set("comment", aggregationPolicy.Comment),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+		return nil
+	}
+}
+
+func UpdateAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming aggregation policy %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("body") {
+		if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadAggregationPolicyFunc(false)(ctx, d, meta)
+}
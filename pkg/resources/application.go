@@ -0,0 +1,314 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the application."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"application_package": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"application_package", "listing"},
+		Description:      relatedResourceDescription("Specifies the identifier of the application package used to create the application.", resources.ApplicationPackage),
+	},
+	"listing": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"application_package", "listing"},
+		Description:      "Specifies the identifier of the listing used to create the application.",
+	},
+	"version": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"listing"},
+		Description:   "Specifies the version of the application package used to create the application. When not set, the default release directive of the application package is used. Changing the value upgrades the application; removing it upgrades the application to the version defined by the release directive.",
+	},
+	"patch": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		RequiredWith:     []string{"version"},
+		Description:      "Specifies the patch of the version used to create the application. Changing the value upgrades the application.",
+	},
+	"debug_mode": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		ConflictsWith:    []string{"listing"},
+		Description:      booleanStringFieldDescription("Enables or disables debug mode for the application. Can be set only when `version` is set. External changes for this field won't be detected."),
+	},
+	"share_events_with_provider": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether to share the events from the application with the provider. External changes for this field won't be detected."),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW APPLICATIONS` for the given application.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowApplicationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE APPLICATION` for the given application.",
+		Elem: &schema.Resource{
+			Schema: schemas.ApplicationDescribeSchema,
+		},
+	},
+}
+
+func Application() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.Applications.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApplicationResource), TrackingCreateWrapper(resources.Application, CreateApplication)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApplicationResource), TrackingReadWrapper(resources.Application, ReadApplicationFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApplicationResource), TrackingUpdateWrapper(resources.Application, UpdateApplication)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ApplicationResource), TrackingDeleteWrapper(resources.Application, deleteFunc)),
+		Description:   "Resource used to manage applications of the Native Application Framework. For more information, check [application documentation](https://docs.snowflake.com/en/sql-reference/sql/create-application).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Application, customdiff.All(
+			ComputedIfAnyAttributeChanged(applicationSchema, ShowOutputAttributeName, "version", "patch", "comment"),
+			ComputedIfAnyAttributeChanged(applicationSchema, DescribeOutputAttributeName, "version", "patch", "comment", "debug_mode", "share_events_with_provider"),
+		)),
+
+		Schema: applicationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.Application, ImportApplication),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportApplication(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := client.Applications.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceKey := "application_package"
+	if application.SourceType == "LISTING" {
+		sourceKey = "listing"
+	}
+	if err := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set(sourceKey, sdk.NewAccountObjectIdentifier(application.Source).FullyQualifiedName()),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk("listing"); ok {
+		listingId, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request := sdk.NewCreateFromListingApplicationRequest(id, listingId)
+		if err := stringAttributeCreate(d, "comment", &request.Comment); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.Applications.CreateFromListing(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		applicationPackageId, err := sdk.ParseAccountObjectIdentifier(d.Get("application_package").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request := sdk.NewCreateApplicationRequest(id, applicationPackageId)
+		if version, ok := d.GetOk("version"); ok {
+			versionAndPatch := sdk.NewVersionAndPatchRequest(version.(string), nil)
+			if err := intAttributeWithSpecialDefaultCreate(d, "patch", &versionAndPatch.Patch); err != nil {
+				return diag.FromErr(err)
+			}
+			request.WithVersion(sdk.NewApplicationVersionRequest().WithVersionAndPatch(versionAndPatch))
+		}
+		if errs := errors.Join(
+			booleanStringAttributeCreate(d, "debug_mode", &request.DebugMode),
+			stringAttributeCreate(d, "comment", &request.Comment),
+		); errs != nil {
+			return diag.FromErr(errs)
+		}
+		if err := client.Applications.Create(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	// SHARE_EVENTS_WITH_PROVIDER is not supported in CREATE APPLICATION.
+	set := sdk.NewApplicationSetRequest()
+	if err := booleanStringAttributeCreate(d, "share_events_with_provider", &set.ShareEventsWithProvider); err != nil {
+		return diag.FromErr(err)
+	}
+	if set.ShareEventsWithProvider != nil {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadApplicationFunc(false)(ctx, d, meta)
+}
+
+func ReadApplicationFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		application, err := client.Applications.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query application. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Application id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		properties, err := client.Applications.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The version is tracked only when it is set in the configuration; otherwise the release directive decides which version is used.
+		if withExternalChangesMarking && d.Get("version").(string) != "" {
+			if err = handleExternalChangesToObjectInShow(d,
+				outputMapping{"version", "version", application.Version, application.Version, nil},
+				outputMapping{"patch", "patch", application.Patch, application.Patch, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err = setStateToValuesFromConfig(d, applicationSchema, []string{
+			"version",
+			"patch",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		errs := errors.Join(
+			d.Set("comment", application.Comment),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.ApplicationToSchema(application)}),
+			d.Set(DescribeOutputAttributeName, schemas.ApplicationPropertiesToSchema(properties)),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+		return nil
+	}
+}
+
+func UpdateApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("version", "patch") {
+		request := sdk.NewAlterApplicationRequest(id)
+		if version := d.Get("version").(string); version != "" {
+			versionAndPatch := sdk.NewVersionAndPatchRequest(version, nil)
+			if patch := d.Get("patch").(int); patch != IntDefault {
+				versionAndPatch.Patch = sdk.Int(patch)
+			}
+			request.WithUpgradeVersion(sdk.NewApplicationVersionRequest().WithVersionAndPatch(versionAndPatch))
+		} else {
+			request.WithUpgrade(sdk.Bool(true))
+		}
+		if err := client.Applications.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error upgrading application %v: %w", d.Id(), err))
+		}
+	}
+
+	set, unset := sdk.NewApplicationSetRequest(), sdk.NewApplicationUnsetRequest()
+	if errs := errors.Join(
+		booleanStringAttributeUpdate(d, "debug_mode", &set.DebugMode, &unset.DebugMode),
+		booleanStringAttributeUpdate(d, "share_events_with_provider", &set.ShareEventsWithProvider, &unset.ShareEventsWithProvider),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.ApplicationSetRequest{}) {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.ApplicationUnsetRequest{}) {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadApplicationFunc(false)(ctx, d, meta)
+}
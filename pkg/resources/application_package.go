@@ -0,0 +1,643 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationPackageSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the application package."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"distribution": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToDistribution),
+		DiffSuppressFunc: SuppressIfAny(NormalizeAndCompare(sdk.ToDistribution), IgnoreChangeToCurrentSnowflakeValueInShow("distribution")),
+		Description:      fmt.Sprintf("Specifies the type of data consumer who can access the application package. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllDistributions)),
+	},
+	"enable_release_channels": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether release channels are enabled for the application package. Release directives managed by this resource can be used only when release channels are disabled. External changes for this field won't be detected."),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application package.",
+	},
+	"version": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the identifier of the version.",
+				},
+				"using": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the path to the stage containing the application files for the version (e.g. `@database.schema.stage/v1`).",
+				},
+				"label": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the label for the version that is displayed to the consumer.",
+				},
+				"patch": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"using": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the path to the stage containing the application files for the patch.",
+							},
+							"label": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Specifies the label for the patch that is displayed to the consumer.",
+							},
+						},
+					},
+					Description: "Patches added to the version. The first block corresponds to patch 1 (patch 0 is created together with the version). Patches can only be appended; removing or modifying existing patches is not supported.",
+				},
+			},
+		},
+		Description: "Versions of the application package. Changing `using` or `label` of an existing version is not supported; remove the version and add it under a new name instead. Versions are not imported.",
+	},
+	"default_release_directive": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the version used by the default release directive.",
+				},
+				"patch": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          0,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+					Description:      "Specifies the patch used by the default release directive.",
+				},
+			},
+		},
+		Description: "Specifies the default release directive. The default release directive cannot be unset in Snowflake, so removing this block only stops managing it.",
+	},
+	"release_directive": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the identifier of the custom release directive.",
+				},
+				"accounts": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the consumer accounts (in the `<organization_name>.<account_name>` format) the release directive applies to.",
+				},
+				"version": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the version used by the release directive.",
+				},
+				"patch": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          0,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+					Description:      "Specifies the patch used by the release directive.",
+				},
+			},
+		},
+		Description: "Specifies custom release directives for selected consumer accounts.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW APPLICATION PACKAGES` for the given application package.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowApplicationPackageSchema,
+		},
+	},
+}
+
+func ApplicationPackage() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.ApplicationPackages.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApplicationPackageResource), TrackingCreateWrapper(resources.ApplicationPackage, CreateApplicationPackage)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApplicationPackageResource), TrackingReadWrapper(resources.ApplicationPackage, ReadApplicationPackageFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApplicationPackageResource), TrackingUpdateWrapper(resources.ApplicationPackage, UpdateApplicationPackage)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ApplicationPackageResource), TrackingDeleteWrapper(resources.ApplicationPackage, deleteFunc)),
+		Description:   "Resource used to manage application packages of the Native Application Framework. For more information, check [application package documentation](https://docs.snowflake.com/en/sql-reference/sql/create-application-package).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ApplicationPackage, customdiff.All(
+			ComputedIfAnyAttributeChanged(applicationPackageSchema, ShowOutputAttributeName, "distribution", "comment"),
+			validateApplicationPackageVersionsDiff,
+		)),
+
+		Schema: applicationPackageSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ApplicationPackage, ImportApplicationPackage),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	applicationPackage, err := client.ApplicationPackages.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("distribution", applicationPackage.Distribution),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateApplicationPackageRequest(id)
+	if errs := errors.Join(
+		attributeMappedValueCreate(d, "distribution", &request.Distribution, func(value any) (*sdk.Distribution, error) {
+			distribution, err := sdk.ToDistribution(value.(string))
+			return &distribution, err
+		}),
+		booleanStringAttributeCreate(d, "enable_release_channels", &request.EnableReleaseChannels),
+		stringAttributeCreate(d, "comment", &request.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.ApplicationPackages.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	for _, version := range applicationPackageVersionsFromRaw(d.Get("version").([]any)) {
+		if err := addApplicationPackageVersion(ctx, client, id, version); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("default_release_directive"); ok {
+		if err := setApplicationPackageDefaultReleaseDirective(ctx, client, id, v.([]any)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, releaseDirective := range applicationPackageReleaseDirectivesFromRaw(d.Get("release_directive").(*schema.Set).List()) {
+		if err := setApplicationPackageReleaseDirective(ctx, client, id, releaseDirective); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadApplicationPackageFunc(false)(ctx, d, meta)
+}
+
+func ReadApplicationPackageFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		applicationPackage, err := client.ApplicationPackages.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query application package. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Application package id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		versions, err := client.ApplicationPackages.ShowVersions(ctx, sdk.NewShowVersionsApplicationPackageRequest(id))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		releaseDirectives, err := client.ApplicationPackages.ShowReleaseDirectives(ctx, sdk.NewShowReleaseDirectivesApplicationPackageRequest(id))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if withExternalChangesMarking {
+			if err = handleExternalChangesToObjectInShow(d,
+				outputMapping{"distribution", "distribution", applicationPackage.Distribution, applicationPackage.Distribution, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		errs := errors.Join(
+			d.Set("comment", applicationPackage.Comment),
+			d.Set("version", applicationPackageVersionsToState(d.Get("version").([]any), versions)),
+			d.Set("default_release_directive", applicationPackageDefaultReleaseDirectiveToState(d.Get("default_release_directive").([]any), releaseDirectives)),
+			d.Set("release_directive", applicationPackageReleaseDirectivesToState(d.Get("release_directive").(*schema.Set).List(), releaseDirectives)),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.ApplicationPackageToSchema(applicationPackage)}),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+		return nil
+	}
+}
+
+func UpdateApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewApplicationPackageSetRequest(), sdk.NewApplicationPackageUnsetRequest()
+	if errs := errors.Join(
+		attributeMappedValueUpdate(d, "distribution", &set.Distribution, &unset.Distribution, sdk.ToDistribution),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.ApplicationPackageSetRequest{}) {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.ApplicationPackageUnsetRequest{}) {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Versions are added before and dropped after release directives are changed, so that the directives can be moved between versions.
+	var removedVersions []applicationPackageVersion
+	if d.HasChange("version") {
+		oldRaw, newRaw := d.GetChange("version")
+		oldVersions := applicationPackageVersionsFromRaw(oldRaw.([]any))
+		newVersions := applicationPackageVersionsFromRaw(newRaw.([]any))
+
+		for _, newVersion := range newVersions {
+			oldVersionIdx := slices.IndexFunc(oldVersions, func(v applicationPackageVersion) bool { return v.Name == newVersion.Name })
+			if oldVersionIdx == -1 {
+				if err := addApplicationPackageVersion(ctx, client, id, newVersion); err != nil {
+					return diag.FromErr(err)
+				}
+				continue
+			}
+			for _, patch := range newVersion.Patches[len(oldVersions[oldVersionIdx].Patches):] {
+				if err := addApplicationPackagePatch(ctx, client, id, newVersion.Name, patch); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		for _, oldVersion := range oldVersions {
+			if !slices.ContainsFunc(newVersions, func(v applicationPackageVersion) bool { return v.Name == oldVersion.Name }) {
+				removedVersions = append(removedVersions, oldVersion)
+			}
+		}
+	}
+
+	if d.HasChange("default_release_directive") {
+		if v, ok := d.GetOk("default_release_directive"); ok {
+			if err := setApplicationPackageDefaultReleaseDirective(ctx, client, id, v.([]any)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("release_directive") {
+		oldRaw, newRaw := d.GetChange("release_directive")
+		oldReleaseDirectives := applicationPackageReleaseDirectivesFromRaw(oldRaw.(*schema.Set).List())
+		newReleaseDirectives := applicationPackageReleaseDirectivesFromRaw(newRaw.(*schema.Set).List())
+
+		for _, oldReleaseDirective := range oldReleaseDirectives {
+			newReleaseDirectiveIdx := slices.IndexFunc(newReleaseDirectives, func(r *applicationPackageReleaseDirective) bool { return r.Name == oldReleaseDirective.Name })
+			if newReleaseDirectiveIdx == -1 || !slices.Equal(newReleaseDirectives[newReleaseDirectiveIdx].Accounts, oldReleaseDirective.Accounts) {
+				err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnsetReleaseDirective(sdk.NewUnsetReleaseDirectiveRequest(oldReleaseDirective.Name)))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error unsetting release directive %s for application package %v: %w", oldReleaseDirective.Name, d.Id(), err))
+				}
+			}
+		}
+		for _, newReleaseDirective := range newReleaseDirectives {
+			oldReleaseDirectiveIdx := slices.IndexFunc(oldReleaseDirectives, func(r *applicationPackageReleaseDirective) bool { return r.Name == newReleaseDirective.Name })
+			switch {
+			case oldReleaseDirectiveIdx == -1 || !slices.Equal(oldReleaseDirectives[oldReleaseDirectiveIdx].Accounts, newReleaseDirective.Accounts):
+				if err := setApplicationPackageReleaseDirective(ctx, client, id, newReleaseDirective); err != nil {
+					return diag.FromErr(err)
+				}
+			case !reflect.DeepEqual(*oldReleaseDirectives[oldReleaseDirectiveIdx], *newReleaseDirective):
+				err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithModifyReleaseDirective(
+					sdk.NewModifyReleaseDirectiveRequest(newReleaseDirective.Name, newReleaseDirective.Version, newReleaseDirective.Patch),
+				))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error modifying release directive %s for application package %v: %w", newReleaseDirective.Name, d.Id(), err))
+				}
+			}
+		}
+	}
+
+	for _, removedVersion := range removedVersions {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithDropVersion(sdk.NewDropVersionRequest(removedVersion.Name))); err != nil {
+			return diag.FromErr(fmt.Errorf("error dropping version %s for application package %v: %w", removedVersion.Name, d.Id(), err))
+		}
+	}
+
+	return ReadApplicationPackageFunc(false)(ctx, d, meta)
+}
+
+type applicationPackagePatch struct {
+	Using string
+	Label string
+}
+
+type applicationPackageVersion struct {
+	Name    string
+	Using   string
+	Label   string
+	Patches []applicationPackagePatch
+}
+
+type applicationPackageReleaseDirective struct {
+	Name     string
+	Accounts []string
+	Version  string
+	Patch    int
+}
+
+func applicationPackageVersionsFromRaw(raw []any) []applicationPackageVersion {
+	versions := make([]applicationPackageVersion, 0, len(raw))
+	for _, v := range raw {
+		versionRaw := v.(map[string]any)
+		version := applicationPackageVersion{
+			Name:  versionRaw["name"].(string),
+			Using: versionRaw["using"].(string),
+			Label: versionRaw["label"].(string),
+		}
+		for _, p := range versionRaw["patch"].([]any) {
+			patchRaw := p.(map[string]any)
+			version.Patches = append(version.Patches, applicationPackagePatch{
+				Using: patchRaw["using"].(string),
+				Label: patchRaw["label"].(string),
+			})
+		}
+		versions = append(versions, version)
+	}
+	return versions
+}
+
+func applicationPackageReleaseDirectivesFromRaw(raw []any) []*applicationPackageReleaseDirective {
+	releaseDirectives := make([]*applicationPackageReleaseDirective, 0, len(raw))
+	for _, v := range raw {
+		releaseDirectiveRaw := v.(map[string]any)
+		accounts := expandStringList(releaseDirectiveRaw["accounts"].(*schema.Set).List())
+		slices.Sort(accounts)
+		releaseDirectives = append(releaseDirectives, &applicationPackageReleaseDirective{
+			Name:     releaseDirectiveRaw["name"].(string),
+			Accounts: accounts,
+			Version:  releaseDirectiveRaw["version"].(string),
+			Patch:    releaseDirectiveRaw["patch"].(int),
+		})
+	}
+	return releaseDirectives
+}
+
+func addApplicationPackageVersion(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, version applicationPackageVersion) error {
+	request := sdk.NewAddVersionRequest(version.Using).WithVersionIdentifier(sdk.String(version.Name))
+	if version.Label != "" {
+		request.WithLabel(sdk.String(version.Label))
+	}
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithAddVersion(request)); err != nil {
+		return fmt.Errorf("error adding version %s to application package %s: %w", version.Name, id.FullyQualifiedName(), err)
+	}
+	for _, patch := range version.Patches {
+		if err := addApplicationPackagePatch(ctx, client, id, version.Name, patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addApplicationPackagePatch(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, versionName string, patch applicationPackagePatch) error {
+	request := sdk.NewAddPatchForVersionRequest(sdk.String(versionName), patch.Using)
+	if patch.Label != "" {
+		request.WithLabel(sdk.String(patch.Label))
+	}
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithAddPatchForVersion(request)); err != nil {
+		return fmt.Errorf("error adding patch for version %s to application package %s: %w", versionName, id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func setApplicationPackageDefaultReleaseDirective(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, raw []any) error {
+	releaseDirectiveRaw := raw[0].(map[string]any)
+	err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetDefaultReleaseDirective(
+		sdk.NewSetDefaultReleaseDirectiveRequest(releaseDirectiveRaw["version"].(string), releaseDirectiveRaw["patch"].(int)),
+	))
+	if err != nil {
+		return fmt.Errorf("error setting default release directive for application package %s: %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func setApplicationPackageReleaseDirective(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, releaseDirective *applicationPackageReleaseDirective) error {
+	err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetReleaseDirective(
+		sdk.NewSetReleaseDirectiveRequest(releaseDirective.Name, releaseDirective.Accounts, releaseDirective.Version, releaseDirective.Patch),
+	))
+	if err != nil {
+		return fmt.Errorf("error setting release directive %s for application package %s: %w", releaseDirective.Name, id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+// applicationPackageVersionsToState keeps the versions and patches from the state that still exist in Snowflake.
+// The source location of a version cannot be read back, so versions created outside of Terraform are not added to the state.
+func applicationPackageVersionsToState(stateVersions []any, versions []sdk.ApplicationPackageVersion) []any {
+	result := make([]any, 0, len(stateVersions))
+	for _, v := range stateVersions {
+		versionRaw := v.(map[string]any)
+		maxPatch := -1
+		for _, version := range versions {
+			if strings.EqualFold(version.Version, versionRaw["name"].(string)) && version.Patch > maxPatch {
+				maxPatch = version.Patch
+			}
+		}
+		if maxPatch == -1 {
+			continue
+		}
+		if patches := versionRaw["patch"].([]any); len(patches) > maxPatch {
+			versionRaw["patch"] = patches[:maxPatch]
+		}
+		result = append(result, versionRaw)
+	}
+	return result
+}
+
+func applicationPackageDefaultReleaseDirectiveToState(stateReleaseDirective []any, releaseDirectives []sdk.ApplicationPackageReleaseDirective) []any {
+	if len(stateReleaseDirective) == 0 {
+		return stateReleaseDirective
+	}
+	for _, releaseDirective := range releaseDirectives {
+		if releaseDirective.Name == "DEFAULT" {
+			return []any{map[string]any{
+				"version": releaseDirective.Version,
+				"patch":   releaseDirective.Patch,
+			}}
+		}
+	}
+	return nil
+}
+
+// applicationPackageReleaseDirectivesToState groups the SHOW RELEASE DIRECTIVES output (one row per target account) by the release directive name.
+func applicationPackageReleaseDirectivesToState(stateReleaseDirectives []any, releaseDirectives []sdk.ApplicationPackageReleaseDirective) []any {
+	stateAccounts := make(map[string][]string)
+	for _, stateReleaseDirective := range applicationPackageReleaseDirectivesFromRaw(stateReleaseDirectives) {
+		stateAccounts[strings.ToUpper(stateReleaseDirective.Name)] = stateReleaseDirective.Accounts
+	}
+
+	var names []string
+	grouped := make(map[string]*applicationPackageReleaseDirective)
+	for _, releaseDirective := range releaseDirectives {
+		if releaseDirective.Name == "DEFAULT" {
+			continue
+		}
+		if _, ok := grouped[releaseDirective.Name]; !ok {
+			names = append(names, releaseDirective.Name)
+			grouped[releaseDirective.Name] = &applicationPackageReleaseDirective{
+				Name:    releaseDirective.Name,
+				Version: releaseDirective.Version,
+				Patch:   releaseDirective.Patch,
+			}
+		}
+		if releaseDirective.TargetName != nil {
+			grouped[releaseDirective.Name].Accounts = append(grouped[releaseDirective.Name].Accounts, *releaseDirective.TargetName)
+		}
+	}
+
+	result := make([]any, 0, len(names))
+	for _, name := range names {
+		releaseDirective := grouped[name]
+		accounts := releaseDirective.Accounts
+		// Account names are returned by Snowflake in upper case, so the values from the state are kept if they are equal ignoring the case.
+		if configAccounts, ok := stateAccounts[strings.ToUpper(name)]; ok && slices.EqualFunc(sortedUpper(configAccounts), sortedUpper(accounts), strings.EqualFold) {
+			accounts = configAccounts
+		}
+		if stateName := stateReleaseDirectiveName(stateReleaseDirectives, name); stateName != "" {
+			name = stateName
+		}
+		result = append(result, map[string]any{
+			"name":     name,
+			"accounts": accounts,
+			"version":  releaseDirective.Version,
+			"patch":    releaseDirective.Patch,
+		})
+	}
+	return result
+}
+
+func stateReleaseDirectiveName(stateReleaseDirectives []any, name string) string {
+	for _, stateReleaseDirective := range applicationPackageReleaseDirectivesFromRaw(stateReleaseDirectives) {
+		if strings.EqualFold(stateReleaseDirective.Name, name) {
+			return stateReleaseDirective.Name
+		}
+	}
+	return ""
+}
+
+func sortedUpper(values []string) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = strings.ToUpper(v)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// validateApplicationPackageVersionsDiff rejects changes to the versions that cannot be applied in Snowflake (versions and patches are immutable).
+func validateApplicationPackageVersionsDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" || !diff.HasChange("version") {
+		return nil
+	}
+	oldRaw, newRaw := diff.GetChange("version")
+	oldVersions := applicationPackageVersionsFromRaw(oldRaw.([]any))
+	for _, newVersion := range applicationPackageVersionsFromRaw(newRaw.([]any)) {
+		oldVersionIdx := slices.IndexFunc(oldVersions, func(v applicationPackageVersion) bool { return v.Name == newVersion.Name })
+		if oldVersionIdx == -1 {
+			continue
+		}
+		oldVersion := oldVersions[oldVersionIdx]
+		if oldVersion.Using != newVersion.Using || oldVersion.Label != newVersion.Label {
+			return fmt.Errorf("changing using or label of the existing version %s is not supported", newVersion.Name)
+		}
+		if len(newVersion.Patches) < len(oldVersion.Patches) || !slices.Equal(oldVersion.Patches, newVersion.Patches[:len(oldVersion.Patches)]) {
+			return fmt.Errorf("patches of the existing version %s can only be appended", newVersion.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,421 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var catalogIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the catalog integration; must be unique in your account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"catalog_source": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToCatalogIntegrationCatalogSource),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToCatalogIntegrationCatalogSource),
+		Description:      fmt.Sprintf("Specifies the type of catalog source. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AsStringList(sdk.AllCatalogIntegrationCatalogSources))),
+	},
+	"table_format": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToCatalogIntegrationTableFormat),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToCatalogIntegrationTableFormat),
+		Description:      fmt.Sprintf("Specifies the table format supplied by the catalog. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AsStringList(sdk.AllCatalogIntegrationTableFormats))),
+	},
+	"catalog_namespace": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the default namespace (database in AWS Glue or namespace in Polaris) for all Iceberg tables associated with the catalog integration.",
+	},
+	"glue_aws_role_arn": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"glue_catalog_id"},
+		Description:  "Specifies the Amazon Resource Name (ARN) of the AWS IAM role to assume. Applicable only when `catalog_source` is `GLUE`.",
+	},
+	"glue_catalog_id": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"glue_aws_role_arn"},
+		Description:  "Specifies the ID of your AWS account. Applicable only when `catalog_source` is `GLUE`.",
+	},
+	"glue_region": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"glue_aws_role_arn"},
+		Description:  "Specifies the AWS Region of your AWS Glue Data Catalog. Applicable only when `catalog_source` is `GLUE`.",
+	},
+	"rest_config": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: externalChangesNotDetectedFieldDescription("Specifies information about the REST catalog (e.g. Polaris). Applicable only when `catalog_source` is `POLARIS`."),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"catalog_uri": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the endpoint URL for the catalog REST API.",
+				},
+				"catalog_api_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: sdkValidation(sdk.ToCatalogIntegrationCatalogApiType),
+					DiffSuppressFunc: NormalizeAndCompare(sdk.ToCatalogIntegrationCatalogApiType),
+					Description:      fmt.Sprintf("Specifies the connection type for the catalog API. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AsStringList(sdk.AllCatalogIntegrationCatalogApiTypes))),
+				},
+				"catalog_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the name of the catalog to use in the REST catalog.",
+				},
+			},
+		},
+		ConflictsWith: []string{"glue_aws_role_arn"},
+	},
+	"rest_authentication": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		RequiredWith: []string{"rest_config"},
+		Description:  externalChangesNotDetectedFieldDescription("Specifies the OAuth authentication used to connect to the REST catalog. Applicable only when `catalog_source` is `POLARIS`."),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"oauth_client_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the client ID of the OAuth2 credential associated with the service connection.",
+				},
+				"oauth_client_secret": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					Description: "Specifies the secret of the OAuth2 credential associated with the service connection.",
+				},
+				"oauth_allowed_scopes": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies one or more scopes for the OAuth token.",
+				},
+			},
+		},
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies whether the catalog integration is available to use for Iceberg tables.",
+	},
+	"refresh_interval_seconds": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateFunc:     validation.IntBetween(30, 86400),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeListValueInDescribe("refresh_interval_seconds"),
+		Description:      "Specifies the number of seconds that Snowflake waits between attempts to poll the external catalog for metadata updates for automated refresh. If removed from the config, the resource is recreated.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the catalog integration.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW CATALOG INTEGRATIONS` for the given catalog integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowCatalogIntegrationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE CATALOG INTEGRATION` for the given catalog integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeCatalogIntegrationSchema,
+		},
+	},
+}
+
+func CatalogIntegration() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.CatalogIntegrations.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.CatalogIntegrationResource), TrackingCreateWrapper(resources.CatalogIntegration, CreateCatalogIntegration)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.CatalogIntegrationResource), TrackingReadWrapper(resources.CatalogIntegration, ReadCatalogIntegrationFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.CatalogIntegrationResource), TrackingUpdateWrapper(resources.CatalogIntegration, UpdateCatalogIntegration)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.CatalogIntegrationResource), TrackingDeleteWrapper(resources.CatalogIntegration, deleteFunc)),
+		Description:   "Resource used to manage catalog integrations. For more information, check [catalog integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-catalog-integration).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.CatalogIntegration, customdiff.All(
+			customdiff.ForceNewIfChange("refresh_interval_seconds", func(ctx context.Context, oldValue, newValue, meta any) bool {
+				return newValue.(int) == IntDefault
+			}),
+			customdiff.ForceNewIfChange("rest_authentication", func(ctx context.Context, oldValue, newValue, meta any) bool {
+				return len(oldValue.([]any)) != len(newValue.([]any))
+			}),
+			ComputedIfAnyAttributeChanged(catalogIntegrationSchema, ShowOutputAttributeName, "comment"),
+			ComputedIfAnyAttributeChanged(catalogIntegrationSchema, DescribeOutputAttributeName, "refresh_interval_seconds", "comment"),
+		)),
+
+		Schema: catalogIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.CatalogIntegration, ImportCatalogIntegration),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := client.CatalogIntegrations.Describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, property := range properties {
+		switch property.Name {
+		case "CATALOG_NAMESPACE":
+			err = d.Set("catalog_namespace", property.Value)
+		case "GLUE_AWS_ROLE_ARN":
+			err = d.Set("glue_aws_role_arn", property.Value)
+		case "GLUE_CATALOG_ID":
+			err = d.Set("glue_catalog_id", property.Value)
+		case "GLUE_REGION":
+			err = d.Set("glue_region", property.Value)
+		case "REFRESH_INTERVAL_SECONDS":
+			var refreshIntervalSeconds int
+			if refreshIntervalSeconds, err = strconv.Atoi(property.Value); err == nil {
+				err = d.Set("refresh_interval_seconds", refreshIntervalSeconds)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	catalogSource, err := sdk.ToCatalogIntegrationCatalogSource(d.Get("catalog_source").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tableFormat, err := sdk.ToCatalogIntegrationTableFormat(d.Get("table_format").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateCatalogIntegrationRequest(id, catalogSource, tableFormat, d.Get("enabled").(bool))
+	errs := errors.Join(
+		stringAttributeCreateBuilder(d, "catalog_namespace", request.WithCatalogNamespace),
+		intAttributeWithSpecialDefaultCreateBuilder(d, "refresh_interval_seconds", request.WithRefreshIntervalSeconds),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if v, ok := d.GetOk("glue_aws_role_arn"); ok {
+		glueParams := sdk.NewCatalogIntegrationGlueParamsRequest(v.(string), d.Get("glue_catalog_id").(string))
+		if err := stringAttributeCreateBuilder(d, "glue_region", glueParams.WithGlueRegion); err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithGlueParams(*glueParams)
+	}
+
+	if v, ok := d.GetOk("rest_config"); ok {
+		restConfig := v.([]any)[0].(map[string]any)
+		restConfigRequest := sdk.NewCatalogIntegrationRestConfigRequest(restConfig["catalog_uri"].(string), restConfig["catalog_name"].(string))
+		if catalogApiType := restConfig["catalog_api_type"].(string); catalogApiType != "" {
+			parsedCatalogApiType, err := sdk.ToCatalogIntegrationCatalogApiType(catalogApiType)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			restConfigRequest.WithCatalogApiType(parsedCatalogApiType)
+		}
+		request.WithRestConfig(*restConfigRequest)
+	}
+
+	if v, ok := d.GetOk("rest_authentication"); ok {
+		restAuthentication := v.([]any)[0].(map[string]any)
+		allowedScopes := collections.Map(expandStringList(restAuthentication["oauth_allowed_scopes"].(*schema.Set).List()), func(scope string) sdk.AllowedScope {
+			return sdk.AllowedScope{Scope: scope}
+		})
+		request.WithRestAuthentication(*sdk.NewCatalogIntegrationOAuthRestAuthenticationRequest(
+			restAuthentication["oauth_client_id"].(string),
+			restAuthentication["oauth_client_secret"].(string),
+			allowedScopes,
+		))
+	}
+
+	if err := client.CatalogIntegrations.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadCatalogIntegrationFunc(false)(ctx, d, meta)
+}
+
+func ReadCatalogIntegrationFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		integration, err := client.CatalogIntegrations.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query catalog integration. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Catalog integration id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		properties, err := client.CatalogIntegrations.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var catalogSource, tableFormat, catalogNamespace, glueAwsRoleArn, glueCatalogId string
+		for _, property := range properties {
+			switch property.Name {
+			case "CATALOG_SOURCE":
+				catalogSource = property.Value
+			case "TABLE_FORMAT":
+				tableFormat = property.Value
+			case "CATALOG_NAMESPACE":
+				catalogNamespace = property.Value
+			case "GLUE_AWS_ROLE_ARN":
+				glueAwsRoleArn = property.Value
+			case "GLUE_CATALOG_ID":
+				glueCatalogId = property.Value
+			case "REFRESH_INTERVAL_SECONDS":
+				if withExternalChangesMarking {
+					refreshIntervalSeconds, err := strconv.Atoi(property.Value)
+					if err != nil {
+						return diag.FromErr(fmt.Errorf("failed to parse REFRESH_INTERVAL_SECONDS property of catalog integration %s, err = %w", id.FullyQualifiedName(), err))
+					}
+					if err := handleExternalChangesToObjectInDescribe(d,
+						describeMapping{"refresh_interval_seconds", "refresh_interval_seconds", property.Value, refreshIntervalSeconds, nil},
+					); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+			}
+		}
+
+		if err := setStateToValuesFromConfig(d, catalogIntegrationSchema, []string{
+			"refresh_interval_seconds",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		errs := errors.Join(
+			d.Set("name", id.Name()),
+			d.Set("catalog_source", catalogSource),
+			d.Set("table_format", tableFormat),
+			d.Set("catalog_namespace", catalogNamespace),
+			d.Set("glue_aws_role_arn", glueAwsRoleArn),
+			d.Set("glue_catalog_id", glueCatalogId),
+			d.Set("enabled", integration.Enabled),
+			d.Set("comment", integration.Comment),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.CatalogIntegrationToSchema(integration)}),
+			d.Set(DescribeOutputAttributeName, []map[string]any{schemas.DescribeCatalogIntegrationToSchema(properties)}),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+		return nil
+	}
+}
+
+func UpdateCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set := sdk.NewCatalogIntegrationSetRequest()
+	if d.HasChange("comment") {
+		set.WithComment(d.Get("comment").(string))
+	}
+	if d.HasChange("refresh_interval_seconds") {
+		if v := d.Get("refresh_interval_seconds").(int); v != IntDefault {
+			set.WithRefreshIntervalSeconds(v)
+		}
+	}
+	if d.HasChange("rest_authentication.0.oauth_client_secret") {
+		if v, ok := d.GetOk("rest_authentication.0.oauth_client_secret"); ok {
+			set.WithRestAuthentication(*sdk.NewCatalogIntegrationSetRestAuthenticationRequest(v.(string)))
+		}
+	}
+
+	if (*set != sdk.CatalogIntegrationSetRequest{}) {
+		if err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadCatalogIntegrationFunc(false)(ctx, d, meta)
+}
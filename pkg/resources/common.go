@@ -129,6 +129,8 @@ func TrackingImportWrapper(resourceName resources.Resource, importImplementation
 func TrackingCreateWrapper(resourceName resources.Resource, createImplementation schema.CreateContextFunc) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.CreateOperation))
+		// The object may not be visible yet in the lookup right after it was created.
+		ctx = sdk.ContextWithNotFoundRetries(ctx)
 		return createImplementation(ctx, d, meta)
 	}
 }
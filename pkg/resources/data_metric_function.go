@@ -0,0 +1,334 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dataMetricFunctionSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the data metric function; must be unique for the schema in which the data metric function is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the data metric function."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the data metric function."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"is_secure": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShow("is_secure"),
+		Description:      booleanStringFieldDescription("Specifies that the data metric function is secure."),
+	},
+	"argument": {
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"table_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The name of the table argument, used in the `expression` to reference the table passed to the data metric function.",
+				},
+				"column": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+								Description: "The name of the column argument.",
+							},
+							"data_type": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: IsDataTypeValid,
+								DiffSuppressFunc: DiffSuppressDataTypes,
+								Description:      "The data type of the column argument.",
+							},
+						},
+					},
+					Description: "The columns of the table argument.",
+				},
+			},
+		},
+		Description: externalChangesNotDetectedFieldDescription("Specifies the table arguments of the data metric function."),
+	},
+	"expression": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: DiffSuppressStatement,
+		Description:      "Specifies the SQL expression for the data metric function. The expression must return a single NUMBER value. The expression is wrapped in `$$` by the provider, so it should not contain `$$`.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the data metric function.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW DATA METRIC FUNCTIONS` for the given data metric function.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDataMetricFunctionSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE DATA METRIC FUNCTION` for the given data metric function.",
+		Elem: &schema.Resource{
+			Schema: schemas.DataMetricFunctionDescribeSchema,
+		},
+	},
+}
+
+func DataMetricFunction() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.DataMetricFunctions.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.DataMetricFunctionResource), TrackingCreateWrapper(resources.DataMetricFunction, CreateDataMetricFunction)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DataMetricFunctionResource), TrackingReadWrapper(resources.DataMetricFunction, ReadDataMetricFunction)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DataMetricFunctionResource), TrackingUpdateWrapper(resources.DataMetricFunction, UpdateDataMetricFunction)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DataMetricFunctionResource), TrackingDeleteWrapper(resources.DataMetricFunction, deleteFunc)),
+		Description:   "Resource used to manage custom data metric functions. For more information, check [data metric functions documentation](https://docs.snowflake.com/en/sql-reference/sql/create-data-metric-function).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.DataMetricFunction, customdiff.All(
+			ComputedIfAnyAttributeChanged(dataMetricFunctionSchema, ShowOutputAttributeName, "name", "is_secure", "comment"),
+			ComputedIfAnyAttributeChanged(dataMetricFunctionSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: dataMetricFunctionSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.DataMetricFunction, ImportDataMetricFunction),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportDataMetricFunction(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	dataMetricFunction, err := client.DataMetricFunctions.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("name", id.Name()),
+		d.Set("is_secure", booleanStringFromBool(dataMetricFunction.IsSecure)),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateDataMetricFunction(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	arguments, err := parseDataMetricFunctionArguments(d.Get("argument").([]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateDataMetricFunctionRequest(id, fmt.Sprintf("$$%s$$", d.Get("expression").(string))).
+		WithArguments(arguments)
+	if err := booleanStringAttributeCreateBuilder(d, "is_secure", request.WithSecure); err != nil {
+		return diag.FromErr(err)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.DataMetricFunctions.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadDataMetricFunction(ctx, d, meta)
+}
+
+func parseDataMetricFunctionArguments(rawArguments []any) ([]sdk.DataMetricFunctionTableArgumentRequest, error) {
+	arguments := make([]sdk.DataMetricFunctionTableArgumentRequest, len(rawArguments))
+	for i, rawArgument := range rawArguments {
+		argument := rawArgument.(map[string]any)
+		rawColumns := argument["column"].([]any)
+		columns := make([]sdk.DataMetricFunctionColumnRequest, len(rawColumns))
+		for j, rawColumn := range rawColumns {
+			column := rawColumn.(map[string]any)
+			dataType, err := datatypes.ParseDataType(column["data_type"].(string))
+			if err != nil {
+				return nil, err
+			}
+			columns[j] = *sdk.NewDataMetricFunctionColumnRequest(column["name"].(string), dataType)
+		}
+		arguments[i] = *sdk.NewDataMetricFunctionTableArgumentRequest(argument["table_name"].(string)).WithColumns(columns)
+	}
+	return arguments, nil
+}
+
+func ReadDataMetricFunction(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataMetricFunction, err := client.DataMetricFunctions.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query data metric function. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Data metric function id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	signature, err := dataMetricFunction.Signature()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	details, err := client.DataMetricFunctions.Describe(ctx, sdk.NewDescribeDataMetricFunctionRequest(id, signature))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	describeOutput := schemas.DataMetricFunctionDetailsToSchema(details)
+
+	if err := handleExternalChangesToObjectInShow(d,
+		outputMapping{"is_secure", "is_secure", dataMetricFunction.IsSecure, booleanStringFromBool(dataMetricFunction.IsSecure), nil},
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setStateToValuesFromConfig(d, dataMetricFunctionSchema, []string{
+		"is_secure",
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The description column holds the comment when it is set and a generic default text otherwise.
+	comment := ""
+	if dataMetricFunction.Description != sdk.DefaultFunctionComment {
+		comment = dataMetricFunction.Description
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("name", id.Name()),
+		d.Set("comment", comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.DataMetricFunctionToSchema(dataMetricFunction)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{describeOutput}),
+	)
+	if body, ok := describeOutput["body"]; ok {
+		errs = errors.Join(errs, d.Set("expression", body))
+	}
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateDataMetricFunction(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataMetricFunction, err := client.DataMetricFunctions.ShowByID(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	signature, err := dataMetricFunction.Signature()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		err := client.DataMetricFunctions.Alter(ctx, sdk.NewAlterDataMetricFunctionRequest(id, signature).WithRenameTo(newId))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming data metric function %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	set, unset := sdk.DataMetricFunctionSetRequest{}, sdk.DataMetricFunctionUnsetRequest{}
+	if err := errors.Join(
+		booleanStringAttributeUpdate(d, "is_secure", &set.Secure, &unset.Secure),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if (set != sdk.DataMetricFunctionSetRequest{}) {
+		if err := client.DataMetricFunctions.Alter(ctx, sdk.NewAlterDataMetricFunctionRequest(id, signature).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (unset != sdk.DataMetricFunctionUnsetRequest{}) {
+		if err := client.DataMetricFunctions.Alter(ctx, sdk.NewAlterDataMetricFunctionRequest(id, signature).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDataMetricFunction(ctx, d, meta)
+}
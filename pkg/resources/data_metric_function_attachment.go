@@ -0,0 +1,372 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dataMetricFunctionAttachmentSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: StringInSlice(collections.Map(sdk.AllDataMetricFunctionReferenceObjectTypes, func(o sdk.ObjectType) string { return o.String() }), true),
+		DiffSuppressFunc: NormalizeAndCompare(func(s string) (string, error) { return strings.ToUpper(s), nil }),
+		Description:      fmt.Sprintf("Type of the object the data metric function is attached to. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllDataMetricFunctionReferenceObjectTypes)),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Fully qualified name of the object the data metric function is attached to.",
+	},
+	"function_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Fully qualified name of the data metric function. It can be a system data metric function (e.g. `SNOWFLAKE.CORE.NULL_COUNT`) or a custom one. This function identifier must be provided without arguments in parenthesis.", resources.DataMetricFunction),
+	},
+	"on": {
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "The object columns on which to associate the data metric function. The data types of the columns must match the data types of the columns specified in the data metric function definition.",
+	},
+	"schedule_status": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          string(sdk.DataMetricScheduleStatusStarted),
+		ValidateDiagFunc: sdkValidation(sdk.ToAllowedDataMetricScheduleStatusOption),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToAllowedDataMetricScheduleStatusOption),
+		Description:      fmt.Sprintf("The status of the metrics association. Valid values are (case-insensitive): %s. The status is changed with `MODIFY DATA METRIC FUNCTION`.", possibleValuesListed(sdk.AllAllowedDataMetricScheduleStatusOptions)),
+	},
+	"data_metric_schedule": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"minutes": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      fmt.Sprintf("Specifies an interval (in minutes) of wait time inserted between runs of the data metric function. Conflicts with `using_cron`. Valid values are: %s. Due to Snowflake limitations, changes in this field are not managed by the provider. Please consider using [taint](https://developer.hashicorp.com/terraform/cli/commands/taint) command, `using_cron` field, or [replace_triggered_by](https://developer.hashicorp.com/terraform/language/meta-arguments/lifecycle#replace_triggered_by) metadata argument.", possibleValuesListed(sdk.AllViewDataMetricScheduleMinutes)),
+					ValidateDiagFunc: IntInSlice(sdk.AllViewDataMetricScheduleMinutes),
+					ExactlyOneOf:     []string{"data_metric_schedule.0.minutes", "data_metric_schedule.0.using_cron"},
+				},
+				"using_cron": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Specifies a cron expression and time zone for periodically running the data metric function. Supports a subset of standard cron utility syntax. Conflicts with `minutes`.",
+					ExactlyOneOf: []string{"data_metric_schedule.0.minutes", "data_metric_schedule.0.using_cron"},
+				},
+			},
+		},
+		Description: "Specifies the schedule to run the data metric functions periodically. The schedule is a property of the object, so it is shared by all data metric functions attached to it: set it in only one attachment per object (or manage it outside of Terraform). The schedule is not unset when the attachment is removed, because other data metric functions may still depend on it. Snowflake requires a schedule to be set on the object before a data metric function is added.",
+	},
+}
+
+func DataMetricFunctionAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.DataMetricFunctionAttachmentResource), TrackingCreateWrapper(resources.DataMetricFunctionAttachment, CreateDataMetricFunctionAttachment)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DataMetricFunctionAttachmentResource), TrackingReadWrapper(resources.DataMetricFunctionAttachment, ReadDataMetricFunctionAttachment)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DataMetricFunctionAttachmentResource), TrackingUpdateWrapper(resources.DataMetricFunctionAttachment, UpdateDataMetricFunctionAttachment)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DataMetricFunctionAttachmentResource), TrackingDeleteWrapper(resources.DataMetricFunctionAttachment, DeleteDataMetricFunctionAttachment)),
+		Description:   "Resource used to attach a data metric function to a table, view, materialized view, dynamic table, or external table. For more information, check [data quality documentation](https://docs.snowflake.com/en/user-guide/data-quality-working).",
+
+		Schema: dataMetricFunctionAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.DataMetricFunctionAttachment, ImportDataMetricFunctionAttachment),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+type dataMetricFunctionAttachmentId struct {
+	ObjectType   sdk.ObjectType
+	ObjectId     sdk.SchemaObjectIdentifier
+	FunctionId   sdk.SchemaObjectIdentifier
+	ColumnsNames []string
+}
+
+func (id dataMetricFunctionAttachmentId) String() string {
+	return helpers.EncodeResourceIdentifier(id.ObjectType.String(), id.ObjectId.FullyQualifiedName(), id.FunctionId.FullyQualifiedName(), strings.Join(id.ColumnsNames, ","))
+}
+
+func (id dataMetricFunctionAttachmentId) columns() []sdk.Column {
+	return collections.Map(id.ColumnsNames, func(name string) sdk.Column { return sdk.Column{Value: name} })
+}
+
+func parseDataMetricFunctionAttachmentId(raw string) (dataMetricFunctionAttachmentId, error) {
+	parts := helpers.ParseResourceIdentifier(raw)
+	if len(parts) != 4 {
+		return dataMetricFunctionAttachmentId{}, fmt.Errorf("invalid ID specified: %v, expected <object_type>|<object_name>|<function_name>|<comma_separated_columns>", raw)
+	}
+	objectType, err := sdk.ToObjectType(parts[0])
+	if err != nil {
+		return dataMetricFunctionAttachmentId{}, err
+	}
+	if !slices.Contains(sdk.AllDataMetricFunctionReferenceObjectTypes, objectType) {
+		return dataMetricFunctionAttachmentId{}, fmt.Errorf("invalid object type: %s, valid values are: %v", objectType, sdk.AllDataMetricFunctionReferenceObjectTypes)
+	}
+	objectId, err := sdk.ParseSchemaObjectIdentifier(parts[1])
+	if err != nil {
+		return dataMetricFunctionAttachmentId{}, err
+	}
+	functionId, err := sdk.ParseSchemaObjectIdentifier(parts[2])
+	if err != nil {
+		return dataMetricFunctionAttachmentId{}, err
+	}
+	if parts[3] == "" {
+		return dataMetricFunctionAttachmentId{}, fmt.Errorf("invalid ID specified: %v, at least one column is required", raw)
+	}
+	return dataMetricFunctionAttachmentId{
+		ObjectType:   objectType,
+		ObjectId:     objectId,
+		FunctionId:   functionId,
+		ColumnsNames: strings.Split(parts[3], ","),
+	}, nil
+}
+
+func ImportDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := parseDataMetricFunctionAttachmentId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := errors.Join(
+		d.Set("object_type", id.ObjectType.String()),
+		d.Set("object_name", id.ObjectId.FullyQualifiedName()),
+		d.Set("function_name", id.FunctionId.FullyQualifiedName()),
+		d.Set("on", id.ColumnsNames),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, err := sdk.ToObjectType(d.Get("object_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectId, err := sdk.ParseSchemaObjectIdentifier(d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	functionId, err := sdk.ParseSchemaObjectIdentifier(d.Get("function_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := dataMetricFunctionAttachmentId{
+		ObjectType:   objectType,
+		ObjectId:     objectId,
+		FunctionId:   functionId,
+		ColumnsNames: expandStringList(d.Get("on").([]any)),
+	}
+
+	if v, ok := d.GetOk("data_metric_schedule"); ok {
+		if err := setDataMetricScheduleOnObject(ctx, client, id, v.([]any)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(id.ObjectType, id.ObjectId).
+		WithAdd(*sdk.NewDataMetricFunctionOnObjectRequest(id.FunctionId, id.columns())))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error adding data metric function %s to %s %s: %w", id.FunctionId.FullyQualifiedName(), id.ObjectType, id.ObjectId.FullyQualifiedName(), err))
+	}
+	d.SetId(id.String())
+
+	status, err := sdk.ToAllowedDataMetricScheduleStatusOption(d.Get("schedule_status").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status == sdk.DataMetricScheduleStatusSuspended {
+		if err := modifyDataMetricFunctionAttachmentStatus(ctx, client, id, status); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDataMetricFunctionAttachment(ctx, d, meta)
+}
+
+func ReadDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := parseDataMetricFunctionAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain, err := sdk.DataMetricFunctionRefEntityDomainForObjectType(id.ObjectType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	references, err := client.DataMetricFunctionReferences.GetForEntity(ctx, sdk.NewGetForEntityDataMetricFunctionReferenceRequest(id.ObjectId, domain))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query data metric function references. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Data metric function attachment id: %s, Err: %s", d.Id(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	reference, err := collections.FindFirst(references, func(r sdk.DataMetricFunctionReference) bool {
+		return sdk.NewSchemaObjectIdentifier(r.MetricDatabaseName, r.MetricSchemaName, r.MetricName).FullyQualifiedName() == id.FunctionId.FullyQualifiedName() &&
+			slices.Equal(collections.Map(r.RefArguments, func(a sdk.DataMetricFunctionRefArgument) string { return a.Name }), id.ColumnsNames)
+	})
+	if err != nil {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Data metric function is no longer attached to the object. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Data metric function attachment id: %s", d.Id()),
+			},
+		}
+	}
+
+	status, err := sdk.ToDataMetricScheduleStatusOption(reference.ScheduleStatus)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var scheduleStatus sdk.DataMetricScheduleStatusOption
+	if slices.Contains(sdk.AllDataMetricScheduleStatusStartedOptions, status) {
+		scheduleStatus = sdk.DataMetricScheduleStatusStarted
+	}
+	if slices.Contains(sdk.AllDataMetricScheduleStatusSuspendedOptions, status) {
+		scheduleStatus = sdk.DataMetricScheduleStatusSuspended
+	}
+
+	errs := errors.Join(
+		d.Set("object_type", id.ObjectType.String()),
+		d.Set("object_name", id.ObjectId.FullyQualifiedName()),
+		d.Set("function_name", id.FunctionId.FullyQualifiedName()),
+		d.Set("on", id.ColumnsNames),
+		d.Set("schedule_status", string(scheduleStatus)),
+	)
+	// Snowflake returns the schedule in the cron format, even if it was set with minutes, so only the cron schedule can be compared.
+	if v, ok := d.GetOk("data_metric_schedule.0.using_cron"); ok && v.(string) != "" {
+		errs = errors.Join(errs, d.Set("data_metric_schedule", []map[string]any{
+			{
+				"using_cron": reference.Schedule,
+			},
+		}))
+	}
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := parseDataMetricFunctionAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("data_metric_schedule") {
+		if v, ok := d.GetOk("data_metric_schedule"); ok {
+			if err := setDataMetricScheduleOnObject(ctx, client, id, v.([]any)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("schedule_status") {
+		status, err := sdk.ToAllowedDataMetricScheduleStatusOption(d.Get("schedule_status").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := modifyDataMetricFunctionAttachmentStatus(ctx, client, id, status); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDataMetricFunctionAttachment(ctx, d, meta)
+}
+
+func DeleteDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := parseDataMetricFunctionAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(id.ObjectType, id.ObjectId).
+		WithDrop(*sdk.NewDataMetricFunctionOnObjectRequest(id.FunctionId, id.columns())))
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(fmt.Errorf("error dropping data metric function %s from %s %s: %w", id.FunctionId.FullyQualifiedName(), id.ObjectType, id.ObjectId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setDataMetricScheduleOnObject(ctx context.Context, client *sdk.Client, id dataMetricFunctionAttachmentId, rawSchedule []any) error {
+	if len(rawSchedule) == 0 || rawSchedule[0] == nil {
+		return nil
+	}
+	schedule := rawSchedule[0].(map[string]any)
+	var value string
+	if v, ok := schedule["minutes"]; ok && v.(int) > 0 {
+		value = fmt.Sprintf("%d MINUTE", v.(int))
+	}
+	if v, ok := schedule["using_cron"]; ok && v.(string) != "" {
+		value = fmt.Sprintf("USING CRON %s", v.(string))
+	}
+	if value == "" {
+		return nil
+	}
+	if err := client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(id.ObjectType, id.ObjectId).WithSetDataMetricSchedule(value)); err != nil {
+		return fmt.Errorf("error setting data metric schedule on %s %s: %w", id.ObjectType, id.ObjectId.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func modifyDataMetricFunctionAttachmentStatus(ctx context.Context, client *sdk.Client, id dataMetricFunctionAttachmentId, status sdk.DataMetricScheduleStatusOption) error {
+	var statusCmd sdk.ViewDataMetricScheduleStatusOperationOption
+	switch status {
+	case sdk.DataMetricScheduleStatusStarted:
+		statusCmd = sdk.ViewDataMetricScheduleStatusOperationResume
+	case sdk.DataMetricScheduleStatusSuspended:
+		statusCmd = sdk.ViewDataMetricScheduleStatusOperationSuspend
+	default:
+		return fmt.Errorf("unsupported schedule status: %s", status)
+	}
+	err := client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(id.ObjectType, id.ObjectId).
+		WithModify(*sdk.NewDataMetricFunctionModifyOnObjectRequest(id.FunctionId, id.columns(), statusCmd)))
+	if err != nil {
+		return fmt.Errorf("error modifying data metric function %s status on %s %s: %w", id.FunctionId.FullyQualifiedName(), id.ObjectType, id.ObjectId.FullyQualifiedName(), err)
+	}
+	return nil
+}
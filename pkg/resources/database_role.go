@@ -132,13 +132,8 @@ func CreateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) d
 	databaseName := d.Get("database").(string)
 	roleName := d.Get("name").(string)
 	id := sdk.NewDatabaseObjectIdentifier(databaseName, roleName)
-	createRequest := sdk.NewCreateDatabaseRoleRequest(id)
-
-	if v, ok := d.GetOk("comment"); ok {
-		createRequest.WithComment(v.(string))
-	}
 
-	err := client.DatabaseRoles.Create(ctx, createRequest)
+	err := client.DatabaseRoles.Create(ctx, databaseRoleCreateRequest(id, d))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -148,6 +143,15 @@ func CreateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) d
 	return ReadDatabaseRole(ctx, d, meta)
 }
 
+func databaseRoleCreateRequest(id sdk.DatabaseObjectIdentifier, d ResourceValueGetter) *sdk.CreateDatabaseRoleRequest {
+	createRequest := sdk.NewCreateDatabaseRoleRequest(id)
+
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(v.(string))
+	}
+	return createRequest
+}
+
 func UpdateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
@@ -156,25 +160,56 @@ func UpdateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) d
 		return diag.FromErr(err)
 	}
 
+	newId, err := alterDatabaseRole(ctx, client, id, d)
+	if newId != id {
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseRole(ctx, d, meta)
+}
+
+// alterDatabaseRole runs the statements for the changed fields (it's also used by the SQL preview). It returns the identifier
+// of the role after the rename, also when one of the next statements fails.
+func alterDatabaseRole(ctx context.Context, client *sdk.Client, id sdk.DatabaseObjectIdentifier, d ResourceValueGetter) (sdk.DatabaseObjectIdentifier, error) {
 	if d.HasChange("name") {
 		newId := sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), d.Get("name").(string))
 
-		err = client.DatabaseRoles.Alter(ctx, sdk.NewAlterDatabaseRoleRequest(id).WithRename(newId))
-		if err != nil {
-			return diag.FromErr(err)
+		if err := client.DatabaseRoles.Alter(ctx, sdk.NewAlterDatabaseRoleRequest(id).WithRename(newId)); err != nil {
+			return id, err
 		}
-
-		d.SetId(helpers.EncodeResourceIdentifier(newId))
 		id = newId
 	}
 
 	if d.HasChange("comment") {
 		newComment := d.Get("comment").(string)
-		err := client.DatabaseRoles.Alter(ctx, sdk.NewAlterDatabaseRoleRequest(id).WithSet(*sdk.NewDatabaseRoleSetRequest(newComment)))
-		if err != nil {
-			return diag.FromErr(err)
+		if err := client.DatabaseRoles.Alter(ctx, sdk.NewAlterDatabaseRoleRequest(id).WithSet(*sdk.NewDatabaseRoleSetRequest(newComment))); err != nil {
+			return id, err
 		}
 	}
+	return id, nil
+}
 
-	return ReadDatabaseRole(ctx, d, meta)
+var databaseRoleSqlPreview = SqlPreview{
+	Create: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id := sdk.NewDatabaseObjectIdentifier(d.Get("database").(string), d.Get("name").(string))
+		return client.DatabaseRoles.Create(ctx, databaseRoleCreateRequest(id, d))
+	},
+	Update: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id, err := sdk.ParseDatabaseObjectIdentifier(d.Id())
+		if err != nil {
+			return err
+		}
+		_, err = alterDatabaseRole(ctx, client, id, d)
+		return err
+	},
+	Delete: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id, err := sdk.ParseDatabaseObjectIdentifier(d.Id())
+		if err != nil {
+			return err
+		}
+		return client.DatabaseRoles.DropSafely(ctx, id)
+	},
 }
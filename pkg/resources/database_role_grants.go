@@ -0,0 +1,250 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var databaseRoleGrantsObjectTypes = slices.Concat(
+	[]string{sdk.ObjectTypeDatabase.String(), sdk.ObjectTypeSchema.String()},
+	sdk.ValidGrantToObjectTypesString,
+)
+
+var databaseRoleGrantsSchema = map[string]*schema.Schema{
+	"database_role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the database role to which the privileges are granted.", resources.DatabaseRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"grant": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        roleGrantsGrantSchema(databaseRoleGrantsObjectTypes),
+		Description: "The complete set of privileges granted to the database role. Every privilege granted to the role that is not listed here (and is not excluded) is revoked. Leaving the set empty revokes all the privileges.",
+	},
+	"exclude": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        roleGrantsExcludeSchema,
+		Description: roleGrantsExcludeDescription,
+	},
+}
+
+func DatabaseRoleGrants() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.DatabaseRoleGrantsResource), TrackingCreateWrapper(resources.DatabaseRoleGrants, CreateDatabaseRoleGrants)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DatabaseRoleGrantsResource), TrackingReadWrapper(resources.DatabaseRoleGrants, ReadDatabaseRoleGrants)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DatabaseRoleGrantsResource), TrackingUpdateWrapper(resources.DatabaseRoleGrants, UpdateDatabaseRoleGrants)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DatabaseRoleGrantsResource), TrackingDeleteWrapper(resources.DatabaseRoleGrants, DeleteDatabaseRoleGrants)),
+		Description: joinWithSpace(
+			"Resource used to manage all the privileges granted to a database role in an authoritative way; the privileges that are not declared in the configuration are revoked.",
+			"Ownership, database roles granted to the role, and the privileges granted by the system are not managed.",
+			"Future grants are not managed either, because they are not listed by `SHOW GRANTS TO DATABASE ROLE`.",
+			"Don't use this resource together with the other resources granting privileges to the same role, as it will revoke them.",
+		),
+
+		Schema: databaseRoleGrantsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.DatabaseRoleGrants, ImportDatabaseRoleGrants),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := sdk.ParseDatabaseObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("database_role_name", id.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := sdk.ParseDatabaseObjectIdentifier(d.Get("database_role_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := applyDatabaseRoleGrants(ctx, d, meta.(*provider.Context).Client, id); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadDatabaseRoleGrants(ctx, d, meta)
+}
+
+func ReadDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseDatabaseObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DatabaseRoles.ShowByID(ctx, id); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query database role. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Database role id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	exclusions, err := roleGrantsExclusionsFromConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actual, err := showDatabaseRoleGrants(ctx, client, id, exclusions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actual = roleGrantsWithConfiguredNames(actual, roleGrantsFromState(d))
+
+	errs := errors.Join(
+		d.Set("database_role_name", id.FullyQualifiedName()),
+		d.Set("grant", collections.Map(actual, roleGrant.toMap)),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := sdk.ParseDatabaseObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges("grant", "exclude") {
+		if err := applyDatabaseRoleGrants(ctx, d, meta.(*provider.Context).Client, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadDatabaseRoleGrants(ctx, d, meta)
+}
+
+func DeleteDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseDatabaseObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DatabaseRoles.ShowByID(ctx, id); err != nil && errors.Is(err, sdk.ErrObjectNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	for _, grant := range roleGrantsFromState(d) {
+		if err := revokeDatabaseRoleGrant(ctx, client, id, grant); err != nil {
+			return diag.FromErr(fmt.Errorf("revoking privileges %v on %s %s failed: %w", grant.Privileges, grant.ObjectType, grant.ObjectName, err))
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// applyDatabaseRoleGrants reads the privileges currently granted to the role and grants or revokes the privileges to match the configuration.
+func applyDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.DatabaseObjectIdentifier) error {
+	exclusions, err := roleGrantsExclusionsFromConfig(d)
+	if err != nil {
+		return err
+	}
+	expected, err := roleGrantsFromConfig(d.Get("grant").(*schema.Set).List(), exclusions)
+	if err != nil {
+		return err
+	}
+	current, err := showDatabaseRoleGrants(ctx, client, id, exclusions)
+	if err != nil {
+		return err
+	}
+	return applyRoleGrantsChanges(ctx, current, expected,
+		func(ctx context.Context, grant roleGrant) error {
+			return revokeDatabaseRoleGrant(ctx, client, id, grant)
+		},
+		func(ctx context.Context, grant roleGrant) error {
+			privileges, on, err := databaseRoleGrantPrivilegesAndOn(grant)
+			if err != nil {
+				return err
+			}
+			var opts *sdk.GrantPrivilegesToDatabaseRoleOptions
+			if grant.WithGrantOption {
+				opts = &sdk.GrantPrivilegesToDatabaseRoleOptions{WithGrantOption: sdk.Bool(true)}
+			}
+			return client.Grants.GrantPrivilegesToDatabaseRole(ctx, privileges, on, id, opts)
+		},
+	)
+}
+
+func showDatabaseRoleGrants(ctx context.Context, client *sdk.Client, id sdk.DatabaseObjectIdentifier, exclusions []roleGrantsExclusion) ([]roleGrant, error) {
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		To: &sdk.ShowGrantsTo{
+			DatabaseRole: id,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// USAGE on the parent database is granted to every database role on creation, and it cannot be revoked.
+	grants = slices.DeleteFunc(grants, func(grant sdk.Grant) bool {
+		return grant.Privilege == sdk.AccountObjectPrivilegeUsage.String() && grant.GrantedOn == sdk.ObjectTypeDatabase && grant.Name.Name() == id.DatabaseName()
+	})
+	return roleGrantsFromShowGrants(grants, exclusions), nil
+}
+
+func revokeDatabaseRoleGrant(ctx context.Context, client *sdk.Client, id sdk.DatabaseObjectIdentifier, grant roleGrant) error {
+	privileges, on, err := databaseRoleGrantPrivilegesAndOn(grant)
+	if err != nil {
+		return err
+	}
+	return client.Grants.RevokePrivilegesFromDatabaseRole(ctx, privileges, on, id, nil)
+}
+
+func databaseRoleGrantPrivilegesAndOn(grant roleGrant) (*sdk.DatabaseRoleGrantPrivileges, *sdk.DatabaseRoleGrantOn, error) {
+	switch {
+	case grant.ObjectType == sdk.ObjectTypeDatabase:
+		databaseId, ok := grant.ObjectId.(sdk.AccountObjectIdentifier)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid identifier %s of %s", grant.ObjectName, grant.ObjectType)
+		}
+		return getDatabaseRolePrivileges(false, grant.Privileges, true, false, false),
+			&sdk.DatabaseRoleGrantOn{Database: &databaseId},
+			nil
+	case grant.ObjectType == sdk.ObjectTypeSchema:
+		schemaId, ok := grant.ObjectId.(sdk.DatabaseObjectIdentifier)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid identifier %s of %s", grant.ObjectName, grant.ObjectType)
+		}
+		return getDatabaseRolePrivileges(false, grant.Privileges, false, true, false),
+			&sdk.DatabaseRoleGrantOn{Schema: &sdk.GrantOnSchema{Schema: &schemaId}},
+			nil
+	case slices.Contains(sdk.ValidGrantToObjectTypesString, strings.ToUpper(grant.ObjectType.String())):
+		return getDatabaseRolePrivileges(false, grant.Privileges, false, false, true),
+			&sdk.DatabaseRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{SchemaObject: &sdk.Object{ObjectType: grant.ObjectType, Name: grant.ObjectId}}},
+			nil
+	default:
+		return nil, nil, fmt.Errorf("privileges on %s cannot be managed by the %s resource", grant.ObjectType, resources.DatabaseRoleGrants)
+	}
+}
@@ -0,0 +1,448 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var eventTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the event table; must be unique for the schema in which the event table is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the event table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the event table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies one or more columns or column expressions in the event table as the clustering key.",
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the event table.",
+	},
+	"row_access_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Row access policy name.", resources.RowAccessPolicy),
+				},
+				"on": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns are affected by the policy.",
+				},
+			},
+		},
+		Description: "Specifies the row access policy to set on the event table.",
+	},
+	"tag": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Definitions of a tag to associate with the event table. Changes to the tags made outside of Terraform are not detected.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Tag name, e.g. department.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Tag value, e.g. marketing_info.",
+				},
+				"database": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the database that the tag was created in.",
+				},
+				"schema": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the schema that the tag was created in.",
+				},
+			},
+		},
+	},
+	strings.ToLower(string(sdk.ObjectParameterDataRetentionTimeInDays)): {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 90)),
+		Description:      enrichWithReferenceToParameterDocs(sdk.ObjectParameterDataRetentionTimeInDays, "Specifies the retention period for the event table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the event table."),
+	},
+	strings.ToLower(string(sdk.ObjectParameterMaxDataExtensionTimeInDays)): {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 90)),
+		Description:      enrichWithReferenceToParameterDocs(sdk.ObjectParameterMaxDataExtensionTimeInDays, "Object parameter that specifies the maximum number of days for which Snowflake can extend the data retention period for the event table to prevent streams on the event table from becoming stale."),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the event table.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW EVENT TABLES` for the given event table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowEventTableSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE EVENT TABLE` for the given event table.",
+		Elem: &schema.Resource{
+			Schema: schemas.EventTableDescribeSchema,
+		},
+	},
+	ParametersAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW PARAMETERS IN TABLE` for the given event table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowEventTableParametersSchema,
+		},
+	},
+}
+
+func EventTable() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.EventTables.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.EventTableResource), TrackingCreateWrapper(resources.EventTable, CreateEventTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.EventTableResource), TrackingReadWrapper(resources.EventTable, ReadEventTable)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.EventTableResource), TrackingUpdateWrapper(resources.EventTable, UpdateEventTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.EventTableResource), TrackingDeleteWrapper(resources.EventTable, deleteFunc)),
+		Description:   "Resource used to manage event tables. For more information, check [event tables documentation](https://docs.snowflake.com/en/sql-reference/sql/create-event-table).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.EventTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(eventTableSchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(eventTableSchema, ParametersAttributeName, strings.ToLower(string(sdk.ObjectParameterDataRetentionTimeInDays)), strings.ToLower(string(sdk.ObjectParameterMaxDataExtensionTimeInDays))),
+			ComputedIfAnyAttributeChanged(eventTableSchema, FullyQualifiedNameAttributeName, "name"),
+			ParametersCustomDiff(
+				eventTableParametersProvider,
+				parameter[sdk.ObjectParameter]{sdk.ObjectParameterDataRetentionTimeInDays, valueTypeInt, sdk.ParameterTypeTable},
+				parameter[sdk.ObjectParameter]{sdk.ObjectParameterMaxDataExtensionTimeInDays, valueTypeInt, sdk.ParameterTypeTable},
+			),
+		)),
+
+		Schema: eventTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.EventTable, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func eventTableParametersProvider(ctx context.Context, d ResourceIdProvider, meta any) ([]*sdk.Parameter, error) {
+	return parametersProvider(ctx, d, meta.(*provider.Context), eventTableParametersProviderFunc, sdk.ParseSchemaObjectIdentifier)
+}
+
+func eventTableParametersProviderFunc(c *sdk.Client) showParametersFunc[sdk.SchemaObjectIdentifier] {
+	return c.EventTables.ShowParameters
+}
+
+func handleEventTableParameterRead(d *schema.ResourceData, eventTableParameters []*sdk.Parameter) error {
+	for _, p := range eventTableParameters {
+		switch p.Key {
+		case
+			string(sdk.ObjectParameterDataRetentionTimeInDays),
+			string(sdk.ObjectParameterMaxDataExtensionTimeInDays):
+			value, err := strconv.Atoi(p.Value)
+			if err != nil {
+				return err
+			}
+			if err := d.Set(strings.ToLower(p.Key), value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func CreateEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateEventTableRequest(id)
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]any)))
+	}
+	if v := d.Get("change_tracking").(bool); v {
+		request.WithChangeTracking(sdk.Bool(v))
+	}
+	if v, ok := d.GetOk("row_access_policy"); ok {
+		policyId, columns, err := extractEventTableRowAccessPolicy(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithRowAccessPolicy(&sdk.TableRowAccessPolicy{Name: policyId, On: columns})
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+	if diags := JoinDiags(
+		handleParameterCreate(d, sdk.ObjectParameterDataRetentionTimeInDays, &request.DataRetentionTimeInDays),
+		handleParameterCreate(d, sdk.ObjectParameterMaxDataExtensionTimeInDays, &request.MaxDataExtensionTimeInDays),
+	); diags != nil {
+		return diags
+	}
+	if err := stringAttributeCreate(d, "comment", &request.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.EventTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadEventTable(ctx, d, meta)
+}
+
+func ReadEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	eventTable, err := client.EventTables.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query event table. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Event table id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	// SHOW EVENT TABLES does not return clustering and change tracking details, so they are read from SHOW TABLES (event tables are listed there with is_event set).
+	table, err := client.Tables.ShowByIDSafely(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	eventTableDetails, err := client.EventTables.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	eventTableParameters, err := client.EventTables.ShowParameters(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyRefs, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainTable))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting policy references for event table: %w", err))
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("name", id.Name()),
+		d.Set("cluster_by", table.GetClusterByKeys()),
+		d.Set("change_tracking", table.ChangeTracking),
+		d.Set("row_access_policy", eventTableRowAccessPolicies(policyRefs)),
+		d.Set("comment", eventTable.Comment),
+		handleEventTableParameterRead(d, eventTableParameters),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.EventTableToSchema(eventTable)}),
+		d.Set(DescribeOutputAttributeName, schemas.EventTableDetailsToSchema(eventTableDetails)),
+		d.Set(ParametersAttributeName, []map[string]any{schemas.EventTableParametersToSchema(eventTableParameters)}),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithRenameTo(&newId))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming event table %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	set, unset := sdk.NewEventTableSetRequest(), sdk.NewEventTableUnsetRequest()
+	if d.HasChange("change_tracking") {
+		set.WithChangeTracking(sdk.Bool(d.Get("change_tracking").(bool)))
+	}
+	if diags := JoinDiags(
+		handleParameterUpdate(d, sdk.ObjectParameterDataRetentionTimeInDays, &set.DataRetentionTimeInDays, &unset.DataRetentionTimeInDays),
+		handleParameterUpdate(d, sdk.ObjectParameterMaxDataExtensionTimeInDays, &set.MaxDataExtensionTimeInDays, &unset.MaxDataExtensionTimeInDays),
+	); diags != nil {
+		return diags
+	}
+	if err := stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if (*set != sdk.EventTableSetRequest{}) {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.EventTableUnsetRequest{}) {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		clusteringAction := sdk.NewEventTableClusteringActionRequest()
+		if clusterBy := expandStringList(d.Get("cluster_by").([]any)); len(clusterBy) > 0 {
+			clusteringAction.WithClusterBy(&clusterBy)
+		} else {
+			clusteringAction.WithDropClusteringKey(sdk.Bool(true))
+		}
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithClusteringAction(clusteringAction)); err != nil {
+			return diag.FromErr(fmt.Errorf("error altering cluster_by for event table %v: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("row_access_policy") {
+		var addReq *sdk.EventTableAddRowAccessPolicyRequest
+		var dropReq *sdk.EventTableDropRowAccessPolicyRequest
+
+		oldRaw, newRaw := d.GetChange("row_access_policy")
+		if len(oldRaw.([]any)) > 0 {
+			oldId, _, err := extractEventTableRowAccessPolicy(oldRaw)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			dropReq = sdk.NewEventTableDropRowAccessPolicyRequest(oldId)
+		}
+		if len(newRaw.([]any)) > 0 {
+			newId, newColumns, err := extractEventTableRowAccessPolicy(newRaw)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			addReq = sdk.NewEventTableAddRowAccessPolicyRequest(newId, newColumns)
+		}
+		req := sdk.NewAlterEventTableRequest(id)
+		if addReq != nil && dropReq != nil { // nolint
+			req.WithDropAndAddRowAccessPolicy(sdk.NewEventTableDropAndAddRowAccessPolicyRequest(*dropReq, *addReq))
+		} else if addReq != nil {
+			req.WithAddRowAccessPolicy(addReq)
+		} else if dropReq != nil {
+			req.WithDropRowAccessPolicy(dropReq)
+		}
+		if err := client.EventTables.Alter(ctx, req); err != nil {
+			return diag.FromErr(fmt.Errorf("error altering row_access_policy for event table %v: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+		if len(unsetTags) > 0 {
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting tags on event table %v, err = %w", d.Id(), err))
+			}
+		}
+		if len(setTags) > 0 {
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSetTags(setTags)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting tags on event table %v, err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	return ReadEventTable(ctx, d, meta)
+}
+
+func extractEventTableRowAccessPolicy(v any) (sdk.SchemaObjectIdentifier, []string, error) {
+	policyConfig := v.([]any)[0].(map[string]any)
+	id, err := sdk.ParseSchemaObjectIdentifier(policyConfig["policy_name"].(string))
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, nil, err
+	}
+	return id, expandStringList(policyConfig["on"].(*schema.Set).List()), nil
+}
+
+func eventTableRowAccessPolicies(policyRefs []sdk.PolicyReference) []map[string]any {
+	var rowAccessPolicies []map[string]any
+	for _, p := range policyRefs {
+		if p.PolicyKind != sdk.PolicyKindRowAccessPolicy {
+			log.Printf("[DEBUG] unexpected policy kind %v in policy references returned from Snowflake", p.PolicyKind)
+			continue
+		}
+		var on []string
+		if p.RefArgColumnNames != nil {
+			on = sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true)
+		}
+		rowAccessPolicies = append(rowAccessPolicies, map[string]any{
+			"policy_name": sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName).FullyQualifiedName(),
+			"on":          on,
+		})
+	}
+	return rowAccessPolicies
+}
@@ -0,0 +1,275 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var executeImmediateFromGitReferences = []string{"branch", "tag", "commit"}
+
+var executeImmediateFromSchema = map[string]*schema.Schema{
+	"git_repository": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Fully qualified name of the git repository containing the executed file.", resources.GitRepository),
+	},
+	"branch": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: executeImmediateFromGitReferences,
+		Description:  "Name of the branch the file is executed from. The branch is resolved to its current commit during the plan, so the new commits on the branch (after fetching the git repository) show up as a change of `commit_hash`. The branch is resolved with the commits already fetched to Snowflake, so the commits fetched during the same apply (e.g. with `fetch_on_apply` of the git repository) are executed only in the next apply.",
+	},
+	"tag": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: executeImmediateFromGitReferences,
+		Description:  "Name of the tag the file is executed from. The tag is resolved to its commit during the plan, so it has to be fetched to Snowflake before.",
+	},
+	"commit": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: executeImmediateFromGitReferences,
+		Description:  "Hash of the commit the file is executed from.",
+	},
+	"file_path": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Path of the executed SQL file in the git repository (e.g. `deploy/setup.sql`).",
+	},
+	"using": {
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Variables passed to the Jinja2 template of the executed file (`USING` clause of `EXECUTE IMMEDIATE FROM`). The file is executed again on every change.",
+	},
+	"revert_file_path": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"revert"},
+		Description:   "Path of the SQL file in the git repository executed when the resource is being destroyed. The file is executed from the same commit as the last applied file, with the same `using` variables.",
+	},
+	"revert": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"revert_file_path"},
+		Description:   "SQL statement executed when the resource is being destroyed.",
+	},
+	"commit_hash": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Hash of the commit the file was executed from. A change of it executes the file again.",
+	},
+}
+
+func ExecuteImmediateFrom() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ExecuteImmediateFromResource), TrackingCreateWrapper(resources.ExecuteImmediateFrom, CreateExecuteImmediateFrom)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ExecuteImmediateFromResource), TrackingReadWrapper(resources.ExecuteImmediateFrom, ReadExecuteImmediateFrom)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ExecuteImmediateFromResource), TrackingUpdateWrapper(resources.ExecuteImmediateFrom, UpdateExecuteImmediateFrom)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ExecuteImmediateFromResource), TrackingDeleteWrapper(resources.ExecuteImmediateFrom, DeleteExecuteImmediateFrom)),
+		Description:   "Resource used to execute a SQL file from a git repository with the [EXECUTE IMMEDIATE FROM](https://docs.snowflake.com/en/sql-reference/sql/execute-immediate-from) command. The file is always executed from the resolved commit (`@<repository>/commits/<commit_hash>/<file_path>`), and it is executed again when the commit or the `using` variables change.",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ExecuteImmediateFrom, customdiff.All(
+			executeImmediateFromCommitHashCustomDiff,
+		)),
+
+		Schema: executeImmediateFromSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+// executeImmediateFromCommitHashCustomDiff resolves the configured git reference to the commit hash, so that the new commits show up in the plan.
+// The commit hash is left unknown only when the git repository does not exist yet (e.g. it is created in the same apply). The reference is
+// resolved against the commits already fetched to Snowflake, so the commits fetched in the same apply (e.g. with fetch_on_apply
+// of the git repository) are executed in the next apply.
+func executeImmediateFromCommitHashCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	for _, key := range append([]string{"git_repository"}, executeImmediateFromGitReferences...) {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("commit_hash")
+		}
+	}
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(diff.Get("git_repository").(string))
+	if err != nil {
+		return err
+	}
+	if _, err := client.GitRepositories.ShowByIDSafely(ctx, id); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			log.Printf("[DEBUG] git repository %s does not exist yet, marking the commit hash as unknown", id.FullyQualifiedName())
+			return diff.SetNewComputed("commit_hash")
+		}
+		return fmt.Errorf("could not check git repository %s: %w", id.FullyQualifiedName(), err)
+	}
+	commitHash, err := resolveGitCommitHash(ctx, client, id, diff.Get("branch").(string), diff.Get("tag").(string), diff.Get("commit").(string))
+	if err != nil {
+		return fmt.Errorf("could not resolve the commit hash in git repository %s (the new branches and tags are visible only after the repository is fetched): %w", id.FullyQualifiedName(), err)
+	}
+	if diff.Get("commit_hash").(string) != commitHash {
+		return diff.SetNew("commit_hash", commitHash)
+	}
+	return nil
+}
+
+// resolveGitCommitHash returns the hash of the commit pointed to by the given branch, tag, or commit in the git repository.
+func resolveGitCommitHash(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, branch string, tag string, commit string) (string, error) {
+	switch {
+	case commit != "":
+		return commit, nil
+	case branch != "":
+		branches, err := client.GitRepositories.ShowGitBranches(ctx, sdk.NewShowGitBranchesGitRepositoryRequest(id).WithGitRepository(true).WithLike(sdk.Like{Pattern: sdk.String(branch)}))
+		if err != nil {
+			return "", err
+		}
+		for _, b := range branches {
+			if b.Name == branch {
+				return b.CommitHash, nil
+			}
+		}
+		return "", fmt.Errorf("branch %s not found in git repository %s", branch, id.FullyQualifiedName())
+	case tag != "":
+		tags, err := client.GitRepositories.ShowGitTags(ctx, sdk.NewShowGitTagsGitRepositoryRequest(id).WithGitRepository(true).WithLike(sdk.Like{Pattern: sdk.String(tag)}))
+		if err != nil {
+			return "", err
+		}
+		for _, t := range tags {
+			if t.Name == tag {
+				return t.CommitHash, nil
+			}
+		}
+		return "", fmt.Errorf("tag %s not found in git repository %s", tag, id.FullyQualifiedName())
+	default:
+		return "", errors.New("one of branch, tag, or commit has to be set")
+	}
+}
+
+func executeImmediateFromUsing(d *schema.ResourceData) []sdk.ExecuteImmediateUsingArgument {
+	using := d.Get("using").(map[string]any)
+	arguments := make([]sdk.ExecuteImmediateUsingArgument, 0, len(using))
+	for _, key := range slices.Sorted(maps.Keys(using)) {
+		arguments = append(arguments, sdk.ExecuteImmediateUsingArgument{Key: key, Value: using[key].(string)})
+	}
+	return arguments
+}
+
+func executeImmediateFromFile(ctx context.Context, client *sdk.Client, d *schema.ResourceData, commitHash string, filePath string) error {
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Get("git_repository").(string))
+	if err != nil {
+		return err
+	}
+	location := sdk.NewStageLocation(id, fmt.Sprintf("commits/%s/%s", commitHash, filePath))
+	if err := client.ExecuteImmediate.From(ctx, location, &sdk.ExecuteImmediateFromOptions{Using: executeImmediateFromUsing(d)}); err != nil {
+		return fmt.Errorf("error executing file %s: %w", location.ToSql(), err)
+	}
+	return nil
+}
+
+// executeImmediateFromApply executes the file from the planned commit hash, or from the commit resolved now if it was unknown during the plan.
+func executeImmediateFromApply(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	commitHash := d.Get("commit_hash").(string)
+	if commitHash == "" {
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Get("git_repository").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		commitHash, err = resolveGitCommitHash(ctx, client, id, d.Get("branch").(string), d.Get("tag").(string), d.Get("commit").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := executeImmediateFromFile(ctx, client, d, commitHash, d.Get("file_path").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("commit_hash", commitHash); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func CreateExecuteImmediateFrom(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := executeImmediateFromApply(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+	d.SetId(id)
+
+	return ReadExecuteImmediateFrom(ctx, d, meta)
+}
+
+func ReadExecuteImmediateFrom(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Get("git_repository").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.GitRepositories.ShowByIDSafely(ctx, id); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query git repository. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Git repository id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateExecuteImmediateFrom(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.HasChanges("commit_hash", "using") {
+		if diags := executeImmediateFromApply(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+	return ReadExecuteImmediateFrom(ctx, d, meta)
+}
+
+func DeleteExecuteImmediateFrom(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	switch {
+	case d.Get("revert_file_path").(string) != "":
+		if err := executeImmediateFromFile(ctx, client, d, d.Get("commit_hash").(string), d.Get("revert_file_path").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	case d.Get("revert").(string) != "":
+		if _, err := client.ExecUnsafe(ctx, d.Get("revert").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteImmediateFromCommitHashCustomDiff(t *testing.T) {
+	r := ExecuteImmediateFrom()
+
+	t.Run("unknown git repository", func(t *testing.T) {
+		client, _ := sdk.NewSqlPreviewClient()
+
+		diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{
+			// the value used by the plugin SDK for the unknown values in the raw configuration
+			"git_repository": "74D93920-ED26-11E3-AC10-0800200C9A66",
+			"branch":         "main",
+			"file_path":      "deploy/setup.sql",
+		}), &provider.Context{Client: client})
+
+		require.NoError(t, err)
+		require.Contains(t, diff.Attributes, "commit_hash")
+		assert.True(t, diff.Attributes["commit_hash"].NewComputed)
+	})
+
+	t.Run("resolution error", func(t *testing.T) {
+		// The SQL preview client fails all the queries, so the git repository can't be checked.
+		client, _ := sdk.NewSqlPreviewClient()
+
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{
+			"git_repository": `"database"."schema"."repository"`,
+			"branch":         "main",
+			"file_path":      "deploy/setup.sql",
+		}), &provider.Context{Client: client})
+
+		require.ErrorIs(t, err, sdk.ErrSqlPreviewQuery)
+		require.ErrorContains(t, err, `could not check git repository "database"."schema"."repository"`)
+	})
+}
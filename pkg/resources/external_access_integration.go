@@ -0,0 +1,272 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the external access integration; must be unique in your account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"allowed_network_rules": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Required:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_network_rules"),
+		Description:      "Specifies the fully qualified names of the egress [network rules](https://docs.snowflake.com/en/sql-reference/sql/create-network-rule) that represent the external network locations allowed for the integration.",
+	},
+	"allowed_api_authentication_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_api_authentication_integrations"),
+		Description:      "Specifies the names of the security integrations whose OAuth authorization server issued the secret used by the UDF or procedure.",
+	},
+	"allowed_authentication_secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_authentication_secrets"),
+		Description:      "Specifies the fully qualified names of the [secrets](https://docs.snowflake.com/en/sql-reference/sql/create-secret) that UDF or procedure handler code can use when accessing the external network locations.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether this integration is enabled or disabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW EXTERNAL ACCESS INTEGRATIONS` for the given external access integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowExternalAccessIntegrationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE EXTERNAL ACCESS INTEGRATION` for the given external access integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeExternalAccessIntegrationSchema,
+		},
+	},
+}
+
+func ExternalAccessIntegration() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.ExternalAccessIntegrations.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingCreateWrapper(resources.ExternalAccessIntegration, CreateExternalAccessIntegration)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingReadWrapper(resources.ExternalAccessIntegration, ReadExternalAccessIntegration)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingUpdateWrapper(resources.ExternalAccessIntegration, UpdateExternalAccessIntegration)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingDeleteWrapper(resources.ExternalAccessIntegration, deleteFunc)),
+		Description:   "Resource used to manage external access integrations. For more information, check [external access integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ExternalAccessIntegration, customdiff.All(
+			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, DescribeOutputAttributeName, "allowed_network_rules", "allowed_api_authentication_integrations", "allowed_authentication_secrets", "enabled", "comment"),
+		)),
+
+		Schema: externalAccessIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ExternalAccessIntegration, ImportName[sdk.AccountObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allowedNetworkRules, err := parseSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateExternalAccessIntegrationRequest(id, allowedNetworkRules, d.Get("enabled").(bool))
+	if err := stringAttributeCreateBuilder(d, "comment", request.WithComment); err != nil {
+		return diag.FromErr(err)
+	}
+	if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+		allowedApiAuthenticationIntegrations, err := collections.MapErr(expandStringList(v.(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithAllowedApiAuthenticationIntegrations(allowedApiAuthenticationIntegrations)
+	}
+	if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+		allowedAuthenticationSecrets, err := parseSchemaObjectIdentifierSet(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithAllowedAuthenticationSecrets(allowedAuthenticationSecrets)
+	}
+
+	if err := client.ExternalAccessIntegrations.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadExternalAccessIntegration(ctx, d, meta)
+}
+
+func ReadExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	integration, err := client.ExternalAccessIntegrations.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query external access integration. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("External access integration id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	integrationProperties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var allowedNetworkRules, allowedAuthenticationSecrets []sdk.SchemaObjectIdentifier
+	var allowedApiAuthenticationIntegrations []sdk.AccountObjectIdentifier
+	for _, property := range integrationProperties {
+		switch property.Name {
+		case "ALLOWED_NETWORK_RULES":
+			allowedNetworkRules, err = sdk.ParseCommaSeparatedSchemaObjectIdentifierArray(property.Value)
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			allowedApiAuthenticationIntegrations, err = sdk.ParseCommaSeparatedAccountObjectIdentifierArray(property.Value)
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			allowedAuthenticationSecrets, err = sdk.ParseCommaSeparatedSchemaObjectIdentifierArray(property.Value)
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to parse %s property of external access integration %s, err = %w", property.Name, id.FullyQualifiedName(), err))
+		}
+	}
+
+	errs := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("allowed_network_rules", collections.Map(allowedNetworkRules, sdk.SchemaObjectIdentifier.FullyQualifiedName)),
+		d.Set("allowed_api_authentication_integrations", collections.Map(allowedApiAuthenticationIntegrations, sdk.AccountObjectIdentifier.FullyQualifiedName)),
+		d.Set("allowed_authentication_secrets", collections.Map(allowedAuthenticationSecrets, sdk.SchemaObjectIdentifier.FullyQualifiedName)),
+		d.Set("enabled", integration.Enabled),
+		d.Set("comment", integration.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ExternalAccessIntegrationToSchema(integration)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.DescribeExternalAccessIntegrationToSchema(integrationProperties)}),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewExternalAccessIntegrationSetRequest(), sdk.NewExternalAccessIntegrationUnsetRequest()
+	if err := stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("enabled") {
+		set.WithEnabled(d.Get("enabled").(bool))
+	}
+	if d.HasChange("allowed_network_rules") {
+		allowedNetworkRules, err := parseSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		set.WithAllowedNetworkRules(allowedNetworkRules)
+	}
+	if d.HasChange("allowed_api_authentication_integrations") {
+		if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+			allowedApiAuthenticationIntegrations, err := collections.MapErr(expandStringList(v.(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			set.WithAllowedApiAuthenticationIntegrations(allowedApiAuthenticationIntegrations)
+		} else {
+			unset.WithAllowedApiAuthenticationIntegrations(true)
+		}
+	}
+	if d.HasChange("allowed_authentication_secrets") {
+		if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+			allowedAuthenticationSecrets, err := parseSchemaObjectIdentifierSet(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			set.WithAllowedAuthenticationSecrets(allowedAuthenticationSecrets)
+		} else {
+			unset.WithAllowedAuthenticationSecrets(true)
+		}
+	}
+
+	if !reflect.DeepEqual(*set, sdk.ExternalAccessIntegrationSetRequest{}) {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.ExternalAccessIntegrationUnsetRequest{}) {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadExternalAccessIntegration(ctx, d, meta)
+}
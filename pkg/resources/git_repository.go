@@ -65,6 +65,16 @@ var gitRepositorySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the git repository.",
 	},
+	"fetch_on_apply": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "When set to `true`, the git repository is fetched from the remote repository (`ALTER GIT REPOSITORY ... FETCH`) on every `terraform apply`, so every plan contains an update of this resource. Note that the resources reading the git repository during the plan (e.g. `snowflake_execute_immediate_from`) see the fetched changes in the next plan.",
+	},
+	"fetch_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Arbitrary value; every change of it fetches the git repository from the remote repository (`ALTER GIT REPOSITORY ... FETCH`), e.g. the commit hash or the build number of the CI pipeline that pushed the changes.",
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 	ShowOutputAttributeName: {
 		Type:        schema.TypeList,
@@ -99,7 +109,8 @@ func GitRepository() *schema.Resource {
 		Description:   "Resource used to manage git repositories. For more information, check [git repositories documentation](https://docs.snowflake.com/en/sql-reference/sql/create-git-repository).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.GitRepository, customdiff.All(
-			ComputedIfAnyAttributeChanged(gitRepositorySchema, ShowOutputAttributeName, "origin", "api_integration", "git_credentials", "comment"),
+			ComputedIfAnyAttributeChanged(gitRepositorySchema, ShowOutputAttributeName, "origin", "api_integration", "git_credentials", "comment", "fetch_trigger"),
+			gitRepositoryFetchOnApplyCustomDiff,
 		)),
 
 		Schema: gitRepositorySchema,
@@ -111,6 +122,14 @@ func GitRepository() *schema.Resource {
 	}
 }
 
+// gitRepositoryFetchOnApplyCustomDiff plans the fetch on every apply for the existing git repositories with fetch_on_apply set.
+func gitRepositoryFetchOnApplyCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" || !diff.Get("fetch_on_apply").(bool) {
+		return nil
+	}
+	return diff.SetNewComputed(ShowOutputAttributeName)
+}
+
 func CreateGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
@@ -213,5 +232,11 @@ func UpdateGitRepository(ctx context.Context, d *schema.ResourceData, meta any)
 			return diag.FromErr(err)
 		}
 	}
+
+	if d.Get("fetch_on_apply").(bool) || d.HasChange("fetch_trigger") {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithFetch(true)); err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching git repository %s: %w", id.FullyQualifiedName(), err))
+		}
+	}
 	return ReadGitRepository(ctx, d, meta)
 }
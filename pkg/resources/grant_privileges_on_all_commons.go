@@ -0,0 +1,159 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getOnAllGrantData returns the bulk operation data for the grants on all objects (or all schemas) in a container.
+func getOnAllGrantData(data fmt.Stringer) (*BulkOperationGrantData, bool) {
+	switch data := data.(type) {
+	case *OnSchemaGrantData:
+		if data.Kind == OnAllSchemasInDatabaseSchemaGrantKind {
+			return &BulkOperationGrantData{
+				ObjectNamePlural: sdk.PluralObjectTypeSchemas,
+				Kind:             InDatabaseBulkOperationGrantKind,
+				Database:         data.DatabaseName,
+			}, true
+		}
+	case *OnSchemaObjectGrantData:
+		if data.Kind == OnAllSchemaObjectGrantKind {
+			return data.OnAllOrFuture, true
+		}
+	}
+	return nil, false
+}
+
+// readPrivilegesGrantedOnAll sets the privileges field to the privileges that are granted to the role on every object
+// currently existing in the container. The objects created after the grant (that do not have the privileges) are reported
+// as the missing privileges, so that the next apply grants them again. The grants with all_privileges are not read at all
+// (see the Read functions), because the set of privileges covered by ALL PRIVILEGES differs for every object type.
+func readPrivilegesGrantedOnAll(ctx context.Context, d *schema.ResourceData, client *sdk.Client, grantsTo *sdk.ShowGrantsTo, onAll *BulkOperationGrantData, expectedPrivileges []string, withGrantOption bool) diag.Diagnostics {
+	if onAll.ObjectNamePlural.Singular().IsWithArguments() {
+		log.Printf("[INFO] Show with on_all option is skipped for %s. No changes in privileges in Snowflake will be detected.", onAll.ObjectNamePlural)
+		return nil
+	}
+
+	objects, err := showObjectsForOnAllGrant(ctx, client, onAll)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Failed to retrieve %s", onAll.ObjectNamePlural),
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: grantsTo})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	actualPrivileges := privilegesGrantedOnAllObjects(grants, onAll.ObjectNamePlural.Singular(), objects, expectedPrivileges, withGrantOption)
+	if err := d.Set("privileges", actualPrivileges); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error setting privileges",
+				Detail:   fmt.Sprintf("Id: %s\nPrivileges: %v\nError: %s", d.Id(), actualPrivileges, err),
+			},
+		}
+	}
+	return nil
+}
+
+// privilegesGrantedOnAllObjects returns the expected privileges that are granted on every given object. The objects owned
+// by the grantee are treated as having all the privileges.
+func privilegesGrantedOnAllObjects(grants []sdk.Grant, grantedOn sdk.ObjectType, objects []sdk.ObjectIdentifier, expectedPrivileges []string, withGrantOption bool) []string {
+	type grantedPrivilege struct {
+		privilege string
+		object    string
+	}
+	granted := make(map[grantedPrivilege]bool)
+	owned := make(map[string]bool)
+	for _, grant := range grants {
+		if grant.GrantedOn != grantedOn || grant.Name == nil {
+			continue
+		}
+		if grant.Privilege == "OWNERSHIP" {
+			owned[grant.Name.FullyQualifiedName()] = true
+		} else if grant.GrantOption == withGrantOption {
+			granted[grantedPrivilege{privilege: grant.Privilege, object: grant.Name.FullyQualifiedName()}] = true
+		}
+	}
+
+	actualPrivileges := make([]string, 0)
+	for _, privilege := range expectedPrivileges {
+		if !slices.ContainsFunc(objects, func(object sdk.ObjectIdentifier) bool {
+			return !owned[object.FullyQualifiedName()] && !granted[grantedPrivilege{privilege: privilege, object: object.FullyQualifiedName()}]
+		}) {
+			actualPrivileges = append(actualPrivileges, privilege)
+		}
+	}
+	return actualPrivileges
+}
+
+// showObjectsForOnAllGrant lists the objects that are covered by the GRANT ... ON ALL ... IN ... statement.
+func showObjectsForOnAllGrant(ctx context.Context, client *sdk.Client, onAll *BulkOperationGrantData) ([]sdk.ObjectIdentifier, error) {
+	var in string
+	switch onAll.Kind {
+	case InDatabaseBulkOperationGrantKind:
+		in = fmt.Sprintf("DATABASE %s", onAll.Database.FullyQualifiedName())
+	case InSchemaBulkOperationGrantKind:
+		in = fmt.Sprintf("SCHEMA %s", onAll.Schema.FullyQualifiedName())
+	}
+	rows, err := client.QueryUnsafe(ctx, fmt.Sprintf("SHOW %s IN %s", onAll.ObjectNamePlural, in))
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]sdk.ObjectIdentifier, 0, len(rows))
+	for _, row := range rows {
+		if !isObjectCoveredByOnAllGrant(onAll.ObjectNamePlural, row) {
+			continue
+		}
+		if onAll.ObjectNamePlural == sdk.PluralObjectTypeSchemas {
+			objects = append(objects, sdk.NewDatabaseObjectIdentifier(unsafeRowString(row, "database_name"), unsafeRowString(row, "name")))
+		} else {
+			objects = append(objects, sdk.NewSchemaObjectIdentifier(unsafeRowString(row, "database_name"), unsafeRowString(row, "schema_name"), unsafeRowString(row, "name")))
+		}
+	}
+	return objects, nil
+}
+
+// isObjectCoveredByOnAllGrant filters out the objects that are listed by SHOW, but are granted with a different object type
+// (e.g. dynamic tables are listed by SHOW TABLES, but they are granted with ALL DYNAMIC TABLES), and the objects in INFORMATION_SCHEMA.
+func isObjectCoveredByOnAllGrant(pluralObjectType sdk.PluralObjectType, row map[string]*any) bool {
+	if unsafeRowString(row, "schema_name") == "INFORMATION_SCHEMA" {
+		return false
+	}
+	switch pluralObjectType {
+	case sdk.PluralObjectTypeSchemas:
+		return unsafeRowString(row, "name") != "INFORMATION_SCHEMA"
+	case sdk.PluralObjectTypeTables:
+		return unsafeRowString(row, "is_external") != "Y" && unsafeRowString(row, "is_dynamic") != "Y" && unsafeRowString(row, "is_iceberg") != "Y"
+	case sdk.PluralObjectTypeViews:
+		return unsafeRowString(row, "is_materialized") != "true"
+	}
+	return true
+}
+
+func unsafeRowString(row map[string]*any, column string) string {
+	if value, ok := row[column]; ok && value != nil && *value != nil {
+		return fmt.Sprintf("%v", *value)
+	}
+	return ""
+}
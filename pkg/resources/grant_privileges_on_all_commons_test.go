@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getOnAllGrantData(t *testing.T) {
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	schemaId := sdk.NewDatabaseObjectIdentifier("DB", "SCHEMA")
+
+	t.Run("on all schemas in database", func(t *testing.T) {
+		onAll, ok := getOnAllGrantData(&OnSchemaGrantData{Kind: OnAllSchemasInDatabaseSchemaGrantKind, DatabaseName: &databaseId})
+		require.True(t, ok)
+		assert.Equal(t, &BulkOperationGrantData{ObjectNamePlural: sdk.PluralObjectTypeSchemas, Kind: InDatabaseBulkOperationGrantKind, Database: &databaseId}, onAll)
+	})
+
+	t.Run("on all schema objects", func(t *testing.T) {
+		bulkOperationGrantData := &BulkOperationGrantData{ObjectNamePlural: sdk.PluralObjectTypeTables, Kind: InSchemaBulkOperationGrantKind, Schema: &schemaId}
+		onAll, ok := getOnAllGrantData(&OnSchemaObjectGrantData{Kind: OnAllSchemaObjectGrantKind, OnAllOrFuture: bulkOperationGrantData})
+		require.True(t, ok)
+		assert.Equal(t, bulkOperationGrantData, onAll)
+	})
+
+	t.Run("other grants", func(t *testing.T) {
+		_, ok := getOnAllGrantData(&OnSchemaGrantData{Kind: OnSchemaSchemaGrantKind, SchemaName: &schemaId})
+		assert.False(t, ok)
+		_, ok = getOnAllGrantData(&OnSchemaObjectGrantData{Kind: OnFutureSchemaObjectGrantKind, OnAllOrFuture: &BulkOperationGrantData{}})
+		assert.False(t, ok)
+		_, ok = getOnAllGrantData(&OnAccountGrantData{})
+		assert.False(t, ok)
+	})
+}
+
+func Test_privilegesGrantedOnAllObjects(t *testing.T) {
+	table1 := sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "TABLE1")
+	table2 := sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "TABLE2")
+	ownedTable := sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "OWNED")
+	objects := []sdk.ObjectIdentifier{table1, table2, ownedTable}
+
+	grants := []sdk.Grant{
+		{Privilege: "SELECT", GrantedOn: sdk.ObjectTypeTable, Name: table1},
+		{Privilege: "SELECT", GrantedOn: sdk.ObjectTypeTable, Name: table2},
+		{Privilege: "INSERT", GrantedOn: sdk.ObjectTypeTable, Name: table1},
+		{Privilege: "UPDATE", GrantedOn: sdk.ObjectTypeTable, Name: table1, GrantOption: true},
+		{Privilege: "UPDATE", GrantedOn: sdk.ObjectTypeTable, Name: table2, GrantOption: true},
+		{Privilege: "OWNERSHIP", GrantedOn: sdk.ObjectTypeTable, Name: ownedTable},
+		{Privilege: "INSERT", GrantedOn: sdk.ObjectTypeView, Name: table2},
+	}
+
+	t.Run("missing privilege on a new object", func(t *testing.T) {
+		privileges := privilegesGrantedOnAllObjects(grants, sdk.ObjectTypeTable, objects, []string{"SELECT", "INSERT"}, false)
+		assert.Equal(t, []string{"SELECT"}, privileges)
+	})
+
+	t.Run("grant option has to match", func(t *testing.T) {
+		assert.Empty(t, privilegesGrantedOnAllObjects(grants, sdk.ObjectTypeTable, objects, []string{"UPDATE"}, false))
+		assert.Equal(t, []string{"UPDATE"}, privilegesGrantedOnAllObjects(grants, sdk.ObjectTypeTable, objects, []string{"UPDATE"}, true))
+	})
+
+	t.Run("no objects in the container", func(t *testing.T) {
+		privileges := privilegesGrantedOnAllObjects(grants, sdk.ObjectTypeTable, nil, []string{"SELECT", "DELETE"}, false)
+		assert.Equal(t, []string{"SELECT", "DELETE"}, privileges)
+	})
+}
+
+func Test_isObjectCoveredByOnAllGrant(t *testing.T) {
+	row := func(values map[string]any) map[string]*any {
+		result := make(map[string]*any)
+		for k, v := range values {
+			result[k] = &v
+		}
+		return result
+	}
+
+	assert.True(t, isObjectCoveredByOnAllGrant(sdk.PluralObjectTypeSchemas, row(map[string]any{"name": "PUBLIC"})))
+	assert.False(t, isObjectCoveredByOnAllGrant(sdk.PluralObjectTypeSchemas, row(map[string]any{"name": "INFORMATION_SCHEMA"})))
+	assert.True(t, isObjectCoveredByOnAllGrant(sdk.PluralObjectTypeTables, row(map[string]any{"name": "T", "is_external": "N", "is_dynamic": "N"})))
+	assert.False(t, isObjectCoveredByOnAllGrant(sdk.PluralObjectTypeTables, row(map[string]any{"name": "T", "is_dynamic": "Y"})))
+	assert.False(t, isObjectCoveredByOnAllGrant(sdk.PluralObjectTypeTables, row(map[string]any{"name": "T", "is_external": "Y"})))
+	assert.False(t, isObjectCoveredByOnAllGrant(sdk.PluralObjectTypeViews, row(map[string]any{"name": "V", "is_materialized": "true"})))
+	assert.False(t, isObjectCoveredByOnAllGrant(sdk.PluralObjectTypeViews, row(map[string]any{"name": "TABLES", "schema_name": "INFORMATION_SCHEMA"})))
+	assert.True(t, isObjectCoveredByOnAllGrant(sdk.PluralObjectTypeStages, row(map[string]any{"name": "S", "is_materialized": nil})))
+}
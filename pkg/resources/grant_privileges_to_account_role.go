@@ -770,7 +770,8 @@ func ReadGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceDat
 	}
 
 	opts, grantedOn := prepareShowGrantsRequestForAccountRole(id)
-	if opts == nil {
+	onAll, isOnAll := getOnAllGrantData(id.Data)
+	if opts == nil && !isOnAll {
 		return nil
 	}
 
@@ -787,6 +788,10 @@ func ReadGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if isOnAll {
+		return readPrivilegesGrantedOnAll(ctx, d, client, &sdk.ShowGrantsTo{Role: id.RoleName}, onAll, id.Privileges, id.WithGrantOption)
+	}
+
 	grants, err := client.Grants.Show(ctx, opts)
 	if err != nil {
 		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
@@ -904,7 +909,7 @@ func prepareShowGrantsRequestForAccountRole(id GrantPrivilegesToAccountRoleId) (
 				},
 			}
 		case OnAllSchemasInDatabaseSchemaGrantKind:
-			log.Printf("[INFO] Show with on_schema.all_schemas_in_database option is skipped. No changes in privileges in Snowflake will be detected.")
+			// Grants on all schemas are read separately in readPrivilegesGrantedOnAll.
 			return nil, ""
 		case OnFutureSchemasInDatabaseSchemaGrantKind:
 			opts.Future = sdk.Bool(true)
@@ -922,7 +927,7 @@ func prepareShowGrantsRequestForAccountRole(id GrantPrivilegesToAccountRoleId) (
 				Object: data.Object,
 			}
 		case OnAllSchemaObjectGrantKind:
-			log.Printf("[INFO] Show with on_schema_object.on_all option is skipped. No changes in privileges in Snowflake will be detected.")
+			// Grants on all schema objects are read separately in readPrivilegesGrantedOnAll.
 			return nil, ""
 		case OnFutureSchemaObjectGrantKind:
 			grantedOn = data.OnAllOrFuture.ObjectNamePlural.Singular()
@@ -1011,8 +1016,6 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 	case onAccountObjectOk:
 		onAccountObject := onAccountObjectBlock.([]any)[0].(map[string]any)
 
-		grantOnAccountObject := new(sdk.GrantOnAccountObject)
-
 		objectType := onAccountObject["object_type"].(string)
 		objectName := onAccountObject["object_name"].(string)
 		objectIdentifier, err := sdk.ParseAccountObjectIdentifier(objectName)
@@ -1020,28 +1023,7 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 			return nil, err
 		}
 
-		switch sdk.ObjectType(objectType) {
-		case sdk.ObjectTypeDatabase:
-			grantOnAccountObject.Database = &objectIdentifier
-		case sdk.ObjectTypeFailoverGroup:
-			grantOnAccountObject.FailoverGroup = &objectIdentifier
-		case sdk.ObjectTypeIntegration:
-			grantOnAccountObject.Integration = &objectIdentifier
-		case sdk.ObjectTypeReplicationGroup:
-			grantOnAccountObject.ReplicationGroup = &objectIdentifier
-		case sdk.ObjectTypeResourceMonitor:
-			grantOnAccountObject.ResourceMonitor = &objectIdentifier
-		case sdk.ObjectTypeUser:
-			grantOnAccountObject.User = &objectIdentifier
-		case sdk.ObjectTypeWarehouse:
-			grantOnAccountObject.Warehouse = &objectIdentifier
-		case sdk.ObjectTypeComputePool:
-			grantOnAccountObject.ComputePool = &objectIdentifier
-		case sdk.ObjectTypeExternalVolume:
-			grantOnAccountObject.ExternalVolume = &objectIdentifier
-		}
-
-		on.AccountObject = grantOnAccountObject
+		on.AccountObject = getGrantOnAccountObject(sdk.ObjectType(objectType), objectIdentifier)
 	case onSchemaOk:
 		onSchema := onSchemaBlock.([]any)[0].(map[string]any)
 
@@ -1230,3 +1212,29 @@ func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) (id
 
 	return id, nil
 }
+
+// getGrantOnAccountObject returns the account object to grant privileges on for the given object type.
+func getGrantOnAccountObject(objectType sdk.ObjectType, id sdk.AccountObjectIdentifier) *sdk.GrantOnAccountObject {
+	grantOnAccountObject := new(sdk.GrantOnAccountObject)
+	switch objectType {
+	case sdk.ObjectTypeDatabase:
+		grantOnAccountObject.Database = &id
+	case sdk.ObjectTypeFailoverGroup:
+		grantOnAccountObject.FailoverGroup = &id
+	case sdk.ObjectTypeIntegration:
+		grantOnAccountObject.Integration = &id
+	case sdk.ObjectTypeReplicationGroup:
+		grantOnAccountObject.ReplicationGroup = &id
+	case sdk.ObjectTypeResourceMonitor:
+		grantOnAccountObject.ResourceMonitor = &id
+	case sdk.ObjectTypeUser:
+		grantOnAccountObject.User = &id
+	case sdk.ObjectTypeWarehouse:
+		grantOnAccountObject.Warehouse = &id
+	case sdk.ObjectTypeComputePool:
+		grantOnAccountObject.ComputePool = &id
+	case sdk.ObjectTypeExternalVolume:
+		grantOnAccountObject.ExternalVolume = &id
+	}
+	return grantOnAccountObject
+}
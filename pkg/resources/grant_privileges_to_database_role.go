@@ -688,7 +688,8 @@ func ReadGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceDa
 	}
 
 	opts, grantedOn := prepareShowGrantsRequest(id)
-	if opts == nil {
+	onAll, isOnAll := getOnAllGrantData(id.Data)
+	if opts == nil && !isOnAll {
 		return nil
 	}
 
@@ -704,6 +705,10 @@ func ReadGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if isOnAll {
+		return readPrivilegesGrantedOnAll(ctx, d, client, &sdk.ShowGrantsTo{DatabaseRole: id.DatabaseRoleName}, onAll, id.Privileges, id.WithGrantOption)
+	}
+
 	grants, err := client.Grants.Show(ctx, opts)
 	if err != nil {
 		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
@@ -791,7 +796,7 @@ func prepareShowGrantsRequest(id GrantPrivilegesToDatabaseRoleId) (*sdk.ShowGran
 				},
 			}
 		case OnAllSchemasInDatabaseSchemaGrantKind:
-			log.Printf("[INFO] Show with on_schema.all_schemas_in_database option is skipped. No changes in privileges in Snowflake will be detected.")
+			// Grants on all schemas are read separately in readPrivilegesGrantedOnAll.
 			return nil, ""
 		case OnFutureSchemasInDatabaseSchemaGrantKind:
 			opts.Future = sdk.Bool(true)
@@ -809,7 +814,7 @@ func prepareShowGrantsRequest(id GrantPrivilegesToDatabaseRoleId) (*sdk.ShowGran
 				Object: data.Object,
 			}
 		case OnAllSchemaObjectGrantKind:
-			log.Printf("[INFO] Show with on_schema_object.on_all option is skipped. No changes in privileges in Snowflake will be detected.")
+			// Grants on all schema objects are read separately in readPrivilegesGrantedOnAll.
 			return nil, ""
 		case OnFutureSchemaObjectGrantKind:
 			grantedOn = data.OnAllOrFuture.ObjectNamePlural.Singular()
@@ -0,0 +1,487 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// hybridTableSystemIndexPrefix is the prefix of the indexes created by Snowflake to back the primary, unique, and foreign keys.
+const hybridTableSystemIndexPrefix = "SYS_INDEX_"
+
+func hybridTableKeySchema(description string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Name of the %s constraint.", description),
+			},
+			"columns": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: fmt.Sprintf("Columns that form the %s. The column names are case-sensitive.", description),
+			},
+		},
+	}
+}
+
+var hybridTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the hybrid table; must be unique for the schema in which the hybrid table is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the hybrid table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the hybrid table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"column": {
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Column name. The column name is case-sensitive.",
+				},
+				"data_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: IsDataTypeValid,
+					DiffSuppressFunc: DiffSuppressDataTypes,
+					Description:      "Column data type.",
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     true,
+					Description: "Specifies whether the column can contain null values. The columns of the primary key are always non-nullable.",
+				},
+				"default": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Default value expression of the column (e.g. `'unknown'` or `CURRENT_TIMESTAMP()`).",
+				},
+				"collate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Collation specification of the column (e.g. `en-ci`).",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Column comment.",
+				},
+			},
+		},
+		Description: externalChangesNotDetectedFieldDescription("Definitions of the columns of the hybrid table. Changing the columns recreates the table. The current columns are available in the `describe_output` field."),
+	},
+	"primary_key": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem:        hybridTableKeySchema("primary key"),
+		Description: externalChangesNotDetectedFieldDescription("Primary key of the hybrid table. Every hybrid table has to have a primary key. Changing the primary key recreates the table."),
+	},
+	"unique_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        hybridTableKeySchema("unique key"),
+		Description: externalChangesNotDetectedFieldDescription("Unique keys of the hybrid table. Changing the unique keys recreates the table."),
+	},
+	"foreign_key": {
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Name of the foreign key constraint.",
+				},
+				"columns": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Columns that form the foreign key. The column names are case-sensitive.",
+				},
+				"references_table": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      relatedResourceDescription("Fully qualified name of the referenced hybrid table.", resources.HybridTable),
+				},
+				"references_columns": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Columns of the referenced table. If not set, the primary key of the referenced table is used. The column names are case-sensitive.",
+				},
+			},
+		},
+		Description: externalChangesNotDetectedFieldDescription("Foreign keys of the hybrid table. Foreign keys can only reference other hybrid tables. Changing the foreign keys recreates the table."),
+	},
+	"index": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the index. The name is case-sensitive.",
+				},
+				"columns": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Columns that form the index. The column names are case-sensitive.",
+				},
+				"include": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Additional columns stored in the index to avoid lookups in the table. The column names are case-sensitive.",
+				},
+			},
+		},
+		Description: "Secondary indexes of the hybrid table. The indexes defined during the table creation are created together with the table. Indexes added later are built online with `CREATE INDEX`; the table stays available while they are built. A changed index is dropped first and then created again with the new definition. Indexes created by Snowflake for the primary, unique, and foreign keys are not listed here.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the hybrid table.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW HYBRID TABLES` for the given hybrid table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowHybridTableSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE TABLE` for the given hybrid table.",
+		Elem: &schema.Resource{
+			Schema: schemas.HybridTableDescribeSchema,
+		},
+	},
+}
+
+func HybridTable() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.HybridTables.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.HybridTableResource), TrackingCreateWrapper(resources.HybridTable, CreateHybridTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.HybridTableResource), TrackingReadWrapper(resources.HybridTable, ReadHybridTable)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.HybridTableResource), TrackingUpdateWrapper(resources.HybridTable, UpdateHybridTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.HybridTableResource), TrackingDeleteWrapper(resources.HybridTable, deleteFunc)),
+		Description:   "Resource used to manage hybrid tables. For more information, check [hybrid tables documentation](https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.HybridTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(hybridTableSchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(hybridTableSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: hybridTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.HybridTable, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	columns, err := collections.MapErr(d.Get("column").([]any), hybridTableColumnRequestFromConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	constraints := collections.Map(d.Get("primary_key").([]any), func(v any) sdk.HybridTableOutOfLineConstraintRequest {
+		return hybridTableKeyRequestFromConfig(v, sdk.ColumnConstraintTypePrimaryKey)
+	})
+	constraints = append(constraints, collections.Map(d.Get("unique_key").([]any), func(v any) sdk.HybridTableOutOfLineConstraintRequest {
+		return hybridTableKeyRequestFromConfig(v, sdk.ColumnConstraintTypeUnique)
+	})...)
+	foreignKeys, err := collections.MapErr(d.Get("foreign_key").([]any), hybridTableForeignKeyRequestFromConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	constraints = append(constraints, foreignKeys...)
+
+	columnsAndConstraints := sdk.NewHybridTableColumnsConstraintsAndIndexesRequest(columns).
+		WithOutOfLineConstraints(constraints)
+	if indexes := d.Get("index").(*schema.Set).List(); len(indexes) > 0 {
+		columnsAndConstraints.WithOutOfLineIndexes(collections.Map(indexes, hybridTableOutOfLineIndexRequestFromConfig))
+	}
+
+	request := sdk.NewCreateHybridTableRequest(id, *columnsAndConstraints)
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.HybridTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadHybridTable(ctx, d, meta)
+}
+
+func ReadHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hybridTable, err := client.HybridTables.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query hybrid table. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Hybrid table id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	hybridTableDetails, err := client.HybridTables.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	indexes, err := client.HybridTables.ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithIn(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("name", id.Name()),
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("index", hybridTableIndexesToState(indexes)),
+		d.Set("comment", hybridTable.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.HybridTableToSchema(hybridTable)}),
+		d.Set(DescribeOutputAttributeName, schemas.HybridTableDetailsToSchema(hybridTableDetails)),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithRenameTo(newId))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming hybrid table %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("index") {
+		oldIndexes, newIndexes := d.GetChange("index")
+		removed := oldIndexes.(*schema.Set).Difference(newIndexes.(*schema.Set)).List()
+		added := newIndexes.(*schema.Set).Difference(oldIndexes.(*schema.Set)).List()
+
+		// Removed indexes are dropped before the new ones are created, so that an index with a changed definition can be recreated under the same name.
+		for _, index := range removed {
+			indexId := sdk.NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), index.(map[string]any)["name"].(string))
+			if err := client.HybridTables.DropIndex(ctx, sdk.NewDropIndexHybridTableRequest(indexId).WithIfExists(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error dropping index %s of hybrid table %v err = %w", indexId.Name(), d.Id(), err))
+			}
+		}
+		for _, index := range added {
+			indexConfig := index.(map[string]any)
+			request := sdk.NewCreateIndexHybridTableRequest(indexConfig["name"].(string), id, hybridTableColumnsFromConfig(indexConfig["columns"].([]any)))
+			if include := indexConfig["include"].([]any); len(include) > 0 {
+				request.WithInclude(hybridTableColumnsFromConfig(include))
+			}
+			if err := client.HybridTables.CreateIndex(ctx, request); err != nil {
+				return diag.FromErr(fmt.Errorf("error creating index %s of hybrid table %v err = %w", request.IndexName, d.Id(), err))
+			}
+		}
+	}
+
+	set, unset := sdk.NewHybridTableSetRequest(), sdk.NewHybridTableUnsetRequest()
+	if err := stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if (*set != sdk.HybridTableSetRequest{}) {
+		if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.HybridTableUnsetRequest{}) {
+		if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadHybridTable(ctx, d, meta)
+}
+
+func hybridTableColumnRequestFromConfig(v any) (sdk.HybridTableColumnRequest, error) {
+	column := v.(map[string]any)
+	dataType, err := datatypes.ParseDataType(column["data_type"].(string))
+	if err != nil {
+		return sdk.HybridTableColumnRequest{}, err
+	}
+	request := sdk.NewHybridTableColumnRequest(column["name"].(string), dataType)
+	if !column["nullable"].(bool) {
+		request.WithNotNull(true)
+	}
+	if defaultValue := column["default"].(string); defaultValue != "" {
+		request.WithDefault(defaultValue)
+	}
+	if collate := column["collate"].(string); collate != "" {
+		request.WithCollate(collate)
+	}
+	if comment := column["comment"].(string); comment != "" {
+		request.WithComment(comment)
+	}
+	return *request, nil
+}
+
+func hybridTableKeyRequestFromConfig(v any, constraintType sdk.ColumnConstraintType) sdk.HybridTableOutOfLineConstraintRequest {
+	key := v.(map[string]any)
+	request := sdk.NewHybridTableOutOfLineConstraintRequest(constraintType, hybridTableColumnsFromConfig(key["columns"].([]any)))
+	if name := key["name"].(string); name != "" {
+		request.WithConstraint(name)
+	}
+	return *request
+}
+
+func hybridTableForeignKeyRequestFromConfig(v any) (sdk.HybridTableOutOfLineConstraintRequest, error) {
+	foreignKey := v.(map[string]any)
+	referencedTableId, err := sdk.ParseSchemaObjectIdentifier(foreignKey["references_table"].(string))
+	if err != nil {
+		return sdk.HybridTableOutOfLineConstraintRequest{}, err
+	}
+	references := sdk.NewHybridTableForeignKeyRequest(referencedTableId)
+	if referencedColumns := foreignKey["references_columns"].([]any); len(referencedColumns) > 0 {
+		references.WithColumns(hybridTableColumnsFromConfig(referencedColumns))
+	}
+	request := hybridTableKeyRequestFromConfig(v, sdk.ColumnConstraintTypeForeignKey)
+	request.WithForeignKey(*references)
+	return request, nil
+}
+
+func hybridTableOutOfLineIndexRequestFromConfig(v any) sdk.HybridTableOutOfLineIndexRequest {
+	index := v.(map[string]any)
+	request := sdk.NewHybridTableOutOfLineIndexRequest(index["name"].(string), hybridTableColumnsFromConfig(index["columns"].([]any)))
+	if include := index["include"].([]any); len(include) > 0 {
+		request.WithInclude(hybridTableColumnsFromConfig(include))
+	}
+	return *request
+}
+
+func hybridTableColumnsFromConfig(columns []any) []sdk.Column {
+	return collections.Map(columns, func(v any) sdk.Column {
+		return sdk.Column{Value: v.(string)}
+	})
+}
+
+func hybridTableIndexesToState(indexes []sdk.HybridTableIndex) []map[string]any {
+	result := make([]map[string]any, 0, len(indexes))
+	for _, index := range indexes {
+		if strings.HasPrefix(index.Name, hybridTableSystemIndexPrefix) {
+			continue
+		}
+		result = append(result, map[string]any{
+			"name":    index.Name,
+			"columns": index.Columns,
+			"include": index.IncludedColumns,
+		})
+	}
+	return result
+}
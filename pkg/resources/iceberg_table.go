@@ -0,0 +1,503 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// icebergTableSnowflakeCatalog is the name of the built-in catalog used for Snowflake-managed Iceberg tables.
+const icebergTableSnowflakeCatalog = "SNOWFLAKE"
+
+var icebergTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the Iceberg table; must be unique for the schema in which the Iceberg table is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the Iceberg table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the Iceberg table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"external_volume": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: SuppressIfAny(suppressIdentifierQuoting, IgnoreChangeToCurrentSnowflakeValueInShow("external_volume_name")),
+		Description:      relatedResourceDescription("Specifies the identifier for the external volume where the Iceberg table stores its metadata files and data in Parquet format. If not set, the default external volume for the schema, database, or account is used.", resources.ExternalVolume),
+	},
+	"catalog": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: SuppressIfAny(suppressIdentifierQuoting, IgnoreChangeToCurrentSnowflakeValueInShow("catalog_name")),
+		Description:      relatedResourceDescription(fmt.Sprintf("Specifies the catalog for the Iceberg table. Use `%s` for a Snowflake-managed table or the name of a catalog integration for a table that uses an external catalog. If not set, the default catalog for the schema, database, or account is used.", icebergTableSnowflakeCatalog), resources.CatalogIntegration),
+	},
+	"base_location": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShow("base_location"),
+		Description:      "Specifies the path to a directory where Snowflake can write data and metadata files for the table. Applicable only to Snowflake-managed tables.",
+	},
+	"catalog_table_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ConflictsWith:    []string{"metadata_file_path"},
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShow("catalog_table_name"),
+		Description:      "Specifies the table name as recognized by the external catalog (e.g. AWS Glue or Polaris). Applicable only to tables that use a catalog integration.",
+	},
+	"metadata_file_path": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"catalog_table_name"},
+		Description:   externalChangesNotDetectedFieldDescription("Specifies the relative path of the Iceberg metadata file to use for column definitions. Applicable only to tables that use an object storage catalog integration. Changing this value refreshes the table metadata from the given file."),
+	},
+	"catalog_namespace": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShow("catalog_namespace"),
+		Description:      "Specifies the namespace of the table in the external catalog. Overrides the default namespace of the catalog integration.",
+	},
+	"catalog_sync": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      externalChangesNotDetectedFieldDescription("Specifies the name of a catalog integration configured for Polaris Catalog. Snowflake syncs the Snowflake-managed table with that external catalog."),
+	},
+	"storage_serialization_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToStorageSerializationPolicy),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToStorageSerializationPolicy),
+		Description:      externalChangesNotDetectedFieldDescription(fmt.Sprintf("Specifies the storage serialization policy for the Snowflake-managed table. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllStorageSerializationPolicies))),
+	},
+	"replace_invalid_characters": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      externalChangesNotDetectedFieldDescription(booleanStringFieldDescription("Specifies whether to replace invalid UTF-8 characters with the Unicode replacement character (�) in query results.")),
+	},
+	"auto_refresh": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShow("auto_refresh"),
+		Description:      booleanStringFieldDescription("Specifies whether Snowflake should automatically poll the external catalog for metadata updates. Applicable only to tables that use a catalog integration."),
+	},
+	"column": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Column name.",
+				},
+				"data_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: IsDataTypeValid,
+					DiffSuppressFunc: DiffSuppressDataTypes,
+					Description:      "Column data type. Changing the data type of an existing column recreates the table.",
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Specifies whether the column can contain null values. Changing this value for an existing column recreates the table.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Column comment. Changing this value for an existing column recreates the table.",
+				},
+			},
+		},
+		Description: fmt.Sprintf("Definitions of the columns of a Snowflake-managed table (`catalog` set to `%[1]s`). New columns are added and removed columns are dropped in place. Tables using an external catalog derive their columns from the catalog metadata, so the columns are read back only for tables in the `%[1]s` catalog.", icebergTableSnowflakeCatalog),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the Iceberg table.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW ICEBERG TABLES` for the given Iceberg table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowIcebergTableSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE ICEBERG TABLE` for the given Iceberg table.",
+		Elem: &schema.Resource{
+			Schema: schemas.IcebergTableDescribeSchema,
+		},
+	},
+}
+
+func IcebergTable() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.IcebergTables.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.IcebergTableResource), TrackingCreateWrapper(resources.IcebergTable, CreateIcebergTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.IcebergTableResource), TrackingReadWrapper(resources.IcebergTable, ReadIcebergTableFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.IcebergTableResource), TrackingUpdateWrapper(resources.IcebergTable, UpdateIcebergTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.IcebergTableResource), TrackingDeleteWrapper(resources.IcebergTable, deleteFunc)),
+		Description:   "Resource used to manage Iceberg tables. For more information, check [Iceberg tables documentation](https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.IcebergTable, customdiff.All(
+			customdiff.ForceNewIfChange("column", icebergTableExistingColumnChanged),
+			ComputedIfAnyAttributeChanged(icebergTableSchema, ShowOutputAttributeName, "name", "auto_refresh", "comment"),
+			ComputedIfAnyAttributeChanged(icebergTableSchema, DescribeOutputAttributeName, "column"),
+			ComputedIfAnyAttributeChanged(icebergTableSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: icebergTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.IcebergTable, ImportIcebergTable),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("external_volume", icebergTable.ExternalVolumeName),
+		d.Set("catalog", icebergTable.CatalogName),
+		d.Set("base_location", icebergTable.BaseLocation),
+		d.Set("catalog_table_name", icebergTable.CatalogTableName),
+		d.Set("catalog_namespace", icebergTable.CatalogNamespace),
+		d.Set("auto_refresh", booleanStringFromBool(icebergTable.AutoRefresh)),
+	)
+	if errs != nil {
+		return nil, errs
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateIcebergTableRequest(id)
+	errs := errors.Join(
+		accountObjectIdentifierAttributeCreate(d, "external_volume", &request.ExternalVolume),
+		accountObjectIdentifierAttributeCreate(d, "catalog", &request.Catalog),
+		accountObjectIdentifierAttributeCreate(d, "catalog_sync", &request.CatalogSync),
+		stringAttributeCreateBuilder(d, "base_location", request.WithBaseLocation),
+		stringAttributeCreateBuilder(d, "catalog_table_name", request.WithCatalogTableName),
+		stringAttributeCreateBuilder(d, "metadata_file_path", request.WithMetadataFilePath),
+		stringAttributeCreateBuilder(d, "catalog_namespace", request.WithCatalogNamespace),
+		attributeMappedValueCreateBuilder(d, "storage_serialization_policy", request.WithStorageSerializationPolicy, sdk.ToStorageSerializationPolicy),
+		booleanStringAttributeCreateBuilder(d, "replace_invalid_characters", request.WithReplaceInvalidCharacters),
+		booleanStringAttributeCreateBuilder(d, "auto_refresh", request.WithAutoRefresh),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if v, ok := d.GetOk("column"); ok {
+		columns, err := collections.MapErr(v.([]any), icebergTableColumnRequestFromConfig)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithColumns(columns)
+	}
+
+	if err := client.IcebergTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadIcebergTableFunc(false)(ctx, d, meta)
+}
+
+func ReadIcebergTableFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		icebergTable, err := client.IcebergTables.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query Iceberg table. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Iceberg table id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		icebergTableDetails, err := client.IcebergTables.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if withExternalChangesMarking {
+			if err = handleExternalChangesToObjectInShow(d,
+				outputMapping{"external_volume_name", "external_volume", icebergTable.ExternalVolumeName, icebergTable.ExternalVolumeName, nil},
+				outputMapping{"catalog_name", "catalog", icebergTable.CatalogName, icebergTable.CatalogName, nil},
+				outputMapping{"base_location", "base_location", icebergTable.BaseLocation, icebergTable.BaseLocation, nil},
+				outputMapping{"catalog_table_name", "catalog_table_name", icebergTable.CatalogTableName, icebergTable.CatalogTableName, nil},
+				outputMapping{"catalog_namespace", "catalog_namespace", icebergTable.CatalogNamespace, icebergTable.CatalogNamespace, nil},
+				outputMapping{"auto_refresh", "auto_refresh", icebergTable.AutoRefresh, booleanStringFromBool(icebergTable.AutoRefresh), nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err = setStateToValuesFromConfig(d, icebergTableSchema, []string{
+			"external_volume",
+			"catalog",
+			"base_location",
+			"catalog_table_name",
+			"catalog_namespace",
+			"auto_refresh",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if strings.EqualFold(icebergTable.CatalogName, icebergTableSnowflakeCatalog) {
+			if err := d.Set("column", icebergTableColumnsToState(icebergTableDetails)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		errs := errors.Join(
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set("name", id.Name()),
+			d.Set("comment", icebergTable.Comment),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.IcebergTableToSchema(icebergTable)}),
+			d.Set(DescribeOutputAttributeName, schemas.IcebergTableDetailsToSchema(icebergTableDetails)),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+		return nil
+	}
+}
+
+func UpdateIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRenameTo(newId))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming Iceberg table %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("column") {
+		oldColumns, newColumns := d.GetChange("column")
+		removed, added := icebergTableColumnsDifference(oldColumns.([]any), newColumns.([]any))
+		if len(removed) > 0 {
+			droppedColumns := collections.Map(removed, func(column map[string]any) sdk.Column {
+				return sdk.Column{Value: column["name"].(string)}
+			})
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithDropColumn(droppedColumns)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, column := range added {
+			columnRequest, err := icebergTableColumnRequestFromConfig(column)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAddColumn(columnRequest)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("metadata_file_path") {
+		if v, ok := d.GetOk("metadata_file_path"); ok {
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRefresh(*sdk.NewIcebergTableRefreshRequest().WithMetadataFilePath(v.(string)))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	set, unset := sdk.NewIcebergTableSetRequest(), sdk.NewIcebergTableUnsetRequest()
+	errs := errors.Join(
+		booleanStringAttributeUnsetFallbackUpdate(d, "replace_invalid_characters", &set.ReplaceInvalidCharacters, false),
+		booleanStringAttributeUnsetFallbackUpdate(d, "auto_refresh", &set.AutoRefresh, false),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	if d.HasChange("catalog_sync") {
+		if v, ok := d.GetOk("catalog_sync"); ok {
+			catalogSync, err := sdk.ParseAccountObjectIdentifier(v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			set.WithCatalogSync(catalogSync)
+		} else {
+			unset.WithCatalogSync(true)
+		}
+	}
+
+	if (*set != sdk.IcebergTableSetRequest{}) {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.IcebergTableUnsetRequest{}) {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadIcebergTableFunc(false)(ctx, d, meta)
+}
+
+func icebergTableColumnRequestFromConfig(v any) (sdk.IcebergTableColumnRequest, error) {
+	column := v.(map[string]any)
+	dataType, err := datatypes.ParseDataType(column["data_type"].(string))
+	if err != nil {
+		return sdk.IcebergTableColumnRequest{}, err
+	}
+	request := sdk.NewIcebergTableColumnRequest(column["name"].(string), dataType)
+	if !column["nullable"].(bool) {
+		request.WithNotNull(true)
+	}
+	if comment := column["comment"].(string); comment != "" {
+		request.WithComment(comment)
+	}
+	return *request, nil
+}
+
+func icebergTableColumnsToState(details []sdk.IcebergTableDetails) []map[string]any {
+	columns := make([]map[string]any, 0, len(details))
+	for _, detail := range details {
+		if detail.Kind != "COLUMN" {
+			continue
+		}
+		columns = append(columns, map[string]any{
+			"name":      detail.Name,
+			"data_type": detail.Type,
+			"nullable":  detail.IsNullable,
+			"comment":   detail.Comment,
+		})
+	}
+	return columns
+}
+
+// icebergTableColumnsDifference returns the columns that are present only in the old or only in the new configuration (matched by name).
+func icebergTableColumnsDifference(oldColumns []any, newColumns []any) (removed []map[string]any, added []map[string]any) {
+	oldByName, newByName := icebergTableColumnsByName(oldColumns), icebergTableColumnsByName(newColumns)
+	for _, column := range oldColumns {
+		if _, ok := newByName[column.(map[string]any)["name"].(string)]; !ok {
+			removed = append(removed, column.(map[string]any))
+		}
+	}
+	for _, column := range newColumns {
+		if _, ok := oldByName[column.(map[string]any)["name"].(string)]; !ok {
+			added = append(added, column.(map[string]any))
+		}
+	}
+	return removed, added
+}
+
+func icebergTableColumnsByName(columns []any) map[string]map[string]any {
+	result := make(map[string]map[string]any, len(columns))
+	for _, column := range columns {
+		result[column.(map[string]any)["name"].(string)] = column.(map[string]any)
+	}
+	return result
+}
+
+// icebergTableExistingColumnChanged reports whether any column present in both the old and the new configuration changed its definition.
+// Such changes cannot be applied with ALTER ICEBERG TABLE, so the table has to be recreated.
+func icebergTableExistingColumnChanged(_ context.Context, oldValue, newValue, _ any) bool {
+	oldByName := icebergTableColumnsByName(oldValue.([]any))
+	for _, newColumn := range newValue.([]any) {
+		newColumnMap := newColumn.(map[string]any)
+		oldColumnMap, ok := oldByName[newColumnMap["name"].(string)]
+		if !ok {
+			continue
+		}
+		if !DiffSuppressDataTypes("", oldColumnMap["data_type"].(string), newColumnMap["data_type"].(string), nil) ||
+			oldColumnMap["nullable"] != newColumnMap["nullable"] ||
+			oldColumnMap["comment"] != newColumnMap["comment"] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,221 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var joinPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the join policy; must be unique for the schema in which the join policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the join policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the join policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      diffSuppressStatementFieldDescription("Specifies the body of the join policy. It must be a `JOIN_CONSTRAINT` expression, e.g. `JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)`."),
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the join policy.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW JOIN POLICIES` for the given join policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowJoinPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE JOIN POLICY` for the given join policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeJoinPolicySchema,
+		},
+	},
+}
+
+func JoinPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.JoinPolicies.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.JoinPolicyResource), TrackingCreateWrapper(resources.JoinPolicy, CreateJoinPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.JoinPolicyResource), TrackingReadWrapper(resources.JoinPolicy, ReadJoinPolicyFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.JoinPolicyResource), TrackingUpdateWrapper(resources.JoinPolicy, UpdateJoinPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.JoinPolicyResource), TrackingDeleteWrapper(resources.JoinPolicy, deleteFunc)),
+		Description:   "Resource used to manage join policies. For more information, check [join policies documentation](https://docs.snowflake.com/en/sql-reference/sql/create-join-policy).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.JoinPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(joinPolicySchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(joinPolicySchema, DescribeOutputAttributeName, "name", "body"),
+			ComputedIfAnyAttributeChanged(joinPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: joinPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.JoinPolicy, ImportJoinPolicy),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportJoinPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	joinPolicyDescription, err := client.JoinPolicies.Describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("body", joinPolicyDescription.Body),
+	)
+	if errs != nil {
+		return nil, errs
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateJoinPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateJoinPolicyRequest(id, d.Get("body").(string))
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.JoinPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadJoinPolicyFunc(false)(ctx, d, meta)
+}
+
+func ReadJoinPolicyFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		joinPolicy, err := client.JoinPolicies.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query join policy. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Join policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		joinPolicyDescription, err := client.JoinPolicies.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		errs := errors.Join(
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.JoinPolicyToSchema(joinPolicy)}),
+			d.Set(DescribeOutputAttributeName, []map[string]any{schemas.JoinPolicyDescriptionToSchema(*joinPolicyDescription)}),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set("body", joinPolicyDescription.Body),
+			d.Set("comment", joinPolicy.Comment),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+		return nil
+	}
+}
+
+func UpdateJoinPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming join policy %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("body") {
+		if err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadJoinPolicyFunc(false)(ctx, d, meta)
+}
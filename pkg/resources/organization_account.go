@@ -0,0 +1,473 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/util"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var organizationAccountSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Specifies the identifier (i.e. name) for the organization account. It must be unique within an organization, regardless of which Snowflake Region the account is in. Changing the name renames the organization account.",
+	},
+	"save_old_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether the original URL can be used to access the organization account after it is renamed. It is used only when the `name` changes. Changing it to `false` when the old URL is saved drops the old URL (`ALTER ORGANIZATION ACCOUNT ... DROP OLD URL`)."),
+	},
+	"admin_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Login name of the initial administrative user of the organization account. A new user is created in the new account with this name and password and granted the GLOBALORGADMIN role in the account. A login name can be any string consisting of letters, numbers, and underscores. Login names are always case-insensitive."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"admin_password": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Password for the initial administrative user of the organization account. Either admin_password or admin_rsa_public_key has to be specified."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+	},
+	"admin_rsa_public_key": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      externalChangesNotDetectedFieldDescription("Assigns a public key to the initial administrative user of the organization account. Either admin_password or admin_rsa_public_key has to be specified."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+	},
+	"first_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("First name of the initial administrative user of the organization account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"last_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Last name of the initial administrative user of the organization account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"email": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Email address of the initial administrative user of the organization account. This email address is used to send any notifications about the account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"must_change_password": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		Description:      externalChangesNotDetectedFieldDescription("Specifies whether the new user created to administer the organization account is forced to change their password upon first login into the account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		ValidateDiagFunc: validateBooleanString,
+	},
+	"edition": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      fmt.Sprintf("Snowflake Edition of the organization account. See more about Snowflake Editions in the [official documentation](https://docs.snowflake.com/en/user-guide/intro-editions). Valid options are: %s", docs.PossibleValuesListed(sdk.AllOrganizationAccountEditions)),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToOrganizationAccountEdition),
+		ValidateDiagFunc: sdkValidation(sdk.ToOrganizationAccountEdition),
+	},
+	"region_group": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: IgnoreAfterCreation,
+		Description:      externalChangesNotDetectedFieldDescription("ID of the region group where the organization account is created. To retrieve the region group ID for existing accounts in your organization, execute the [SHOW REGIONS](https://docs.snowflake.com/en/sql-reference/sql/show-regions) command."),
+	},
+	"region": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "[Snowflake Region ID](https://docs.snowflake.com/en/user-guide/admin-account-identifier.html#label-snowflake-region-ids) of the region where the organization account is created. If no value is provided, Snowflake creates the account in the same Snowflake Region as the current account (i.e. the account in which the CREATE ORGANIZATION ACCOUNT statement is executed.)",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the organization account. Snowflake changes the comment only in the organization account itself, so after creation the comment can be changed only when the resource uses a connection to the organization account.",
+	},
+	"password_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      relatedResourceDescription("Specifies [password policy](https://docs.snowflake.com/en/user-guide/password-authentication#label-using-password-policies) for the organization account. The policy has to exist in the organization account, and it can be set only when the resource uses a connection to the organization account.", resources.PasswordPolicy),
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"session_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      relatedResourceDescription("Specifies [session policy](https://docs.snowflake.com/en/user-guide/session-policies-using) for the organization account. The policy has to exist in the organization account, and it can be set only when the resource uses a connection to the organization account.", resources.SessionPolicy),
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW ORGANIZATION ACCOUNTS` for the given organization account.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOrganizationAccountSchema,
+		},
+	},
+}
+
+func OrganizationAccount() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Resource used to create and manage an organization account. See [CREATE ORGANIZATION ACCOUNT](https://docs.snowflake.com/en/sql-reference/sql/create-organization-account) documentation for more information on resource capabilities. Snowflake does not support dropping organization accounts, so destroying the resource only removes it from the state.",
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingCreateWrapper(resources.OrganizationAccount, CreateOrganizationAccount)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingReadWrapper(resources.OrganizationAccount, ReadOrganizationAccount(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingUpdateWrapper(resources.OrganizationAccount, UpdateOrganizationAccount)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingDeleteWrapper(resources.OrganizationAccount, DeleteOrganizationAccount)),
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.OrganizationAccount, customdiff.All(
+			ComputedIfAnyAttributeChanged(organizationAccountSchema, FullyQualifiedNameAttributeName, "name"),
+			ComputedIfAnyAttributeChanged(organizationAccountSchema, ShowOutputAttributeName, "name", "save_old_url", "comment"),
+			organizationAccountPoliciesOnCreateCustomDiff,
+		)),
+
+		Schema: organizationAccountSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.OrganizationAccount, ImportOrganizationAccount),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// organizationAccountPoliciesOnCreateCustomDiff fails the plan when the policies are set for a new organization account.
+// The policies are set with ALTER ORGANIZATION ACCOUNT, which can be run only in the organization account itself.
+func organizationAccountPoliciesOnCreateCustomDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() != "" {
+		return nil
+	}
+	var setPolicies []string
+	for _, field := range []string{"password_policy", "session_policy"} {
+		if v, ok := d.GetOk(field); ok && v.(string) != "" {
+			setPolicies = append(setPolicies, field)
+		}
+	}
+	if len(setPolicies) > 0 {
+		return fmt.Errorf("%s cannot be set when the organization account is created, because the policies can be set only in the organization account itself; set them after the account is created, using a connection to the organization account (see `connection_name`)", strings.Join(setPolicies, " and "))
+	}
+	return nil
+}
+
+func ImportOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	organizationAccount, err := client.OrganizationAccounts.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment string
+	if organizationAccount.Comment != nil {
+		comment = *organizationAccount.Comment
+	}
+
+	if err := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("save_old_url", BooleanDefault),
+		d.Set("edition", string(organizationAccount.Edition)),
+		d.Set("region", organizationAccount.SnowflakeRegion),
+		d.Set("comment", comment),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	edition, err := sdk.ToOrganizationAccountEdition(d.Get("edition").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateOrganizationAccountRequest(id, d.Get("admin_name").(string), d.Get("email").(string), edition)
+
+	if v, ok := d.GetOk("admin_password"); ok {
+		request.WithAdminPassword(v.(string))
+	}
+	if v, ok := d.GetOk("admin_rsa_public_key"); ok {
+		request.WithAdminRsaPublicKey(v.(string))
+	}
+	if v, ok := d.GetOk("first_name"); ok {
+		request.WithFirstName(v.(string))
+	}
+	if v, ok := d.GetOk("last_name"); ok {
+		request.WithLastName(v.(string))
+	}
+	if v := d.Get("must_change_password"); v != BooleanDefault {
+		parsedBool, err := booleanStringToBool(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithMustChangePassword(parsedBool)
+	}
+	if v, ok := d.GetOk("region_group"); ok {
+		request.WithRegionGroup(v.(string))
+	}
+	if v, ok := d.GetOk("region"); ok {
+		request.WithRegion(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.OrganizationAccounts.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := util.Retry(5, 3*time.Second, func() (error, bool) {
+		_, err = client.OrganizationAccounts.ShowByID(ctx, id)
+		if err != nil {
+			log.Printf("[DEBUG] retryable operation resulted in error: %v", err)
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				return nil, false
+			} else {
+				return err, true
+			}
+		}
+		return nil, true
+	}); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to query organization account (%s) after creation, err: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(id.Name())
+
+	return ReadOrganizationAccount(false)(ctx, d, meta)
+}
+
+func ReadOrganizationAccount(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		organizationAccount, err := client.OrganizationAccounts.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query organization account. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Organization Account: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		if withExternalChangesMarking {
+			if err = handleExternalChangesToObjectInShow(d,
+				outputMapping{"edition", "edition", string(organizationAccount.Edition), string(organizationAccount.Edition), nil},
+				outputMapping{"snowflake_region", "region", organizationAccount.SnowflakeRegion, organizationAccount.SnowflakeRegion, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err = setStateToValuesFromConfig(d, organizationAccountSchema, []string{
+				"edition",
+				"region",
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		var comment string
+		if organizationAccount.Comment != nil {
+			comment = *organizationAccount.Comment
+		}
+
+		if errs := errors.Join(
+			d.Set("comment", comment),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.OrganizationAccountToSchema(organizationAccount)}),
+		); errs != nil {
+			return diag.FromErr(errs)
+		}
+
+		connectedToOrganizationAccount, err := isConnectedToOrganizationAccount(ctx, client, organizationAccount)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// The policies attached to the organization account can be read only in the organization account itself.
+		if connectedToOrganizationAccount {
+			if err := readOrganizationAccountPolicies(ctx, client, d, organizationAccount); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func readOrganizationAccountPolicies(ctx context.Context, client *sdk.Client, d *schema.ResourceData, organizationAccount *sdk.OrganizationAccount) error {
+	attachedPolicies, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(sdk.NewAccountObjectIdentifier(organizationAccount.AccountLocator), sdk.PolicyEntityDomainAccount))
+	if err != nil {
+		return err
+	}
+
+	for _, policyKind := range []sdk.PolicyKind{sdk.PolicyKindPasswordPolicy, sdk.PolicyKindSessionPolicy} {
+		if policy, err := collections.FindFirst(attachedPolicies, func(p sdk.PolicyReference) bool { return p.PolicyKind == policyKind }); err == nil {
+			if err := d.Set(strings.ToLower(string(policyKind)), sdk.NewSchemaObjectIdentifier(*policy.PolicyDb, *policy.PolicySchema, policy.PolicyName).FullyQualifiedName()); err != nil {
+				return err
+			}
+		} else {
+			if err := d.Set(strings.ToLower(string(policyKind)), nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func UpdateOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		rename := sdk.NewOrganizationAccountRenameRequest(&newId)
+		if v := d.Get("save_old_url").(string); v != BooleanDefault {
+			parsed, err := booleanStringToBool(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			rename.WithSaveOldUrl(parsed)
+		}
+
+		if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().WithName(id).WithRenameTo(*rename)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(newId.Name())
+		id = newId
+	} else if d.HasChange("save_old_url") && d.Get("save_old_url").(string) == BooleanFalse {
+		organizationAccount, err := client.OrganizationAccounts.ShowByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if organizationAccount.OldAccountUrl != nil && *organizationAccount.OldAccountUrl != "" {
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().WithName(id).WithDropOldUrl(true)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChanges("comment", "password_policy", "session_policy") {
+		organizationAccount, err := client.OrganizationAccounts.ShowByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		connectedToOrganizationAccount, err := isConnectedToOrganizationAccount(ctx, client, organizationAccount)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !connectedToOrganizationAccount {
+			return diag.FromErr(fmt.Errorf("comment, password_policy, and session_policy of the organization account %s can be changed only in the organization account itself; use a connection to the organization account (see `connection_name`)", id.FullyQualifiedName()))
+		}
+	}
+
+	if d.HasChange("comment") {
+		if newComment := d.Get("comment").(string); newComment != "" {
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().WithSet(*sdk.NewOrganizationAccountSetRequest().WithComment(newComment))); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().WithUnset(*sdk.NewOrganizationAccountUnsetRequest().WithComment(true))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	for _, policyKind := range []sdk.PolicyKind{sdk.PolicyKindPasswordPolicy, sdk.PolicyKindSessionPolicy} {
+		field := strings.ToLower(string(policyKind))
+		if !d.HasChange(field) {
+			continue
+		}
+		if v := d.Get(field).(string); v != "" {
+			policyId, err := sdk.ParseSchemaObjectIdentifier(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := client.OrganizationAccounts.SetPolicySafely(ctx, policyKind, policyId); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.OrganizationAccounts.UnsetPolicySafely(ctx, policyKind); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadOrganizationAccount(false)(ctx, d, meta)
+}
+
+func DeleteOrganizationAccount(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	// Snowflake does not support dropping organization accounts, so the resource is only removed from the state.
+	d.SetId("")
+	return nil
+}
+
+// isConnectedToOrganizationAccount checks whether the current connection is made to the given organization account.
+func isConnectedToOrganizationAccount(ctx context.Context, client *sdk.Client, organizationAccount *sdk.OrganizationAccount) (bool, error) {
+	currentAccountName, err := client.ContextFunctions.CurrentAccountName(ctx)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(currentAccountName, organizationAccount.AccountName), nil
+}
@@ -0,0 +1,480 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var replicationGroupSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the replication group. The identifier must be unique in the account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"object_types": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Type(s) of objects for which you are enabling replication from the source account to the target account. The following object types are supported: \"ACCOUNT PARAMETERS\", \"DATABASES\", \"INTEGRATIONS\", \"NETWORK POLICIES\", \"RESOURCE MONITORS\", \"ROLES\", \"SHARES\", \"USERS\", \"WAREHOUSES\". Required when `from_replica` is not set.",
+	},
+	"allowed_databases": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the database or list of databases for which you are enabling replication from the source account to the target account. The `object_types` list must include DATABASES to set this parameter.",
+	},
+	"allowed_shares": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the share or list of shares for which you are enabling replication from the source account to the target account. The `object_types` list must include SHARES to set this parameter.",
+	},
+	"allowed_integration_types": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Type(s) of integrations for which you are enabling replication from the source account to the target account. The `object_types` list must include INTEGRATIONS to set this parameter. The following integration types are supported: \"SECURITY INTEGRATIONS\", \"API INTEGRATIONS\", \"STORAGE INTEGRATIONS\", \"EXTERNAL ACCESS INTEGRATIONS\", \"NOTIFICATION INTEGRATIONS\".",
+	},
+	"allowed_accounts": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the target account or list of target accounts to which replication of specified objects from the source account is enabled. Expected in the form <org_name>.<target_account_name>. The account in which the group is created is always allowed and should not be listed. Required when `from_replica` is not set.",
+	},
+	"ignore_edition_check": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Allows replicating objects to accounts on lower editions.",
+	},
+	"from_replica": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"object_types", "allowed_accounts", "allowed_databases", "allowed_shares", "allowed_integration_types", "ignore_edition_check", "replication_schedule"},
+		Description:   "Specifies the primary replication group from which the secondary replication group is created.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"organization_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of your Snowflake organization.",
+				},
+				"source_account_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Source account from which you are enabling replication of the specified objects.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Identifier for the primary replication group in the source account.",
+				},
+			},
+		},
+	},
+	"replication_schedule": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the schedule for refreshing secondary replication groups.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cron": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"replication_schedule.0.cron", "replication_schedule.0.interval"},
+					Description:  "Specifies the cron expression for the replication schedule.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"expression": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the cron expression for the replication schedule. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\".",
+							},
+							"time_zone": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the time zone for secondary group refresh.",
+							},
+						},
+					},
+				},
+				"interval": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ExactlyOneOf: []string{"replication_schedule.0.cron", "replication_schedule.0.interval"},
+					ValidateFunc: validation.IntBetween(1, 11520),
+					Description:  "Specifies the interval in minutes for the replication schedule.",
+				},
+			},
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW REPLICATION GROUPS` for the given replication group.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowReplicationGroupSchema,
+		},
+	},
+}
+
+func ReplicationGroup() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.ReplicationGroups.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ReplicationGroupResource), TrackingCreateWrapper(resources.ReplicationGroup, CreateReplicationGroup)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ReplicationGroupResource), TrackingReadWrapper(resources.ReplicationGroup, ReadReplicationGroup)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ReplicationGroupResource), TrackingUpdateWrapper(resources.ReplicationGroup, UpdateReplicationGroup)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ReplicationGroupResource), TrackingDeleteWrapper(resources.ReplicationGroup, deleteFunc)),
+		Description:   "Resource used to manage replication groups. Replication groups replicate the specified objects to other accounts without the failover capability, which makes them available on all editions supporting replication. For more information, check [replication groups documentation](https://docs.snowflake.com/en/sql-reference/sql/create-replication-group).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ReplicationGroup, customdiff.All(
+			ComputedIfAnyAttributeChanged(replicationGroupSchema, ShowOutputAttributeName, "object_types", "allowed_integration_types", "allowed_accounts", "replication_schedule"),
+		)),
+
+		Schema: replicationGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ReplicationGroup, ImportName[sdk.AccountObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateReplicationGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// if from_replica is set, then we are creating a secondary replication group from the existing primary one
+	if v, ok := d.GetOk("from_replica"); ok {
+		fromReplica := v.([]any)[0].(map[string]any)
+		primaryReplicationGroupId := sdk.NewExternalObjectIdentifier(
+			sdk.NewAccountIdentifier(fromReplica["organization_name"].(string), fromReplica["source_account_name"].(string)),
+			sdk.NewAccountObjectIdentifier(fromReplica["name"].(string)),
+		)
+		if err := client.ReplicationGroups.CreateReplica(ctx, id, primaryReplicationGroupId, nil); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(helpers.EncodeResourceIdentifier(id))
+		return ReadReplicationGroup(ctx, d, meta)
+	}
+
+	// these two are required attributes if from_replica is not set
+	if _, ok := d.GetOk("object_types"); !ok {
+		return diag.FromErr(errors.New("object_types field is required when from_replica is not set"))
+	}
+	if _, ok := d.GetOk("allowed_accounts"); !ok {
+		return diag.FromErr(errors.New("allowed_accounts field is required when from_replica is not set"))
+	}
+	allowedAccounts, err := replicationGroupAccountIdentifiers(d.Get("allowed_accounts").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := &sdk.CreateReplicationGroupOptions{
+		AllowedDatabases:        replicationGroupAccountObjectIdentifiers(d.Get("allowed_databases").(*schema.Set).List()),
+		AllowedShares:           replicationGroupAccountObjectIdentifiers(d.Get("allowed_shares").(*schema.Set).List()),
+		AllowedIntegrationTypes: replicationGroupIntegrationTypes(d.Get("allowed_integration_types").(*schema.Set).List()),
+	}
+	if d.Get("ignore_edition_check").(bool) {
+		opts.IgnoreEditionCheck = sdk.Bool(true)
+	}
+	if replicationSchedule := replicationGroupScheduleFromConfig(d.Get("replication_schedule").([]any)); replicationSchedule != "" {
+		opts.ReplicationSchedule = sdk.String(replicationSchedule)
+	}
+
+	objectTypes := replicationGroupObjectTypes(d.Get("object_types").(*schema.Set).List())
+	if err := client.ReplicationGroups.Create(ctx, id, objectTypes, allowedAccounts, opts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadReplicationGroup(ctx, d, meta)
+}
+
+func ReadReplicationGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	replicationGroup, err := client.ReplicationGroups.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query replication group. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Replication group id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := errors.Join(
+		d.Set("name", replicationGroup.Name),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ReplicationGroupToSchema(replicationGroup)}),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the remaining attributes are managed in the primary replication group
+	if !replicationGroup.IsPrimary {
+		return nil
+	}
+
+	objectTypes := make([]any, len(replicationGroup.ObjectTypes))
+	for i, objectType := range replicationGroup.ObjectTypes {
+		objectTypes[i] = string(objectType)
+	}
+	allowedIntegrationTypes := make([]any, len(replicationGroup.AllowedIntegrationTypes))
+	for i, integrationType := range replicationGroup.AllowedIntegrationTypes {
+		allowedIntegrationTypes[i] = string(integrationType)
+	}
+	// the current account is always added to the allowed accounts, so it's skipped to not produce permanent drift
+	currentAccountId := sdk.NewAccountIdentifier(replicationGroup.OrganizationName, replicationGroup.AccountName)
+	allowedAccounts := make([]any, 0, len(replicationGroup.AllowedAccounts))
+	for _, accountId := range replicationGroup.AllowedAccounts {
+		if accountId.Name() != currentAccountId.Name() {
+			allowedAccounts = append(allowedAccounts, accountId.Name())
+		}
+	}
+
+	databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allowedDatabases := make([]any, len(databases))
+	for i, database := range databases {
+		allowedDatabases[i] = database.Name()
+	}
+
+	shares, err := client.ReplicationGroups.ShowShares(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allowedShares := make([]any, len(shares))
+	for i, share := range shares {
+		allowedShares[i] = share.Name()
+	}
+
+	replicationSchedule, err := replicationGroupScheduleToState(replicationGroup.ReplicationSchedule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := errors.Join(
+		d.Set("object_types", schema.NewSet(schema.HashString, objectTypes)),
+		d.Set("allowed_integration_types", schema.NewSet(schema.HashString, allowedIntegrationTypes)),
+		d.Set("allowed_accounts", schema.NewSet(schema.HashString, allowedAccounts)),
+		d.Set("allowed_databases", schema.NewSet(schema.HashString, allowedDatabases)),
+		d.Set("allowed_shares", schema.NewSet(schema.HashString, allowedShares)),
+		d.Set("replication_schedule", replicationSchedule),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateReplicationGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	o, n := d.GetChange("allowed_databases")
+	addedDatabases, removedDatabases := ListDiff(
+		replicationGroupAccountObjectIdentifiers(o.(*schema.Set).List()),
+		replicationGroupAccountObjectIdentifiers(n.(*schema.Set).List()),
+	)
+	o, n = d.GetChange("allowed_shares")
+	addedShares, removedShares := ListDiff(
+		replicationGroupAccountObjectIdentifiers(o.(*schema.Set).List()),
+		replicationGroupAccountObjectIdentifiers(n.(*schema.Set).List()),
+	)
+	o, n = d.GetChange("allowed_accounts")
+	oldAllowedAccounts, err := replicationGroupAccountIdentifiers(o.(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newAllowedAccounts, err := replicationGroupAccountIdentifiers(n.(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	addedAccounts, removedAccounts := ListDiff(oldAllowedAccounts, newAllowedAccounts)
+
+	// objects are removed before the object types are changed, because removing an object type makes its objects unmanageable
+	removals := []*sdk.ReplicationGroupRemove{
+		{AllowedDatabases: removedDatabases},
+		{AllowedShares: removedShares},
+		{AllowedAccounts: removedAccounts},
+	}
+	for _, remove := range removals {
+		if !anyReplicationGroupObjectsSet(remove.AllowedDatabases, remove.AllowedShares, remove.AllowedAccounts) {
+			continue
+		}
+		if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Remove: remove}); err != nil {
+			return diag.FromErr(fmt.Errorf("error removing objects from replication group %s, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	// allowed integration types have to be set together with object types
+	if d.HasChanges("object_types", "allowed_integration_types") {
+		err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{
+			Set: &sdk.ReplicationGroupSet{
+				ObjectTypes:             replicationGroupObjectTypes(d.Get("object_types").(*schema.Set).List()),
+				AllowedIntegrationTypes: replicationGroupIntegrationTypes(d.Get("allowed_integration_types").(*schema.Set).List()),
+			},
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	additions := []*sdk.ReplicationGroupAdd{
+		{AllowedDatabases: addedDatabases},
+		{AllowedShares: addedShares},
+		{AllowedAccounts: addedAccounts},
+	}
+	for _, add := range additions {
+		if !anyReplicationGroupObjectsSet(add.AllowedDatabases, add.AllowedShares, add.AllowedAccounts) {
+			continue
+		}
+		if len(add.AllowedAccounts) > 0 && d.Get("ignore_edition_check").(bool) {
+			add.IgnoreEditionCheck = sdk.Bool(true)
+		}
+		if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Add: add}); err != nil {
+			return diag.FromErr(fmt.Errorf("error adding objects to replication group %s, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if d.HasChange("replication_schedule") {
+		opts := &sdk.AlterSourceReplicationGroupOptions{}
+		if replicationSchedule := replicationGroupScheduleFromConfig(d.Get("replication_schedule").([]any)); replicationSchedule != "" {
+			opts.Set = &sdk.ReplicationGroupSet{ReplicationSchedule: sdk.String(replicationSchedule)}
+		} else {
+			opts.Unset = &sdk.ReplicationGroupUnset{ReplicationSchedule: sdk.Bool(true)}
+		}
+		if err := client.ReplicationGroups.AlterSource(ctx, id, opts); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadReplicationGroup(ctx, d, meta)
+}
+
+func anyReplicationGroupObjectsSet(databases []sdk.AccountObjectIdentifier, shares []sdk.AccountObjectIdentifier, accounts []sdk.AccountIdentifier) bool {
+	return len(databases) > 0 || len(shares) > 0 || len(accounts) > 0
+}
+
+func replicationGroupObjectTypes(raw []any) []sdk.PluralObjectType {
+	objectTypes := make([]sdk.PluralObjectType, 0, len(raw))
+	for _, v := range expandStringList(raw) {
+		objectTypes = append(objectTypes, sdk.PluralObjectType(v))
+	}
+	return objectTypes
+}
+
+func replicationGroupIntegrationTypes(raw []any) []sdk.IntegrationType {
+	integrationTypes := make([]sdk.IntegrationType, 0, len(raw))
+	for _, v := range expandStringList(raw) {
+		integrationTypes = append(integrationTypes, sdk.IntegrationType(v))
+	}
+	return integrationTypes
+}
+
+func replicationGroupAccountObjectIdentifiers(raw []any) []sdk.AccountObjectIdentifier {
+	ids := make([]sdk.AccountObjectIdentifier, 0, len(raw))
+	for _, v := range expandStringList(raw) {
+		ids = append(ids, sdk.NewAccountObjectIdentifier(v))
+	}
+	return ids
+}
+
+func replicationGroupAccountIdentifiers(raw []any) ([]sdk.AccountIdentifier, error) {
+	ids := make([]sdk.AccountIdentifier, 0, len(raw))
+	for _, v := range expandStringList(raw) {
+		parts := strings.Split(v, ".")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("allowed account %s cannot be an account locator and must be of the format <org_name>.<target_account_name>", v)
+		}
+		ids = append(ids, sdk.NewAccountIdentifier(parts[0], parts[1]))
+	}
+	return ids, nil
+}
+
+func replicationGroupScheduleFromConfig(raw []any) string {
+	if len(raw) == 0 || raw[0] == nil {
+		return ""
+	}
+	replicationSchedule := raw[0].(map[string]any)
+	if crons := replicationSchedule["cron"].([]any); len(crons) > 0 {
+		cron := crons[0].(map[string]any)
+		return fmt.Sprintf("USING CRON %s %s", cron["expression"].(string), cron["time_zone"].(string))
+	}
+	return fmt.Sprintf("%d MINUTE", replicationSchedule["interval"].(int))
+}
+
+func replicationGroupScheduleToState(replicationSchedule string) ([]any, error) {
+	switch {
+	case replicationSchedule == "":
+		return nil, nil
+	case strings.HasSuffix(replicationSchedule, " MINUTE"):
+		interval, err := strconv.Atoi(strings.TrimSuffix(replicationSchedule, " MINUTE"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid replication schedule %s, err = %w", replicationSchedule, err)
+		}
+		return []any{map[string]any{"interval": interval}}, nil
+	default:
+		parts := strings.Split(replicationSchedule, " ")
+		timeZone := parts[len(parts)-1]
+		expression := strings.TrimSuffix(strings.TrimPrefix(replicationSchedule, "USING CRON "), " "+timeZone)
+		return []any{map[string]any{
+			"cron": []any{map[string]any{
+				"expression": expression,
+				"time_zone":  timeZone,
+			}},
+		}}, nil
+	}
+}
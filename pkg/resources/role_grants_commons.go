@@ -0,0 +1,329 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// roleGrantsGrantSchema returns the schema of the grant block of the authoritative role grants resources.
+func roleGrantsGrantSchema(validObjectTypes []string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"privileges": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: isNotOwnershipGrant(),
+				},
+				Description: "The privileges granted on the object. This field is case-sensitive; use only upper-case privileges.",
+			},
+			"object_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: StringInSlice(validObjectTypes, true),
+				Description:      fmt.Sprintf("The type of the object on which the privileges are granted. Valid values are: %s.", strings.Join(validObjectTypes, " | ")),
+			},
+			"object_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The fully qualified name of the object on which the privileges are granted. Required for all object types except `ACCOUNT`. For functions and procedures, the name has to contain the argument types (e.g. `\"db\".\"schema\".\"function\"(NUMBER, VARCHAR)`).",
+			},
+			"with_grant_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies whether the grantee can grant the privileges to other roles.",
+			},
+		},
+	}
+}
+
+var roleGrantsExcludeSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"object_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Excludes the grants on the objects of the given type (e.g. `DATABASE`).",
+		},
+		"object_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Excludes the grants on the object with the given fully qualified name. Requires `object_type` to be set.",
+		},
+		"in_database": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			Description:      "Excludes the grants on the given database and on all the schemas and schema objects in it (e.g. `SNOWFLAKE`).",
+		},
+	},
+}
+
+const roleGrantsExcludeDescription = "Grants that are not managed by this resource. They are neither read nor revoked. A grant is excluded when it matches all the fields set in at least one of the blocks."
+
+// roleGrant represents privileges granted to a role on a single object with the same grant option.
+type roleGrant struct {
+	ObjectType sdk.ObjectType
+	// ObjectName is the name as provided in the configuration; ObjectId is its parsed value (nil for the account).
+	ObjectName      string
+	ObjectId        sdk.ObjectIdentifier
+	WithGrantOption bool
+	Privileges      []string
+}
+
+func (g roleGrant) key() string {
+	var name string
+	if g.ObjectId != nil {
+		name = g.ObjectId.FullyQualifiedName()
+	}
+	return fmt.Sprintf("%s|%s|%t", g.ObjectType, name, g.WithGrantOption)
+}
+
+func (g roleGrant) toMap() map[string]any {
+	return map[string]any{
+		"privileges":        collections.Map(g.Privileges, func(privilege string) any { return privilege }),
+		"object_type":       g.ObjectType.String(),
+		"object_name":       g.ObjectName,
+		"with_grant_option": g.WithGrantOption,
+	}
+}
+
+func roleGrantFromConfig(v any) (roleGrant, error) {
+	grantConfig := v.(map[string]any)
+	grant := roleGrant{
+		ObjectType:      sdk.ObjectType(strings.ToUpper(grantConfig["object_type"].(string))),
+		ObjectName:      grantConfig["object_name"].(string),
+		WithGrantOption: grantConfig["with_grant_option"].(bool),
+		Privileges:      expandStringList(grantConfig["privileges"].(*schema.Set).List()),
+	}
+	switch {
+	case grant.ObjectType == sdk.ObjectTypeAccount && grant.ObjectName != "":
+		return roleGrant{}, errors.New("object_name cannot be set for grants on ACCOUNT")
+	case grant.ObjectType == sdk.ObjectTypeAccount:
+		return grant, nil
+	case grant.ObjectName == "":
+		return roleGrant{}, fmt.Errorf("object_name has to be set for grants on %s", grant.ObjectType)
+	}
+	id, err := GetOnObjectIdentifier(grant.ObjectType, grant.ObjectName)
+	if err != nil {
+		return roleGrant{}, err
+	}
+	grant.ObjectId = id
+	return grant, nil
+}
+
+// roleGrantsFromConfig parses the grant blocks and verifies that every object is declared at most once for a given grant option.
+func roleGrantsFromConfig(grantsConfig []any, exclusions []roleGrantsExclusion) ([]roleGrant, error) {
+	grants, err := collections.MapErr(grantsConfig, roleGrantFromConfig)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool)
+	for _, grant := range grants {
+		if keys[grant.key()] {
+			return nil, fmt.Errorf("privileges on %s %s with grant option set to %t are declared more than once; merge them into a single grant block", grant.ObjectType, grant.ObjectName, grant.WithGrantOption)
+		}
+		keys[grant.key()] = true
+		if slices.ContainsFunc(exclusions, func(exclusion roleGrantsExclusion) bool { return exclusion.matches(grant.ObjectType, grant.ObjectId) }) {
+			return nil, fmt.Errorf("privileges on %s %s are declared, but the object is excluded", grant.ObjectType, grant.ObjectName)
+		}
+	}
+	return grants, nil
+}
+
+type roleGrantsExclusion struct {
+	ObjectType *sdk.ObjectType
+	ObjectId   sdk.ObjectIdentifier
+	InDatabase *sdk.AccountObjectIdentifier
+}
+
+func roleGrantsExclusionFromConfig(v any) (roleGrantsExclusion, error) {
+	exclusionConfig, ok := v.(map[string]any)
+	if !ok {
+		return roleGrantsExclusion{}, errors.New("at least one of object_type, object_name, or in_database has to be set in the exclude block")
+	}
+	var exclusion roleGrantsExclusion
+	if objectType := exclusionConfig["object_type"].(string); objectType != "" {
+		exclusion.ObjectType = sdk.Pointer(sdk.ObjectType(strings.ToUpper(objectType)))
+	}
+	if objectName := exclusionConfig["object_name"].(string); objectName != "" {
+		if exclusion.ObjectType == nil {
+			return roleGrantsExclusion{}, errors.New("object_type has to be set when object_name is set in the exclude block")
+		}
+		id, err := GetOnObjectIdentifier(*exclusion.ObjectType, objectName)
+		if err != nil {
+			return roleGrantsExclusion{}, err
+		}
+		exclusion.ObjectId = id
+	}
+	if inDatabase := exclusionConfig["in_database"].(string); inDatabase != "" {
+		id, err := sdk.ParseAccountObjectIdentifier(inDatabase)
+		if err != nil {
+			return roleGrantsExclusion{}, err
+		}
+		exclusion.InDatabase = &id
+	}
+	if exclusion.ObjectType == nil && exclusion.InDatabase == nil {
+		return roleGrantsExclusion{}, errors.New("at least one of object_type, object_name, or in_database has to be set in the exclude block")
+	}
+	return exclusion, nil
+}
+
+func (e roleGrantsExclusion) matches(objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) bool {
+	if e.ObjectType != nil && *e.ObjectType != objectType {
+		return false
+	}
+	if e.ObjectId != nil && (objectId == nil || e.ObjectId.FullyQualifiedName() != objectId.FullyQualifiedName()) {
+		return false
+	}
+	if e.InDatabase != nil {
+		databaseName, ok := roleGrantObjectDatabaseName(objectType, objectId)
+		if !ok || databaseName != e.InDatabase.Name() {
+			return false
+		}
+	}
+	return true
+}
+
+// roleGrantObjectDatabaseName returns the name of the database the object belongs to (or the name of the object if it is a database).
+func roleGrantObjectDatabaseName(objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) (string, bool) {
+	if objectId == nil {
+		return "", false
+	}
+	if objectType == sdk.ObjectTypeDatabase {
+		return objectId.Name(), true
+	}
+	if id, ok := objectId.(interface{ DatabaseName() string }); ok {
+		return id.DatabaseName(), true
+	}
+	return "", false
+}
+
+// roleGrantsFromShowGrants groups the privileges returned by SHOW GRANTS TO ... by object and grant option. Ownership,
+// role hierarchy (usage on other roles), grants made by the system (without the granting role), and excluded grants are skipped.
+func roleGrantsFromShowGrants(grants []sdk.Grant, exclusions []roleGrantsExclusion) []roleGrant {
+	result := make([]roleGrant, 0)
+	indexes := make(map[string]int)
+	for _, grant := range grants {
+		if grant.Privilege == "OWNERSHIP" || grant.GrantedBy.Name() == "" {
+			continue
+		}
+		objectType := grant.GrantedOn
+		switch objectType {
+		case sdk.ObjectTypeRole, sdk.ObjectTypeDatabaseRole, sdk.ObjectTypeApplicationRole:
+			continue
+		case sdk.ObjectTypeApplication:
+			// Applications are granted on as databases; see the comment in ReadGrantPrivilegesToAccountRole.
+			objectType = sdk.ObjectTypeDatabase
+		}
+		var objectId sdk.ObjectIdentifier
+		var objectName string
+		if objectType != sdk.ObjectTypeAccount {
+			objectId = grant.Name
+			objectName = grant.Name.FullyQualifiedName()
+		}
+		if slices.ContainsFunc(exclusions, func(exclusion roleGrantsExclusion) bool { return exclusion.matches(objectType, objectId) }) {
+			continue
+		}
+		current := roleGrant{
+			ObjectType:      objectType,
+			ObjectName:      objectName,
+			ObjectId:        objectId,
+			WithGrantOption: grant.GrantOption,
+		}
+		if index, ok := indexes[current.key()]; ok {
+			result[index].Privileges = append(result[index].Privileges, grant.Privilege)
+		} else {
+			current.Privileges = []string{grant.Privilege}
+			indexes[current.key()] = len(result)
+			result = append(result, current)
+		}
+	}
+	return result
+}
+
+// roleGrantsWithConfiguredNames keeps the object names and the IMPORTED PRIVILEGES privilege the way they were configured,
+// so that the differences in the identifier quoting do not produce plans.
+func roleGrantsWithConfiguredNames(actual []roleGrant, configured []roleGrant) []roleGrant {
+	configuredByKey := make(map[string]roleGrant)
+	for _, grant := range configured {
+		configuredByKey[grant.key()] = grant
+	}
+	return collections.Map(actual, func(grant roleGrant) roleGrant {
+		configuredGrant, ok := configuredByKey[grant.key()]
+		if !ok {
+			return grant
+		}
+		grant.ObjectName = configuredGrant.ObjectName
+		// IMPORTED PRIVILEGES are returned as USAGE in SHOW GRANTS.
+		importedPrivileges := sdk.AccountObjectPrivilegeImportedPrivileges.String()
+		if slices.Contains(configuredGrant.Privileges, importedPrivileges) {
+			if usageIndex := slices.Index(grant.Privileges, sdk.AccountObjectPrivilegeUsage.String()); usageIndex >= 0 {
+				grant.Privileges[usageIndex] = importedPrivileges
+			}
+		}
+		return grant
+	})
+}
+
+// roleGrantsChanges returns the privileges that have to be revoked and granted to get from the current to the expected grants.
+func roleGrantsChanges(current []roleGrant, expected []roleGrant) (toRevoke []roleGrant, toGrant []roleGrant) {
+	privilegesDiff := func(from []roleGrant, to []roleGrant) []roleGrant {
+		toByKey := make(map[string]roleGrant)
+		for _, grant := range to {
+			toByKey[grant.key()] = grant
+		}
+		diff := make([]roleGrant, 0)
+		for _, grant := range from {
+			privileges := slices.DeleteFunc(slices.Clone(grant.Privileges), func(privilege string) bool {
+				return slices.Contains(toByKey[grant.key()].Privileges, privilege)
+			})
+			if len(privileges) > 0 {
+				grant.Privileges = privileges
+				diff = append(diff, grant)
+			}
+		}
+		return diff
+	}
+	return privilegesDiff(current, expected), privilegesDiff(expected, current)
+}
+
+// applyRoleGrantsChanges revokes the privileges first, so that changing the grant option of a privilege works.
+func applyRoleGrantsChanges(ctx context.Context, current []roleGrant, expected []roleGrant, revoke func(context.Context, roleGrant) error, grant func(context.Context, roleGrant) error) error {
+	toRevoke, toGrant := roleGrantsChanges(current, expected)
+	for _, g := range toRevoke {
+		if err := revoke(ctx, g); err != nil {
+			return fmt.Errorf("revoking privileges %v on %s %s failed: %w", g.Privileges, g.ObjectType, g.ObjectName, err)
+		}
+	}
+	for _, g := range toGrant {
+		if err := grant(ctx, g); err != nil {
+			return fmt.Errorf("granting privileges %v on %s %s failed: %w", g.Privileges, g.ObjectType, g.ObjectName, err)
+		}
+	}
+	return nil
+}
+
+func roleGrantsExclusionsFromConfig(d *schema.ResourceData) ([]roleGrantsExclusion, error) {
+	return collections.MapErr(d.Get("exclude").([]any), roleGrantsExclusionFromConfig)
+}
+
+// roleGrantsFromState parses the grant blocks from the state; the blocks that cannot be parsed are skipped.
+func roleGrantsFromState(d *schema.ResourceData) []roleGrant {
+	grants := make([]roleGrant, 0)
+	for _, v := range d.Get("grant").(*schema.Set).List() {
+		if grant, err := roleGrantFromConfig(v); err == nil {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
@@ -0,0 +1,230 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_roleGrantsFromShowGrants(t *testing.T) {
+	grantedBy := sdk.NewAccountObjectIdentifier("ADMIN")
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	schemaId := sdk.NewDatabaseObjectIdentifier("DB", "SCHEMA")
+	tableId := sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "TABLE")
+	snowflakeId := sdk.NewAccountObjectIdentifier("SNOWFLAKE")
+
+	grants := []sdk.Grant{
+		{Privilege: "CREATE DATABASE", GrantedOn: sdk.ObjectTypeAccount, Name: sdk.NewAccountObjectIdentifier("ACCOUNT"), GrantedBy: grantedBy},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeDatabase, Name: databaseId, GrantedBy: grantedBy},
+		{Privilege: "MONITOR", GrantedOn: sdk.ObjectTypeDatabase, Name: databaseId, GrantedBy: grantedBy},
+		{Privilege: "CREATE TABLE", GrantedOn: sdk.ObjectTypeDatabase, Name: databaseId, GrantOption: true, GrantedBy: grantedBy},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeSchema, Name: schemaId, GrantedBy: grantedBy},
+		{Privilege: "SELECT", GrantedOn: sdk.ObjectTypeTable, Name: tableId, GrantedBy: grantedBy},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeApplication, Name: sdk.NewAccountObjectIdentifier("APP"), GrantedBy: grantedBy},
+		// skipped
+		{Privilege: "OWNERSHIP", GrantedOn: sdk.ObjectTypeTable, Name: tableId, GrantedBy: grantedBy},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeRole, Name: sdk.NewAccountObjectIdentifier("OTHER_ROLE"), GrantedBy: grantedBy},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeDatabaseRole, Name: sdk.NewDatabaseObjectIdentifier("DB", "DB_ROLE"), GrantedBy: grantedBy},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier("SYSTEM_DB"), GrantedBy: sdk.NewAccountObjectIdentifier("")},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeSchema, Name: sdk.NewDatabaseObjectIdentifier("SNOWFLAKE", "ACCOUNT_USAGE"), GrantedBy: grantedBy},
+	}
+	exclusions := []roleGrantsExclusion{{InDatabase: &snowflakeId}}
+
+	result := roleGrantsFromShowGrants(grants, exclusions)
+
+	require.Len(t, result, 6)
+	assert.Equal(t, roleGrant{ObjectType: sdk.ObjectTypeAccount, Privileges: []string{"CREATE DATABASE"}}, result[0])
+	assert.Equal(t, roleGrant{ObjectType: sdk.ObjectTypeDatabase, ObjectName: databaseId.FullyQualifiedName(), ObjectId: databaseId, Privileges: []string{"USAGE", "MONITOR"}}, result[1])
+	assert.Equal(t, roleGrant{ObjectType: sdk.ObjectTypeDatabase, ObjectName: databaseId.FullyQualifiedName(), ObjectId: databaseId, WithGrantOption: true, Privileges: []string{"CREATE TABLE"}}, result[2])
+	assert.Equal(t, roleGrant{ObjectType: sdk.ObjectTypeSchema, ObjectName: schemaId.FullyQualifiedName(), ObjectId: schemaId, Privileges: []string{"USAGE"}}, result[3])
+	assert.Equal(t, roleGrant{ObjectType: sdk.ObjectTypeTable, ObjectName: tableId.FullyQualifiedName(), ObjectId: tableId, Privileges: []string{"SELECT"}}, result[4])
+	assert.Equal(t, sdk.ObjectTypeDatabase, result[5].ObjectType)
+}
+
+func Test_roleGrantsExclusion_matches(t *testing.T) {
+	snowflakeId := sdk.NewAccountObjectIdentifier("SNOWFLAKE")
+	databaseType := sdk.ObjectTypeDatabase
+	tableType := sdk.ObjectTypeTable
+	tableId := sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "TABLE")
+
+	testCases := []struct {
+		Name       string
+		Exclusion  roleGrantsExclusion
+		ObjectType sdk.ObjectType
+		ObjectId   sdk.ObjectIdentifier
+		Expected   bool
+	}{
+		{Name: "in database - the database itself", Exclusion: roleGrantsExclusion{InDatabase: &snowflakeId}, ObjectType: sdk.ObjectTypeDatabase, ObjectId: snowflakeId, Expected: true},
+		{Name: "in database - schema", Exclusion: roleGrantsExclusion{InDatabase: &snowflakeId}, ObjectType: sdk.ObjectTypeSchema, ObjectId: sdk.NewDatabaseObjectIdentifier("SNOWFLAKE", "S"), Expected: true},
+		{Name: "in database - function", Exclusion: roleGrantsExclusion{InDatabase: &snowflakeId}, ObjectType: sdk.ObjectTypeFunction, ObjectId: sdk.NewSchemaObjectIdentifierWithArguments("SNOWFLAKE", "S", "F", sdk.DataTypeVARCHAR), Expected: true},
+		{Name: "in database - other database", Exclusion: roleGrantsExclusion{InDatabase: &snowflakeId}, ObjectType: sdk.ObjectTypeDatabase, ObjectId: sdk.NewAccountObjectIdentifier("DB"), Expected: false},
+		{Name: "in database - account", Exclusion: roleGrantsExclusion{InDatabase: &snowflakeId}, ObjectType: sdk.ObjectTypeAccount, Expected: false},
+		{Name: "in database - warehouse with the same name", Exclusion: roleGrantsExclusion{InDatabase: &snowflakeId}, ObjectType: sdk.ObjectTypeWarehouse, ObjectId: snowflakeId, Expected: false},
+		{Name: "object type", Exclusion: roleGrantsExclusion{ObjectType: &databaseType}, ObjectType: sdk.ObjectTypeDatabase, ObjectId: snowflakeId, Expected: true},
+		{Name: "object type - different type", Exclusion: roleGrantsExclusion{ObjectType: &databaseType}, ObjectType: sdk.ObjectTypeSchema, ObjectId: sdk.NewDatabaseObjectIdentifier("SNOWFLAKE", "S"), Expected: false},
+		{Name: "object type and name", Exclusion: roleGrantsExclusion{ObjectType: &tableType, ObjectId: tableId}, ObjectType: sdk.ObjectTypeTable, ObjectId: tableId, Expected: true},
+		{Name: "object type and name - different name", Exclusion: roleGrantsExclusion{ObjectType: &tableType, ObjectId: tableId}, ObjectType: sdk.ObjectTypeTable, ObjectId: sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "OTHER"), Expected: false},
+		{Name: "object type and in database", Exclusion: roleGrantsExclusion{ObjectType: &tableType, InDatabase: sdk.Pointer(sdk.NewAccountObjectIdentifier("DB"))}, ObjectType: sdk.ObjectTypeTable, ObjectId: tableId, Expected: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, tt.Exclusion.matches(tt.ObjectType, tt.ObjectId))
+		})
+	}
+}
+
+func Test_roleGrantsExclusionFromConfig(t *testing.T) {
+	exclusionConfig := func(objectType string, objectName string, inDatabase string) map[string]any {
+		return map[string]any{
+			"object_type": objectType,
+			"object_name": objectName,
+			"in_database": inDatabase,
+		}
+	}
+
+	t.Run("in database", func(t *testing.T) {
+		exclusion, err := roleGrantsExclusionFromConfig(exclusionConfig("", "", "SNOWFLAKE"))
+		require.NoError(t, err)
+		assert.Nil(t, exclusion.ObjectType)
+		assert.Equal(t, sdk.Pointer(sdk.NewAccountObjectIdentifier("SNOWFLAKE")), exclusion.InDatabase)
+	})
+
+	t.Run("object type and name", func(t *testing.T) {
+		exclusion, err := roleGrantsExclusionFromConfig(exclusionConfig("table", "DB.SCHEMA.TABLE", ""))
+		require.NoError(t, err)
+		assert.Equal(t, sdk.Pointer(sdk.ObjectTypeTable), exclusion.ObjectType)
+		assert.Equal(t, sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "TABLE"), exclusion.ObjectId)
+	})
+
+	t.Run("empty block", func(t *testing.T) {
+		_, err := roleGrantsExclusionFromConfig(nil)
+		require.ErrorContains(t, err, "at least one of object_type, object_name, or in_database has to be set")
+	})
+
+	t.Run("object name without type", func(t *testing.T) {
+		_, err := roleGrantsExclusionFromConfig(exclusionConfig("", "DB", ""))
+		require.ErrorContains(t, err, "object_type has to be set when object_name is set")
+	})
+}
+
+func Test_roleGrantsFromConfig(t *testing.T) {
+	grantConfig := func(objectType string, objectName string, withGrantOption bool, privileges ...string) any {
+		return map[string]any{
+			"object_type":       objectType,
+			"object_name":       objectName,
+			"with_grant_option": withGrantOption,
+			"privileges":        schema.NewSet(schema.HashString, collections.Map(privileges, func(privilege string) any { return privilege })),
+		}
+	}
+
+	t.Run("valid grants", func(t *testing.T) {
+		grants, err := roleGrantsFromConfig([]any{
+			grantConfig("ACCOUNT", "", false, "CREATE DATABASE"),
+			grantConfig("DATABASE", "DB", false, "USAGE"),
+			grantConfig("DATABASE", "\"DB\"", true, "MONITOR"),
+			grantConfig("function", "DB.SCHEMA.FUNC(VARCHAR)", false, "USAGE"),
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, grants, 4)
+		assert.Nil(t, grants[0].ObjectId)
+		assert.Equal(t, sdk.NewAccountObjectIdentifier("DB"), grants[1].ObjectId)
+		assert.Equal(t, sdk.ObjectTypeFunction, grants[3].ObjectType)
+		assert.Equal(t, sdk.NewSchemaObjectIdentifierWithArguments("DB", "SCHEMA", "FUNC", sdk.DataTypeVARCHAR), grants[3].ObjectId)
+	})
+
+	t.Run("duplicated object", func(t *testing.T) {
+		_, err := roleGrantsFromConfig([]any{
+			grantConfig("DATABASE", "DB", false, "USAGE"),
+			grantConfig("DATABASE", "\"DB\"", false, "MONITOR"),
+		}, nil)
+		require.ErrorContains(t, err, "declared more than once")
+	})
+
+	t.Run("excluded object", func(t *testing.T) {
+		_, err := roleGrantsFromConfig([]any{
+			grantConfig("SCHEMA", "SNOWFLAKE.ACCOUNT_USAGE", false, "USAGE"),
+		}, []roleGrantsExclusion{{InDatabase: sdk.Pointer(sdk.NewAccountObjectIdentifier("SNOWFLAKE"))}})
+		require.ErrorContains(t, err, "the object is excluded")
+	})
+
+	t.Run("object name on account", func(t *testing.T) {
+		_, err := roleGrantsFromConfig([]any{grantConfig("ACCOUNT", "ACC", false, "CREATE DATABASE")}, nil)
+		require.ErrorContains(t, err, "object_name cannot be set for grants on ACCOUNT")
+	})
+
+	t.Run("missing object name", func(t *testing.T) {
+		_, err := roleGrantsFromConfig([]any{grantConfig("DATABASE", "", false, "USAGE")}, nil)
+		require.ErrorContains(t, err, "object_name has to be set for grants on DATABASE")
+	})
+}
+
+func Test_roleGrantsWithConfiguredNames(t *testing.T) {
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	actual := []roleGrant{
+		{ObjectType: sdk.ObjectTypeDatabase, ObjectName: databaseId.FullyQualifiedName(), ObjectId: databaseId, Privileges: []string{"USAGE"}},
+		{ObjectType: sdk.ObjectTypeDatabase, ObjectName: databaseId.FullyQualifiedName(), ObjectId: databaseId, WithGrantOption: true, Privileges: []string{"MONITOR"}},
+	}
+	configured := []roleGrant{
+		{ObjectType: sdk.ObjectTypeDatabase, ObjectName: "DB", ObjectId: databaseId, Privileges: []string{"IMPORTED PRIVILEGES"}},
+	}
+
+	result := roleGrantsWithConfiguredNames(actual, configured)
+
+	require.Len(t, result, 2)
+	assert.Equal(t, "DB", result[0].ObjectName)
+	assert.Equal(t, []string{"IMPORTED PRIVILEGES"}, result[0].Privileges)
+	assert.Equal(t, databaseId.FullyQualifiedName(), result[1].ObjectName)
+	assert.Equal(t, []string{"MONITOR"}, result[1].Privileges)
+}
+
+func Test_applyRoleGrantsChanges(t *testing.T) {
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	schemaId := sdk.NewDatabaseObjectIdentifier("DB", "SCHEMA")
+	current := []roleGrant{
+		{ObjectType: sdk.ObjectTypeDatabase, ObjectId: databaseId, Privileges: []string{"USAGE", "MONITOR"}},
+		{ObjectType: sdk.ObjectTypeSchema, ObjectId: schemaId, Privileges: []string{"USAGE"}},
+	}
+	expected := []roleGrant{
+		{ObjectType: sdk.ObjectTypeDatabase, ObjectId: databaseId, Privileges: []string{"USAGE", "CREATE SCHEMA"}},
+		{ObjectType: sdk.ObjectTypeSchema, ObjectId: schemaId, WithGrantOption: true, Privileges: []string{"USAGE"}},
+	}
+
+	var operations []string
+	record := func(operation string) func(context.Context, roleGrant) error {
+		return func(_ context.Context, grant roleGrant) error {
+			for _, privilege := range grant.Privileges {
+				operations = append(operations, operation+" "+privilege+" ON "+grant.ObjectType.String())
+			}
+			return nil
+		}
+	}
+
+	err := applyRoleGrantsChanges(context.Background(), current, expected, record("REVOKE"), record("GRANT"))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"REVOKE MONITOR ON DATABASE",
+		"REVOKE USAGE ON SCHEMA",
+		"GRANT CREATE SCHEMA ON DATABASE",
+		"GRANT USAGE ON SCHEMA",
+	}, operations)
+
+	t.Run("no changes", func(t *testing.T) {
+		operations = nil
+		err := applyRoleGrantsChanges(context.Background(), expected, expected, record("REVOKE"), record("GRANT"))
+		require.NoError(t, err)
+		assert.Empty(t, operations)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := applyRoleGrantsChanges(context.Background(), current, expected, func(context.Context, roleGrant) error { return errors.New("test error") }, record("GRANT"))
+		require.ErrorContains(t, err, "revoking privileges [MONITOR] on DATABASE")
+	})
+}
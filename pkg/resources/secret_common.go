@@ -81,7 +81,7 @@ func handleSecretRead(d *schema.ResourceData,
 	)
 }
 
-func handleSecretUpdate(d *schema.ResourceData, set *sdk.SecretSetRequest, unset *sdk.SecretUnsetRequest) {
+func handleSecretUpdate(d ResourceValueGetter, set *sdk.SecretSetRequest, unset *sdk.SecretUnsetRequest) {
 	if d.HasChange("comment") {
 		if v, ok := d.GetOk("comment"); ok {
 			set.WithComment(v.(string))
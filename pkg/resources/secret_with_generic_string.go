@@ -68,14 +68,7 @@ func CreateContextSecretWithGenericString(ctx context.Context, d *schema.Resourc
 	databaseName, schemaName, name := d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	secretSting := d.Get("secret_string").(string)
-
-	request := sdk.NewCreateWithGenericStringSecretRequest(id, secretSting)
-	if v, ok := d.GetOk("comment"); ok {
-		request.WithComment(v.(string))
-	}
-
-	err := client.Secrets.CreateWithGenericString(ctx, request)
+	err := client.Secrets.CreateWithGenericString(ctx, secretWithGenericStringCreateRequest(id, d))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,6 +78,16 @@ func CreateContextSecretWithGenericString(ctx context.Context, d *schema.Resourc
 	return ReadContextSecretWithGenericString(ctx, d, meta)
 }
 
+func secretWithGenericStringCreateRequest(id sdk.SchemaObjectIdentifier, d ResourceValueGetter) *sdk.CreateWithGenericStringSecretRequest {
+	secretSting := d.Get("secret_string").(string)
+
+	request := sdk.NewCreateWithGenericStringSecretRequest(id, secretSting)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+	return request
+}
+
 func ReadContextSecretWithGenericString(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
@@ -130,6 +133,14 @@ func UpdateContextSecretWithGenericString(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	if err := alterSecretWithGenericString(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadContextSecretWithGenericString(ctx, d, meta)
+}
+
+func alterSecretWithGenericString(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d ResourceValueGetter) error {
 	set := &sdk.SecretSetRequest{}
 	unset := &sdk.SecretUnsetRequest{}
 	handleSecretUpdate(d, set, unset)
@@ -146,15 +157,35 @@ func UpdateContextSecretWithGenericString(ctx context.Context, d *schema.Resourc
 
 	if !reflect.DeepEqual(*set, sdk.SecretSetRequest{}) {
 		if err := client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithSet(*set)); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
 	if !reflect.DeepEqual(*unset, sdk.SecretUnsetRequest{}) {
 		if err := client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithUnset(*unset)); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
+	return nil
+}
 
-	return ReadContextSecretWithGenericString(ctx, d, meta)
+var secretWithGenericStringSqlPreview = SqlPreview{
+	Create: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+		return client.Secrets.CreateWithGenericString(ctx, secretWithGenericStringCreateRequest(id, d))
+	},
+	Update: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return err
+		}
+		return alterSecretWithGenericString(ctx, client, id, d)
+	},
+	Delete: func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error {
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return err
+		}
+		return client.Secrets.DropSafely(ctx, id)
+	},
 }
@@ -0,0 +1,423 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func semanticViewExpressionSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: fmt.Sprintf("Specifies the %s of the semantic view.", kind),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"qualified_expression_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the name of the expression qualified with the alias of the logical table it belongs to, e.g. `orders.order_count`.",
+				},
+				"sql_expression": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      diffSuppressStatementFieldDescription("Specifies the SQL expression, e.g. `COUNT(orders.id)`."),
+					DiffSuppressFunc: DiffSuppressStatement,
+				},
+				"synonym": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the synonyms of the expression.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies a comment for the expression.",
+				},
+			},
+		},
+	}
+}
+
+var semanticViewSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the semantic view; must be unique for the schema in which the semantic view is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the semantic view."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the semantic view."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"tables": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Description: "Specifies the logical tables of the semantic view.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"table_alias": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies the alias of the logical table used in relationships and expressions.",
+				},
+				"table_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("Specifies the fully qualified name of the table the logical table is based on."), resources.Table),
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+				"primary_key": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the primary key columns of the logical table. Column names are case-sensitive.",
+				},
+				"unique": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies the unique keys of the logical table.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"columns": {
+								Type:        schema.TypeList,
+								Required:    true,
+								ForceNew:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Specifies the columns of the unique key. Column names are case-sensitive.",
+							},
+						},
+					},
+				},
+				"synonym": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the synonyms of the logical table.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies a comment for the logical table.",
+				},
+			},
+		},
+	},
+	"relationships": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the relationships between the logical tables of the semantic view.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"relationship_identifier": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies the identifier of the relationship.",
+				},
+				"table_alias": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the alias of the logical table that references the other one.",
+				},
+				"columns": {
+					Type:        schema.TypeList,
+					Required:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the referencing columns. Column names are case-sensitive.",
+				},
+				"referenced_table_alias": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the alias of the referenced logical table.",
+				},
+				"referenced_columns": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the referenced columns. If not set, the primary key of the referenced logical table is used. Column names are case-sensitive.",
+				},
+			},
+		},
+	},
+	"facts":      semanticViewExpressionSchema("facts"),
+	"dimensions": semanticViewExpressionSchema("dimensions"),
+	"metrics":    semanticViewExpressionSchema("metrics"),
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the semantic view.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SEMANTIC VIEWS` for the given semantic view.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowSemanticViewSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE SEMANTIC VIEW` for the given semantic view.",
+		Elem: &schema.Resource{
+			Schema: schemas.SemanticViewDescribeSchema,
+		},
+	},
+}
+
+func SemanticView() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.SemanticViews.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.SemanticViewResource), TrackingCreateWrapper(resources.SemanticView, CreateSemanticView)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.SemanticViewResource), TrackingReadWrapper(resources.SemanticView, ReadSemanticViewFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.SemanticViewResource), TrackingUpdateWrapper(resources.SemanticView, UpdateSemanticView)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.SemanticViewResource), TrackingDeleteWrapper(resources.SemanticView, deleteFunc)),
+		Description:   "Resource used to manage semantic views. For more information, check [semantic views documentation](https://docs.snowflake.com/en/sql-reference/sql/create-semantic-view). The definition of the semantic view (`tables`, `relationships`, `facts`, `dimensions`, and `metrics`) cannot be altered, so changing it recreates the semantic view. The definition is not read from Snowflake, so external changes to it are not detected.",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.SemanticView, customdiff.All(
+			ComputedIfAnyAttributeChanged(semanticViewSchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(semanticViewSchema, DescribeOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(semanticViewSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: semanticViewSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.SemanticView, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateSemanticView(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	tables, err := parseSemanticViewTables(d.Get("tables").([]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := sdk.NewCreateSemanticViewRequest(id, tables)
+
+	if v, ok := d.GetOk("relationships"); ok {
+		request.WithRelationships(parseSemanticViewRelationships(v.([]any)))
+	}
+	if v, ok := d.GetOk("facts"); ok {
+		request.WithFacts(parseSemanticViewExpressions(v.([]any)))
+	}
+	if v, ok := d.GetOk("dimensions"); ok {
+		request.WithDimensions(parseSemanticViewExpressions(v.([]any)))
+	}
+	if v, ok := d.GetOk("metrics"); ok {
+		request.WithMetrics(parseSemanticViewExpressions(v.([]any)))
+	}
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.SemanticViews.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadSemanticViewFunc(false)(ctx, d, meta)
+}
+
+func parseSemanticViewColumns(raw []any) []sdk.Column {
+	return collections.Map(expandStringList(raw), func(column string) sdk.Column {
+		return sdk.Column{Value: column}
+	})
+}
+
+func parseSemanticViewSynonyms(raw []any) []sdk.SemanticViewSynonym {
+	return collections.Map(expandStringList(raw), func(synonym string) sdk.SemanticViewSynonym {
+		return sdk.SemanticViewSynonym{Value: synonym}
+	})
+}
+
+func parseSemanticViewTables(raw []any) ([]sdk.SemanticViewTableRequest, error) {
+	tables := make([]sdk.SemanticViewTableRequest, len(raw))
+	for i, t := range raw {
+		table := t.(map[string]any)
+		tableId, err := sdk.ParseSchemaObjectIdentifier(table["table_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+		request := sdk.NewSemanticViewTableRequest(tableId)
+		if alias := table["table_alias"].(string); alias != "" {
+			request.WithTableAlias(alias)
+		}
+		if primaryKey := table["primary_key"].([]any); len(primaryKey) > 0 {
+			request.WithPrimaryKey(parseSemanticViewColumns(primaryKey))
+		}
+		if unique := table["unique"].([]any); len(unique) > 0 {
+			request.WithUniqueKeys(collections.Map(unique, func(u any) sdk.SemanticViewUniqueKeyRequest {
+				return *sdk.NewSemanticViewUniqueKeyRequest(parseSemanticViewColumns(u.(map[string]any)["columns"].([]any)))
+			}))
+		}
+		if synonyms := table["synonym"].(*schema.Set).List(); len(synonyms) > 0 {
+			request.WithWithSynonyms(parseSemanticViewSynonyms(synonyms))
+		}
+		if comment := table["comment"].(string); comment != "" {
+			request.WithComment(comment)
+		}
+		tables[i] = *request
+	}
+	return tables, nil
+}
+
+func parseSemanticViewRelationships(raw []any) []sdk.SemanticViewRelationshipRequest {
+	return collections.Map(raw, func(r any) sdk.SemanticViewRelationshipRequest {
+		relationship := r.(map[string]any)
+		request := sdk.NewSemanticViewRelationshipRequest(
+			relationship["table_alias"].(string),
+			parseSemanticViewColumns(relationship["columns"].([]any)),
+			relationship["referenced_table_alias"].(string),
+		)
+		if identifier := relationship["relationship_identifier"].(string); identifier != "" {
+			request.WithRelationshipIdentifier(identifier)
+		}
+		if referencedColumns := relationship["referenced_columns"].([]any); len(referencedColumns) > 0 {
+			request.WithRefColumns(parseSemanticViewColumns(referencedColumns))
+		}
+		return *request
+	})
+}
+
+func parseSemanticViewExpressions(raw []any) []sdk.SemanticViewExpressionRequest {
+	return collections.Map(raw, func(e any) sdk.SemanticViewExpressionRequest {
+		expression := e.(map[string]any)
+		request := sdk.NewSemanticViewExpressionRequest(expression["qualified_expression_name"].(string), expression["sql_expression"].(string))
+		if synonyms := expression["synonym"].(*schema.Set).List(); len(synonyms) > 0 {
+			request.WithWithSynonyms(parseSemanticViewSynonyms(synonyms))
+		}
+		if comment := expression["comment"].(string); comment != "" {
+			request.WithComment(comment)
+		}
+		return *request
+	})
+}
+
+func ReadSemanticViewFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		semanticView, err := client.SemanticViews.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query semantic view. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Semantic view id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		details, err := client.SemanticViews.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		errs := errors.Join(
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.SemanticViewToSchema(semanticView)}),
+			d.Set(DescribeOutputAttributeName, schemas.SemanticViewDetailsToSchema(details)),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set("comment", semanticView.Comment),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+		return nil
+	}
+}
+
+func UpdateSemanticView(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.SemanticViews.Alter(ctx, sdk.NewAlterSemanticViewRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming semantic view %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.SemanticViews.Alter(ctx, sdk.NewAlterSemanticViewRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.SemanticViews.Alter(ctx, sdk.NewAlterSemanticViewRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadSemanticViewFunc(false)(ctx, d, meta)
+}
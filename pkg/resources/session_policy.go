@@ -0,0 +1,327 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const sessionPolicySecondaryRolesNone = "NONE"
+
+var sessionPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the session policy; must be unique for the schema in which the session policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the session policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the session policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"session_idle_timeout_mins": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(5, 240)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("session_idle_timeout_mins"),
+		Description:      "Specifies the number of minutes in which a session can be idle before users must authenticate to Snowflake again. Valid values are between 5 and 240. If not specified, Snowflake uses the default value.",
+		Default:          IntDefault,
+	},
+	"session_ui_idle_timeout_mins": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(5, 240)),
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("session_ui_idle_timeout_mins"),
+		Description:      "Specifies the number of minutes in which a Snowsight or Classic Console session can be idle before users must authenticate to Snowflake again. Valid values are between 5 and 240. If not specified, Snowflake uses the default value.",
+		Default:          IntDefault,
+	},
+	"allowed_secondary_roles": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:    true,
+		Description: "Specifies the roles that can be activated as secondary roles in the sessions governed by the policy. Use `[\"ALL\"]` to allow all roles granted to the user, or `[\"NONE\"]` to allow no secondary roles (`ALLOWED_SECONDARY_ROLES = ()`), which can't be combined with other roles. If not specified, Snowflake uses the default value (all roles are allowed).",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the session policy.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SESSION POLICIES` for the given session policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowSessionPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE SESSION POLICY` for the given session policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeSessionPolicySchema,
+		},
+	},
+}
+
+func SessionPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.SessionPolicies.DropSafely
+		},
+	)
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.SessionPolicyResource), TrackingCreateWrapper(resources.SessionPolicy, CreateSessionPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.SessionPolicyResource), TrackingReadWrapper(resources.SessionPolicy, ReadSessionPolicyFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.SessionPolicyResource), TrackingUpdateWrapper(resources.SessionPolicy, UpdateSessionPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.SessionPolicyResource), TrackingDeleteWrapper(resources.SessionPolicy, deleteFunc)),
+		Description:   "Resource used to manage session policies. For more information, check [session policies documentation](https://docs.snowflake.com/en/sql-reference/sql/create-session-policy).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.SessionPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(sessionPolicySchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(sessionPolicySchema, DescribeOutputAttributeName, "name", "session_idle_timeout_mins", "session_ui_idle_timeout_mins", "allowed_secondary_roles", "comment"),
+		)),
+
+		Schema: sessionPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.SessionPolicy, ImportSessionPolicy),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportSessionPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	sessionPolicyDescription, err := client.SessionPolicies.Describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+		d.Set("session_idle_timeout_mins", sessionPolicyDescription.SessionIdleTimeoutMins),
+		d.Set("session_ui_idle_timeout_mins", sessionPolicyDescription.SessionUIIdleTimeoutMins),
+		d.Set("allowed_secondary_roles", sessionPolicySecondaryRolesFromDescribe(sessionPolicyDescription.AllowedSecondaryRoles)),
+	)
+	if errs != nil {
+		return nil, errs
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateSessionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateSessionPolicyRequest(id)
+	errs := errors.Join(
+		intAttributeWithSpecialDefaultCreateBuilder(d, "session_idle_timeout_mins", request.WithSessionIdleTimeoutMins),
+		intAttributeWithSpecialDefaultCreateBuilder(d, "session_ui_idle_timeout_mins", request.WithSessionUiIdleTimeoutMins),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	if v, ok := d.GetOk("allowed_secondary_roles"); ok {
+		secondaryRoles, err := sessionPolicySecondaryRolesRequest(expandStringList(v.(*schema.Set).List()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithAllowedSecondaryRoles(secondaryRoles)
+	}
+
+	if err := client.SessionPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadSessionPolicyFunc(false)(ctx, d, meta)
+}
+
+func ReadSessionPolicyFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		sessionPolicy, err := client.SessionPolicies.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query session policy. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Session policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		sessionPolicyDescription, err := client.SessionPolicies.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if withExternalChangesMarking {
+			if err = handleExternalChangesToObjectInFlatDescribe(d,
+				outputMapping{"session_idle_timeout_mins", "session_idle_timeout_mins", sessionPolicyDescription.SessionIdleTimeoutMins, sessionPolicyDescription.SessionIdleTimeoutMins, nil},
+				outputMapping{"session_ui_idle_timeout_mins", "session_ui_idle_timeout_mins", sessionPolicyDescription.SessionUIIdleTimeoutMins, sessionPolicyDescription.SessionUIIdleTimeoutMins, nil},
+				outputMapping{"allowed_secondary_roles", "allowed_secondary_roles", normalizeSessionPolicySecondaryRoles(sessionPolicyDescription.AllowedSecondaryRoles), sessionPolicySecondaryRolesFromDescribe(sessionPolicyDescription.AllowedSecondaryRoles), normalizeSessionPolicySecondaryRoles},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err = setStateToValuesFromConfig(d, sessionPolicySchema, []string{
+			"session_idle_timeout_mins",
+			"session_ui_idle_timeout_mins",
+			"allowed_secondary_roles",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		errs := errors.Join(
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.SessionPolicyToSchema(sessionPolicy)}),
+			d.Set(DescribeOutputAttributeName, []map[string]any{schemas.SessionPolicyDescriptionToSchema(*sessionPolicyDescription)}),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set("comment", sessionPolicy.Comment),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+		return nil
+	}
+}
+
+func UpdateSessionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming session policy %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	set, unset := sdk.NewSessionPolicySetRequest(), sdk.NewSessionPolicyUnsetRequest()
+	errs := errors.Join(
+		intAttributeWithSpecialDefaultUpdate(d, "session_idle_timeout_mins", &set.SessionIdleTimeoutMins, &unset.SessionIdleTimeoutMins),
+		intAttributeWithSpecialDefaultUpdate(d, "session_ui_idle_timeout_mins", &set.SessionUiIdleTimeoutMins, &unset.SessionUiIdleTimeoutMins),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	if d.HasChange("allowed_secondary_roles") {
+		if v, ok := d.GetOk("allowed_secondary_roles"); ok {
+			secondaryRoles, err := sessionPolicySecondaryRolesRequest(expandStringList(v.(*schema.Set).List()))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			set.WithAllowedSecondaryRoles(secondaryRoles)
+		} else {
+			unset.WithAllowedSecondaryRoles(true)
+		}
+	}
+
+	if (*set != sdk.SessionPolicySetRequest{}) {
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.SessionPolicyUnsetRequest{}) {
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadSessionPolicyFunc(false)(ctx, d, meta)
+}
+
+// sessionPolicySecondaryRolesRequest maps the configured roles to the request; ALL is a special value allowing every granted role,
+// and NONE is a special value allowing no secondary roles.
+func sessionPolicySecondaryRolesRequest(roles []string) (sdk.SessionPolicySecondaryRolesRequest, error) {
+	request := sdk.NewSessionPolicySecondaryRolesRequest()
+	if slices.ContainsFunc(roles, func(role string) bool { return strings.EqualFold(role, sessionPolicySecondaryRolesNone) }) {
+		if len(roles) > 1 {
+			return sdk.SessionPolicySecondaryRolesRequest{}, fmt.Errorf("%s can't be combined with other roles in allowed_secondary_roles, got: %v", sessionPolicySecondaryRolesNone, roles)
+		}
+		return *request.WithNone(true), nil
+	}
+	if slices.ContainsFunc(roles, func(role string) bool { return strings.EqualFold(role, "ALL") }) {
+		return *request.WithAll(true), nil
+	}
+	return *request.WithRoles(collections.Map(roles, sdk.NewAccountObjectIdentifier)), nil
+}
+
+// sessionPolicySecondaryRolesFromDescribe maps the roles from the describe output to the allowed_secondary_roles value.
+// An empty list means that no secondary roles are allowed; the default value is returned from Snowflake as ALL.
+func sessionPolicySecondaryRolesFromDescribe(roles []string) []string {
+	if len(roles) == 0 {
+		return []string{sessionPolicySecondaryRolesNone}
+	}
+	return roles
+}
+
+// normalizeSessionPolicySecondaryRoles makes the list of roles comparable regardless of its order.
+func normalizeSessionPolicySecondaryRoles(value any) any {
+	var roles []string
+	switch v := value.(type) {
+	case []string:
+		roles = slices.Clone(v)
+	case []any:
+		roles = expandStringList(v)
+	}
+	slices.Sort(roles)
+	return strings.Join(roles, ",")
+}
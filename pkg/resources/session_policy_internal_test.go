@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionPolicySecondaryRolesRequest(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		request, err := sessionPolicySecondaryRolesRequest([]string{"none"})
+
+		require.NoError(t, err)
+		require.Equal(t, *sdk.NewSessionPolicySecondaryRolesRequest().WithNone(true), request)
+	})
+
+	t.Run("none combined with other roles", func(t *testing.T) {
+		_, err := sessionPolicySecondaryRolesRequest([]string{"NONE", "ROLE"})
+
+		require.ErrorContains(t, err, "NONE can't be combined with other roles in allowed_secondary_roles")
+	})
+
+	t.Run("all", func(t *testing.T) {
+		request, err := sessionPolicySecondaryRolesRequest([]string{"ALL"})
+
+		require.NoError(t, err)
+		require.Equal(t, *sdk.NewSessionPolicySecondaryRolesRequest().WithAll(true), request)
+	})
+
+	t.Run("roles", func(t *testing.T) {
+		request, err := sessionPolicySecondaryRolesRequest([]string{"ROLE"})
+
+		require.NoError(t, err)
+		require.Equal(t, *sdk.NewSessionPolicySecondaryRolesRequest().WithRoles([]sdk.AccountObjectIdentifier{sdk.NewAccountObjectIdentifier("ROLE")}), request)
+	})
+}
+
+func TestSessionPolicyNoSecondaryRoles(t *testing.T) {
+	// The statements are recorded instead of being run, so reading the policy after the change fails and its result is not checked.
+	client, recorded := sdk.NewSqlPreviewClient()
+	meta := &provider.Context{Client: client}
+
+	t.Run("create", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, sessionPolicySchema, map[string]any{
+			"database":                "database",
+			"schema":                  "schema",
+			"name":                    "policy",
+			"allowed_secondary_roles": []any{"NONE"},
+		})
+
+		_ = CreateSessionPolicy(context.Background(), d, meta)
+
+		require.Equal(t, []string{`CREATE SESSION POLICY "database"."schema"."policy" ALLOWED_SECONDARY_ROLES = ()`}, recorded.Statements())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		client, recorded := sdk.NewSqlPreviewClient()
+		r := SessionPolicy()
+		state := &terraform.InstanceState{
+			ID: `"database"."schema"."policy"`,
+			Attributes: map[string]string{
+				"id":                           `"database"."schema"."policy"`,
+				"database":                     "database",
+				"schema":                       "schema",
+				"name":                         "policy",
+				"session_idle_timeout_mins":    "-1",
+				"session_ui_idle_timeout_mins": "-1",
+				"allowed_secondary_roles.#":    "1",
+				"allowed_secondary_roles.0":    "ALL",
+			},
+		}
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]any{
+			"database":                "database",
+			"schema":                  "schema",
+			"name":                    "policy",
+			"allowed_secondary_roles": []any{"NONE"},
+		}), meta)
+		require.NoError(t, err)
+		d, err := schema.InternalMap(r.Schema).Data(state, diff)
+		require.NoError(t, err)
+
+		_ = UpdateSessionPolicy(context.Background(), d, &provider.Context{Client: client})
+
+		require.Equal(t, []string{`ALTER SESSION POLICY "database"."schema"."policy" SET ALLOWED_SECONDARY_ROLES = ()`}, recorded.Statements())
+	})
+}
+
+func TestSessionPolicySecondaryRolesFromDescribe(t *testing.T) {
+	require.Equal(t, []string{"NONE"}, sessionPolicySecondaryRolesFromDescribe([]string{}))
+	require.Equal(t, []string{"ALL"}, sessionPolicySecondaryRolesFromDescribe([]string{"ALL"}))
+}
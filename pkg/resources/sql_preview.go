@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// ResourceValueGetter is the part of schema.ResourceData (and schema.ResourceDiff) used to build the SDK requests from the resource values.
+type ResourceValueGetter interface {
+	Id() string
+	Get(string) any
+	GetOk(string) (any, bool)
+	HasChange(string) bool
+}
+
+// SqlPreview builds the statements run by the resource operations without calling the operations. Each function builds the SDK requests
+// the same way as the matching operation, and runs them with the client returned from sdk.NewSqlPreviewClient, which only records
+// the statements. The functions can't depend on the current state of the objects, as no queries are run with that client.
+type SqlPreview struct {
+	Create func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error
+	Update func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error
+	Delete func(ctx context.Context, d ResourceValueGetter, client *sdk.Client) error
+}
+
+// SqlPreviews returns the SQL previews of the resources supporting them, keyed by the resource name.
+func SqlPreviews() map[string]SqlPreview {
+	return map[string]SqlPreview{
+		resources.AccountRole.String():             accountRoleSqlPreview,
+		resources.DatabaseRole.String():            databaseRoleSqlPreview,
+		resources.SecretWithGenericString.String(): secretWithGenericStringSqlPreview,
+	}
+}
@@ -0,0 +1,396 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageFileSchema = map[string]*schema.Schema{
+	"stage": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Fully qualified name of the internal stage the file is uploaded to.", resources.Stage),
+	},
+	"path": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validateStageFilePath,
+		Description:      "Directory on the stage the file is uploaded to (e.g. `libs/python`). The file is uploaded to the root of the stage when not set. The path cannot start or end with `/`.",
+	},
+	"file_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validateStageFileName,
+		Description:      "Name of the file on the stage (e.g. `handler.py`). When `auto_compress` is set, Snowflake appends the `.gz` extension to it.",
+	},
+	"source": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: []string{"source", "content"},
+		Description:  "Path to the local file that is uploaded. Changes in the file content are detected during the plan and the file is uploaded again.",
+	},
+	"content": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: []string{"source", "content"},
+		Description:  "Inline content of the uploaded file.",
+	},
+	"auto_compress": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies whether Snowflake compresses the file with gzip during the upload (`AUTO_COMPRESS` option of `PUT`). Unlike in the `PUT` command, the file is uploaded uncompressed by default, so that it can be referenced by its name (e.g. in the `imports` of functions and procedures).",
+	},
+	"content_md5": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "MD5 hash of the local content (`source` or `content`) that was uploaded. It is emptied when the file on the stage was changed outside of Terraform, so that the file is uploaded again.",
+	},
+	"location": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Location of the file on the stage (e.g. `@\"db\".\"schema\".\"stage\"/libs/python/handler.py`), which can be used to reference the file in other objects.",
+	},
+	"md5": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "MD5 hash of the file on the stage, as returned by the `LIST` command.",
+	},
+	"size": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Size of the file on the stage in bytes, as returned by the `LIST` command.",
+	},
+	"last_modified": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Last modification time of the file on the stage, as returned by the `LIST` command.",
+	},
+}
+
+func StageFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StageFileResource), TrackingCreateWrapper(resources.StageFile, CreateStageFile)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StageFileResource), TrackingReadWrapper(resources.StageFile, ReadStageFile)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StageFileResource), TrackingUpdateWrapper(resources.StageFile, UpdateStageFile)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StageFileResource), TrackingDeleteWrapper(resources.StageFile, DeleteStageFile)),
+		Description:   "Resource used to upload a file to an internal stage with the [PUT](https://docs.snowflake.com/en/sql-reference/sql/put) command. The file on the stage is tracked with the [LIST](https://docs.snowflake.com/en/sql-reference/sql/list) command and removed with the [REMOVE](https://docs.snowflake.com/en/sql-reference/sql/remove) command.",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StageFile, customdiff.All(
+			stageFileContentMd5CustomDiff,
+		)),
+
+		Schema: stageFileSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StageFile, ImportStageFile),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+type stageFileId struct {
+	StageId  sdk.SchemaObjectIdentifier
+	Path     string
+	FileName string
+}
+
+func (id stageFileId) String() string {
+	return helpers.EncodeResourceIdentifier(id.StageId.FullyQualifiedName(), id.Path, id.FileName)
+}
+
+// relativePath returns the path of the file relative to the root of the stage.
+func (id stageFileId) relativePath() string {
+	if id.Path == "" {
+		return id.FileName
+	}
+	return id.Path + "/" + id.FileName
+}
+
+func (id stageFileId) directoryLocation() sdk.StageLocation {
+	return sdk.NewStageLocation(id.StageId, id.Path)
+}
+
+func (id stageFileId) fileLocation() sdk.StageLocation {
+	return sdk.NewStageLocation(id.StageId, id.relativePath())
+}
+
+// filePattern matches the file, and its compressed version, in the LIST and REMOVE commands.
+// The location used in these commands is a prefix, so without the pattern files like handler.py.bak would be matched as well.
+func (id stageFileId) filePattern() string {
+	return fmt.Sprintf(`.*%s(\.gz)?`, regexp.QuoteMeta(id.relativePath()))
+}
+
+func parseStageFileId(raw string) (stageFileId, error) {
+	parts := helpers.ParseResourceIdentifier(raw)
+	if len(parts) != 3 {
+		return stageFileId{}, fmt.Errorf("invalid ID specified: %v, expected <stage_name>|<path>|<file_name>", raw)
+	}
+	stageId, err := sdk.ParseSchemaObjectIdentifier(parts[0])
+	if err != nil {
+		return stageFileId{}, err
+	}
+	if parts[2] == "" {
+		return stageFileId{}, fmt.Errorf("invalid ID specified: %v, file name is required", raw)
+	}
+	return stageFileId{
+		StageId:  stageId,
+		Path:     parts[1],
+		FileName: parts[2],
+	}, nil
+}
+
+func validateStageFilePath(value any, _ cty.Path) diag.Diagnostics {
+	path := value.(string)
+	if strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") {
+		return diag.Errorf("path %s cannot start or end with /", path)
+	}
+	return nil
+}
+
+func validateStageFileName(value any, _ cty.Path) diag.Diagnostics {
+	fileName := value.(string)
+	if fileName == "" || strings.Contains(fileName, "/") {
+		return diag.Errorf("file name %s cannot be empty or contain /, use the path field to upload the file to a directory", fileName)
+	}
+	return nil
+}
+
+// stageFileContentMd5CustomDiff computes the hash of the local content, so that changes in the local file are visible in the plan.
+func stageFileContentMd5CustomDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	var content []byte
+	switch {
+	case !diff.NewValueKnown("content") || !diff.NewValueKnown("source"):
+		return stageFileSetContentChanged(diff)
+	case diff.Get("content").(string) != "":
+		content = []byte(diff.Get("content").(string))
+	case diff.Get("source").(string) != "":
+		fileContent, err := os.ReadFile(diff.Get("source").(string))
+		if err != nil {
+			// The file may be created during the apply (e.g. by other resources).
+			if errors.Is(err, os.ErrNotExist) {
+				return stageFileSetContentChanged(diff)
+			}
+			return fmt.Errorf("error reading source file: %w", err)
+		}
+		content = fileContent
+	default:
+		return nil
+	}
+	hash := md5.Sum(content)
+	newContentMd5 := hex.EncodeToString(hash[:])
+	if diff.Get("content_md5").(string) == newContentMd5 {
+		return nil
+	}
+	if err := diff.SetNew("content_md5", newContentMd5); err != nil {
+		return err
+	}
+	return stageFileSetListOutputChanged(diff)
+}
+
+func stageFileSetContentChanged(diff *schema.ResourceDiff) error {
+	if err := diff.SetNewComputed("content_md5"); err != nil {
+		return err
+	}
+	return stageFileSetListOutputChanged(diff)
+}
+
+func stageFileSetListOutputChanged(diff *schema.ResourceDiff) error {
+	return errors.Join(
+		diff.SetNewComputed("md5"),
+		diff.SetNewComputed("size"),
+		diff.SetNewComputed("last_modified"),
+	)
+}
+
+func ImportStageFile(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := parseStageFileId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	client := meta.(*provider.Context).Client
+	stageFile, err := findStageFile(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := errors.Join(
+		d.Set("stage", id.StageId.FullyQualifiedName()),
+		d.Set("path", id.Path),
+		d.Set("file_name", id.FileName),
+		d.Set("auto_compress", stageFileRelativeName(stageFile.Name) != id.relativePath()),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := stageFileId{
+		StageId:  stageId,
+		Path:     d.Get("path").(string),
+		FileName: d.Get("file_name").(string),
+	}
+	if err := putStageFile(ctx, client, d, id); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.String())
+
+	return ReadStageFile(ctx, d, meta)
+}
+
+func putStageFile(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id stageFileId) error {
+	content, contentMd5, err := stageFileContent(d)
+	if err != nil {
+		return err
+	}
+	err = client.StageFiles.PutStream(ctx, content, id.FileName, id.directoryLocation(), &sdk.PutStageFileOptions{
+		AutoCompress: sdk.Bool(d.Get("auto_compress").(bool)),
+		Overwrite:    sdk.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading file %s to stage %s: %w", id.relativePath(), id.StageId.FullyQualifiedName(), err)
+	}
+	return d.Set("content_md5", contentMd5)
+}
+
+// stageFileContent returns the content to upload with its hash. The content is read once, so that the hash matches the uploaded file.
+func stageFileContent(d *schema.ResourceData) (io.Reader, string, error) {
+	var content []byte
+	if v, ok := d.GetOk("content"); ok {
+		content = []byte(v.(string))
+	} else {
+		fileContent, err := os.ReadFile(d.Get("source").(string))
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading source file: %w", err)
+		}
+		content = fileContent
+	}
+	hash := md5.Sum(content)
+	return bytes.NewReader(content), hex.EncodeToString(hash[:]), nil
+}
+
+// stageFileRelativeName strips the stage name (the first part of the name returned by LIST for the internal stages) from the file name.
+func stageFileRelativeName(name string) string {
+	_, relativeName, _ := strings.Cut(name, "/")
+	return relativeName
+}
+
+func findStageFile(ctx context.Context, client *sdk.Client, id stageFileId) (*sdk.StageFile, error) {
+	files, err := client.StageFiles.List(ctx, id.fileLocation(), &sdk.ListStageFilesOptions{Pattern: sdk.String(id.filePattern())})
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if relativeName := stageFileRelativeName(file.Name); relativeName == id.relativePath() || relativeName == id.relativePath()+".gz" {
+			return &file, nil
+		}
+	}
+	return nil, fmt.Errorf("file %s not found in stage %s: %w", id.relativePath(), id.StageId.FullyQualifiedName(), sdk.ErrObjectNotFound)
+}
+
+func ReadStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := parseStageFileId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stageFile, err := findStageFile(ctx, client, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query stage file. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Stage file: %s, Err: %s", id.String(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	stageFileMd5 := ""
+	if stageFile.Md5 != nil {
+		stageFileMd5 = *stageFile.Md5
+	}
+	// The file was replaced outside of Terraform, so the content hash is emptied to upload the file again.
+	if previousMd5 := d.Get("md5").(string); previousMd5 != "" && previousMd5 != stageFileMd5 {
+		log.Printf("[DEBUG] stage file %s was changed outside of Terraform, md5 changed from %s to %s", id.relativePath(), previousMd5, stageFileMd5)
+		if err := d.Set("content_md5", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := errors.Join(
+		d.Set("location", sdk.NewStageLocation(id.StageId, stageFileRelativeName(stageFile.Name)).ToSql()),
+		d.Set("md5", stageFileMd5),
+		d.Set("size", stageFile.Size),
+		d.Set("last_modified", stageFile.LastModified),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := parseStageFileId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("source", "content", "content_md5") {
+		if err := putStageFile(ctx, client, d, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadStageFile(ctx, d, meta)
+}
+
+func DeleteStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := parseStageFileId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.StageFiles.Remove(ctx, id.fileLocation(), &sdk.RemoveStageFilesOptions{Pattern: sdk.String(id.filePattern())}); err != nil {
+		return diag.FromErr(fmt.Errorf("error removing file %s from stage %s: %w", id.relativePath(), id.StageId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
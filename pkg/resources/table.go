@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
@@ -143,6 +145,13 @@ var tableSchema = map[string]*schema.Schema{
 					Default:     "",
 					Description: "Masking policy to apply on column. It has to be a fully qualified name.",
 				},
+				"projection_policy": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "",
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      relatedResourceDescription("Projection policy to apply on column. It has to be a fully qualified name.", resources.ProjectionPolicy),
+				},
 				"collate": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -205,6 +214,48 @@ var tableSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies whether to enable change tracking on the table. Default false.",
 	},
+	"aggregation_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Aggregation policy name.", resources.AggregationPolicy),
+				},
+				"entity_key": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns uniquely identify an entity within the table.",
+				},
+			},
+		},
+		Description: "Specifies the aggregation policy to set on a table.",
+	},
+	"join_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Join policy name.", resources.JoinPolicy),
+				},
+			},
+		},
+		Description: "Specifies the join policy to set on a table.",
+	},
 	"tag":                           tagReferenceSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
@@ -261,14 +312,15 @@ type columnIdentity struct {
 }
 
 type column struct {
-	name          string
-	dataType      string
-	nullable      bool
-	_default      *columnDefault
-	identity      *columnIdentity
-	comment       string
-	maskingPolicy string
-	collate       string
+	name             string
+	dataType         string
+	nullable         bool
+	_default         *columnDefault
+	identity         *columnIdentity
+	comment          string
+	maskingPolicy    string
+	projectionPolicy string
+	collate          string
 }
 
 type columns []column
@@ -276,20 +328,21 @@ type columns []column
 type changedColumns []changedColumn
 
 type changedColumn struct {
-	newColumn             column // our new column
-	changedDataType       bool
-	changedNullConstraint bool
-	droppedDefault        bool
-	changedComment        bool
-	changedMaskingPolicy  bool
-	changedCollate        bool
+	newColumn               column // our new column
+	changedDataType         bool
+	changedNullConstraint   bool
+	droppedDefault          bool
+	changedComment          bool
+	changedMaskingPolicy    bool
+	changedProjectionPolicy bool
+	changedCollate          bool
 }
 
 func (c columns) getChangedColumnProperties(new columns) (changed changedColumns) {
 	changed = changedColumns{}
 	for _, cO := range c {
 		for _, cN := range new {
-			changeColumn := changedColumn{cN, false, false, false, false, false, false}
+			changeColumn := changedColumn{cN, false, false, false, false, false, false, false}
 			if cO.name == cN.name && cO.dataType != cN.dataType {
 				changeColumn.changedDataType = true
 			}
@@ -312,6 +365,10 @@ func (c columns) getChangedColumnProperties(new columns) (changed changedColumns
 				changeColumn.changedCollate = true
 			}
 
+			if cO.name == cN.name && cO.projectionPolicy != cN.projectionPolicy {
+				changeColumn.changedProjectionPolicy = true
+			}
+
 			changed = append(changed, changeColumn)
 		}
 	}
@@ -376,14 +433,15 @@ func getColumn(from interface{}) (to column) {
 	}
 
 	return column{
-		name:          c["name"].(string),
-		dataType:      c["type"].(string),
-		nullable:      c["nullable"].(bool),
-		_default:      cd,
-		identity:      id,
-		comment:       c["comment"].(string),
-		collate:       c["collate"].(string),
-		maskingPolicy: c["masking_policy"].(string),
+		name:             c["name"].(string),
+		dataType:         c["type"].(string),
+		nullable:         c["nullable"].(bool),
+		_default:         cd,
+		identity:         id,
+		comment:          c["comment"].(string),
+		collate:          c["collate"].(string),
+		maskingPolicy:    c["masking_policy"].(string),
+		projectionPolicy: c["projection_policy"].(string),
 	}
 }
 
@@ -486,7 +544,7 @@ func getPrimaryKey(from interface{}) (to primarykey) {
 	return to
 }
 
-func toColumnConfig(descriptions []sdk.TableColumnDetails) []any {
+func toColumnConfig(descriptions []sdk.TableColumnDetails, policyRefs []sdk.PolicyReference) []any {
 	flattened := make([]any, 0)
 	for _, td := range descriptions {
 		if td.Kind != "COLUMN" {
@@ -511,6 +569,17 @@ func toColumnConfig(descriptions []sdk.TableColumnDetails) []any {
 			flat["masking_policy"] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(*td.PolicyName).FullyQualifiedName()
 		}
 
+		projectionPolicy, err := collections.FindFirst(policyRefs, func(r sdk.PolicyReference) bool {
+			return r.PolicyKind == sdk.PolicyKindProjectionPolicy && r.RefColumnName != nil && *r.RefColumnName == td.Name
+		})
+		if err == nil {
+			if projectionPolicy.PolicyDb != nil && projectionPolicy.PolicySchema != nil {
+				flat["projection_policy"] = sdk.NewSchemaObjectIdentifier(*projectionPolicy.PolicyDb, *projectionPolicy.PolicySchema, projectionPolicy.PolicyName).FullyQualifiedName()
+			} else {
+				log.Printf("[DEBUG] could not store projection policy name: policy db and schema can not be empty")
+			}
+		}
+
 		identity := toColumnIdentityConfig(td)
 		if identity != nil {
 			flat["identity"] = []any{identity}
@@ -646,9 +715,93 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
+	for _, c := range getColumns(d.Get("column")) {
+		if c.projectionPolicy != "" {
+			columnAction := sdk.NewTableColumnActionRequest().WithSetProjectionPolicy(sdk.NewTableColumnAlterSetProjectionPolicyActionRequest(sdk.QuoteIdentifierPart(c.name), sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(c.projectionPolicy)))
+			if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(columnAction)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting projection policy on column %v err = %w", c.name, err))
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("aggregation_policy"); ok {
+		if err := setTableAggregationPolicy(ctx, client, id, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("join_policy"); ok {
+		if err := setTableJoinPolicy(ctx, client, id, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadTable(ctx, d, meta)
 }
 
+func setTableAggregationPolicy(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, v any) error {
+	policyId, entityKey, err := extractPolicyWithColumnsSet(v, "entity_key")
+	if err != nil {
+		return err
+	}
+	request := sdk.NewTableSetAggregationPolicyRequest(policyId).WithForce(sdk.Bool(true))
+	if len(entityKey) > 0 {
+		request.WithEntityKey(collections.Map(entityKey, func(c sdk.Column) string { return c.Value }))
+	}
+	return client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetAggregationPolicy(request))
+}
+
+func setTableJoinPolicy(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, v any) error {
+	policyId, err := sdk.ParseSchemaObjectIdentifier(v.([]any)[0].(map[string]any)["policy_name"].(string))
+	if err != nil {
+		return err
+	}
+	return client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetJoinPolicy(sdk.NewTableSetJoinPolicyRequest(policyId).WithForce(sdk.Bool(true))))
+}
+
+// tableHasPolicies checks if the aggregation, join, or projection policies are set in the configuration or in the state.
+// The policy references are read only in this case, because the POLICY_REFERENCES table function requires additional
+// privileges and a running warehouse, which should not be required from the tables that do not use these policies.
+func tableHasPolicies(d *schema.ResourceData) bool {
+	if len(d.Get("aggregation_policy").([]any)) > 0 || len(d.Get("join_policy").([]any)) > 0 {
+		return true
+	}
+	return slices.ContainsFunc(d.Get("column").([]any), func(column any) bool {
+		c, ok := column.(map[string]any)
+		return ok && c["projection_policy"] != ""
+	})
+}
+
+func handleTablePolicyReferences(policyRefs []sdk.PolicyReference, d *schema.ResourceData) error {
+	var aggregationPolicies []map[string]any
+	var joinPolicies []map[string]any
+	for _, p := range policyRefs {
+		if p.PolicyDb == nil || p.PolicySchema == nil {
+			continue
+		}
+		policyName := sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName)
+		switch p.PolicyKind {
+		case sdk.PolicyKindAggregationPolicy:
+			var entityKey []string
+			if p.RefArgColumnNames != nil {
+				entityKey = sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true)
+			}
+			aggregationPolicies = append(aggregationPolicies, map[string]any{
+				"policy_name": policyName.FullyQualifiedName(),
+				"entity_key":  entityKey,
+			})
+		case sdk.PolicyKindJoinPolicy:
+			joinPolicies = append(joinPolicies, map[string]any{
+				"policy_name": policyName.FullyQualifiedName(),
+			})
+		}
+	}
+	return errors.Join(
+		d.Set("aggregation_policy", aggregationPolicies),
+		d.Set("join_policy", joinPolicies),
+	)
+}
+
 func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
@@ -697,6 +850,17 @@ func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		return diag.FromErr(err)
 	}
 
+	var policyRefs []sdk.PolicyReference
+	if tableHasPolicies(d) {
+		policyRefs, err = client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainTable))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("getting policy references for table: %w", err))
+		}
+		if err := handleTablePolicyReferences(policyRefs, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Set the relevant data in the state
 	toSet := map[string]interface{}{
 		"name":            table.Name,
@@ -704,7 +868,7 @@ func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		"database":        table.DatabaseName,
 		"schema":          table.SchemaName,
 		"comment":         table.Comment,
-		"column":          toColumnConfig(tableDescription),
+		"column":          toColumnConfig(tableDescription, policyRefs),
 		"cluster_by":      table.GetClusterByKeys(),
 		"change_tracking": table.ChangeTracking,
 	}
@@ -852,6 +1016,14 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("error adding column: %w", err))
 			}
+
+			if cA.projectionPolicy != "" {
+				columnAction := sdk.NewTableColumnActionRequest().WithSetProjectionPolicy(sdk.NewTableColumnAlterSetProjectionPolicyActionRequest(sdk.QuoteIdentifierPart(cA.name), sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.projectionPolicy)))
+				err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(columnAction))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error setting projection policy on column %v: err %w", cA.name, err))
+				}
+			}
 		}
 		for _, cA := range changed {
 			if cA.changedDataType || cA.changedCollate {
@@ -907,6 +1079,18 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
 				}
 			}
+			if cA.changedProjectionPolicy {
+				columnAction := sdk.NewTableColumnActionRequest()
+				if strings.TrimSpace(cA.newColumn.projectionPolicy) == "" {
+					columnAction.WithUnsetProjectionPolicy(sdk.NewTableColumnAlterUnsetProjectionPolicyActionRequest(sdk.QuoteIdentifierPart(cA.newColumn.name)))
+				} else {
+					columnAction.WithSetProjectionPolicy(sdk.NewTableColumnAlterSetProjectionPolicyActionRequest(sdk.QuoteIdentifierPart(cA.newColumn.name), sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.newColumn.projectionPolicy)).WithForce(sdk.Bool(true)))
+				}
+				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(columnAction))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
+				}
+			}
 		}
 	}
 
@@ -941,6 +1125,30 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		}
 	}
 
+	if d.HasChange("aggregation_policy") {
+		if v, ok := d.GetOk("aggregation_policy"); ok {
+			if err := setTableAggregationPolicy(ctx, client, id, v); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting aggregation policy on %v, err = %w", d.Id(), err))
+			}
+		} else {
+			if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetAggregationPolicy(sdk.Bool(true))); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting aggregation policy on %v, err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("join_policy") {
+		if v, ok := d.GetOk("join_policy"); ok {
+			if err := setTableJoinPolicy(ctx, client, id, v); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting join policy on %v, err = %w", d.Id(), err))
+			}
+		} else {
+			if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetJoinPolicy(sdk.Bool(true))); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting join policy on %v, err = %w", d.Id(), err))
+			}
+		}
+	}
+
 	if d.HasChange("tag") {
 		unsetTags, setTags := GetTagsDiff(d, "tag")
 
@@ -0,0 +1,708 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var taskGraphTaskSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: blocklistedCharactersFieldDescription("Specifies the identifier for the task; must be unique for the database and schema in which the task graph is created."),
+	},
+	"sql_statement": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Any single SQL statement, or a call to a stored procedure, executed when the task runs.",
+	},
+	"warehouse": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		Description:      relatedResourceDescription("The warehouse the task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.", resources.Warehouse),
+	},
+	"when": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a Boolean SQL expression; multiple conditions joined with AND/OR are supported. If the conditions of the expression are not met, then the task skips the current run.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the task.",
+	},
+}
+
+var taskGraphSchema = map[string]*schema.Schema{
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the tasks of the task graph."),
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the tasks of the task graph."),
+	},
+	"started": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies if the task graph should be started or suspended. The task graph is started with `SYSTEM$TASK_DEPENDENTS_ENABLE`, which resumes all the tasks in the graph.",
+	},
+	"root": {
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: "The root task of the task graph.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: blocklistedCharactersFieldDescription("Specifies the identifier for the root task. Changing it recreates the whole task graph."),
+				},
+				"sql_statement": taskGraphTaskSchema["sql_statement"],
+				"warehouse":     taskGraphTaskSchema["warehouse"],
+				"schedule": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "The schedule for periodically running the task graph. This can be a cron or interval in minutes. (when set, one of the sub-fields `minutes` or `using_cron` should be set)",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"minutes": {
+								Type:             schema.TypeInt,
+								Optional:         true,
+								Description:      "Specifies an interval (in minutes) of wait time inserted between runs of the task graph. Accepts positive integers only. (conflicts with `using_cron`)",
+								ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+								ExactlyOneOf:     []string{"root.0.schedule.0.minutes", "root.0.schedule.0.using_cron"},
+							},
+							"using_cron": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "Specifies a cron expression and time zone for periodically running the task graph. Supports a subset of standard cron utility syntax. (conflicts with `minutes`)",
+								DiffSuppressFunc: ignoreCaseSuppressFunc,
+								ExactlyOneOf:     []string{"root.0.schedule.0.minutes", "root.0.schedule.0.using_cron"},
+							},
+						},
+					},
+				},
+				"config": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a string representation of key value pairs that can be accessed by all tasks in the task graph. Must be in JSON format.",
+				},
+				"allow_overlapping_execution": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "By default, Snowflake ensures that only one instance of a particular task graph is allowed to run at a time, setting the parameter value to true permits task graph runs to overlap.",
+				},
+				"error_integration": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("Specifies the name of the notification integration used for error notifications."), resources.NotificationIntegration),
+				},
+				"when":    taskGraphTaskSchema["when"],
+				"comment": taskGraphTaskSchema["comment"],
+			},
+		},
+	},
+	"task": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The child tasks of the task graph. The tasks are kept in a set and identified by their `name` (unique in the task graph), in the schema of the root task; the dependencies between them are defined with `after`. The task graph cannot contain tasks that are after the tasks from other schemas.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name":          taskGraphTaskSchema["name"],
+				"sql_statement": taskGraphTaskSchema["sql_statement"],
+				"after": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Names of the predecessor tasks of the task; each of them has to be the root task or another task in the task graph. The task graph cannot contain cycles.",
+				},
+				"warehouse": taskGraphTaskSchema["warehouse"],
+				"when":      taskGraphTaskSchema["when"],
+				"comment":   taskGraphTaskSchema["comment"],
+			},
+		},
+	},
+	"finalizer": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The finalizer task of the task graph. It runs after all other tasks in the task graph run to completion. For more information, see [Release and cleanup of task graphs](https://docs.snowflake.com/en/user-guide/tasks-graphs.html#label-finalizer-task).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name":          taskGraphTaskSchema["name"],
+				"sql_statement": taskGraphTaskSchema["sql_statement"],
+				"warehouse":     taskGraphTaskSchema["warehouse"],
+				"comment":       taskGraphTaskSchema["comment"],
+			},
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func TaskGraph() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.TaskGraphResource), TrackingCreateWrapper(resources.TaskGraph, CreateTaskGraph)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.TaskGraphResource), TrackingReadWrapper(resources.TaskGraph, ReadTaskGraph)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.TaskGraphResource), TrackingUpdateWrapper(resources.TaskGraph, UpdateTaskGraph)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.TaskGraphResource), TrackingDeleteWrapper(resources.TaskGraph, DeleteTaskGraph)),
+		Description:   "Resource used to manage a whole task graph (a root task, its child tasks, and a finalizer task) at once. The root task is suspended once before the changes, all the tasks are applied with `CREATE OR ALTER TASK`, and the graph is resumed with `SYSTEM$TASK_DEPENDENTS_ENABLE`. For more information, check [task graphs documentation](https://docs.snowflake.com/en/user-guide/tasks-graphs).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.TaskGraph, customdiff.All(
+			ComputedIfAnyAttributeChanged(taskGraphSchema, FullyQualifiedNameAttributeName, "root"),
+			taskGraphValidationCustomDiff,
+		)),
+
+		Schema: taskGraphSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.TaskGraph, ImportTaskGraph),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+type taskGraphTask struct {
+	Name         string
+	SqlStatement string
+	After        []string
+	Warehouse    string
+	When         string
+	Comment      string
+}
+
+type taskGraphRootTask struct {
+	taskGraphTask
+	Schedule                  string
+	Config                    string
+	AllowOverlappingExecution bool
+	ErrorIntegration          string
+}
+
+func expandTaskGraphTask(v any) taskGraphTask {
+	m := v.(map[string]any)
+	task := taskGraphTask{
+		Name:         m["name"].(string),
+		SqlStatement: m["sql_statement"].(string),
+		Warehouse:    m["warehouse"].(string),
+		Comment:      m["comment"].(string),
+	}
+	if when, ok := m["when"]; ok {
+		task.When = when.(string)
+	}
+	if after, ok := m["after"]; ok {
+		task.After = expandStringList(after.(*schema.Set).List())
+		slices.Sort(task.After)
+	}
+	return task
+}
+
+func expandTaskGraphRootTask(v any) (*taskGraphRootTask, error) {
+	list := v.([]any)
+	if len(list) == 0 || list[0] == nil {
+		return nil, errors.New("root task is required")
+	}
+	m := list[0].(map[string]any)
+	root := &taskGraphRootTask{
+		taskGraphTask:             expandTaskGraphTask(m),
+		Config:                    m["config"].(string),
+		AllowOverlappingExecution: m["allow_overlapping_execution"].(bool),
+		ErrorIntegration:          m["error_integration"].(string),
+	}
+	if schedule := m["schedule"].([]any); len(schedule) > 0 && schedule[0] != nil {
+		scheduleMap := schedule[0].(map[string]any)
+		switch {
+		case scheduleMap["minutes"].(int) > 0:
+			root.Schedule = fmt.Sprintf("%d MINUTE", scheduleMap["minutes"].(int))
+		case scheduleMap["using_cron"].(string) != "":
+			root.Schedule = fmt.Sprintf("USING CRON %s", scheduleMap["using_cron"].(string))
+		default:
+			return nil, errors.New("when setting a schedule either minutes or using_cron field should be set")
+		}
+	}
+	return root, nil
+}
+
+func expandTaskGraphTasks(v any) []taskGraphTask {
+	return expandTaskGraphTaskList(v.(*schema.Set).List())
+}
+
+func expandTaskGraphTaskList(list []any) []taskGraphTask {
+	tasks := make([]taskGraphTask, 0, len(list))
+	for _, item := range list {
+		if item != nil {
+			tasks = append(tasks, expandTaskGraphTask(item))
+		}
+	}
+	return tasks
+}
+
+func expandTaskGraphFinalizer(v any) *taskGraphTask {
+	if tasks := expandTaskGraphTaskList(v.([]any)); len(tasks) > 0 {
+		return &tasks[0]
+	}
+	return nil
+}
+
+// sortTaskGraph validates the task graph and returns the child tasks in the topological order (every task after all of its predecessors).
+// The tasks without dependencies between them are sorted by name, so that the order is deterministic.
+func sortTaskGraph(rootName string, tasks []taskGraphTask, finalizer *taskGraphTask) ([]taskGraphTask, error) {
+	var errs []error
+	tasksByName := make(map[string]taskGraphTask, len(tasks))
+	for _, task := range tasks {
+		if task.Name == rootName {
+			errs = append(errs, fmt.Errorf("task %s has the same name as the root task", task.Name))
+		}
+		if finalizer != nil && task.Name == finalizer.Name {
+			errs = append(errs, fmt.Errorf("task %s has the same name as the finalizer task", task.Name))
+		}
+		if _, ok := tasksByName[task.Name]; ok {
+			errs = append(errs, fmt.Errorf("task %s is defined more than once", task.Name))
+		}
+		tasksByName[task.Name] = task
+	}
+	if finalizer != nil && finalizer.Name == rootName {
+		errs = append(errs, fmt.Errorf("finalizer task %s has the same name as the root task", finalizer.Name))
+	}
+	for _, task := range tasks {
+		for _, predecessor := range task.After {
+			if _, ok := tasksByName[predecessor]; !ok && predecessor != rootName {
+				errs = append(errs, fmt.Errorf("task %s is after %s, which is neither the root task nor a task in the task graph", task.Name, predecessor))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	sorted := make([]taskGraphTask, 0, len(tasks))
+	done := map[string]bool{rootName: true}
+	for len(sorted) < len(tasks) {
+		var ready []taskGraphTask
+		for _, task := range tasks {
+			if !done[task.Name] && !slices.ContainsFunc(task.After, func(predecessor string) bool { return !done[predecessor] }) {
+				ready = append(ready, task)
+			}
+		}
+		if len(ready) == 0 {
+			var cycle []string
+			for _, task := range tasks {
+				if !done[task.Name] {
+					cycle = append(cycle, task.Name)
+				}
+			}
+			slices.Sort(cycle)
+			return nil, fmt.Errorf("task graph contains a cycle between the tasks: %s", strings.Join(cycle, ", "))
+		}
+		slices.SortFunc(ready, func(a, b taskGraphTask) int { return strings.Compare(a.Name, b.Name) })
+		for _, task := range ready {
+			done[task.Name] = true
+		}
+		sorted = append(sorted, ready...)
+	}
+	return sorted, nil
+}
+
+// taskGraphValidationCustomDiff validates the task graph during the plan; the validation is skipped until all the names are known.
+func taskGraphValidationCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsWhollyKnown() {
+		return nil
+	}
+	root, err := expandTaskGraphRootTask(diff.Get("root"))
+	if err != nil {
+		return err
+	}
+	_, err = sortTaskGraph(root.Name, expandTaskGraphTasks(diff.Get("task")), expandTaskGraphFinalizer(diff.Get("finalizer")))
+	return err
+}
+
+func taskGraphWarehouse(warehouse string) (*sdk.CreateTaskWarehouseRequest, error) {
+	warehouseId, err := sdk.ParseAccountObjectIdentifier(warehouse)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewCreateTaskWarehouseRequest().WithWarehouse(warehouseId), nil
+}
+
+func taskGraphCreateOrAlterRequest(id sdk.SchemaObjectIdentifier, task taskGraphTask) (*sdk.CreateOrAlterTaskRequest, error) {
+	req := sdk.NewCreateOrAlterTaskRequest(id, task.SqlStatement)
+	if task.Warehouse != "" {
+		warehouse, err := taskGraphWarehouse(task.Warehouse)
+		if err != nil {
+			return nil, err
+		}
+		req.WithWarehouse(*warehouse)
+	}
+	if task.When != "" {
+		req.WithWhen(task.When)
+	}
+	if task.Comment != "" {
+		req.WithComment(task.Comment)
+	}
+	return req, nil
+}
+
+// applyTaskGraph suspends the root task once, applies all the tasks in the topological order, drops the removed tasks, and resumes the whole graph.
+func applyTaskGraph(ctx context.Context, client *sdk.Client, d *schema.ResourceData) (err error) {
+	databaseName, schemaName := d.Get("database").(string), d.Get("schema").(string)
+	taskId := func(name string) sdk.SchemaObjectIdentifier {
+		return sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+	}
+
+	root, err := expandTaskGraphRootTask(d.Get("root"))
+	if err != nil {
+		return err
+	}
+	finalizer := expandTaskGraphFinalizer(d.Get("finalizer"))
+	sortedTasks, err := sortTaskGraph(root.Name, expandTaskGraphTasks(d.Get("task")), finalizer)
+	if err != nil {
+		return err
+	}
+	rootId := taskId(root.Name)
+
+	if d.Id() != "" {
+		rootTask, err := client.Tasks.ShowByID(ctx, rootId)
+		if err != nil {
+			return err
+		}
+		if rootTask.IsStarted() {
+			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(rootId).WithSuspend(true)); err != nil {
+				return fmt.Errorf("error suspending root task %s: %w", rootId.FullyQualifiedName(), err)
+			}
+		}
+	}
+
+	// The task graph is started also when applying it fails, so that it's not left suspended. The id is set only after the root task exists.
+	defer func() {
+		if d.Id() != "" && d.Get("started").(bool) {
+			if resumeErr := client.SystemFunctions.TaskDependentsEnable(ctx, rootId); resumeErr != nil {
+				err = errors.Join(err, fmt.Errorf("error resuming task graph %s: %w", rootId.FullyQualifiedName(), resumeErr))
+			}
+		}
+	}()
+
+	// The root task can have only one finalizer, so the previous one has to be dropped before the new one is created.
+	oldFinalizer, _ := d.GetChange("finalizer")
+	if previous := expandTaskGraphFinalizer(oldFinalizer); previous != nil && (finalizer == nil || finalizer.Name != previous.Name) {
+		if err := client.Tasks.DropSafely(ctx, taskId(previous.Name)); err != nil {
+			return fmt.Errorf("error dropping finalizer task %s: %w", taskId(previous.Name).FullyQualifiedName(), err)
+		}
+	}
+
+	rootReq, err := taskGraphCreateOrAlterRequest(rootId, root.taskGraphTask)
+	if err != nil {
+		return err
+	}
+	rootReq.WithAllowOverlappingExecution(root.AllowOverlappingExecution)
+	if root.Schedule != "" {
+		rootReq.WithSchedule(root.Schedule)
+	}
+	if root.Config != "" {
+		rootReq.WithConfig(root.Config)
+	}
+	if root.ErrorIntegration != "" {
+		errorIntegrationId, err := sdk.ParseAccountObjectIdentifier(root.ErrorIntegration)
+		if err != nil {
+			return err
+		}
+		rootReq.WithErrorIntegration(errorIntegrationId)
+	}
+	if err := client.Tasks.CreateOrAlter(ctx, rootReq); err != nil {
+		return fmt.Errorf("error applying root task %s: %w", rootId.FullyQualifiedName(), err)
+	}
+	if d.Id() == "" {
+		d.SetId(helpers.EncodeResourceIdentifier(rootId))
+	}
+
+	for _, task := range sortedTasks {
+		req, err := taskGraphCreateOrAlterRequest(taskId(task.Name), task)
+		if err != nil {
+			return err
+		}
+		req.WithAfter(collections.Map(task.After, taskId))
+		if err := client.Tasks.CreateOrAlter(ctx, req); err != nil {
+			return fmt.Errorf("error applying task %s: %w", taskId(task.Name).FullyQualifiedName(), err)
+		}
+	}
+
+	if finalizer != nil {
+		req, err := taskGraphCreateOrAlterRequest(taskId(finalizer.Name), *finalizer)
+		if err != nil {
+			return err
+		}
+		if err := client.Tasks.CreateOrAlter(ctx, req.WithFinalize(rootId)); err != nil {
+			return fmt.Errorf("error applying finalizer task %s: %w", taskId(finalizer.Name).FullyQualifiedName(), err)
+		}
+	}
+
+	oldTasks, _ := d.GetChange("task")
+	for _, task := range expandTaskGraphTasks(oldTasks) {
+		if !slices.ContainsFunc(sortedTasks, func(t taskGraphTask) bool { return t.Name == task.Name }) {
+			if err := client.Tasks.DropSafely(ctx, taskId(task.Name)); err != nil {
+				return fmt.Errorf("error dropping task %s: %w", taskId(task.Name).FullyQualifiedName(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ImportTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := errors.Join(
+		d.Set("database", id.DatabaseName()),
+		d.Set("schema", id.SchemaName()),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	if err := applyTaskGraph(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return ReadTaskGraph(ctx, d, meta)
+}
+
+func UpdateTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	if err := applyTaskGraph(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return ReadTaskGraph(ctx, d, meta)
+}
+
+// taskGraphValueOrState returns the value from the state when it is equal to the value from Snowflake, so that the formatting from the configuration is kept.
+func taskGraphValueOrState(stateValue string, snowflakeValue string, equal schema.SchemaDiffSuppressFunc) string {
+	if equal("", stateValue, snowflakeValue, nil) {
+		return stateValue
+	}
+	return snowflakeValue
+}
+
+func flattenTaskGraphTask(task *sdk.Task, stateTask taskGraphTask) map[string]any {
+	var warehouse string
+	if task.Warehouse != nil {
+		warehouse = taskGraphValueOrState(stateTask.Warehouse, task.Warehouse.Name(), suppressIdentifierQuoting)
+	}
+	return map[string]any{
+		"name":          task.Name,
+		"sql_statement": taskGraphValueOrState(stateTask.SqlStatement, task.Definition, DiffSuppressStatement),
+		"warehouse":     warehouse,
+		"when":          taskGraphValueOrState(stateTask.When, task.Condition, DiffSuppressStatement),
+		"comment":       task.Comment,
+	}
+}
+
+// taskGraphChildTasks returns the tasks from the same schema that depend (directly or indirectly) on the root task, sorted by name.
+func taskGraphChildTasks(rootId sdk.SchemaObjectIdentifier, tasks []sdk.Task) []sdk.Task {
+	inGraph := map[string]bool{rootId.Name(): true}
+	var children []sdk.Task
+	for added := true; added; {
+		added = false
+		for _, task := range tasks {
+			if inGraph[task.Name] {
+				continue
+			}
+			if slices.ContainsFunc(task.TaskRelations.Predecessors, func(predecessor sdk.SchemaObjectIdentifier) bool {
+				return predecessor.DatabaseName() == rootId.DatabaseName() && predecessor.SchemaName() == rootId.SchemaName() && inGraph[predecessor.Name()]
+			}) {
+				inGraph[task.Name] = true
+				children = append(children, task)
+				added = true
+			}
+		}
+	}
+	slices.SortFunc(children, func(a, b sdk.Task) int { return strings.Compare(a.Name, b.Name) })
+	return children
+}
+
+// taskGraphTaskAfter returns the names of the predecessors of the child task. The tasks in the task graph are identified by their names
+// in the schema of the root task, so the predecessors from other schemas can't be represented in the task graph and are rejected.
+func taskGraphTaskAfter(rootId sdk.SchemaObjectIdentifier, task sdk.Task) ([]string, error) {
+	after := make([]string, len(task.TaskRelations.Predecessors))
+	for i, predecessor := range task.TaskRelations.Predecessors {
+		if predecessor.DatabaseName() != rootId.DatabaseName() || predecessor.SchemaName() != rootId.SchemaName() {
+			return nil, fmt.Errorf("task %s is after %s, which is not in the schema of the task graph; the task graph can contain only the tasks from the schema %s", task.ID().FullyQualifiedName(), predecessor.FullyQualifiedName(), rootId.SchemaId().FullyQualifiedName())
+		}
+		after[i] = predecessor.Name()
+	}
+	return after, nil
+}
+
+func ReadTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rootTask, err := client.Tasks.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query root task. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Task id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	tasks, err := client.Tasks.Show(ctx, sdk.NewShowTaskRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Schema: id.SchemaId()}}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var stateRoot taskGraphRootTask
+	if root, err := expandTaskGraphRootTask(d.Get("root")); err == nil {
+		stateRoot = *root
+	}
+	stateTasks := make(map[string]taskGraphTask)
+	for _, task := range expandTaskGraphTasks(d.Get("task")) {
+		stateTasks[task.Name] = task
+	}
+	var stateFinalizer taskGraphTask
+	if finalizer := expandTaskGraphFinalizer(d.Get("finalizer")); finalizer != nil {
+		stateFinalizer = *finalizer
+	}
+
+	root := flattenTaskGraphTask(rootTask, stateRoot.taskGraphTask)
+	root["config"] = taskGraphValueOrState(stateRoot.Config, rootTask.Config, DiffSuppressStatement)
+	root["allow_overlapping_execution"] = rootTask.AllowOverlappingExecution
+	root["error_integration"] = ""
+	if rootTask.ErrorIntegration != nil {
+		root["error_integration"] = taskGraphValueOrState(stateRoot.ErrorIntegration, rootTask.ErrorIntegration.Name(), suppressIdentifierQuoting)
+	}
+	root["schedule"] = nil
+	if len(rootTask.Schedule) > 0 {
+		taskSchedule, err := sdk.ParseTaskSchedule(rootTask.Schedule)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		switch {
+		case len(taskSchedule.Cron) > 0:
+			root["schedule"] = []any{map[string]any{"using_cron": taskSchedule.Cron}}
+		case taskSchedule.Minutes > 0:
+			root["schedule"] = []any{map[string]any{"minutes": taskSchedule.Minutes}}
+		}
+	}
+
+	childTasks := taskGraphChildTasks(id, tasks)
+	flattenedTasks := make([]map[string]any, len(childTasks))
+	for i, task := range childTasks {
+		after, err := taskGraphTaskAfter(id, task)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		flattenedTasks[i] = flattenTaskGraphTask(&task, stateTasks[task.Name])
+		flattenedTasks[i]["after"] = after
+	}
+
+	var flattenedFinalizer []map[string]any
+	if rootTask.TaskRelations.FinalizerTask != nil {
+		finalizerTask, err := client.Tasks.ShowByIDSafely(ctx, *rootTask.TaskRelations.FinalizerTask)
+		if err != nil && !errors.Is(err, sdk.ErrObjectNotFound) {
+			return diag.FromErr(err)
+		}
+		if finalizerTask != nil {
+			finalizer := flattenTaskGraphTask(finalizerTask, stateFinalizer)
+			delete(finalizer, "when")
+			flattenedFinalizer = []map[string]any{finalizer}
+		}
+	}
+
+	if errs := errors.Join(
+		d.Set("database", rootTask.DatabaseName),
+		d.Set("schema", rootTask.SchemaName),
+		d.Set("started", rootTask.IsStarted()),
+		d.Set("root", []map[string]any{root}),
+		d.Set("task", flattenedTasks),
+		d.Set("finalizer", flattenedFinalizer),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func DeleteTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	taskId := func(name string) sdk.SchemaObjectIdentifier {
+		return sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), name)
+	}
+
+	rootTask, err := client.Tasks.ShowByIDSafely(ctx, id)
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotFound) {
+		return diag.FromErr(err)
+	}
+	if rootTask != nil && rootTask.IsStarted() {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithSuspend(true)); err != nil {
+			return diag.FromErr(fmt.Errorf("error suspending root task %s: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	// The tasks are dropped starting from the leaves, so that none of the remaining tasks becomes a standalone task.
+	tasksToDrop := make([]sdk.SchemaObjectIdentifier, 0)
+	if finalizer := expandTaskGraphFinalizer(d.Get("finalizer")); finalizer != nil {
+		tasksToDrop = append(tasksToDrop, taskId(finalizer.Name))
+	}
+	tasks := expandTaskGraphTasks(d.Get("task"))
+	if sortedTasks, err := sortTaskGraph(id.Name(), tasks, nil); err == nil {
+		tasks = sortedTasks
+	}
+	for i := len(tasks) - 1; i >= 0; i-- {
+		tasksToDrop = append(tasksToDrop, taskId(tasks[i].Name))
+	}
+	tasksToDrop = append(tasksToDrop, id)
+
+	for _, taskToDrop := range tasksToDrop {
+		if err := client.Tasks.DropSafely(ctx, taskToDrop); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting task %s err = %w", taskToDrop.FullyQualifiedName(), err))
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,118 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortTaskGraph(t *testing.T) {
+	task := func(name string, after ...string) taskGraphTask {
+		return taskGraphTask{Name: name, After: after}
+	}
+	names := func(tasks []taskGraphTask) []string {
+		return collections.Map(tasks, func(task taskGraphTask) string { return task.Name })
+	}
+
+	t.Run("no child tasks", func(t *testing.T) {
+		sorted, err := sortTaskGraph("root", nil, nil)
+
+		require.NoError(t, err)
+		require.Empty(t, sorted)
+	})
+
+	t.Run("tasks sorted topologically and by name", func(t *testing.T) {
+		tasks := []taskGraphTask{
+			task("d", "b", "c"),
+			task("c", "root"),
+			task("b", "root"),
+			task("e", "a"),
+			task("a", "d"),
+		}
+
+		sorted, err := sortTaskGraph("root", tasks, &taskGraphTask{Name: "finalizer"})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "c", "d", "a", "e"}, names(sorted))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		tasks := []taskGraphTask{
+			task("a", "root"),
+			task("b", "a", "d"),
+			task("c", "b"),
+			task("d", "c"),
+		}
+
+		_, err := sortTaskGraph("root", tasks, nil)
+
+		require.ErrorContains(t, err, "task graph contains a cycle between the tasks: b, c, d")
+	})
+
+	t.Run("task after itself", func(t *testing.T) {
+		_, err := sortTaskGraph("root", []taskGraphTask{task("a", "a")}, nil)
+
+		require.ErrorContains(t, err, "task graph contains a cycle between the tasks: a")
+	})
+
+	t.Run("unknown predecessor", func(t *testing.T) {
+		_, err := sortTaskGraph("root", []taskGraphTask{task("a", "root"), task("b", "x")}, nil)
+
+		require.ErrorContains(t, err, "task b is after x, which is neither the root task nor a task in the task graph")
+	})
+
+	t.Run("finalizer as predecessor", func(t *testing.T) {
+		_, err := sortTaskGraph("root", []taskGraphTask{task("a", "finalizer")}, &taskGraphTask{Name: "finalizer"})
+
+		require.ErrorContains(t, err, "task a is after finalizer, which is neither the root task nor a task in the task graph")
+	})
+
+	t.Run("duplicated names", func(t *testing.T) {
+		tasks := []taskGraphTask{
+			task("a", "root"),
+			task("a", "root"),
+			task("root", "a"),
+			task("finalizer", "root"),
+		}
+
+		_, err := sortTaskGraph("root", tasks, &taskGraphTask{Name: "finalizer"})
+
+		require.ErrorContains(t, err, "task a is defined more than once")
+		require.ErrorContains(t, err, "task root has the same name as the root task")
+		require.ErrorContains(t, err, "task finalizer has the same name as the finalizer task")
+	})
+
+	t.Run("finalizer with the root name", func(t *testing.T) {
+		_, err := sortTaskGraph("root", nil, &taskGraphTask{Name: "root"})
+
+		require.ErrorContains(t, err, "finalizer task root has the same name as the root task")
+	})
+}
+
+func TestTaskGraphTaskAfter(t *testing.T) {
+	rootId := sdk.NewSchemaObjectIdentifier("database", "schema", "root")
+	task := func(predecessors ...sdk.SchemaObjectIdentifier) sdk.Task {
+		return sdk.Task{DatabaseName: "database", SchemaName: "schema", Name: "task", TaskRelations: sdk.TaskRelations{Predecessors: predecessors}}
+	}
+
+	t.Run("predecessors from the schema of the task graph", func(t *testing.T) {
+		after, err := taskGraphTaskAfter(rootId, task(rootId, sdk.NewSchemaObjectIdentifier("database", "schema", "other")))
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"root", "other"}, after)
+	})
+
+	t.Run("predecessor from another schema", func(t *testing.T) {
+		_, err := taskGraphTaskAfter(rootId, task(rootId, sdk.NewSchemaObjectIdentifier("database", "other_schema", "root")))
+
+		require.ErrorContains(t, err, `task "database"."schema"."task" is after "database"."other_schema"."root", which is not in the schema of the task graph`)
+	})
+
+	t.Run("predecessor from another database", func(t *testing.T) {
+		_, err := taskGraphTaskAfter(rootId, task(sdk.NewSchemaObjectIdentifier("other_database", "schema", "root")))
+
+		require.ErrorContains(t, err, `task "database"."schema"."task" is after "other_database"."schema"."root", which is not in the schema of the task graph`)
+	})
+}
@@ -0,0 +1,35 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeAggregationPolicySchema represents output of DESCRIBE query for the single AggregationPolicy.
+var DescribeAggregationPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"return_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func AggregationPolicyDescriptionToSchema(aggregationPolicyDescription sdk.AggregationPolicyDescription) map[string]any {
+	aggregationPolicySchema := make(map[string]any)
+	aggregationPolicySchema["name"] = aggregationPolicyDescription.Name
+	aggregationPolicySchema["signature"] = aggregationPolicyDescription.Signature
+	aggregationPolicySchema["return_type"] = aggregationPolicyDescription.ReturnType
+	aggregationPolicySchema["body"] = aggregationPolicyDescription.Body
+	return aggregationPolicySchema
+}
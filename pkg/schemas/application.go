@@ -0,0 +1,29 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ApplicationDescribeSchema represents output of DESCRIBE query for the single Application.
+var ApplicationDescribeSchema = map[string]*schema.Schema{
+	"property": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"value": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func ApplicationPropertiesToSchema(properties []sdk.ApplicationProperty) []map[string]any {
+	result := make([]map[string]any, len(properties))
+	for i, property := range properties {
+		result[i] = map[string]any{
+			"property": property.Property,
+			"value":    property.Value,
+		}
+	}
+	return result
+}
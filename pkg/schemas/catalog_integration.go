@@ -0,0 +1,52 @@
+package schemas
+
+import (
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeCatalogIntegrationSchema represents output of DESCRIBE query for the single CatalogIntegration.
+var DescribeCatalogIntegrationSchema = map[string]*schema.Schema{
+	"catalog_source":           DescribePropertyListSchema,
+	"table_format":             DescribePropertyListSchema,
+	"catalog_namespace":        DescribePropertyListSchema,
+	"enabled":                  DescribePropertyListSchema,
+	"refresh_interval_seconds": DescribePropertyListSchema,
+	"comment":                  DescribePropertyListSchema,
+	"glue_aws_role_arn":        DescribePropertyListSchema,
+	"glue_catalog_id":          DescribePropertyListSchema,
+	"glue_region":              DescribePropertyListSchema,
+	"glue_aws_iam_user_arn":    DescribePropertyListSchema,
+	"glue_aws_external_id":     DescribePropertyListSchema,
+	"rest_config":              DescribePropertyListSchema,
+	"rest_authentication":      DescribePropertyListSchema,
+}
+
+var _ = DescribeCatalogIntegrationSchema
+
+func DescribeCatalogIntegrationToSchema(integrationProperties []sdk.CatalogIntegrationProperty) map[string]any {
+	propsSchema := make(map[string]any)
+	for _, property := range integrationProperties {
+		propertyName := strings.ToLower(property.Name)
+		if _, ok := DescribeCatalogIntegrationSchema[propertyName]; ok {
+			propsSchema[propertyName] = []map[string]any{CatalogIntegrationPropertyToSchema(&property)}
+		} else {
+			log.Printf("[DEBUG] Unknown catalog integration property %s", propertyName)
+		}
+	}
+	return propsSchema
+}
+
+var _ = DescribeCatalogIntegrationToSchema
+
+func CatalogIntegrationPropertyToSchema(property *sdk.CatalogIntegrationProperty) map[string]any {
+	return map[string]any{
+		"name":    property.Name,
+		"type":    property.Type,
+		"value":   property.Value,
+		"default": property.Default,
+	}
+}
@@ -0,0 +1,37 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataMetricFunctionDescribeSchema represents output of DESCRIBE query for the single DataMetricFunction.
+var DataMetricFunctionDescribeSchema = map[string]*schema.Schema{
+	"signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"returns": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"language": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func DataMetricFunctionDetailsToSchema(details []sdk.DataMetricFunctionDetail) map[string]any {
+	dataMetricFunctionSchema := make(map[string]any)
+	for _, detail := range details {
+		switch detail.Property {
+		case "signature", "returns", "language", "body":
+			dataMetricFunctionSchema[detail.Property] = detail.Value
+		}
+	}
+	return dataMetricFunctionSchema
+}
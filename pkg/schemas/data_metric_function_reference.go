@@ -0,0 +1,104 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataMetricFunctionReferenceSchema represents output of DATA_METRIC_FUNCTION_REFERENCES query for the single DataMetricFunctionReference.
+var DataMetricFunctionReferenceSchema = map[string]*schema.Schema{
+	"metric_database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"metric_schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"metric_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"argument_signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"data_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_entity_database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_entity_schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_entity_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_entity_domain": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_arguments": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"domain": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+	"ref_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schedule": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schedule_status": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func DataMetricFunctionReferenceToSchema(reference *sdk.DataMetricFunctionReference) map[string]any {
+	refArguments := make([]map[string]any, len(reference.RefArguments))
+	for i, argument := range reference.RefArguments {
+		refArguments[i] = map[string]any{
+			"domain": argument.Domain,
+			"id":     argument.Id,
+			"name":   argument.Name,
+		}
+	}
+	return map[string]any{
+		"metric_database_name":     reference.MetricDatabaseName,
+		"metric_schema_name":       reference.MetricSchemaName,
+		"metric_name":              reference.MetricName,
+		"argument_signature":       reference.ArgumentSignature,
+		"data_type":                reference.DataType,
+		"ref_entity_database_name": reference.RefEntityDatabaseName,
+		"ref_entity_schema_name":   reference.RefEntitySchemaName,
+		"ref_entity_name":          reference.RefEntityName,
+		"ref_entity_domain":        reference.RefEntityDomain,
+		"ref_arguments":            refArguments,
+		"ref_id":                   reference.RefId,
+		"schedule":                 reference.Schedule,
+		"schedule_status":          reference.ScheduleStatus,
+	}
+}
@@ -0,0 +1,39 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// EventTableDescribeSchema represents output of DESCRIBE query for the single EventTable.
+var EventTableDescribeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func EventTableDetailsToSchema(details []sdk.EventTableDetails) []map[string]any {
+	result := make([]map[string]any, len(details))
+	for i, detail := range details {
+		result[i] = map[string]any{
+			"name":    detail.Name,
+			"type":    detail.Type,
+			"kind":    detail.Kind,
+			"comment": detail.Comment,
+		}
+	}
+	return result
+}
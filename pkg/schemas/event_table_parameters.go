@@ -0,0 +1,33 @@
+package schemas
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ShowEventTableParametersSchema = make(map[string]*schema.Schema)
+	EventTableParameters           = []sdk.ObjectParameter{
+		sdk.ObjectParameterDataRetentionTimeInDays,
+		sdk.ObjectParameterMaxDataExtensionTimeInDays,
+	}
+)
+
+func init() {
+	for _, param := range EventTableParameters {
+		ShowEventTableParametersSchema[strings.ToLower(string(param))] = ParameterListSchema
+	}
+}
+
+func EventTableParametersToSchema(parameters []*sdk.Parameter) map[string]any {
+	eventTableParametersValue := make(map[string]any)
+	for _, param := range parameters {
+		if slices.Contains(EventTableParameters, sdk.ObjectParameter(param.Key)) {
+			eventTableParametersValue[strings.ToLower(param.Key)] = []map[string]any{ParameterToSchema(param)}
+		}
+	}
+	return eventTableParametersValue
+}
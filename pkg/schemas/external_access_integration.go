@@ -0,0 +1,44 @@
+package schemas
+
+import (
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeExternalAccessIntegrationSchema represents output of DESCRIBE query for the single ExternalAccessIntegration.
+var DescribeExternalAccessIntegrationSchema = map[string]*schema.Schema{
+	"enabled":               DescribePropertyListSchema,
+	"allowed_network_rules": DescribePropertyListSchema,
+	"allowed_api_authentication_integrations": DescribePropertyListSchema,
+	"allowed_authentication_secrets":          DescribePropertyListSchema,
+	"comment":                                 DescribePropertyListSchema,
+}
+
+var _ = DescribeExternalAccessIntegrationSchema
+
+func DescribeExternalAccessIntegrationToSchema(integrationProperties []sdk.ExternalAccessIntegrationProperty) map[string]any {
+	propsSchema := make(map[string]any)
+	for _, property := range integrationProperties {
+		propertyName := strings.ToLower(property.Name)
+		if _, ok := DescribeExternalAccessIntegrationSchema[propertyName]; ok {
+			propsSchema[propertyName] = []map[string]any{ExternalAccessIntegrationPropertyToSchema(&property)}
+		} else {
+			log.Printf("[DEBUG] Unknown external access integration property %s", propertyName)
+		}
+	}
+	return propsSchema
+}
+
+var _ = DescribeExternalAccessIntegrationToSchema
+
+func ExternalAccessIntegrationPropertyToSchema(property *sdk.ExternalAccessIntegrationProperty) map[string]any {
+	return map[string]any{
+		"name":    property.Name,
+		"type":    property.Type,
+		"value":   property.Value,
+		"default": property.Default,
+	}
+}
@@ -43,5 +43,3 @@ func ExternalVolumeDescriptionToSchema(description []sdk.ExternalVolumeProperty)
 	}
 	return result
 }
-
-var _ = ExternalVolumeDescriptionToSchema
@@ -4,26 +4,35 @@ import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 var SdkShowResultStructs = []any{
 	sdk.Account{},
+	sdk.AggregationPolicy{},
 	sdk.Alert{},
 	sdk.ApiIntegration{},
 	sdk.ApplicationPackage{},
 	sdk.ApplicationRole{},
 	sdk.Application{},
 	sdk.AuthenticationPolicy{},
+	sdk.CatalogIntegration{},
 	sdk.ComputePool{},
 	sdk.Connection{},
+	sdk.DataMetricFunction{},
 	sdk.DatabaseRole{},
 	sdk.Database{},
 	sdk.DynamicTable{},
 	sdk.EventTable{},
+	sdk.ExternalAccessIntegration{},
 	sdk.ExternalFunction{},
 	sdk.ExternalTable{},
 	sdk.ExternalVolume{},
 	sdk.FailoverGroup{},
 	sdk.FileFormat{},
 	sdk.Function{},
+	sdk.GitBranch{},
 	sdk.GitRepository{},
+	sdk.GitTag{},
 	sdk.Grant{},
+	sdk.HybridTable{},
+	sdk.IcebergTable{},
+	sdk.JoinPolicy{},
 	sdk.Listing{},
 	sdk.ManagedAccount{},
 	sdk.MaskingPolicy{},
@@ -36,8 +45,10 @@ var SdkShowResultStructs = []any{
 	sdk.Pipe{},
 	sdk.PolicyReference{},
 	sdk.Procedure{},
+	sdk.ProjectionPolicy{},
 	sdk.ReplicationAccount{},
 	sdk.ReplicationDatabase{},
+	sdk.ReplicationGroup{},
 	sdk.Region{},
 	sdk.ResourceMonitor{},
 	sdk.Role{},
@@ -45,6 +56,7 @@ var SdkShowResultStructs = []any{
 	sdk.Schema{},
 	sdk.Secret{},
 	sdk.SecurityIntegration{},
+	sdk.SemanticView{},
 	sdk.Service{},
 	sdk.Sequence{},
 	sdk.SessionPolicy{},
@@ -0,0 +1,59 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// HybridTableDescribeSchema represents output of DESCRIBE query for the single HybridTable.
+var HybridTableDescribeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"is_nullable": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"default": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"primary_key": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"unique_key": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func HybridTableDetailsToSchema(details []sdk.HybridTableDetails) []map[string]any {
+	result := make([]map[string]any, len(details))
+	for i, detail := range details {
+		result[i] = map[string]any{
+			"name":        detail.Name,
+			"type":        detail.Type,
+			"kind":        detail.Kind,
+			"is_nullable": detail.IsNullable,
+			"default":     detail.Default,
+			"primary_key": detail.PrimaryKey,
+			"unique_key":  detail.UniqueKey,
+			"comment":     detail.Comment,
+		}
+	}
+	return result
+}
@@ -0,0 +1,49 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IcebergTableDescribeSchema represents output of DESCRIBE query for the single IcebergTable.
+var IcebergTableDescribeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"is_nullable": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"default": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func IcebergTableDetailsToSchema(details []sdk.IcebergTableDetails) []map[string]any {
+	result := make([]map[string]any, len(details))
+	for i, detail := range details {
+		result[i] = map[string]any{
+			"name":        detail.Name,
+			"type":        detail.Type,
+			"kind":        detail.Kind,
+			"is_nullable": detail.IsNullable,
+			"default":     detail.Default,
+			"comment":     detail.Comment,
+		}
+	}
+	return result
+}
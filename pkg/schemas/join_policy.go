@@ -0,0 +1,35 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeJoinPolicySchema represents output of DESCRIBE query for the single JoinPolicy.
+var DescribeJoinPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"return_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func JoinPolicyDescriptionToSchema(joinPolicyDescription sdk.JoinPolicyDescription) map[string]any {
+	joinPolicySchema := make(map[string]any)
+	joinPolicySchema["name"] = joinPolicyDescription.Name
+	joinPolicySchema["signature"] = joinPolicyDescription.Signature
+	joinPolicySchema["return_type"] = joinPolicyDescription.ReturnType
+	joinPolicySchema["body"] = joinPolicyDescription.Body
+	return joinPolicySchema
+}
@@ -0,0 +1,406 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeListingSchema represents output of DESCRIBE query for the single listing.
+var DescribeListingSchema = map[string]*schema.Schema{
+	"global_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"updated_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"published_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"title": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"subtitle": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"description": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"listing_terms": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"state": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"share": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"application_package": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"business_needs": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"usage_examples": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"data_attributes": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"categories": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"resources": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"profile": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"customized_contact_info": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"data_dictionary": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"data_preview": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"revisions": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"target_accounts": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"regions": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"refresh_schedule": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"refresh_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"review_state": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"rejection_reason": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"unpublished_by_admin_reasons": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"is_monetized": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"is_application": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"is_targeted": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"is_limited_trial": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"is_by_request": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"limited_trial_plan": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"retried_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"scheduled_drop_time": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"manifest_yaml": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"distribution": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"is_mountless_queryable": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"organization_profile_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"uniform_listing_locator": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"trial_details": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"approver_contact": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"support_contact": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"live_version_uri": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_committed_version_uri": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_committed_version_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_committed_version_alias": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"published_version_uri": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"published_version_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"published_version_alias": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"is_share": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"request_approval_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"monetization_display_order": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"legacy_uniform_listing_locators": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = DescribeListingSchema
+
+func ListingDetailsToSchema(listingDetails sdk.ListingDetails) map[string]any {
+	listingDetailsSchema := make(map[string]any)
+	listingDetailsSchema["global_name"] = listingDetails.GlobalName
+	listingDetailsSchema["name"] = listingDetails.Name
+	listingDetailsSchema["owner"] = listingDetails.Owner
+	listingDetailsSchema["owner_role_type"] = listingDetails.OwnerRoleType
+	listingDetailsSchema["created_on"] = listingDetails.CreatedOn
+	listingDetailsSchema["updated_on"] = listingDetails.UpdatedOn
+	if listingDetails.PublishedOn != nil {
+		listingDetailsSchema["published_on"] = *listingDetails.PublishedOn
+	}
+	listingDetailsSchema["title"] = listingDetails.Title
+	if listingDetails.Subtitle != nil {
+		listingDetailsSchema["subtitle"] = *listingDetails.Subtitle
+	}
+	if listingDetails.Description != nil {
+		listingDetailsSchema["description"] = *listingDetails.Description
+	}
+	if listingDetails.ListingTerms != nil {
+		listingDetailsSchema["listing_terms"] = *listingDetails.ListingTerms
+	}
+	listingDetailsSchema["state"] = string(listingDetails.State)
+	if listingDetails.Share != nil {
+		listingDetailsSchema["share"] = listingDetails.Share.Name()
+	}
+	if listingDetails.ApplicationPackage != nil {
+		listingDetailsSchema["application_package"] = listingDetails.ApplicationPackage.Name()
+	}
+	if listingDetails.BusinessNeeds != nil {
+		listingDetailsSchema["business_needs"] = *listingDetails.BusinessNeeds
+	}
+	if listingDetails.UsageExamples != nil {
+		listingDetailsSchema["usage_examples"] = *listingDetails.UsageExamples
+	}
+	if listingDetails.DataAttributes != nil {
+		listingDetailsSchema["data_attributes"] = *listingDetails.DataAttributes
+	}
+	if listingDetails.Categories != nil {
+		listingDetailsSchema["categories"] = *listingDetails.Categories
+	}
+	if listingDetails.Resources != nil {
+		listingDetailsSchema["resources"] = *listingDetails.Resources
+	}
+	if listingDetails.Profile != nil {
+		listingDetailsSchema["profile"] = *listingDetails.Profile
+	}
+	if listingDetails.CustomizedContactInfo != nil {
+		listingDetailsSchema["customized_contact_info"] = *listingDetails.CustomizedContactInfo
+	}
+	if listingDetails.DataDictionary != nil {
+		listingDetailsSchema["data_dictionary"] = *listingDetails.DataDictionary
+	}
+	if listingDetails.DataPreview != nil {
+		listingDetailsSchema["data_preview"] = *listingDetails.DataPreview
+	}
+	if listingDetails.Comment != nil {
+		listingDetailsSchema["comment"] = *listingDetails.Comment
+	}
+	listingDetailsSchema["revisions"] = listingDetails.Revisions
+	if listingDetails.TargetAccounts != nil {
+		listingDetailsSchema["target_accounts"] = *listingDetails.TargetAccounts
+	}
+	if listingDetails.Regions != nil {
+		listingDetailsSchema["regions"] = *listingDetails.Regions
+	}
+	if listingDetails.RefreshSchedule != nil {
+		listingDetailsSchema["refresh_schedule"] = *listingDetails.RefreshSchedule
+	}
+	if listingDetails.RefreshType != nil {
+		listingDetailsSchema["refresh_type"] = *listingDetails.RefreshType
+	}
+	if listingDetails.ReviewState != nil {
+		listingDetailsSchema["review_state"] = *listingDetails.ReviewState
+	}
+	if listingDetails.RejectionReason != nil {
+		listingDetailsSchema["rejection_reason"] = *listingDetails.RejectionReason
+	}
+	if listingDetails.UnpublishedByAdminReasons != nil {
+		listingDetailsSchema["unpublished_by_admin_reasons"] = *listingDetails.UnpublishedByAdminReasons
+	}
+	listingDetailsSchema["is_monetized"] = listingDetails.IsMonetized
+	listingDetailsSchema["is_application"] = listingDetails.IsApplication
+	listingDetailsSchema["is_targeted"] = listingDetails.IsTargeted
+	if listingDetails.IsLimitedTrial != nil {
+		listingDetailsSchema["is_limited_trial"] = *listingDetails.IsLimitedTrial
+	}
+	if listingDetails.IsByRequest != nil {
+		listingDetailsSchema["is_by_request"] = *listingDetails.IsByRequest
+	}
+	if listingDetails.LimitedTrialPlan != nil {
+		listingDetailsSchema["limited_trial_plan"] = *listingDetails.LimitedTrialPlan
+	}
+	if listingDetails.RetriedOn != nil {
+		listingDetailsSchema["retried_on"] = *listingDetails.RetriedOn
+	}
+	if listingDetails.ScheduledDropTime != nil {
+		listingDetailsSchema["scheduled_drop_time"] = *listingDetails.ScheduledDropTime
+	}
+	listingDetailsSchema["manifest_yaml"] = listingDetails.ManifestYaml
+	if listingDetails.Distribution != nil {
+		listingDetailsSchema["distribution"] = *listingDetails.Distribution
+	}
+	if listingDetails.IsMountlessQueryable != nil {
+		listingDetailsSchema["is_mountless_queryable"] = *listingDetails.IsMountlessQueryable
+	}
+	if listingDetails.OrganizationProfileName != nil {
+		listingDetailsSchema["organization_profile_name"] = *listingDetails.OrganizationProfileName
+	}
+	if listingDetails.UniformListingLocator != nil {
+		listingDetailsSchema["uniform_listing_locator"] = *listingDetails.UniformListingLocator
+	}
+	if listingDetails.TrialDetails != nil {
+		listingDetailsSchema["trial_details"] = *listingDetails.TrialDetails
+	}
+	if listingDetails.ApproverContact != nil {
+		listingDetailsSchema["approver_contact"] = *listingDetails.ApproverContact
+	}
+	if listingDetails.SupportContact != nil {
+		listingDetailsSchema["support_contact"] = *listingDetails.SupportContact
+	}
+	if listingDetails.LiveVersionUri != nil {
+		listingDetailsSchema["live_version_uri"] = *listingDetails.LiveVersionUri
+	}
+	if listingDetails.LastCommittedVersionUri != nil {
+		listingDetailsSchema["last_committed_version_uri"] = *listingDetails.LastCommittedVersionUri
+	}
+	if listingDetails.LastCommittedVersionName != nil {
+		listingDetailsSchema["last_committed_version_name"] = *listingDetails.LastCommittedVersionName
+	}
+	if listingDetails.LastCommittedVersionAlias != nil {
+		listingDetailsSchema["last_committed_version_alias"] = *listingDetails.LastCommittedVersionAlias
+	}
+	if listingDetails.PublishedVersionUri != nil {
+		listingDetailsSchema["published_version_uri"] = *listingDetails.PublishedVersionUri
+	}
+	if listingDetails.PublishedVersionName != nil {
+		listingDetailsSchema["published_version_name"] = *listingDetails.PublishedVersionName
+	}
+	if listingDetails.PublishedVersionAlias != nil {
+		listingDetailsSchema["published_version_alias"] = *listingDetails.PublishedVersionAlias
+	}
+	if listingDetails.IsShare != nil {
+		listingDetailsSchema["is_share"] = *listingDetails.IsShare
+	}
+	if listingDetails.RequestApprovalType != nil {
+		listingDetailsSchema["request_approval_type"] = *listingDetails.RequestApprovalType
+	}
+	if listingDetails.MonetizationDisplayOrder != nil {
+		listingDetailsSchema["monetization_display_order"] = *listingDetails.MonetizationDisplayOrder
+	}
+	if listingDetails.LegacyUniformListingLocators != nil {
+		listingDetailsSchema["legacy_uniform_listing_locators"] = *listingDetails.LegacyUniformListingLocators
+	}
+	return listingDetailsSchema
+}
+
+var _ = ListingDetailsToSchema
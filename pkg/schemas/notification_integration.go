@@ -0,0 +1,43 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeNotificationIntegrationSchema represents a single property from the output of DESCRIBE query for the notification integration.
+var DescribeNotificationIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"value": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"default": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = DescribeNotificationIntegrationSchema
+
+func NotificationIntegrationPropertiesToSchema(properties []sdk.NotificationIntegrationProperty) []map[string]any {
+	result := make([]map[string]any, len(properties))
+	for i, property := range properties {
+		result[i] = map[string]any{
+			"name":    property.Name,
+			"type":    property.Type,
+			"value":   property.Value,
+			"default": property.Default,
+		}
+	}
+	return result
+}
+
+var _ = NotificationIntegrationPropertiesToSchema
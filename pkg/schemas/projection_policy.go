@@ -0,0 +1,35 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeProjectionPolicySchema represents output of DESCRIBE query for the single ProjectionPolicy.
+var DescribeProjectionPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"return_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func ProjectionPolicyDescriptionToSchema(projectionPolicyDescription sdk.ProjectionPolicyDescription) map[string]any {
+	projectionPolicySchema := make(map[string]any)
+	projectionPolicySchema["name"] = projectionPolicyDescription.Name
+	projectionPolicySchema["signature"] = projectionPolicyDescription.Signature
+	projectionPolicySchema["return_type"] = projectionPolicyDescription.ReturnType
+	projectionPolicySchema["body"] = projectionPolicyDescription.Body
+	return projectionPolicySchema
+}
@@ -0,0 +1,51 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SemanticViewDescribeSchema represents output of DESCRIBE query for the single SemanticView.
+var SemanticViewDescribeSchema = map[string]*schema.Schema{
+	"object_kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"object_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"parent_entity": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"property": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"property_value": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func SemanticViewDetailsToSchema(details []sdk.SemanticViewDetails) []map[string]any {
+	result := make([]map[string]any, len(details))
+	for i, detail := range details {
+		detailSchema := map[string]any{
+			"property":       detail.Property,
+			"property_value": detail.PropertyValue,
+		}
+		if detail.ObjectKind != nil {
+			detailSchema["object_kind"] = *detail.ObjectKind
+		}
+		if detail.ObjectName != nil {
+			detailSchema["object_name"] = *detail.ObjectName
+		}
+		if detail.ParentEntity != nil {
+			detailSchema["parent_entity"] = *detail.ParentEntity
+		}
+		result[i] = detailSchema
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeSessionPolicySchema represents output of DESCRIBE query for the single SessionPolicy.
+var DescribeSessionPolicySchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"session_idle_timeout_mins": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"session_ui_idle_timeout_mins": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"allowed_secondary_roles": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func SessionPolicyDescriptionToSchema(sessionPolicyDescription sdk.SessionPolicyDescription) map[string]any {
+	sessionPolicySchema := make(map[string]any)
+	sessionPolicySchema["created_on"] = sessionPolicyDescription.CreatedOn
+	sessionPolicySchema["name"] = sessionPolicyDescription.Name
+	sessionPolicySchema["session_idle_timeout_mins"] = sessionPolicyDescription.SessionIdleTimeoutMins
+	sessionPolicySchema["session_ui_idle_timeout_mins"] = sessionPolicyDescription.SessionUIIdleTimeoutMins
+	sessionPolicySchema["allowed_secondary_roles"] = sessionPolicyDescription.AllowedSecondaryRoles
+	sessionPolicySchema["comment"] = sessionPolicyDescription.Comment
+	return sessionPolicySchema
+}
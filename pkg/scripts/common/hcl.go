@@ -0,0 +1,232 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceBlock is a single resource generated for an existing Snowflake object, together with its import block.
+type ResourceBlock struct {
+	ResourceType string
+	Name         string
+	ImportId     string
+	Body         *BlockBody
+}
+
+type attribute struct {
+	Name  string
+	Value any
+}
+
+type nestedBlock struct {
+	Name string
+	Body *BlockBody
+}
+
+type BlockBody struct {
+	Attributes []attribute
+	Blocks     []nestedBlock
+}
+
+func NewBlockBody() *BlockBody {
+	return &BlockBody{}
+}
+
+// WithAttribute adds the attribute to the block. Empty strings, false booleans, and empty lists are skipped,
+// so that the default values are not set explicitly in the generated configuration.
+func (b *BlockBody) WithAttribute(name string, value any) *BlockBody {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return b
+		}
+	case bool:
+		if !v {
+			return b
+		}
+	case []string:
+		if len(v) == 0 {
+			return b
+		}
+	}
+	return b.WithAttributeAlways(name, value)
+}
+
+// WithAttributeAlways adds the attribute to the block, even if it holds the zero value.
+func (b *BlockBody) WithAttributeAlways(name string, value any) *BlockBody {
+	b.Attributes = append(b.Attributes, attribute{Name: name, Value: value})
+	return b
+}
+
+func (b *BlockBody) WithBlock(name string, body *BlockBody) *BlockBody {
+	b.Blocks = append(b.Blocks, nestedBlock{Name: name, Body: body})
+	return b
+}
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// ResourceName converts the object name parts to a valid Terraform resource name, e.g. "MY DB"."Schema" to my_db_schema.
+func ResourceName(parts ...string) string {
+	name := invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "_" + name
+	}
+	return name
+}
+
+// RenderResourceBlocks renders all the blocks. The blocks that do not match the current resource schema are skipped with a warning.
+func RenderResourceBlocks(blocks []ResourceBlock) string {
+	resourcesMap := provider.Provider().ResourcesMap
+	rendered := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		r, ok := resourcesMap[block.ResourceType]
+		if !ok {
+			ScriptsWarn("Skipping %s.%s: unknown resource type", block.ResourceType, block.Name)
+			continue
+		}
+		content, err := RenderResourceBlock(block, r.Schema)
+		if err != nil {
+			ScriptsWarn("Skipping %v", err)
+			continue
+		}
+		rendered = append(rendered, content)
+	}
+	return strings.Join(rendered, "\n")
+}
+
+// RenderRemovedBlock renders the removed block that removes the resource from the state without destroying the object.
+func RenderRemovedBlock(address string) string {
+	return fmt.Sprintf("removed {\n  from = %s\n\n  lifecycle {\n    destroy = false\n  }\n}\n", address)
+}
+
+// RenderResourceBlock renders the import block and the resource block. The attributes are validated against the current
+// resource schema: the attributes that are not present in the schema (or are computed only) are skipped, and the missing
+// required attributes result in an error.
+func RenderResourceBlock(block ResourceBlock, resourceSchema map[string]*schema.Schema) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("import {\n")
+	sb.WriteString(fmt.Sprintf("  to = %s.%s\n", block.ResourceType, block.Name))
+	sb.WriteString(fmt.Sprintf("  id = %s\n", quoteString(block.ImportId)))
+	sb.WriteString("}\n\n")
+	sb.WriteString(fmt.Sprintf("resource %q %q {\n", block.ResourceType, block.Name))
+	if err := renderBody(&sb, block.Body, resourceSchema, 1); err != nil {
+		return "", fmt.Errorf("%s.%s: %w", block.ResourceType, block.Name, err)
+	}
+	sb.WriteString("}\n")
+	return sb.String(), nil
+}
+
+func renderBody(sb *strings.Builder, body *BlockBody, bodySchema map[string]*schema.Schema, indentLevel int) error {
+	indent := strings.Repeat("  ", indentLevel)
+
+	for name, s := range bodySchema {
+		if s.Required && !slices.ContainsFunc(body.Attributes, func(a attribute) bool { return a.Name == name }) &&
+			!slices.ContainsFunc(body.Blocks, func(b nestedBlock) bool { return b.Name == name }) {
+			return fmt.Errorf("required attribute %s is missing", name)
+		}
+	}
+
+	attributes := slices.DeleteFunc(slices.Clone(body.Attributes), func(a attribute) bool {
+		s, ok := bodySchema[a.Name]
+		return !ok || (s.Computed && !s.Optional)
+	})
+	width := 0
+	for _, a := range attributes {
+		width = max(width, len(a.Name))
+	}
+	for _, a := range attributes {
+		value, err := renderValue(bodySchema[a.Name], a.Value, indent)
+		if err != nil {
+			return fmt.Errorf("attribute %s: %w", a.Name, err)
+		}
+		sb.WriteString(fmt.Sprintf("%s%-*s = %s\n", indent, width, a.Name, value))
+	}
+
+	for _, b := range body.Blocks {
+		s, ok := bodySchema[b.Name]
+		if !ok {
+			continue
+		}
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			return fmt.Errorf("%s is not a block", b.Name)
+		}
+		sb.WriteString(fmt.Sprintf("\n%s%s {\n", indent, b.Name))
+		if err := renderBody(sb, b.Body, elem.Schema, indentLevel+1); err != nil {
+			return fmt.Errorf("block %s: %w", b.Name, err)
+		}
+		sb.WriteString(fmt.Sprintf("%s}\n", indent))
+	}
+	return nil
+}
+
+// renderValue renders the value in the type expected by the schema, e.g. booleans are rendered as strings
+// for the fields that accept "true", "false", and "default" values.
+func renderValue(s *schema.Schema, value any, indent string) (string, error) {
+	switch s.Type {
+	case schema.TypeString:
+		switch v := value.(type) {
+		case string:
+			if strings.Contains(v, "\n") {
+				return heredoc(v, indent), nil
+			}
+			return quoteString(v), nil
+		case bool:
+			return quoteString(strconv.FormatBool(v)), nil
+		case int:
+			return quoteString(strconv.Itoa(v)), nil
+		}
+	case schema.TypeBool:
+		if v, ok := value.(bool); ok {
+			return strconv.FormatBool(v), nil
+		}
+	case schema.TypeInt:
+		if v, ok := value.(int); ok {
+			return strconv.Itoa(v), nil
+		}
+	case schema.TypeList, schema.TypeSet:
+		if v, ok := value.([]string); ok {
+			quoted := make([]string, len(v))
+			for i, item := range v {
+				quoted[i] = quoteString(item)
+			}
+			return fmt.Sprintf("[%s]", strings.Join(quoted, ", ")), nil
+		}
+	}
+	return "", fmt.Errorf("value %v of type %T cannot be used for the field of type %s", value, value, s.Type)
+}
+
+var hclStringReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+func quoteString(value string) string {
+	return `"` + hclStringReplacer.Replace(value) + `"`
+}
+
+var hclHeredocReplacer = strings.NewReplacer(
+	"${", "$${",
+	"%{", "%%{",
+)
+
+func heredoc(value string, indent string) string {
+	var sb strings.Builder
+	sb.WriteString("<<-EOT\n")
+	for _, line := range strings.Split(strings.TrimRight(value, "\n"), "\n") {
+		sb.WriteString(indent + "  " + hclHeredocReplacer.Replace(line) + "\n")
+	}
+	sb.WriteString(indent + "EOT")
+	return sb.String()
+}
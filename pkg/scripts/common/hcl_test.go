@@ -0,0 +1,76 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResourceName(t *testing.T) {
+	assert.Equal(t, "my_db_schema", ResourceName("MY DB", "Schema"))
+	assert.Equal(t, "_1db", ResourceName("1DB"))
+	assert.Equal(t, "role_on_database_db", ResourceName("ROLE", "on", "DATABASE", "DB"))
+}
+
+func Test_RenderResourceBlock(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name":        {Type: schema.TypeString, Required: true},
+		"is_secure":   {Type: schema.TypeString, Optional: true},
+		"retention":   {Type: schema.TypeInt, Optional: true},
+		"privileges":  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"statement":   {Type: schema.TypeString, Optional: true},
+		"show_output": {Type: schema.TypeList, Computed: true},
+		"column": {Type: schema.TypeList, Optional: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"name":     {Type: schema.TypeString, Required: true},
+			"nullable": {Type: schema.TypeBool, Optional: true},
+		}}},
+	}
+
+	t.Run("all attributes", func(t *testing.T) {
+		block := ResourceBlock{
+			ResourceType: "snowflake_view",
+			Name:         "db_schema_view",
+			ImportId:     `"db"."schema"."view"`,
+			Body: NewBlockBody().
+				WithAttribute("name", `view "${x}"`).
+				WithAttribute("is_secure", true).
+				WithAttribute("retention", 1).
+				WithAttribute("privileges", []string{"SELECT", "INSERT"}).
+				WithAttribute("statement", "select *\nfrom t").
+				WithAttribute("show_output", "skipped").
+				WithAttribute("unknown", "skipped").
+				WithBlock("column", NewBlockBody().WithAttribute("name", "ID").WithAttributeAlways("nullable", false)),
+		}
+
+		rendered, err := RenderResourceBlock(block, resourceSchema)
+		require.NoError(t, err)
+		assert.Equal(t, `import {
+  to = snowflake_view.db_schema_view
+  id = "\"db\".\"schema\".\"view\""
+}
+
+resource "snowflake_view" "db_schema_view" {
+  name       = "view \"$${x}\""
+  is_secure  = "true"
+  retention  = 1
+  privileges = ["SELECT", "INSERT"]
+  statement  = <<-EOT
+    select *
+    from t
+  EOT
+
+  column {
+    name     = "ID"
+    nullable = false
+  }
+}
+`, rendered)
+	})
+
+	t.Run("missing required attribute", func(t *testing.T) {
+		_, err := RenderResourceBlock(ResourceBlock{ResourceType: "snowflake_view", Name: "view", Body: NewBlockBody().WithAttribute("name", "")}, resourceSchema)
+		require.ErrorContains(t, err, "required attribute name is missing")
+	})
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/scripts/common"
+)
+
+/*
+This script is used to migrate the deprecated grant resources (removed in v1) to the current grant resources.
+It reads the Terraform state file and, for every deprecated grant, it generates the equivalent
+snowflake_grant_privileges_to_account_role, snowflake_grant_ownership, or snowflake_grant_account_role configuration
+with an import block, and a removed block for the deprecated resource.
+
+Usage: go run ./pkg/scripts/grants_migration [-state <file>] [-output <file>] [-removed-output <file>]
+*/
+func main() {
+	statePath := flag.String("state", "terraform.tfstate", "Path to the Terraform state file (e.g. the output of terraform state pull).")
+	output := flag.String("output", "grants.tf", "Path to the generated file with the import blocks and the new resources.")
+	removedOutput := flag.String("removed-output", "removed.tf", "Path to the generated file with the removed blocks for the deprecated resources.")
+	flag.Parse()
+
+	state, err := readState(*statePath)
+	if err != nil {
+		common.ScriptsWarn("Reading the state failed: %v", err)
+		os.Exit(1)
+	}
+
+	m := migrate(state)
+
+	if err := os.WriteFile(*output, []byte(common.RenderResourceBlocks(m.blocks)), 0o600); err != nil {
+		common.ScriptsWarn("Writing to %s failed: %v", *output, err)
+		os.Exit(1)
+	}
+	removed := make([]string, len(m.removed))
+	for i, address := range m.removed {
+		removed[i] = common.RenderRemovedBlock(address)
+	}
+	if err := os.WriteFile(*removedOutput, []byte(strings.Join(removed, "\n")), 0o600); err != nil {
+		common.ScriptsWarn("Writing to %s failed: %v", *removedOutput, err)
+		os.Exit(1)
+	}
+	common.ScriptsDebug("Migrated %d deprecated resources to %d resources in %s (removed blocks in %s)", len(m.removed), len(m.blocks), *output, *removedOutput)
+}
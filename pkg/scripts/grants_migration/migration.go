@@ -0,0 +1,384 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/scripts/common"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// deprecatedGrant describes the object on which the deprecated grant resource granted the privilege.
+type deprecatedGrant struct {
+	objectType    sdk.ObjectType
+	nameAttribute string
+}
+
+var deprecatedAccountObjectGrants = map[string]deprecatedGrant{
+	"snowflake_database_grant":         {objectType: sdk.ObjectTypeDatabase, nameAttribute: "database_name"},
+	"snowflake_warehouse_grant":        {objectType: sdk.ObjectTypeWarehouse, nameAttribute: "warehouse_name"},
+	"snowflake_integration_grant":      {objectType: sdk.ObjectTypeIntegration, nameAttribute: "integration_name"},
+	"snowflake_resource_monitor_grant": {objectType: sdk.ObjectTypeResourceMonitor, nameAttribute: "monitor_name"},
+	"snowflake_user_grant":             {objectType: sdk.ObjectTypeUser, nameAttribute: "user_name"},
+	"snowflake_failover_group_grant":   {objectType: sdk.ObjectTypeFailoverGroup, nameAttribute: "failover_group_name"},
+}
+
+var deprecatedSchemaObjectGrants = map[string]deprecatedGrant{
+	"snowflake_table_grant":             {objectType: sdk.ObjectTypeTable, nameAttribute: "table_name"},
+	"snowflake_view_grant":              {objectType: sdk.ObjectTypeView, nameAttribute: "view_name"},
+	"snowflake_materialized_view_grant": {objectType: sdk.ObjectTypeMaterializedView, nameAttribute: "materialized_view_name"},
+	"snowflake_external_table_grant":    {objectType: sdk.ObjectTypeExternalTable, nameAttribute: "external_table_name"},
+	"snowflake_stage_grant":             {objectType: sdk.ObjectTypeStage, nameAttribute: "stage_name"},
+	"snowflake_sequence_grant":          {objectType: sdk.ObjectTypeSequence, nameAttribute: "sequence_name"},
+	"snowflake_stream_grant":            {objectType: sdk.ObjectTypeStream, nameAttribute: "stream_name"},
+	"snowflake_task_grant":              {objectType: sdk.ObjectTypeTask, nameAttribute: "task_name"},
+	"snowflake_pipe_grant":              {objectType: sdk.ObjectTypePipe, nameAttribute: "pipe_name"},
+	"snowflake_file_format_grant":       {objectType: sdk.ObjectTypeFileFormat, nameAttribute: "file_format_name"},
+	"snowflake_tag_grant":               {objectType: sdk.ObjectTypeTag, nameAttribute: "tag_name"},
+	"snowflake_masking_policy_grant":    {objectType: sdk.ObjectTypeMaskingPolicy, nameAttribute: "masking_policy_name"},
+	"snowflake_row_access_policy_grant": {objectType: sdk.ObjectTypeRowAccessPolicy, nameAttribute: "row_access_policy_name"},
+}
+
+// unsupportedDeprecatedGrants are not migrated, because the objects are identified by their arguments, which are not stored in a format that can be converted reliably.
+var unsupportedDeprecatedGrants = []string{
+	"snowflake_function_grant",
+	"snowflake_procedure_grant",
+}
+
+// grantOn is the object (or objects, for on_all and on_future grants) on which the privilege is granted.
+type grantOn struct {
+	onAccount  bool
+	objectType sdk.ObjectType
+	objectId   sdk.ObjectIdentifier
+	onAll      bool
+	onFuture   bool
+	bulk       *resources.BulkOperationGrantData
+}
+
+type migration struct {
+	blocks  []common.ResourceBlock
+	removed []string
+	names   map[string]int
+}
+
+// migrate converts the deprecated grant resources found in the state. The deprecated resource is removed from the state
+// only if all its instances were converted.
+func migrate(state *terraformState) *migration {
+	m := &migration{names: make(map[string]int)}
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || !isDeprecatedGrant(r.Type) {
+			continue
+		}
+		if slices.Contains(unsupportedDeprecatedGrants, r.Type) {
+			common.ScriptsWarn("Skipping %s: %s resources are not supported, migrate them manually", r.address(), r.Type)
+			continue
+		}
+		blocks := make([]common.ResourceBlock, 0)
+		var errs []error
+		for _, instance := range r.Instances {
+			instanceBlocks, err := convertInstance(r, instance)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			blocks = append(blocks, instanceBlocks...)
+		}
+		if len(errs) > 0 {
+			common.ScriptsWarn("Skipping %s: %v", r.address(), errs)
+			continue
+		}
+		for _, block := range blocks {
+			m.add(block)
+		}
+		m.removed = append(m.removed, r.address())
+	}
+	return m
+}
+
+func isDeprecatedGrant(resourceType string) bool {
+	_, isAccountObjectGrant := deprecatedAccountObjectGrants[resourceType]
+	_, isSchemaObjectGrant := deprecatedSchemaObjectGrants[resourceType]
+	return isAccountObjectGrant || isSchemaObjectGrant || slices.Contains(unsupportedDeprecatedGrants, resourceType) || slices.Contains([]string{
+		"snowflake_account_grant",
+		"snowflake_schema_grant",
+		"snowflake_role_grants",
+		"snowflake_role_ownership_grant",
+		"snowflake_user_ownership_grant",
+	}, resourceType)
+}
+
+// add registers the resource, making its name unique within the resource type.
+func (m *migration) add(block common.ResourceBlock) {
+	key := block.ResourceType + "." + block.Name
+	m.names[key]++
+	if count := m.names[key]; count > 1 {
+		block.Name = fmt.Sprintf("%s_%d", block.Name, count)
+	}
+	m.blocks = append(m.blocks, block)
+}
+
+func convertInstance(r stateResource, instance stateInstance) ([]common.ResourceBlock, error) {
+	switch r.Type {
+	case "snowflake_role_grants":
+		return convertRoleGrants(r, instance), nil
+	case "snowflake_role_ownership_grant":
+		return convertOwnershipGrant(r, instance, instance.stringAttribute("to_role_name"), instance.stringAttribute("current_grants"), grantOn{
+			objectType: sdk.ObjectTypeRole,
+			objectId:   sdk.NewAccountObjectIdentifier(instance.stringAttribute("on_role_name")),
+		})
+	case "snowflake_user_ownership_grant":
+		return convertOwnershipGrant(r, instance, instance.stringAttribute("to_role_name"), instance.stringAttribute("current_grants"), grantOn{
+			objectType: sdk.ObjectTypeUser,
+			objectId:   sdk.NewAccountObjectIdentifier(instance.stringAttribute("on_user_name")),
+		})
+	}
+
+	on, err := grantOnFromInstance(r.Type, instance)
+	if err != nil {
+		return nil, err
+	}
+	if len(instance.setAttribute("shares")) > 0 {
+		common.ScriptsWarn("%s: the grants to shares are not migrated, use snowflake_grant_privileges_to_share resource for them", r.address())
+	}
+
+	privilege := strings.ToUpper(instance.stringAttribute("privilege"))
+	blocks := make([]common.ResourceBlock, 0)
+	for _, role := range instance.setAttribute("roles") {
+		if privilege == "OWNERSHIP" {
+			ownershipBlocks, err := convertOwnershipGrant(r, instance, role, "", on)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, ownershipBlocks...)
+			continue
+		}
+		block, err := convertPrivilegesGrant(r, instance, role, privilege, instance.boolAttribute("with_grant_option"), on)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+func grantOnFromInstance(resourceType string, instance stateInstance) (grantOn, error) {
+	on := grantOn{
+		onAll:    instance.boolAttribute("on_all"),
+		onFuture: instance.boolAttribute("on_future"),
+	}
+	databaseName := instance.stringAttribute("database_name")
+	schemaName := instance.stringAttribute("schema_name")
+
+	switch {
+	case resourceType == "snowflake_account_grant":
+		on.onAccount = true
+	case resourceType == "snowflake_schema_grant":
+		if on.onAll || on.onFuture {
+			on.bulk = &resources.BulkOperationGrantData{
+				ObjectNamePlural: sdk.PluralObjectTypeSchemas,
+				Kind:             resources.InDatabaseBulkOperationGrantKind,
+				Database:         sdk.Pointer(sdk.NewAccountObjectIdentifier(databaseName)),
+			}
+		} else {
+			on.objectType = sdk.ObjectTypeSchema
+			on.objectId = sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)
+		}
+	default:
+		if grant, ok := deprecatedAccountObjectGrants[resourceType]; ok {
+			on.objectType = grant.objectType
+			on.objectId = sdk.NewAccountObjectIdentifier(instance.stringAttribute(grant.nameAttribute))
+			break
+		}
+		grant, ok := deprecatedSchemaObjectGrants[resourceType]
+		if !ok {
+			return on, fmt.Errorf("unsupported resource type %s", resourceType)
+		}
+		if on.onAll || on.onFuture {
+			on.bulk = &resources.BulkOperationGrantData{ObjectNamePlural: grant.objectType.Plural()}
+			if schemaName != "" {
+				on.bulk.Kind = resources.InSchemaBulkOperationGrantKind
+				on.bulk.Schema = sdk.Pointer(sdk.NewDatabaseObjectIdentifier(databaseName, schemaName))
+			} else {
+				on.bulk.Kind = resources.InDatabaseBulkOperationGrantKind
+				on.bulk.Database = sdk.Pointer(sdk.NewAccountObjectIdentifier(databaseName))
+			}
+		} else {
+			on.objectType = grant.objectType
+			on.objectId = sdk.NewSchemaObjectIdentifier(databaseName, schemaName, instance.stringAttribute(grant.nameAttribute))
+		}
+	}
+	return on, nil
+}
+
+// convertRoleGrants converts snowflake_role_grants to one snowflake_grant_account_role per parent role and user.
+// The names are not quoted, because snowflake_grant_account_role sets them without quotes on import.
+func convertRoleGrants(r stateResource, instance stateInstance) []common.ResourceBlock {
+	roleId := sdk.NewAccountObjectIdentifier(instance.stringAttribute("role_name"))
+	blocks := make([]common.ResourceBlock, 0)
+	for _, grantee := range []struct {
+		attribute  string
+		objectType sdk.ObjectType
+		field      string
+	}{
+		{attribute: "roles", objectType: sdk.ObjectTypeRole, field: "parent_role_name"},
+		{attribute: "users", objectType: sdk.ObjectTypeUser, field: "user_name"},
+	} {
+		for _, name := range instance.setAttribute(grantee.attribute) {
+			granteeId := sdk.NewAccountObjectIdentifier(name)
+			blocks = append(blocks, common.ResourceBlock{
+				ResourceType: "snowflake_grant_account_role",
+				Name:         common.ResourceName(append(r.nameParts(instance), name)...),
+				ImportId:     helpers.EncodeSnowflakeID(roleId.FullyQualifiedName(), grantee.objectType.String(), granteeId.FullyQualifiedName()),
+				Body: common.NewBlockBody().
+					WithAttribute("role_name", roleId.Name()).
+					WithAttribute(grantee.field, granteeId.Name()),
+			})
+		}
+	}
+	return blocks
+}
+
+func convertPrivilegesGrant(r stateResource, instance stateInstance, role string, privilege string, withGrantOption bool, on grantOn) (common.ResourceBlock, error) {
+	roleId := sdk.NewAccountObjectIdentifier(role)
+	id := resources.GrantPrivilegesToAccountRoleId{
+		RoleName:        roleId,
+		WithGrantOption: withGrantOption,
+	}
+	body := common.NewBlockBody().WithAttribute("account_role_name", roleId.FullyQualifiedName())
+	if privilege == "ALL" || privilege == "ALL PRIVILEGES" {
+		id.AllPrivileges = true
+		body.WithAttribute("all_privileges", true)
+	} else {
+		id.Privileges = []string{privilege}
+		body.WithAttribute("privileges", []string{privilege})
+	}
+	body.WithAttribute("with_grant_option", withGrantOption)
+
+	switch {
+	case on.onAccount:
+		id.Kind = resources.OnAccountAccountRoleGrantKind
+		id.Data = &resources.OnAccountGrantData{}
+		body.WithAttribute("on_account", true)
+	case on.bulk != nil && on.bulk.ObjectNamePlural == sdk.PluralObjectTypeSchemas:
+		data := &resources.OnSchemaGrantData{DatabaseName: on.bulk.Database}
+		onSchema := common.NewBlockBody()
+		if on.onFuture {
+			data.Kind = resources.OnFutureSchemasInDatabaseSchemaGrantKind
+			onSchema.WithAttribute("future_schemas_in_database", on.bulk.Database.FullyQualifiedName())
+		} else {
+			data.Kind = resources.OnAllSchemasInDatabaseSchemaGrantKind
+			onSchema.WithAttribute("all_schemas_in_database", on.bulk.Database.FullyQualifiedName())
+		}
+		id.Kind = resources.OnSchemaAccountRoleGrantKind
+		id.Data = data
+		body.WithBlock("on_schema", onSchema)
+	case on.bulk != nil:
+		data := &resources.OnSchemaObjectGrantData{OnAllOrFuture: on.bulk}
+		blockName := "all"
+		if on.onFuture {
+			data.Kind = resources.OnFutureSchemaObjectGrantKind
+			blockName = "future"
+		} else {
+			data.Kind = resources.OnAllSchemaObjectGrantKind
+		}
+		id.Kind = resources.OnSchemaObjectAccountRoleGrantKind
+		id.Data = data
+		body.WithBlock("on_schema_object", common.NewBlockBody().WithBlock(blockName, bulkBlockBody(on.bulk, false)))
+	case on.objectType == sdk.ObjectTypeSchema:
+		schemaId := on.objectId.(sdk.DatabaseObjectIdentifier)
+		id.Kind = resources.OnSchemaAccountRoleGrantKind
+		id.Data = &resources.OnSchemaGrantData{Kind: resources.OnSchemaSchemaGrantKind, SchemaName: &schemaId}
+		body.WithBlock("on_schema", common.NewBlockBody().WithAttribute("schema_name", schemaId.FullyQualifiedName()))
+	default:
+		if accountObjectId, ok := on.objectId.(sdk.AccountObjectIdentifier); ok {
+			id.Kind = resources.OnAccountObjectAccountRoleGrantKind
+			id.Data = &resources.OnAccountObjectGrantData{ObjectType: on.objectType, ObjectName: accountObjectId}
+			body.WithBlock("on_account_object", common.NewBlockBody().
+				WithAttribute("object_type", on.objectType.String()).
+				WithAttribute("object_name", accountObjectId.FullyQualifiedName()),
+			)
+		} else {
+			id.Kind = resources.OnSchemaObjectAccountRoleGrantKind
+			id.Data = &resources.OnSchemaObjectGrantData{Kind: resources.OnObjectSchemaObjectGrantKind, Object: &sdk.Object{ObjectType: on.objectType, Name: on.objectId}}
+			body.WithBlock("on_schema_object", common.NewBlockBody().
+				WithAttribute("object_type", on.objectType.String()).
+				WithAttribute("object_name", on.objectId.FullyQualifiedName()),
+			)
+		}
+	}
+
+	return common.ResourceBlock{
+		ResourceType: "snowflake_grant_privileges_to_account_role",
+		Name:         common.ResourceName(append(r.nameParts(instance), role)...),
+		ImportId:     id.String(),
+		Body:         body,
+	}, nil
+}
+
+func convertOwnershipGrant(r stateResource, instance stateInstance, role string, currentGrants string, on grantOn) ([]common.ResourceBlock, error) {
+	if on.onAccount {
+		return nil, fmt.Errorf("ownership cannot be granted on the account")
+	}
+	roleId := sdk.NewAccountObjectIdentifier(role)
+	id := resources.GrantOwnershipId{
+		GrantOwnershipTargetRoleKind: resources.ToAccountGrantOwnershipTargetRoleKind,
+		AccountRoleName:              roleId,
+	}
+	// The names are set the same way as they are set by the resource on import.
+	body := common.NewBlockBody().WithAttribute("account_role_name", roleId.Name())
+	if currentGrants != "" {
+		outboundPrivileges := resources.OutboundPrivilegesBehavior(strings.ToUpper(currentGrants))
+		id.OutboundPrivilegesBehavior = &outboundPrivileges
+		body.WithAttribute("outbound_privileges", string(outboundPrivileges))
+	}
+
+	switch {
+	case on.bulk != nil:
+		id.Data = on.bulk
+		blockName := "all"
+		if on.onFuture {
+			id.Kind = resources.OnFutureGrantOwnershipKind
+			blockName = "future"
+		} else {
+			id.Kind = resources.OnAllGrantOwnershipKind
+		}
+		body.WithBlock("on", common.NewBlockBody().WithBlock(blockName, bulkBlockBody(on.bulk, true)))
+	default:
+		id.Kind = resources.OnObjectGrantOwnershipKind
+		id.Data = &resources.OnObjectGrantOwnershipData{ObjectType: on.objectType, ObjectName: on.objectId}
+		objectName := on.objectId.FullyQualifiedName()
+		if accountObjectId, ok := on.objectId.(sdk.AccountObjectIdentifier); ok {
+			objectName = accountObjectId.Name()
+		}
+		body.WithBlock("on", common.NewBlockBody().
+			WithAttribute("object_type", on.objectType.String()).
+			WithAttribute("object_name", objectName),
+		)
+	}
+
+	return []common.ResourceBlock{{
+		ResourceType: "snowflake_grant_ownership",
+		Name:         common.ResourceName(append(r.nameParts(instance), role)...),
+		ImportId:     id.String(),
+		Body:         body,
+	}}, nil
+}
+
+// bulkBlockBody returns the body of the all or future block. The snowflake_grant_ownership resource sets the database name without quotes on import.
+func bulkBlockBody(bulk *resources.BulkOperationGrantData, unquotedDatabase bool) *common.BlockBody {
+	body := common.NewBlockBody().WithAttribute("object_type_plural", bulk.ObjectNamePlural.String())
+	switch bulk.Kind {
+	case resources.InDatabaseBulkOperationGrantKind:
+		if unquotedDatabase {
+			body.WithAttribute("in_database", bulk.Database.Name())
+		} else {
+			body.WithAttribute("in_database", bulk.Database.FullyQualifiedName())
+		}
+	case resources.InSchemaBulkOperationGrantKind:
+		body.WithAttribute("in_schema", bulk.Schema.FullyQualifiedName())
+	}
+	return body
+}
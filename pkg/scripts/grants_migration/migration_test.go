@@ -0,0 +1,105 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/scripts/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_migrate(t *testing.T) {
+	state := &terraformState{Version: 4, Resources: []stateResource{
+		{Mode: "managed", Type: "snowflake_database_grant", Name: "usage", Instances: []stateInstance{
+			{Attributes: map[string]any{"database_name": "DB", "privilege": "USAGE", "roles": []any{"ROLE_B", "ROLE_A"}, "with_grant_option": true}},
+		}},
+		{Mode: "managed", Type: "snowflake_table_grant", Name: "select", Instances: []stateInstance{
+			{Attributes: map[string]any{"database_name": "DB", "schema_name": "SCHEMA", "privilege": "SELECT", "roles": []any{"ROLE"}, "on_future": true}},
+		}},
+		{Mode: "managed", Type: "snowflake_schema_grant", Name: "ownership", Instances: []stateInstance{
+			{Attributes: map[string]any{"database_name": "DB", "schema_name": "SCHEMA", "privilege": "OWNERSHIP", "roles": []any{"ROLE"}}},
+		}},
+		{Module: "module.grants", Mode: "managed", Type: "snowflake_role_grants", Name: "hierarchy", Instances: []stateInstance{
+			{IndexKey: "x", Attributes: map[string]any{"role_name": "CHILD", "roles": []any{"PARENT"}, "users": []any{"USER"}}},
+		}},
+		{Mode: "managed", Type: "snowflake_role_ownership_grant", Name: "own", Instances: []stateInstance{
+			{Attributes: map[string]any{"on_role_name": "CHILD", "to_role_name": "OWNER", "current_grants": "COPY"}},
+		}},
+		{Mode: "managed", Type: "snowflake_function_grant", Name: "function"},
+		{Mode: "managed", Type: "snowflake_database", Name: "db"},
+	}}
+
+	m := migrate(state)
+
+	type expectedBlock struct {
+		resourceType string
+		name         string
+		importId     string
+	}
+	expected := []expectedBlock{
+		{"snowflake_grant_privileges_to_account_role", "usage_role_a", `"ROLE_A"|true|false|USAGE|OnAccountObject|DATABASE|"DB"`},
+		{"snowflake_grant_privileges_to_account_role", "usage_role_b", `"ROLE_B"|true|false|USAGE|OnAccountObject|DATABASE|"DB"`},
+		{"snowflake_grant_privileges_to_account_role", "select_role", `"ROLE"|false|false|SELECT|OnSchemaObject|OnFuture|TABLES|InSchema|"DB"."SCHEMA"`},
+		{"snowflake_grant_ownership", "ownership_role", `ToAccountRole|"ROLE"||OnObject|SCHEMA|"DB"."SCHEMA"`},
+		{"snowflake_grant_account_role", "grants_hierarchy_x_parent", `"CHILD"|ROLE|"PARENT"`},
+		{"snowflake_grant_account_role", "grants_hierarchy_x_user", `"CHILD"|USER|"USER"`},
+		{"snowflake_grant_ownership", "own_owner", `ToAccountRole|"OWNER"|COPY|OnObject|ROLE|"CHILD"`},
+	}
+	require.Len(t, m.blocks, len(expected))
+	for i, block := range m.blocks {
+		assert.Equal(t, expected[i].resourceType, block.ResourceType)
+		assert.Equal(t, expected[i].name, block.Name)
+		assert.Equal(t, expected[i].importId, block.ImportId)
+	}
+	assert.Equal(t, []string{
+		"snowflake_database_grant.usage",
+		"snowflake_table_grant.select",
+		"snowflake_schema_grant.ownership",
+		"module.grants.snowflake_role_grants.hierarchy",
+		"snowflake_role_ownership_grant.own",
+	}, m.removed)
+
+	t.Run("rendered configuration", func(t *testing.T) {
+		rendered := common.RenderResourceBlocks(m.blocks)
+
+		assert.Contains(t, rendered, `resource "snowflake_grant_privileges_to_account_role" "select_role" {
+  account_role_name = "\"ROLE\""
+  privileges        = ["SELECT"]
+
+  on_schema_object {
+
+    future {
+      object_type_plural = "TABLES"
+      in_schema          = "\"DB\".\"SCHEMA\""
+    }
+  }
+}`)
+		assert.Contains(t, rendered, `resource "snowflake_grant_ownership" "own_owner" {
+  account_role_name   = "OWNER"
+  outbound_privileges = "COPY"
+
+  on {
+    object_type = "ROLE"
+    object_name = "CHILD"
+  }
+}`)
+		assert.Contains(t, rendered, `resource "snowflake_grant_account_role" "grants_hierarchy_x_user" {
+  role_name = "CHILD"
+  user_name = "USER"
+}`)
+	})
+}
+
+func Test_migrate_allInstancesRequired(t *testing.T) {
+	state := &terraformState{Version: 4, Resources: []stateResource{
+		{Mode: "managed", Type: "snowflake_account_grant", Name: "grants", Instances: []stateInstance{
+			{IndexKey: 0, Attributes: map[string]any{"privilege": "CREATE DATABASE", "roles": []any{"ROLE"}}},
+			{IndexKey: 1, Attributes: map[string]any{"privilege": "OWNERSHIP", "roles": []any{"ROLE"}}},
+		}},
+	}}
+
+	m := migrate(state)
+
+	assert.Empty(t, m.blocks)
+	assert.Empty(t, m.removed)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// terraformState holds the parts of the Terraform state file (format version 4) used by the migration.
+type terraformState struct {
+	Version   int             `json:"version"`
+	Resources []stateResource `json:"resources"`
+}
+
+type stateResource struct {
+	Module    string          `json:"module"`
+	Mode      string          `json:"mode"`
+	Type      string          `json:"type"`
+	Name      string          `json:"name"`
+	Instances []stateInstance `json:"instances"`
+}
+
+type stateInstance struct {
+	IndexKey   any            `json:"index_key"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+func readState(path string) (*terraformState, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	state := new(terraformState)
+	if err := json.Unmarshal(content, state); err != nil {
+		return nil, fmt.Errorf("parsing state file %s: %w", path, err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("unsupported state file version %d, only version 4 is supported", state.Version)
+	}
+	return state, nil
+}
+
+// address returns the resource address used in the removed block (without the instance key).
+func (r stateResource) address() string {
+	address := fmt.Sprintf("%s.%s", r.Type, r.Name)
+	if r.Module != "" {
+		address = fmt.Sprintf("%s.%s", r.Module, address)
+	}
+	return address
+}
+
+// nameParts returns the parts identifying the instance, used to build the names of the new resources.
+func (r stateResource) nameParts(instance stateInstance) []string {
+	parts := make([]string, 0)
+	if r.Module != "" {
+		parts = append(parts, strings.ReplaceAll(r.Module, "module.", ""))
+	}
+	parts = append(parts, r.Name)
+	if instance.IndexKey != nil {
+		parts = append(parts, fmt.Sprintf("%v", instance.IndexKey))
+	}
+	return parts
+}
+
+func (i stateInstance) stringAttribute(name string) string {
+	if value, ok := i.Attributes[name].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func (i stateInstance) boolAttribute(name string) bool {
+	if value, ok := i.Attributes[name].(bool); ok {
+		return value
+	}
+	return false
+}
+
+// setAttribute returns the sorted values of the set of strings.
+func (i stateInstance) setAttribute(name string) []string {
+	values := make([]string, 0)
+	if list, ok := i.Attributes[name].([]any); ok {
+		for _, item := range list {
+			if value, ok := item.(string); ok && value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	sort.Strings(values)
+	return values
+}
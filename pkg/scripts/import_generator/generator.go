@@ -0,0 +1,567 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/scripts/common"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+)
+
+type objectType string
+
+const (
+	objectTypeDatabase     objectType = "database"
+	objectTypeSchema       objectType = "schema"
+	objectTypeWarehouse    objectType = "warehouse"
+	objectTypeAccountRole  objectType = "account_role"
+	objectTypeDatabaseRole objectType = "database_role"
+	objectTypeUser         objectType = "user"
+	objectTypeTable        objectType = "table"
+	objectTypeView         objectType = "view"
+	objectTypeSequence     objectType = "sequence"
+	objectTypeStage        objectType = "stage"
+	objectTypeFileFormat   objectType = "file_format"
+	objectTypeStream       objectType = "stream"
+	objectTypeTask         objectType = "task"
+	objectTypeFunction     objectType = "function"
+	objectTypeProcedure    objectType = "procedure"
+	objectTypeSecret       objectType = "secret"
+	objectTypeGrant        objectType = "grant"
+)
+
+var allObjectTypes = []objectType{
+	objectTypeDatabase,
+	objectTypeSchema,
+	objectTypeWarehouse,
+	objectTypeAccountRole,
+	objectTypeDatabaseRole,
+	objectTypeUser,
+	objectTypeTable,
+	objectTypeView,
+	objectTypeSequence,
+	objectTypeStage,
+	objectTypeFileFormat,
+	objectTypeStream,
+	objectTypeTask,
+	objectTypeFunction,
+	objectTypeProcedure,
+	objectTypeSecret,
+	objectTypeGrant,
+}
+
+func toObjectType(s string) (objectType, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if idx := slices.Index(allObjectTypes, objectType(s)); idx >= 0 {
+		return allObjectTypes[idx], nil
+	}
+	return "", fmt.Errorf("invalid object type: %s, valid values are: %v", s, allObjectTypes)
+}
+
+// systemRoles and systemUsers are created by Snowflake in every account, so they are not generated.
+var (
+	systemRoles = []string{"ACCOUNTADMIN", "ORGADMIN", "GLOBALORGADMIN", "SECURITYADMIN", "SYSADMIN", "USERADMIN", "PUBLIC"}
+	systemUsers = []string{"SNOWFLAKE"}
+)
+
+// accountObjectGrantTypes lists the types accepted by the on_account_object block of the snowflake_grant_privileges_to_account_role resource.
+var accountObjectGrantTypes = []sdk.ObjectType{
+	sdk.ObjectTypeUser,
+	sdk.ObjectTypeResourceMonitor,
+	sdk.ObjectTypeWarehouse,
+	sdk.ObjectTypeComputePool,
+	sdk.ObjectTypeDatabase,
+	sdk.ObjectTypeIntegration,
+	sdk.ObjectTypeFailoverGroup,
+	sdk.ObjectTypeReplicationGroup,
+	sdk.ObjectTypeExternalVolume,
+}
+
+// filters decide which objects are generated. The name patterns are matched against the dot-separated object names
+// without quotes (e.g. MY_DATABASE.MY_SCHEMA.MY_TABLE); for grants, they are matched against the grantee role name.
+type filters struct {
+	includeTypes []objectType
+	excludeTypes []objectType
+	include      *regexp.Regexp
+	exclude      *regexp.Regexp
+}
+
+func (f filters) typeEnabled(t objectType) bool {
+	if len(f.includeTypes) > 0 && !slices.Contains(f.includeTypes, t) {
+		return false
+	}
+	return !slices.Contains(f.excludeTypes, t)
+}
+
+func (f filters) nameMatches(parts ...string) bool {
+	name := strings.Join(parts, ".")
+	if f.include != nil && !f.include.MatchString(name) {
+		return false
+	}
+	return f.exclude == nil || !f.exclude.MatchString(name)
+}
+
+func (f filters) matches(t objectType, parts ...string) bool {
+	return f.typeEnabled(t) && f.nameMatches(parts...)
+}
+
+type generator struct {
+	client  *sdk.Client
+	filters filters
+
+	blocks []common.ResourceBlock
+	names  map[string]int
+}
+
+func newGenerator(client *sdk.Client, filters filters) *generator {
+	return &generator{
+		client:  client,
+		filters: filters,
+		names:   make(map[string]int),
+	}
+}
+
+// add registers the resource, making its name unique within the resource type.
+func (g *generator) add(resourceType string, name string, importId string, body *common.BlockBody) {
+	key := resourceType + "." + name
+	g.names[key]++
+	if count := g.names[key]; count > 1 {
+		name = fmt.Sprintf("%s_%d", name, count)
+	}
+	g.blocks = append(g.blocks, common.ResourceBlock{
+		ResourceType: resourceType,
+		Name:         name,
+		ImportId:     importId,
+		Body:         body,
+	})
+}
+
+func (g *generator) generate(ctx context.Context) ([]common.ResourceBlock, error) {
+	steps := []func(context.Context) error{
+		g.generateDatabases,
+		g.generateWarehouses,
+		g.generateRolesAndGrants,
+		g.generateUsers,
+	}
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return g.blocks, nil
+}
+
+func (g *generator) generateDatabases(ctx context.Context) error {
+	if !slices.ContainsFunc([]objectType{objectTypeDatabase, objectTypeSchema, objectTypeDatabaseRole, objectTypeTable, objectTypeView, objectTypeSequence, objectTypeStage, objectTypeFileFormat, objectTypeStream, objectTypeTask, objectTypeFunction, objectTypeProcedure, objectTypeSecret}, g.filters.typeEnabled) {
+		return nil
+	}
+	databases, err := g.client.Databases.Show(ctx, &sdk.ShowDatabasesOptions{})
+	if err != nil {
+		return fmt.Errorf("listing databases: %w", err)
+	}
+	for _, database := range databases {
+		// Shared, secondary, and application databases are managed by the other resources.
+		if database.Origin != nil || (database.Kind != "" && database.Kind != "STANDARD") || database.Name == "SNOWFLAKE" {
+			common.ScriptsDebug("Skipping database %s of kind %s", database.Name, database.Kind)
+			continue
+		}
+		if g.filters.matches(objectTypeDatabase, database.Name) {
+			g.add("snowflake_database", common.ResourceName(database.Name), helpers.EncodeResourceIdentifier(database.ID()), common.NewBlockBody().
+				WithAttribute("name", database.Name).
+				WithAttribute("is_transient", database.Transient).
+				WithAttribute("comment", database.Comment),
+			)
+		}
+		steps := []func(context.Context, sdk.Database) error{
+			g.generateSchemas,
+			g.generateDatabaseRoles,
+			g.generateTables,
+			g.generateViews,
+			g.generateSequences,
+			g.generateStages,
+			g.generateFileFormats,
+			g.generateStreams,
+			g.generateTasks,
+			g.generateFunctions,
+			g.generateProcedures,
+			g.generateSecrets,
+		}
+		for _, step := range steps {
+			if err := step(ctx, database); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *generator) generateSchemas(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeSchema) {
+		return nil
+	}
+	schemas, err := g.client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{
+		In: &sdk.SchemaIn{
+			Database: sdk.Bool(true),
+			Name:     database.ID(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing schemas in database %s: %w", database.Name, err)
+	}
+	for _, s := range schemas {
+		if s.Name == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeSchema, s.DatabaseName, s.Name) {
+			continue
+		}
+		g.add("snowflake_schema", common.ResourceName(s.DatabaseName, s.Name), helpers.EncodeResourceIdentifier(s.ID()), common.NewBlockBody().
+			WithAttribute("database", s.DatabaseName).
+			WithAttribute("name", s.Name).
+			WithAttribute("is_transient", s.IsTransient()).
+			WithAttribute("with_managed_access", s.IsManagedAccess()).
+			WithAttribute("comment", s.Comment),
+		)
+	}
+	return nil
+}
+
+func (g *generator) generateDatabaseRoles(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeDatabaseRole) {
+		return nil
+	}
+	databaseRoles, err := g.client.DatabaseRoles.Show(ctx, sdk.NewShowDatabaseRoleRequest(database.ID()))
+	if err != nil {
+		return fmt.Errorf("listing database roles in database %s: %w", database.Name, err)
+	}
+	for _, databaseRole := range databaseRoles {
+		if !g.filters.matches(objectTypeDatabaseRole, databaseRole.DatabaseName, databaseRole.Name) {
+			continue
+		}
+		g.add("snowflake_database_role", common.ResourceName(databaseRole.DatabaseName, databaseRole.Name), helpers.EncodeResourceIdentifier(databaseRole.ID()), common.NewBlockBody().
+			WithAttribute("database", databaseRole.DatabaseName).
+			WithAttribute("name", databaseRole.Name).
+			WithAttribute("comment", databaseRole.Comment),
+		)
+	}
+	return nil
+}
+
+func (g *generator) generateTables(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeTable) {
+		return nil
+	}
+	tables, err := g.client.Tables.Show(ctx, sdk.NewShowTableRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Database: database.ID()}}))
+	if err != nil {
+		return fmt.Errorf("listing tables in database %s: %w", database.Name, err)
+	}
+	for _, table := range tables {
+		if table.SchemaName == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeTable, table.DatabaseName, table.SchemaName, table.Name) {
+			continue
+		}
+		// Transient, temporary, external, and event tables cannot be managed by the snowflake_table resource.
+		if table.Kind != "TABLE" || table.IsExternal || table.IsEvent {
+			common.ScriptsWarn("Skipping table %s of kind %s", table.ID().FullyQualifiedName(), table.Kind)
+			continue
+		}
+		columns, err := g.client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(table.ID()))
+		if err != nil {
+			return fmt.Errorf("describing columns of table %s: %w", table.ID().FullyQualifiedName(), err)
+		}
+		body := common.NewBlockBody().
+			WithAttribute("database", table.DatabaseName).
+			WithAttribute("schema", table.SchemaName).
+			WithAttribute("name", table.Name).
+			WithAttribute("comment", table.Comment).
+			WithAttribute("cluster_by", table.GetClusterByKeys()).
+			WithAttribute("change_tracking", table.ChangeTracking)
+		for _, column := range columns {
+			columnBody := common.NewBlockBody().
+				WithAttribute("name", column.Name).
+				WithAttribute("type", string(column.Type)).
+				WithAttributeAlways("nullable", column.IsNullable)
+			if column.Comment != nil {
+				columnBody.WithAttribute("comment", *column.Comment)
+			}
+			body.WithBlock("column", columnBody)
+		}
+		g.add("snowflake_table", common.ResourceName(table.DatabaseName, table.SchemaName, table.Name), helpers.EncodeResourceIdentifier(table.ID()), body)
+	}
+	return nil
+}
+
+func (g *generator) generateViews(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeView) {
+		return nil
+	}
+	views, err := g.client.Views.Show(ctx, sdk.NewShowViewRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Database: database.ID()}}))
+	if err != nil {
+		return fmt.Errorf("listing views in database %s: %w", database.Name, err)
+	}
+	for _, view := range views {
+		if view.SchemaName == "INFORMATION_SCHEMA" || view.IsMaterialized || !g.filters.matches(objectTypeView, view.DatabaseName, view.SchemaName, view.Name) {
+			continue
+		}
+		statement, err := snowflake.NewViewSelectStatementExtractor(view.Text).Extract()
+		if err != nil || statement == "" {
+			common.ScriptsWarn("Skipping view %s, its statement could not be read: %v", view.ID().FullyQualifiedName(), err)
+			continue
+		}
+		g.add("snowflake_view", common.ResourceName(view.DatabaseName, view.SchemaName, view.Name), helpers.EncodeResourceIdentifier(view.ID()), common.NewBlockBody().
+			WithAttribute("database", view.DatabaseName).
+			WithAttribute("schema", view.SchemaName).
+			WithAttribute("name", view.Name).
+			WithAttribute("is_secure", view.IsSecure).
+			WithAttribute("comment", view.Comment).
+			WithAttribute("statement", statement),
+		)
+	}
+	return nil
+}
+
+func (g *generator) generateWarehouses(ctx context.Context) error {
+	if !g.filters.typeEnabled(objectTypeWarehouse) {
+		return nil
+	}
+	warehouses, err := g.client.Warehouses.Show(ctx, &sdk.ShowWarehouseOptions{})
+	if err != nil {
+		return fmt.Errorf("listing warehouses: %w", err)
+	}
+	for _, warehouse := range warehouses {
+		if !g.filters.matches(objectTypeWarehouse, warehouse.Name) {
+			continue
+		}
+		body := common.NewBlockBody().
+			WithAttribute("name", warehouse.Name).
+			WithAttribute("warehouse_type", string(warehouse.Type)).
+			WithAttribute("warehouse_size", string(warehouse.Size)).
+			WithAttributeAlways("auto_suspend", warehouse.AutoSuspend).
+			WithAttributeAlways("auto_resume", warehouse.AutoResume)
+		if warehouse.MaxClusterCount > 1 {
+			body.WithAttribute("min_cluster_count", warehouse.MinClusterCount).
+				WithAttribute("max_cluster_count", warehouse.MaxClusterCount).
+				WithAttribute("scaling_policy", string(warehouse.ScalingPolicy))
+		}
+		if warehouse.EnableQueryAcceleration {
+			body.WithAttribute("enable_query_acceleration", true).
+				WithAttribute("query_acceleration_max_scale_factor", warehouse.QueryAccelerationMaxScaleFactor)
+		}
+		if resourceMonitor := warehouse.ResourceMonitor.Name(); resourceMonitor != "" && resourceMonitor != "null" {
+			body.WithAttribute("resource_monitor", warehouse.ResourceMonitor.FullyQualifiedName())
+		}
+		body.WithAttribute("comment", warehouse.Comment)
+		g.add("snowflake_warehouse", common.ResourceName(warehouse.Name), helpers.EncodeResourceIdentifier(warehouse.ID()), body)
+	}
+	return nil
+}
+
+func (g *generator) generateUsers(ctx context.Context) error {
+	if !g.filters.typeEnabled(objectTypeUser) {
+		return nil
+	}
+	users, err := g.client.Users.Show(ctx, &sdk.ShowUserOptions{})
+	if err != nil {
+		return fmt.Errorf("listing users: %w", err)
+	}
+	for _, user := range users {
+		if slices.Contains(systemUsers, user.Name) || !g.filters.matches(objectTypeUser, user.Name) {
+			continue
+		}
+		var resourceType string
+		switch strings.ToUpper(user.Type) {
+		case "", "NULL", "PERSON":
+			resourceType = "snowflake_user"
+		case "SERVICE":
+			resourceType = "snowflake_service_user"
+		case "LEGACY_SERVICE":
+			resourceType = "snowflake_legacy_service_user"
+		default:
+			common.ScriptsWarn("Skipping user %s of unsupported type %s", user.Name, user.Type)
+			continue
+		}
+		// The attributes that are not supported by the given user type are skipped during rendering.
+		g.add(resourceType, common.ResourceName(user.Name), helpers.EncodeResourceIdentifier(user.ID()), common.NewBlockBody().
+			WithAttribute("name", user.Name).
+			WithAttribute("login_name", user.LoginName).
+			WithAttribute("display_name", user.DisplayName).
+			WithAttribute("first_name", user.FirstName).
+			WithAttribute("last_name", user.LastName).
+			WithAttribute("email", user.Email).
+			WithAttribute("default_warehouse", user.DefaultWarehouse).
+			WithAttribute("default_namespace", user.DefaultNamespace).
+			WithAttribute("default_role", user.DefaultRole).
+			WithAttribute("disabled", user.Disabled).
+			WithAttribute("comment", user.Comment),
+		)
+	}
+	return nil
+}
+
+func (g *generator) generateRolesAndGrants(ctx context.Context) error {
+	if !g.filters.typeEnabled(objectTypeAccountRole) && !g.filters.typeEnabled(objectTypeGrant) {
+		return nil
+	}
+	roles, err := g.client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+	if err != nil {
+		return fmt.Errorf("listing roles: %w", err)
+	}
+	for _, role := range roles {
+		if !g.filters.nameMatches(role.Name) {
+			continue
+		}
+		if g.filters.typeEnabled(objectTypeAccountRole) && !slices.Contains(systemRoles, role.Name) {
+			g.add("snowflake_account_role", common.ResourceName(role.Name), helpers.EncodeResourceIdentifier(role.ID()), common.NewBlockBody().
+				WithAttribute("name", role.Name).
+				WithAttribute("comment", role.Comment),
+			)
+		}
+		if g.filters.typeEnabled(objectTypeGrant) {
+			if err := g.generateGrantsOfRole(ctx, role.ID()); err != nil {
+				return err
+			}
+			if err := g.generateGrantsToRole(ctx, role.ID()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// generateGrantsOfRole generates the grants of the role to the other roles and users.
+func (g *generator) generateGrantsOfRole(ctx context.Context, id sdk.AccountObjectIdentifier) error {
+	grants, err := g.client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{
+			Role: id,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing grants of role %s: %w", id.Name(), err)
+	}
+	for _, grant := range grants {
+		// The grants without the grantor are created by Snowflake.
+		if grant.GrantedBy.Name() == "" || grant.GranteeName == nil {
+			continue
+		}
+		grantee := sdk.NewAccountObjectIdentifier(grant.GranteeName.Name())
+		// The names are not quoted, because the resource sets them without quotes on import.
+		body := common.NewBlockBody().WithAttribute("role_name", id.Name())
+		switch grant.GrantedTo {
+		case sdk.ObjectTypeRole:
+			body.WithAttribute("parent_role_name", grantee.Name())
+		case sdk.ObjectTypeUser:
+			body.WithAttribute("user_name", grantee.Name())
+		default:
+			continue
+		}
+		g.add("snowflake_grant_account_role", common.ResourceName(id.Name(), "to", grantee.Name()),
+			helpers.EncodeSnowflakeID(id.FullyQualifiedName(), grant.GrantedTo.String(), grantee.FullyQualifiedName()),
+			body,
+		)
+	}
+	return nil
+}
+
+type privilegesGrantKey struct {
+	grantedOn       sdk.ObjectType
+	objectName      string
+	withGrantOption bool
+}
+
+// generateGrantsToRole generates one snowflake_grant_privileges_to_account_role resource for all the privileges
+// granted to the role on the given object.
+func (g *generator) generateGrantsToRole(ctx context.Context, id sdk.AccountObjectIdentifier) error {
+	grants, err := g.client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		To: &sdk.ShowGrantsTo{
+			Role: id,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing grants to role %s: %w", id.Name(), err)
+	}
+
+	privileges := make(map[privilegesGrantKey][]string)
+	objects := make(map[privilegesGrantKey]sdk.ObjectIdentifier)
+	keys := make([]privilegesGrantKey, 0)
+	for _, grant := range grants {
+		if grant.Privilege == "OWNERSHIP" || grant.GrantedBy.Name() == "" || grant.GrantedOn == sdk.ObjectTypeRole {
+			continue
+		}
+		if grant.GrantedOn.IsWithArguments() {
+			common.ScriptsWarn("Skipping %s on %s %s granted to role %s: objects with arguments are not supported", grant.Privilege, grant.GrantedOn, grant.Name, id.Name())
+			continue
+		}
+		key := privilegesGrantKey{grantedOn: grant.GrantedOn, withGrantOption: grant.GrantOption}
+		if grant.Name != nil && grant.GrantedOn != sdk.ObjectTypeAccount {
+			key.objectName = grant.Name.FullyQualifiedName()
+			objects[key] = grant.Name
+		}
+		if _, ok := privileges[key]; !ok {
+			keys = append(keys, key)
+		}
+		privileges[key] = append(privileges[key], grant.Privilege)
+	}
+
+	for _, key := range keys {
+		grantPrivileges := privileges[key]
+		sort.Strings(grantPrivileges)
+		grantId := resources.GrantPrivilegesToAccountRoleId{
+			RoleName:        id,
+			WithGrantOption: key.withGrantOption,
+			Privileges:      grantPrivileges,
+		}
+		body := common.NewBlockBody().
+			WithAttribute("account_role_name", id.FullyQualifiedName()).
+			WithAttribute("privileges", grantPrivileges).
+			WithAttribute("with_grant_option", key.withGrantOption)
+
+		object := objects[key]
+		switch {
+		case key.grantedOn == sdk.ObjectTypeAccount:
+			grantId.Kind = resources.OnAccountAccountRoleGrantKind
+			grantId.Data = &resources.OnAccountGrantData{}
+			body.WithAttribute("on_account", true)
+		case slices.Contains(accountObjectGrantTypes, key.grantedOn):
+			objectId := sdk.NewAccountObjectIdentifier(object.Name())
+			grantId.Kind = resources.OnAccountObjectAccountRoleGrantKind
+			grantId.Data = &resources.OnAccountObjectGrantData{ObjectType: key.grantedOn, ObjectName: objectId}
+			body.WithBlock("on_account_object", common.NewBlockBody().
+				WithAttribute("object_type", key.grantedOn.String()).
+				WithAttribute("object_name", objectId.FullyQualifiedName()),
+			)
+		case key.grantedOn == sdk.ObjectTypeSchema:
+			schemaId, err := sdk.ParseDatabaseObjectIdentifier(object.FullyQualifiedName())
+			if err != nil {
+				return err
+			}
+			grantId.Kind = resources.OnSchemaAccountRoleGrantKind
+			grantId.Data = &resources.OnSchemaGrantData{Kind: resources.OnSchemaSchemaGrantKind, SchemaName: &schemaId}
+			body.WithBlock("on_schema", common.NewBlockBody().
+				WithAttribute("schema_name", schemaId.FullyQualifiedName()),
+			)
+		case slices.Contains(sdk.ValidGrantToObjectTypesString, key.grantedOn.String()):
+			grantId.Kind = resources.OnSchemaObjectAccountRoleGrantKind
+			grantId.Data = &resources.OnSchemaObjectGrantData{Kind: resources.OnObjectSchemaObjectGrantKind, Object: &sdk.Object{ObjectType: key.grantedOn, Name: object}}
+			body.WithBlock("on_schema_object", common.NewBlockBody().
+				WithAttribute("object_type", key.grantedOn.String()).
+				WithAttribute("object_name", object.FullyQualifiedName()),
+			)
+		default:
+			common.ScriptsWarn("Skipping %v on %s %s granted to role %s: unsupported object type", grantPrivileges, key.grantedOn, key.objectName, id.Name())
+			continue
+		}
+
+		nameParts := []string{id.Name(), "on", key.grantedOn.String()}
+		if object != nil {
+			nameParts = append(nameParts, strings.ReplaceAll(object.FullyQualifiedName(), `"`, ""))
+		}
+		if key.withGrantOption {
+			nameParts = append(nameParts, "with_grant_option")
+		}
+		g.add("snowflake_grant_privileges_to_account_role", common.ResourceName(nameParts...), grantId.String(), body)
+	}
+	return nil
+}
@@ -0,0 +1,390 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/scripts/common"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+)
+
+// functionResourceTypes and procedureResourceTypes map the languages to the resources managing the functions and procedures.
+var (
+	functionResourceTypes = map[string]string{
+		"SQL":        "snowflake_function_sql",
+		"JAVASCRIPT": "snowflake_function_javascript",
+		"PYTHON":     "snowflake_function_python",
+		"JAVA":       "snowflake_function_java",
+		"SCALA":      "snowflake_function_scala",
+	}
+	procedureResourceTypes = map[string]string{
+		"SQL":        "snowflake_procedure_sql",
+		"JAVASCRIPT": "snowflake_procedure_javascript",
+		"PYTHON":     "snowflake_procedure_python",
+		"JAVA":       "snowflake_procedure_java",
+		"SCALA":      "snowflake_procedure_scala",
+	}
+)
+
+func (g *generator) generateSequences(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeSequence) {
+		return nil
+	}
+	sequences, err := g.client.Sequences.Show(ctx, sdk.NewShowSequenceRequest().WithIn(sdk.In{Database: database.ID()}))
+	if err != nil {
+		return fmt.Errorf("listing sequences in database %s: %w", database.Name, err)
+	}
+	for _, sequence := range sequences {
+		if sequence.SchemaName == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeSequence, sequence.DatabaseName, sequence.SchemaName, sequence.Name) {
+			continue
+		}
+		ordering := "NOORDER"
+		if sequence.Ordered {
+			ordering = "ORDER"
+		}
+		g.add("snowflake_sequence", common.ResourceName(sequence.DatabaseName, sequence.SchemaName, sequence.Name), helpers.EncodeSnowflakeID(sequence.ID()), common.NewBlockBody().
+			WithAttribute("database", sequence.DatabaseName).
+			WithAttribute("schema", sequence.SchemaName).
+			WithAttribute("name", sequence.Name).
+			WithAttributeAlways("increment", sequence.Interval).
+			WithAttribute("ordering", ordering).
+			WithAttribute("comment", sequence.Comment),
+		)
+	}
+	return nil
+}
+
+func (g *generator) generateStages(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeStage) {
+		return nil
+	}
+	stages, err := g.client.Stages.Show(ctx, sdk.NewShowStageRequest().WithIn(sdk.In{Database: database.ID()}))
+	if err != nil {
+		return fmt.Errorf("listing stages in database %s: %w", database.Name, err)
+	}
+	for _, stage := range stages {
+		if stage.SchemaName == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeStage, stage.DatabaseName, stage.SchemaName, stage.Name) {
+			continue
+		}
+		// The credentials of the external stages cannot be read from Snowflake.
+		if stage.HasCredentials {
+			common.ScriptsWarn("Skipping stage %s: its credentials cannot be read, so the resource has to be written manually", stage.ID().FullyQualifiedName())
+			continue
+		}
+		body := common.NewBlockBody().
+			WithAttribute("database", stage.DatabaseName).
+			WithAttribute("schema", stage.SchemaName).
+			WithAttribute("name", stage.Name).
+			WithAttribute("url", stage.Url)
+		if stage.StorageIntegration != nil {
+			body.WithAttribute("storage_integration", *stage.StorageIntegration)
+		}
+		body.WithAttribute("comment", stage.Comment)
+		g.add("snowflake_stage", common.ResourceName(stage.DatabaseName, stage.SchemaName, stage.Name), helpers.EncodeSnowflakeID(stage.ID()), body)
+	}
+	return nil
+}
+
+func (g *generator) generateFileFormats(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeFileFormat) {
+		return nil
+	}
+	fileFormats, err := g.client.FileFormats.Show(ctx, &sdk.ShowFileFormatsOptions{In: &sdk.In{Database: database.ID()}})
+	if err != nil {
+		return fmt.Errorf("listing file formats in database %s: %w", database.Name, err)
+	}
+	for _, fileFormat := range fileFormats {
+		id := fileFormat.ID()
+		if id.SchemaName() == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeFileFormat, id.DatabaseName(), id.SchemaName(), id.Name()) {
+			continue
+		}
+		// The format options are left out; they are shown in the plan after the import.
+		g.add("snowflake_file_format", common.ResourceName(id.DatabaseName(), id.SchemaName(), id.Name()), helpers.EncodeSnowflakeID(id), common.NewBlockBody().
+			WithAttribute("database", id.DatabaseName()).
+			WithAttribute("schema", id.SchemaName()).
+			WithAttribute("name", id.Name()).
+			WithAttribute("format_type", string(fileFormat.Type)).
+			WithAttribute("comment", fileFormat.Comment),
+		)
+	}
+	return nil
+}
+
+func (g *generator) generateStreams(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeStream) {
+		return nil
+	}
+	streams, err := g.client.Streams.Show(ctx, sdk.NewShowStreamRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Database: database.ID()}}))
+	if err != nil {
+		return fmt.Errorf("listing streams in database %s: %w", database.Name, err)
+	}
+	for _, stream := range streams {
+		if stream.SchemaName == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeStream, stream.DatabaseName, stream.SchemaName, stream.Name) {
+			continue
+		}
+		if stream.SourceType == nil || stream.TableName == nil {
+			common.ScriptsWarn("Skipping stream %s: its source could not be read", stream.ID().FullyQualifiedName())
+			continue
+		}
+		body := common.NewBlockBody().
+			WithAttribute("database", stream.DatabaseName).
+			WithAttribute("schema", stream.SchemaName).
+			WithAttribute("name", stream.Name)
+		var resourceType string
+		switch *stream.SourceType {
+		case sdk.StreamSourceTypeTable:
+			resourceType = "snowflake_stream_on_table"
+			body.WithAttribute("table", sourceIdentifier(*stream.TableName)).
+				WithAttribute("append_only", stream.IsAppendOnly())
+		case sdk.StreamSourceTypeView:
+			resourceType = "snowflake_stream_on_view"
+			body.WithAttribute("view", sourceIdentifier(*stream.TableName)).
+				WithAttribute("append_only", stream.IsAppendOnly())
+		case sdk.StreamSourceTypeExternalTable:
+			resourceType = "snowflake_stream_on_external_table"
+			body.WithAttribute("external_table", sourceIdentifier(*stream.TableName)).
+				WithAttribute("insert_only", stream.IsInsertOnly())
+		case sdk.StreamSourceTypeStage:
+			resourceType = "snowflake_stream_on_directory_table"
+			body.WithAttribute("stage", sourceIdentifier(*stream.TableName))
+		default:
+			common.ScriptsWarn("Skipping stream %s on unsupported source type %s", stream.ID().FullyQualifiedName(), *stream.SourceType)
+			continue
+		}
+		if stream.Comment != nil {
+			body.WithAttribute("comment", *stream.Comment)
+		}
+		g.add(resourceType, common.ResourceName(stream.DatabaseName, stream.SchemaName, stream.Name), helpers.EncodeResourceIdentifier(stream.ID()), body)
+	}
+	return nil
+}
+
+// sourceIdentifier returns the fully qualified name of the stream source, or the name as returned by Snowflake if it cannot be parsed.
+func sourceIdentifier(name string) string {
+	if id, err := sdk.ParseSchemaObjectIdentifier(name); err == nil {
+		return id.FullyQualifiedName()
+	}
+	return name
+}
+
+func (g *generator) generateTasks(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeTask) {
+		return nil
+	}
+	tasks, err := g.client.Tasks.Show(ctx, sdk.NewShowTaskRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Database: database.ID()}}))
+	if err != nil {
+		return fmt.Errorf("listing tasks in database %s: %w", database.Name, err)
+	}
+	for _, task := range tasks {
+		if task.SchemaName == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeTask, task.DatabaseName, task.SchemaName, task.Name) {
+			continue
+		}
+		body := common.NewBlockBody().
+			WithAttribute("database", task.DatabaseName).
+			WithAttribute("schema", task.SchemaName).
+			WithAttribute("name", task.Name).
+			WithAttributeAlways("started", task.IsStarted()).
+			WithAttribute("sql_statement", task.Definition).
+			WithAttribute("when", task.Condition).
+			WithAttribute("config", task.Config).
+			WithAttribute("allow_overlapping_execution", task.AllowOverlappingExecution).
+			WithAttribute("after", collections.Map(task.TaskRelations.Predecessors, sdk.SchemaObjectIdentifier.FullyQualifiedName)).
+			WithAttribute("comment", task.Comment)
+		if task.Warehouse != nil {
+			body.WithAttribute("warehouse", task.Warehouse.Name())
+		}
+		if task.ErrorIntegration != nil {
+			body.WithAttribute("error_integration", task.ErrorIntegration.Name())
+		}
+		if task.TaskRelations.FinalizedRootTask != nil {
+			body.WithAttribute("finalize", task.TaskRelations.FinalizedRootTask.FullyQualifiedName())
+		}
+		if task.Schedule != "" {
+			taskSchedule, err := sdk.ParseTaskSchedule(task.Schedule)
+			if err != nil {
+				common.ScriptsWarn("Skipping task %s: its schedule could not be read: %v", task.ID().FullyQualifiedName(), err)
+				continue
+			}
+			switch {
+			case taskSchedule.Cron != "":
+				body.WithBlock("schedule", common.NewBlockBody().WithAttribute("using_cron", taskSchedule.Cron))
+			case taskSchedule.Minutes > 0:
+				body.WithBlock("schedule", common.NewBlockBody().WithAttribute("minutes", taskSchedule.Minutes))
+			}
+		}
+		g.add("snowflake_task", common.ResourceName(task.DatabaseName, task.SchemaName, task.Name), helpers.EncodeResourceIdentifier(task.ID()), body)
+	}
+	return nil
+}
+
+func (g *generator) generateFunctions(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeFunction) {
+		return nil
+	}
+	functions, err := g.client.Functions.Show(ctx, sdk.NewShowFunctionRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Database: database.ID()}}))
+	if err != nil {
+		return fmt.Errorf("listing functions in database %s: %w", database.Name, err)
+	}
+	for _, function := range functions {
+		id := function.ID()
+		if function.IsBuiltin || function.SchemaName == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeFunction, id.DatabaseName(), id.SchemaName(), id.Name()) {
+			continue
+		}
+		resourceType, ok := functionResourceTypes[strings.ToUpper(function.Language)]
+		if !ok || function.IsExternalFunction || function.IsDataMetric {
+			common.ScriptsWarn("Skipping function %s: functions in %s language (external and data metric functions included) are not supported", id.FullyQualifiedName(), function.Language)
+			continue
+		}
+		details, err := g.client.Functions.DescribeDetails(ctx, id)
+		if err != nil {
+			return fmt.Errorf("describing function %s: %w", id.FullyQualifiedName(), err)
+		}
+		if details.Body == nil && definitionRequired(details.Language) {
+			common.ScriptsWarn("Skipping secure function %s: its definition cannot be read", id.FullyQualifiedName())
+			continue
+		}
+		body := functionOrProcedureBody(id, details.NormalizedArguments, details.ReturnDataType, function.IsSecure, function.Description).
+			WithAttribute("runtime_version", stringOrEmpty(details.RuntimeVersion)).
+			WithAttribute("handler", stringOrEmpty(details.Handler)).
+			WithAttribute("packages", details.NormalizedPackages).
+			WithAttribute("external_access_integrations", collections.Map(details.NormalizedExternalAccessIntegrations, sdk.AccountObjectIdentifier.FullyQualifiedName)).
+			WithAttribute("function_definition", stringOrEmpty(details.Body))
+		withFunctionOrProcedureFiles(body, details.NormalizedImports, details.NormalizedSecrets)
+		g.add(resourceType, common.ResourceName(id.DatabaseName(), id.SchemaName(), id.Name()), id.FullyQualifiedName(), body)
+	}
+	return nil
+}
+
+func (g *generator) generateProcedures(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeProcedure) {
+		return nil
+	}
+	procedures, err := g.client.Procedures.Show(ctx, sdk.NewShowProcedureRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Database: database.ID()}}))
+	if err != nil {
+		return fmt.Errorf("listing procedures in database %s: %w", database.Name, err)
+	}
+	for _, procedure := range procedures {
+		id := procedure.ID()
+		if procedure.IsBuiltin || procedure.SchemaName == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeProcedure, id.DatabaseName(), id.SchemaName(), id.Name()) {
+			continue
+		}
+		// The language is not returned by SHOW PROCEDURES, so every procedure has to be described.
+		details, err := g.client.Procedures.DescribeDetails(ctx, id)
+		if err != nil {
+			return fmt.Errorf("describing procedure %s: %w", id.FullyQualifiedName(), err)
+		}
+		if details.Body == nil && definitionRequired(details.Language) {
+			common.ScriptsWarn("Skipping secure procedure %s: its definition cannot be read", id.FullyQualifiedName())
+			continue
+		}
+		resourceType, ok := procedureResourceTypes[strings.ToUpper(details.Language)]
+		if !ok {
+			common.ScriptsWarn("Skipping procedure %s: procedures in %s language are not supported", id.FullyQualifiedName(), details.Language)
+			continue
+		}
+		body := functionOrProcedureBody(id, details.NormalizedArguments, details.ReturnDataType, procedure.IsSecure, procedure.Description).
+			WithAttribute("execute_as", details.ExecuteAs).
+			WithAttribute("runtime_version", stringOrEmpty(details.RuntimeVersion)).
+			WithAttribute("handler", stringOrEmpty(details.Handler)).
+			WithAttribute("snowpark_package", details.SnowparkVersion).
+			WithAttribute("packages", details.NormalizedPackages).
+			WithAttribute("external_access_integrations", collections.Map(details.NormalizedExternalAccessIntegrations, sdk.AccountObjectIdentifier.FullyQualifiedName)).
+			WithAttribute("procedure_definition", stringOrEmpty(details.Body))
+		withFunctionOrProcedureFiles(body, details.NormalizedImports, details.NormalizedSecrets)
+		g.add(resourceType, common.ResourceName(id.DatabaseName(), id.SchemaName(), id.Name()), id.FullyQualifiedName(), body)
+	}
+	return nil
+}
+
+func functionOrProcedureBody(id sdk.SchemaObjectIdentifierWithArguments, arguments []sdk.NormalizedArgument, returnDataType datatypes.DataType, isSecure bool, comment string) *common.BlockBody {
+	body := common.NewBlockBody().
+		WithAttribute("database", id.DatabaseName()).
+		WithAttribute("schema", id.SchemaName()).
+		WithAttribute("name", id.Name()).
+		WithAttribute("is_secure", isSecure).
+		WithAttribute("comment", comment)
+	if returnDataType != nil {
+		body.WithAttribute("return_type", returnDataType.ToSql())
+	}
+	for _, argument := range arguments {
+		body.WithBlock("arguments", common.NewBlockBody().
+			WithAttribute("arg_name", argument.Name).
+			WithAttribute("arg_data_type", argument.DataType.ToSql()),
+		)
+	}
+	return body
+}
+
+func withFunctionOrProcedureFiles(body *common.BlockBody, imports []sdk.NormalizedPath, secrets map[string]sdk.SchemaObjectIdentifier) {
+	for _, path := range imports {
+		body.WithBlock("imports", common.NewBlockBody().
+			WithAttribute("stage_location", path.StageLocation).
+			WithAttribute("path_on_stage", path.PathOnStage),
+		)
+	}
+	for _, variableName := range slices.Sorted(maps.Keys(secrets)) {
+		body.WithBlock("secrets", common.NewBlockBody().
+			WithAttribute("secret_variable_name", variableName).
+			WithAttribute("secret_id", secrets[variableName].FullyQualifiedName()),
+		)
+	}
+}
+
+// definitionRequired reports whether the function or procedure in the given language cannot be created without the definition.
+// The definition is not returned for the secure objects.
+func definitionRequired(language string) bool {
+	return slices.Contains([]string{"SQL", "JAVASCRIPT"}, strings.ToUpper(language))
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// generateSecrets generates only the secrets with the OAuth client credentials flow. The other secrets hold sensitive values
+// (e.g. passwords or refresh tokens) that cannot be read from Snowflake, so they are listed in the warnings instead.
+func (g *generator) generateSecrets(ctx context.Context, database sdk.Database) error {
+	if !g.filters.typeEnabled(objectTypeSecret) {
+		return nil
+	}
+	secrets, err := g.client.Secrets.Show(ctx, sdk.NewShowSecretRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Database: database.ID()}}))
+	if err != nil {
+		return fmt.Errorf("listing secrets in database %s: %w", database.Name, err)
+	}
+	for _, secret := range secrets {
+		if secret.SchemaName == "INFORMATION_SCHEMA" || !g.filters.matches(objectTypeSecret, secret.DatabaseName, secret.SchemaName, secret.Name) {
+			continue
+		}
+		if secret.SecretType != string(sdk.SecretTypeOAuth2) {
+			common.ScriptsWarn("Skipping secret %s of type %s: its value cannot be read, so the resource has to be written manually", secret.ID().FullyQualifiedName(), secret.SecretType)
+			continue
+		}
+		details, err := g.client.Secrets.Describe(ctx, secret.ID())
+		if err != nil {
+			return fmt.Errorf("describing secret %s: %w", secret.ID().FullyQualifiedName(), err)
+		}
+		if details.OauthRefreshTokenExpiryTime != nil || details.IntegrationName == nil {
+			common.ScriptsWarn("Skipping secret %s with the OAuth authorization code grant flow: its refresh token cannot be read, so the resource has to be written manually", secret.ID().FullyQualifiedName())
+			continue
+		}
+		body := common.NewBlockBody().
+			WithAttribute("database", secret.DatabaseName).
+			WithAttribute("schema", secret.SchemaName).
+			WithAttribute("name", secret.Name).
+			WithAttribute("api_authentication", *details.IntegrationName).
+			WithAttributeAlways("oauth_scopes", details.OauthScopes)
+		if secret.Comment != nil {
+			body.WithAttribute("comment", *secret.Comment)
+		}
+		g.add("snowflake_secret_with_client_credentials", common.ResourceName(secret.DatabaseName, secret.SchemaName, secret.Name), helpers.EncodeResourceIdentifier(secret.ID()), body)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/scripts/common"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+/*
+This script is used to generate the Terraform configuration for the objects existing in a Snowflake account.
+It lists the objects with the SDK client and, for every object, it generates an import block together with the resource
+configuration. The generated attributes are validated against the current resource schemas. The objects that cannot be
+generated (e.g. secrets holding passwords) are skipped with a warning naming them.
+
+Usage: go run ./pkg/scripts/import_generator [-profile <profile>] [-output <file>] [-include-types <types>] [-exclude-types <types>] [-include <regex>] [-exclude <regex>]
+*/
+func main() {
+	profile := flag.String("profile", "default", "Profile from the Snowflake TOML config file used to connect to Snowflake.")
+	output := flag.String("output", "imported.tf", "Path to the generated file.")
+	includeTypes := flag.String("include-types", "", fmt.Sprintf("Comma-separated object types to generate; all types by default. Valid values are: %v.", allObjectTypes))
+	excludeTypes := flag.String("exclude-types", "", "Comma-separated object types to skip.")
+	include := flag.String("include", "", "Only the objects with the names matching this regular expression are generated.")
+	exclude := flag.String("exclude", "", "The objects with the names matching this regular expression are skipped.")
+	flag.Parse()
+
+	f, err := parseFilters(*includeTypes, *excludeTypes, *include, *exclude)
+	if err != nil {
+		common.ScriptsWarn("Invalid filters: %v", err)
+		os.Exit(1)
+	}
+
+	config, err := sdk.ProfileConfig(*profile)
+	if err != nil || config == nil {
+		common.ScriptsWarn("Reading profile %s failed: %v", *profile, err)
+		os.Exit(1)
+	}
+	client, err := sdk.NewClient(config)
+	if err != nil {
+		common.ScriptsWarn("Connecting to Snowflake failed: %v", err)
+		os.Exit(1)
+	}
+
+	blocks, err := newGenerator(client, f).generate(context.Background())
+	if err != nil {
+		common.ScriptsWarn("Generating the configuration failed: %v", err)
+		os.Exit(1)
+	}
+
+	content := common.RenderResourceBlocks(blocks)
+	if err := os.WriteFile(*output, []byte(content), 0o600); err != nil {
+		common.ScriptsWarn("Writing to %s failed: %v", *output, err)
+		os.Exit(1)
+	}
+	common.ScriptsDebug("Generated %d resources in %s", len(blocks), *output)
+}
+
+func parseFilters(includeTypes string, excludeTypes string, include string, exclude string) (filters, error) {
+	var f filters
+	var err error
+	if f.includeTypes, err = parseObjectTypes(includeTypes); err != nil {
+		return f, err
+	}
+	if f.excludeTypes, err = parseObjectTypes(excludeTypes); err != nil {
+		return f, err
+	}
+	if include != "" {
+		if f.include, err = regexp.Compile(include); err != nil {
+			return f, err
+		}
+	}
+	if exclude != "" {
+		if f.exclude, err = regexp.Compile(exclude); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
+}
+
+func parseObjectTypes(s string) ([]objectType, error) {
+	types := make([]objectType, 0)
+	if strings.TrimSpace(s) == "" {
+		return types, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		t, err := toObjectType(part)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
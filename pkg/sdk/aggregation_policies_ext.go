@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateAggregationPolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
@@ -0,0 +1,230 @@
+package sdk
+
+import "testing"
+
+func TestAggregationPolicies_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateAggregationPolicyOptions
+	defaultOpts := func() *CreateAggregationPolicyOptions {
+		return &CreateAggregationPolicyOptions{
+			name: id,
+			body: "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.body] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.body = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateAggregationPolicyOptions", "body"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateAggregationPolicyOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE AGGREGATION POLICY %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE AGGREGATION POLICY %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5) COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+}
+
+func TestAggregationPolicies_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterAggregationPolicyOptions
+	defaultOpts := func() *AlterAggregationPolicyOptions {
+		return &AlterAggregationPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetBody opts.SetTags opts.UnsetTags opts.SetComment opts.UnsetComment] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterAggregationPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetBody opts.SetTags opts.UnsetTags opts.SetComment opts.UnsetComment] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterAggregationPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set body", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetBody = String("AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY %s SET BODY -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)", id.FullyQualifiedName())
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY %s SET COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetComment = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("tag2"),
+				Value: "value2",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER AGGREGATION POLICY %s SET TAG "tag1" = 'value1', "tag2" = 'value2'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+			NewAccountObjectIdentifier("tag2"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER AGGREGATION POLICY %s UNSET TAG "tag1", "tag2"`, id.FullyQualifiedName())
+	})
+}
+
+func TestAggregationPolicies_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropAggregationPolicyOptions
+	defaultOpts := func() *DropAggregationPolicyOptions {
+		return &DropAggregationPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP AGGREGATION POLICY %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP AGGREGATION POLICY IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestAggregationPolicies_Show(t *testing.T) {
+	// Minimal valid ShowAggregationPolicyOptions
+	defaultOpts := func() *ShowAggregationPolicyOptions {
+		return &ShowAggregationPolicyOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AGGREGATION POLICIES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &ExtendedIn{
+			In: In{
+				Account: Bool(true),
+			},
+		}
+		opts.Limit = &LimitFrom{
+			Rows: Pointer(10),
+			From: Pointer("foo"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AGGREGATION POLICIES LIKE 'pattern' IN ACCOUNT LIMIT 10 FROM 'foo'")
+	})
+}
+
+func TestAggregationPolicies_Describe(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeAggregationPolicyOptions
+	defaultOpts := func() *DescribeAggregationPolicyOptions {
+		return &DescribeAggregationPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE AGGREGATION POLICY %s", id.FullyQualifiedName())
+	})
+}
@@ -50,6 +50,48 @@ var applicationPackageUnset = g.NewQueryStruct("ApplicationPackageUnset").
 	OptionalSQL("DISTRIBUTION").
 	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "DefaultDdlCollation", "Comment", "Distribution")
 
+var applicationPackageVersionDbRow = g.DbStruct("applicationPackageVersionRow").
+	Text("version").
+	Number("patch").
+	OptionalText("label").
+	OptionalText("comment").
+	Text("created_on").
+	OptionalText("dropped_on").
+	OptionalText("log_level").
+	OptionalText("trace_level").
+	OptionalText("state").
+	OptionalText("review_status")
+
+var applicationPackageVersion = g.PlainStruct("ApplicationPackageVersion").
+	Text("Version").
+	Number("Patch").
+	OptionalText("Label").
+	OptionalText("Comment").
+	Text("CreatedOn").
+	OptionalText("DroppedOn").
+	OptionalText("LogLevel").
+	OptionalText("TraceLevel").
+	OptionalText("State").
+	OptionalText("ReviewStatus")
+
+var applicationPackageReleaseDirectiveDbRow = g.DbStruct("applicationPackageReleaseDirectiveRow").
+	Text("name").
+	OptionalText("target_type").
+	OptionalText("target_name").
+	Text("created_on").
+	Text("version").
+	Number("patch").
+	OptionalText("modified_on")
+
+var applicationPackageReleaseDirective = g.PlainStruct("ApplicationPackageReleaseDirective").
+	Text("Name").
+	OptionalText("TargetType").
+	OptionalText("TargetName").
+	Text("CreatedOn").
+	Text("Version").
+	Number("Patch").
+	OptionalText("ModifiedOn")
+
 var ApplicationPackagesDef = g.NewInterface(
 	"ApplicationPackages",
 	"ApplicationPackage",
@@ -66,6 +108,7 @@ var ApplicationPackagesDef = g.NewInterface(
 		OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 		PredefinedQueryStructField("Distribution", "*Distribution", g.ParameterOptions().SQL("DISTRIBUTION")).
+		OptionalBooleanAssignment("ENABLE_RELEASE_CHANNELS", g.ParameterOptions()).
 		OptionalTags().
 		WithValidation(g.ValidIdentifier, "name"),
 ).AlterOperation(
@@ -165,4 +208,28 @@ var ApplicationPackagesDef = g.NewInterface(
 		OptionalLimit(),
 ).ShowByIdOperationWithFiltering(
 	g.ShowByIDLikeFiltering,
+).CustomShowOperation(
+	"ShowVersions",
+	g.ShowMappingKindSlice,
+	"https://docs.snowflake.com/en/sql-reference/sql/show-versions",
+	applicationPackageVersionDbRow,
+	applicationPackageVersion,
+	g.NewQueryStruct("ShowVersionsApplicationPackage").
+		Show().
+		SQL("VERSIONS").
+		SQL("IN APPLICATION PACKAGE").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).CustomShowOperation(
+	"ShowReleaseDirectives",
+	g.ShowMappingKindSlice,
+	"https://docs.snowflake.com/en/sql-reference/sql/show-release-directives",
+	applicationPackageReleaseDirectiveDbRow,
+	applicationPackageReleaseDirective,
+	g.NewQueryStruct("ShowReleaseDirectivesApplicationPackage").
+		Show().
+		SQL("RELEASE DIRECTIVES").
+		SQL("IN APPLICATION PACKAGE").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
 )
@@ -42,6 +42,11 @@ func (s *CreateApplicationPackageRequest) WithDistribution(Distribution *Distrib
 	return s
 }
 
+func (s *CreateApplicationPackageRequest) WithEnableReleaseChannels(EnableReleaseChannels *bool) *CreateApplicationPackageRequest {
+	s.EnableReleaseChannels = EnableReleaseChannels
+	return s
+}
+
 func (s *CreateApplicationPackageRequest) WithTag(Tag []TagAssociation) *CreateApplicationPackageRequest {
 	s.Tag = Tag
 	return s
@@ -289,3 +294,19 @@ func (s *ShowApplicationPackageRequest) WithLimit(Limit *LimitFrom) *ShowApplica
 	s.Limit = Limit
 	return s
 }
+
+func NewShowVersionsApplicationPackageRequest(
+	name AccountObjectIdentifier,
+) *ShowVersionsApplicationPackageRequest {
+	s := ShowVersionsApplicationPackageRequest{}
+	s.name = name
+	return &s
+}
+
+func NewShowReleaseDirectivesApplicationPackageRequest(
+	name AccountObjectIdentifier,
+) *ShowReleaseDirectivesApplicationPackageRequest {
+	s := ShowReleaseDirectivesApplicationPackageRequest{}
+	s.name = name
+	return &s
+}
@@ -3,10 +3,12 @@ package sdk
 //go:generate go run ./dto-builder-generator/main.go
 
 var (
-	_ optionsProvider[CreateApplicationPackageOptions] = new(CreateApplicationPackageRequest)
-	_ optionsProvider[AlterApplicationPackageOptions]  = new(AlterApplicationPackageRequest)
-	_ optionsProvider[DropApplicationPackageOptions]   = new(DropApplicationPackageRequest)
-	_ optionsProvider[ShowApplicationPackageOptions]   = new(ShowApplicationPackageRequest)
+	_ optionsProvider[CreateApplicationPackageOptions]                = new(CreateApplicationPackageRequest)
+	_ optionsProvider[AlterApplicationPackageOptions]                 = new(AlterApplicationPackageRequest)
+	_ optionsProvider[DropApplicationPackageOptions]                  = new(DropApplicationPackageRequest)
+	_ optionsProvider[ShowApplicationPackageOptions]                  = new(ShowApplicationPackageRequest)
+	_ optionsProvider[ShowVersionsApplicationPackageOptions]          = new(ShowVersionsApplicationPackageRequest)
+	_ optionsProvider[ShowReleaseDirectivesApplicationPackageOptions] = new(ShowReleaseDirectivesApplicationPackageRequest)
 )
 
 type CreateApplicationPackageRequest struct {
@@ -17,6 +19,7 @@ type CreateApplicationPackageRequest struct {
 	DefaultDdlCollation        *string
 	Comment                    *string
 	Distribution               *Distribution
+	EnableReleaseChannels      *bool
 	Tag                        []TagAssociation
 }
 
@@ -100,3 +103,11 @@ type ShowApplicationPackageRequest struct {
 	StartsWith *string
 	Limit      *LimitFrom
 }
+
+type ShowVersionsApplicationPackageRequest struct {
+	name AccountObjectIdentifier // required
+}
+
+type ShowReleaseDirectivesApplicationPackageRequest struct {
+	name AccountObjectIdentifier // required
+}
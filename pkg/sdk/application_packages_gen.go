@@ -13,6 +13,8 @@ type ApplicationPackages interface {
 	Show(ctx context.Context, request *ShowApplicationPackageRequest) ([]ApplicationPackage, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
 	ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
+	ShowVersions(ctx context.Context, request *ShowVersionsApplicationPackageRequest) ([]ApplicationPackageVersion, error)
+	ShowReleaseDirectives(ctx context.Context, request *ShowReleaseDirectivesApplicationPackageRequest) ([]ApplicationPackageReleaseDirective, error)
 }
 
 // CreateApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application-package.
@@ -26,6 +28,7 @@ type CreateApplicationPackageOptions struct {
 	DefaultDdlCollation        *string                 `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
 	Comment                    *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
 	Distribution               *Distribution           `ddl:"parameter" sql:"DISTRIBUTION"`
+	EnableReleaseChannels      *bool                   `ddl:"parameter" sql:"ENABLE_RELEASE_CHANNELS"`
 	Tag                        []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
 }
 
@@ -150,3 +153,65 @@ type ApplicationPackage struct {
 func (a *ApplicationPackage) ID() AccountObjectIdentifier {
 	return NewAccountObjectIdentifier(a.Name)
 }
+
+// ShowVersionsApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-versions.
+type ShowVersionsApplicationPackageOptions struct {
+	show                 bool                    `ddl:"static" sql:"SHOW"`
+	versions             bool                    `ddl:"static" sql:"VERSIONS"`
+	inApplicationPackage bool                    `ddl:"static" sql:"IN APPLICATION PACKAGE"`
+	name                 AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type applicationPackageVersionRow struct {
+	Version      string         `db:"version"`
+	Patch        int            `db:"patch"`
+	Label        sql.NullString `db:"label"`
+	Comment      sql.NullString `db:"comment"`
+	CreatedOn    string         `db:"created_on"`
+	DroppedOn    sql.NullString `db:"dropped_on"`
+	LogLevel     sql.NullString `db:"log_level"`
+	TraceLevel   sql.NullString `db:"trace_level"`
+	State        sql.NullString `db:"state"`
+	ReviewStatus sql.NullString `db:"review_status"`
+}
+
+type ApplicationPackageVersion struct {
+	Version      string
+	Patch        int
+	Label        *string
+	Comment      *string
+	CreatedOn    string
+	DroppedOn    *string
+	LogLevel     *string
+	TraceLevel   *string
+	State        *string
+	ReviewStatus *string
+}
+
+// ShowReleaseDirectivesApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-release-directives.
+type ShowReleaseDirectivesApplicationPackageOptions struct {
+	show                 bool                    `ddl:"static" sql:"SHOW"`
+	releaseDirectives    bool                    `ddl:"static" sql:"RELEASE DIRECTIVES"`
+	inApplicationPackage bool                    `ddl:"static" sql:"IN APPLICATION PACKAGE"`
+	name                 AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type applicationPackageReleaseDirectiveRow struct {
+	Name       string         `db:"name"`
+	TargetType sql.NullString `db:"target_type"`
+	TargetName sql.NullString `db:"target_name"`
+	CreatedOn  string         `db:"created_on"`
+	Version    string         `db:"version"`
+	Patch      int            `db:"patch"`
+	ModifiedOn sql.NullString `db:"modified_on"`
+}
+
+type ApplicationPackageReleaseDirective struct {
+	Name       string
+	TargetType *string
+	TargetName *string
+	CreatedOn  string
+	Version    string
+	Patch      int
+	ModifiedOn *string
+}
@@ -55,6 +55,26 @@ func (v *applicationPackages) ShowByIDSafely(ctx context.Context, id AccountObje
 	return SafeShowById(v.client, v.ShowByID, ctx, id)
 }
 
+func (v *applicationPackages) ShowVersions(ctx context.Context, request *ShowVersionsApplicationPackageRequest) ([]ApplicationPackageVersion, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[applicationPackageVersionRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationPackageVersionRow, ApplicationPackageVersion](dbRows)
+	return resultList, nil
+}
+
+func (v *applicationPackages) ShowReleaseDirectives(ctx context.Context, request *ShowReleaseDirectivesApplicationPackageRequest) ([]ApplicationPackageReleaseDirective, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[applicationPackageReleaseDirectiveRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationPackageReleaseDirectiveRow, ApplicationPackageReleaseDirective](dbRows)
+	return resultList, nil
+}
+
 func (r *CreateApplicationPackageRequest) toOpts() *CreateApplicationPackageOptions {
 	opts := &CreateApplicationPackageOptions{
 		IfNotExists:                r.IfNotExists,
@@ -64,6 +84,7 @@ func (r *CreateApplicationPackageRequest) toOpts() *CreateApplicationPackageOpti
 		DefaultDdlCollation:        r.DefaultDdlCollation,
 		Comment:                    r.Comment,
 		Distribution:               r.Distribution,
+		EnableReleaseChannels:      r.EnableReleaseChannels,
 		Tag:                        r.Tag,
 	}
 	return opts
@@ -180,3 +201,46 @@ func (r applicationPackageRow) convert() *ApplicationPackage {
 	}
 	return e
 }
+
+func (r *ShowVersionsApplicationPackageRequest) toOpts() *ShowVersionsApplicationPackageOptions {
+	opts := &ShowVersionsApplicationPackageOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r applicationPackageVersionRow) convert() *ApplicationPackageVersion {
+	v := &ApplicationPackageVersion{
+		Version:   r.Version,
+		Patch:     r.Patch,
+		CreatedOn: r.CreatedOn,
+	}
+	mapNullString(&v.Label, r.Label)
+	mapNullString(&v.Comment, r.Comment)
+	mapNullString(&v.DroppedOn, r.DroppedOn)
+	mapNullString(&v.LogLevel, r.LogLevel)
+	mapNullString(&v.TraceLevel, r.TraceLevel)
+	mapNullString(&v.State, r.State)
+	mapNullString(&v.ReviewStatus, r.ReviewStatus)
+	return v
+}
+
+func (r *ShowReleaseDirectivesApplicationPackageRequest) toOpts() *ShowReleaseDirectivesApplicationPackageOptions {
+	opts := &ShowReleaseDirectivesApplicationPackageOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r applicationPackageReleaseDirectiveRow) convert() *ApplicationPackageReleaseDirective {
+	rd := &ApplicationPackageReleaseDirective{
+		Name:      r.Name,
+		CreatedOn: r.CreatedOn,
+		Version:   r.Version,
+		Patch:     r.Patch,
+	}
+	mapNullString(&rd.TargetType, r.TargetType)
+	mapNullString(&rd.TargetName, r.TargetName)
+	mapNullString(&rd.ModifiedOn, r.ModifiedOn)
+	return rd
+}
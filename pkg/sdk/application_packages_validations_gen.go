@@ -5,6 +5,8 @@ var (
 	_ validatable = new(AlterApplicationPackageOptions)
 	_ validatable = new(DropApplicationPackageOptions)
 	_ validatable = new(ShowApplicationPackageOptions)
+	_ validatable = new(ShowVersionsApplicationPackageOptions)
+	_ validatable = new(ShowReleaseDirectivesApplicationPackageOptions)
 )
 
 func (opts *CreateApplicationPackageOptions) validate() error {
@@ -55,3 +57,25 @@ func (opts *ShowApplicationPackageOptions) validate() error {
 	var errs []error
 	return JoinErrors(errs...)
 }
+
+func (opts *ShowVersionsApplicationPackageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowReleaseDirectivesApplicationPackageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
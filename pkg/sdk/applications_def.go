@@ -4,10 +4,6 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
-/*
- * 	todo: add definition for `CREATE APPLICATION <name> FROM LISTING <listing_name> [ COMMENT = '<string_literal>' ] [ WITH TAG ( <tag_name> = '<tag_value>' [ , ... ] ) ]`
- */
-
 var versionAndPatch = g.NewQueryStruct("VersionAndPatch").
 	TextAssignment("VERSION", g.ParameterOptions().NoEquals().NoQuotes().Required()).
 	OptionalNumberAssignment("PATCH", g.ParameterOptions().NoEquals().Required())
@@ -55,6 +51,19 @@ var ApplicationsDef = g.NewInterface(
 		OptionalTags().
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifier, "PackageName"),
+).CustomOperation(
+	"CreateFromListing",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-application",
+	g.NewQueryStruct("CreateApplicationFromListing").
+		Create().
+		SQL("APPLICATION").
+		Name().
+		SQL("FROM LISTING").
+		Identifier("ListingName", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Required()).
+		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+		OptionalTags().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ValidIdentifier, "ListingName"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-application",
 	g.NewQueryStruct("DropApplication").
@@ -34,6 +34,26 @@ func (s *CreateApplicationRequest) WithTag(Tag []TagAssociation) *CreateApplicat
 	return s
 }
 
+func NewCreateFromListingApplicationRequest(
+	name AccountObjectIdentifier,
+	ListingName AccountObjectIdentifier,
+) *CreateFromListingApplicationRequest {
+	s := CreateFromListingApplicationRequest{}
+	s.name = name
+	s.ListingName = ListingName
+	return &s
+}
+
+func (s *CreateFromListingApplicationRequest) WithComment(Comment *string) *CreateFromListingApplicationRequest {
+	s.Comment = Comment
+	return s
+}
+
+func (s *CreateFromListingApplicationRequest) WithTag(Tag []TagAssociation) *CreateFromListingApplicationRequest {
+	s.Tag = Tag
+	return s
+}
+
 func NewApplicationVersionRequest() *ApplicationVersionRequest {
 	return &ApplicationVersionRequest{}
 }
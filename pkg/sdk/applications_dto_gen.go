@@ -3,11 +3,12 @@ package sdk
 //go:generate go run ./dto-builder-generator/main.go
 
 var (
-	_ optionsProvider[CreateApplicationOptions]   = new(CreateApplicationRequest)
-	_ optionsProvider[DropApplicationOptions]     = new(DropApplicationRequest)
-	_ optionsProvider[AlterApplicationOptions]    = new(AlterApplicationRequest)
-	_ optionsProvider[ShowApplicationOptions]     = new(ShowApplicationRequest)
-	_ optionsProvider[DescribeApplicationOptions] = new(DescribeApplicationRequest)
+	_ optionsProvider[CreateApplicationOptions]            = new(CreateApplicationRequest)
+	_ optionsProvider[CreateFromListingApplicationOptions] = new(CreateFromListingApplicationRequest)
+	_ optionsProvider[DropApplicationOptions]              = new(DropApplicationRequest)
+	_ optionsProvider[AlterApplicationOptions]             = new(AlterApplicationRequest)
+	_ optionsProvider[ShowApplicationOptions]              = new(ShowApplicationRequest)
+	_ optionsProvider[DescribeApplicationOptions]          = new(DescribeApplicationRequest)
 )
 
 type CreateApplicationRequest struct {
@@ -19,6 +20,13 @@ type CreateApplicationRequest struct {
 	Tag         []TagAssociation
 }
 
+type CreateFromListingApplicationRequest struct {
+	name        AccountObjectIdentifier // required
+	ListingName AccountObjectIdentifier // required
+	Comment     *string
+	Tag         []TagAssociation
+}
+
 type ApplicationVersionRequest struct {
 	VersionDirectory *string
 	VersionAndPatch  *VersionAndPatchRequest
@@ -7,6 +7,7 @@ import (
 
 type Applications interface {
 	Create(ctx context.Context, request *CreateApplicationRequest) error
+	CreateFromListing(ctx context.Context, request *CreateFromListingApplicationRequest) error
 	Drop(ctx context.Context, request *DropApplicationRequest) error
 	DropSafely(ctx context.Context, id AccountObjectIdentifier) error
 	Alter(ctx context.Context, request *AlterApplicationRequest) error
@@ -29,6 +30,17 @@ type CreateApplicationOptions struct {
 	Tag                    []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
 }
 
+// CreateFromListingApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application.
+type CreateFromListingApplicationOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"`
+	application bool                    `ddl:"static" sql:"APPLICATION"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	fromListing bool                    `ddl:"static" sql:"FROM LISTING"`
+	ListingName AccountObjectIdentifier `ddl:"identifier"`
+	Comment     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag         []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
 type ApplicationVersion struct {
 	VersionDirectory *string          `ddl:"keyword,single_quotes"`
 	VersionAndPatch  *VersionAndPatch `ddl:"keyword,no_quotes"`
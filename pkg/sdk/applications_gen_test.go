@@ -90,6 +90,54 @@ func TestApplications_Create(t *testing.T) {
 	})
 }
 
+func TestApplications_CreateFromListing(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+	lid := randomAccountObjectIdentifier()
+
+	defaultOpts := func() *CreateFromListingApplicationOptions {
+		return &CreateFromListingApplicationOptions{
+			name:        id,
+			ListingName: lid,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateFromListingApplicationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: incorrect listing identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ListingName = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION %s FROM LISTING %s`, id.FullyQualifiedName(), lid.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		tid := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.Comment = String("test")
+		opts.Tag = []TagAssociation{
+			{
+				Name:  tid,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION %s FROM LISTING %s COMMENT = 'test' TAG (%s = 'v1')`, id.FullyQualifiedName(), lid.FullyQualifiedName(), tid.FullyQualifiedName())
+	})
+}
+
 func TestApplications_Alter(t *testing.T) {
 	id := randomAccountObjectIdentifier()
 
@@ -2,6 +2,7 @@ package sdk
 
 var (
 	_ validatable = new(CreateApplicationOptions)
+	_ validatable = new(CreateFromListingApplicationOptions)
 	_ validatable = new(DropApplicationOptions)
 	_ validatable = new(AlterApplicationOptions)
 	_ validatable = new(ShowApplicationOptions)
@@ -30,6 +31,20 @@ func (opts *CreateApplicationOptions) validate() error {
 	return JoinErrors(errs...)
 }
 
+func (opts *CreateFromListingApplicationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.ListingName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
 func (opts *DropApplicationOptions) validate() error {
 	if opts == nil {
 		return ErrNilOptions
@@ -0,0 +1,215 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+)
+
+//go:generate go run ./poc/main.go
+
+type (
+	CatalogIntegrationCatalogSource  string
+	CatalogIntegrationTableFormat    string
+	CatalogIntegrationCatalogApiType string
+)
+
+const (
+	CatalogIntegrationCatalogSourceGlue        CatalogIntegrationCatalogSource = "GLUE"
+	CatalogIntegrationCatalogSourceObjectStore CatalogIntegrationCatalogSource = "OBJECT_STORE"
+	CatalogIntegrationCatalogSourcePolaris     CatalogIntegrationCatalogSource = "POLARIS"
+
+	CatalogIntegrationTableFormatIceberg CatalogIntegrationTableFormat = "ICEBERG"
+	CatalogIntegrationTableFormatDelta   CatalogIntegrationTableFormat = "DELTA"
+
+	CatalogIntegrationCatalogApiTypePublic  CatalogIntegrationCatalogApiType = "PUBLIC"
+	CatalogIntegrationCatalogApiTypePrivate CatalogIntegrationCatalogApiType = "PRIVATE"
+)
+
+var AllCatalogIntegrationCatalogSources = []CatalogIntegrationCatalogSource{
+	CatalogIntegrationCatalogSourceGlue,
+	CatalogIntegrationCatalogSourceObjectStore,
+	CatalogIntegrationCatalogSourcePolaris,
+}
+
+var AllCatalogIntegrationTableFormats = []CatalogIntegrationTableFormat{
+	CatalogIntegrationTableFormatIceberg,
+	CatalogIntegrationTableFormatDelta,
+}
+
+var AllCatalogIntegrationCatalogApiTypes = []CatalogIntegrationCatalogApiType{
+	CatalogIntegrationCatalogApiTypePublic,
+	CatalogIntegrationCatalogApiTypePrivate,
+}
+
+func ToCatalogIntegrationCatalogSource(s string) (CatalogIntegrationCatalogSource, error) {
+	switch strings.ToUpper(s) {
+	case string(CatalogIntegrationCatalogSourceGlue):
+		return CatalogIntegrationCatalogSourceGlue, nil
+	case string(CatalogIntegrationCatalogSourceObjectStore):
+		return CatalogIntegrationCatalogSourceObjectStore, nil
+	case string(CatalogIntegrationCatalogSourcePolaris):
+		return CatalogIntegrationCatalogSourcePolaris, nil
+	default:
+		return "", fmt.Errorf("invalid catalog source: %s", s)
+	}
+}
+
+func ToCatalogIntegrationTableFormat(s string) (CatalogIntegrationTableFormat, error) {
+	switch strings.ToUpper(s) {
+	case string(CatalogIntegrationTableFormatIceberg):
+		return CatalogIntegrationTableFormatIceberg, nil
+	case string(CatalogIntegrationTableFormatDelta):
+		return CatalogIntegrationTableFormatDelta, nil
+	default:
+		return "", fmt.Errorf("invalid table format: %s", s)
+	}
+}
+
+func ToCatalogIntegrationCatalogApiType(s string) (CatalogIntegrationCatalogApiType, error) {
+	switch strings.ToUpper(s) {
+	case string(CatalogIntegrationCatalogApiTypePublic):
+		return CatalogIntegrationCatalogApiTypePublic, nil
+	case string(CatalogIntegrationCatalogApiTypePrivate):
+		return CatalogIntegrationCatalogApiTypePrivate, nil
+	default:
+		return "", fmt.Errorf("invalid catalog api type: %s", s)
+	}
+}
+
+var catalogIntegrationGlueParamsDef = g.NewQueryStruct("CatalogIntegrationGlueParams").
+	TextAssignment("GLUE_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes().Required()).
+	TextAssignment("GLUE_CATALOG_ID", g.ParameterOptions().SingleQuotes().Required()).
+	OptionalTextAssignment("GLUE_REGION", g.ParameterOptions().SingleQuotes())
+
+var catalogIntegrationRestConfigDef = g.NewQueryStruct("CatalogIntegrationRestConfig").
+	TextAssignment("CATALOG_URI", g.ParameterOptions().SingleQuotes().Required()).
+	OptionalAssignment("CATALOG_API_TYPE", g.KindOfTPointer[CatalogIntegrationCatalogApiType](), g.ParameterOptions()).
+	TextAssignment("CATALOG_NAME", g.ParameterOptions().SingleQuotes().Required())
+
+var catalogIntegrationOAuthRestAuthenticationDef = g.NewQueryStruct("CatalogIntegrationOAuthRestAuthentication").
+	PredefinedQueryStructField("restAuthenticationType", "string", g.StaticOptions().SQL("TYPE = OAUTH")).
+	TextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes().Required()).
+	TextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes().Required()).
+	ListAssignment("OAUTH_ALLOWED_SCOPES", "AllowedScope", g.ParameterOptions().Parentheses().Required())
+
+// TODO [SNOW-1016561]: all integrations reuse almost the same show, drop, and describe. For now we are copying it. Consider reusing in linked issue.
+var CatalogIntegrationsDef = g.NewInterface(
+	"CatalogIntegrations",
+	"CatalogIntegration",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-catalog-integration",
+		g.NewQueryStruct("CreateCatalogIntegration").
+			Create().
+			OrReplace().
+			SQL("CATALOG INTEGRATION").
+			IfNotExists().
+			Name().
+			Assignment("CATALOG_SOURCE", g.KindOfT[CatalogIntegrationCatalogSource](), g.ParameterOptions().Required()).
+			Assignment("TABLE_FORMAT", g.KindOfT[CatalogIntegrationTableFormat](), g.ParameterOptions().Required()).
+			OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()).
+			OptionalQueryStructField(
+				"GlueParams",
+				catalogIntegrationGlueParamsDef,
+				g.KeywordOptions(),
+			).
+			OptionalQueryStructField(
+				"RestConfig",
+				catalogIntegrationRestConfigDef,
+				g.ListOptions().Parentheses().NoComma().SQL("REST_CONFIG ="),
+			).
+			OptionalQueryStructField(
+				"RestAuthentication",
+				catalogIntegrationOAuthRestAuthenticationDef,
+				g.ListOptions().Parentheses().NoComma().SQL("REST_AUTHENTICATION ="),
+			).
+			BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+			OptionalNumberAssignment("REFRESH_INTERVAL_SECONDS", g.ParameterOptions()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace").
+			WithValidation(g.ConflictingFields, "GlueParams", "RestConfig"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-catalog-integration",
+		g.NewQueryStruct("AlterCatalogIntegration").
+			Alter().
+			SQL("CATALOG INTEGRATION").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("CatalogIntegrationSet").
+					OptionalQueryStructField(
+						"RestAuthentication",
+						g.NewQueryStruct("CatalogIntegrationSetRestAuthentication").
+							TextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes().Required()),
+						g.ListOptions().Parentheses().NoComma().SQL("REST_AUTHENTICATION ="),
+					).
+					OptionalNumberAssignment("REFRESH_INTERVAL_SECONDS", g.ParameterOptions()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "RestAuthentication", "RefreshIntervalSeconds", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "IfExists", "SetTags").
+			WithValidation(g.ConflictingFields, "IfExists", "UnsetTags").
+			WithValidation(g.ExactlyOneValueSet, "Set", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-integration",
+		g.NewQueryStruct("DropCatalogIntegration").
+			Drop().
+			SQL("CATALOG INTEGRATION").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-catalog-integrations",
+		g.DbStruct("showCatalogIntegrationsDbRow").
+			Text("name").
+			Text("type").
+			Text("category").
+			Bool("enabled").
+			OptionalText("comment").
+			Time("created_on"),
+		g.PlainStruct("CatalogIntegration").
+			Text("Name").
+			Text("Type").
+			Text("Category").
+			Bool("Enabled").
+			Text("Comment").
+			Time("CreatedOn"),
+		g.NewQueryStruct("ShowCatalogIntegrations").
+			Show().
+			SQL("CATALOG INTEGRATIONS").
+			OptionalLike(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-integration",
+		g.DbStruct("descCatalogIntegrationsDbRow").
+			Text("property").
+			Text("property_type").
+			Text("property_value").
+			Text("property_default"),
+		g.PlainStruct("CatalogIntegrationProperty").
+			Text("Name").
+			Text("Type").
+			Text("Value").
+			Text("Default"),
+		g.NewQueryStruct("DescribeCatalogIntegration").
+			Describe().
+			SQL("CATALOG INTEGRATION").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
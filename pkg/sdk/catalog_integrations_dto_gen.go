@@ -0,0 +1,75 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateCatalogIntegrationOptions]   = new(CreateCatalogIntegrationRequest)
+	_ optionsProvider[AlterCatalogIntegrationOptions]    = new(AlterCatalogIntegrationRequest)
+	_ optionsProvider[DropCatalogIntegrationOptions]     = new(DropCatalogIntegrationRequest)
+	_ optionsProvider[ShowCatalogIntegrationOptions]     = new(ShowCatalogIntegrationRequest)
+	_ optionsProvider[DescribeCatalogIntegrationOptions] = new(DescribeCatalogIntegrationRequest)
+)
+
+type CreateCatalogIntegrationRequest struct {
+	OrReplace              *bool
+	IfNotExists            *bool
+	name                   AccountObjectIdentifier         // required
+	CatalogSource          CatalogIntegrationCatalogSource // required
+	TableFormat            CatalogIntegrationTableFormat   // required
+	CatalogNamespace       *string
+	GlueParams             *CatalogIntegrationGlueParamsRequest
+	RestConfig             *CatalogIntegrationRestConfigRequest
+	RestAuthentication     *CatalogIntegrationOAuthRestAuthenticationRequest
+	Enabled                bool // required
+	RefreshIntervalSeconds *int
+	Comment                *string
+}
+
+type CatalogIntegrationGlueParamsRequest struct {
+	GlueAwsRoleArn string // required
+	GlueCatalogId  string // required
+	GlueRegion     *string
+}
+
+type CatalogIntegrationRestConfigRequest struct {
+	CatalogUri     string // required
+	CatalogApiType *CatalogIntegrationCatalogApiType
+	CatalogName    string // required
+}
+
+type CatalogIntegrationOAuthRestAuthenticationRequest struct {
+	OauthClientId      string         // required
+	OauthClientSecret  string         // required
+	OauthAllowedScopes []AllowedScope // required
+}
+
+type AlterCatalogIntegrationRequest struct {
+	IfExists  *bool
+	name      AccountObjectIdentifier // required
+	Set       *CatalogIntegrationSetRequest
+	SetTags   []TagAssociation
+	UnsetTags []ObjectIdentifier
+}
+
+type CatalogIntegrationSetRequest struct {
+	RestAuthentication     *CatalogIntegrationSetRestAuthenticationRequest
+	RefreshIntervalSeconds *int
+	Comment                *string
+}
+
+type CatalogIntegrationSetRestAuthenticationRequest struct {
+	OauthClientSecret string // required
+}
+
+type DropCatalogIntegrationRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+}
+
+type ShowCatalogIntegrationRequest struct {
+	Like *Like
+}
+
+type DescribeCatalogIntegrationRequest struct {
+	name AccountObjectIdentifier // required
+}
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateCatalogIntegrationRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
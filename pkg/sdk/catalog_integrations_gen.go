@@ -0,0 +1,138 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type CatalogIntegrations interface {
+	Create(ctx context.Context, request *CreateCatalogIntegrationRequest) error
+	Alter(ctx context.Context, request *AlterCatalogIntegrationRequest) error
+	Drop(ctx context.Context, request *DropCatalogIntegrationRequest) error
+	DropSafely(ctx context.Context, id AccountObjectIdentifier) error
+	Show(ctx context.Context, request *ShowCatalogIntegrationRequest) ([]CatalogIntegration, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegration, error)
+	ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegration, error)
+	Describe(ctx context.Context, id AccountObjectIdentifier) ([]CatalogIntegrationProperty, error)
+}
+
+// CreateCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-catalog-integration.
+type CreateCatalogIntegrationOptions struct {
+	create                 bool                                       `ddl:"static" sql:"CREATE"`
+	OrReplace              *bool                                      `ddl:"keyword" sql:"OR REPLACE"`
+	catalogIntegration     bool                                       `ddl:"static" sql:"CATALOG INTEGRATION"`
+	IfNotExists            *bool                                      `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                   AccountObjectIdentifier                    `ddl:"identifier"`
+	CatalogSource          CatalogIntegrationCatalogSource            `ddl:"parameter" sql:"CATALOG_SOURCE"`
+	TableFormat            CatalogIntegrationTableFormat              `ddl:"parameter" sql:"TABLE_FORMAT"`
+	CatalogNamespace       *string                                    `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+	GlueParams             *CatalogIntegrationGlueParams              `ddl:"keyword"`
+	RestConfig             *CatalogIntegrationRestConfig              `ddl:"list,parentheses,no_comma" sql:"REST_CONFIG ="`
+	RestAuthentication     *CatalogIntegrationOAuthRestAuthentication `ddl:"list,parentheses,no_comma" sql:"REST_AUTHENTICATION ="`
+	Enabled                bool                                       `ddl:"parameter" sql:"ENABLED"`
+	RefreshIntervalSeconds *int                                       `ddl:"parameter" sql:"REFRESH_INTERVAL_SECONDS"`
+	Comment                *string                                    `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type CatalogIntegrationGlueParams struct {
+	GlueAwsRoleArn string  `ddl:"parameter,single_quotes" sql:"GLUE_AWS_ROLE_ARN"`
+	GlueCatalogId  string  `ddl:"parameter,single_quotes" sql:"GLUE_CATALOG_ID"`
+	GlueRegion     *string `ddl:"parameter,single_quotes" sql:"GLUE_REGION"`
+}
+
+type CatalogIntegrationRestConfig struct {
+	CatalogUri     string                            `ddl:"parameter,single_quotes" sql:"CATALOG_URI"`
+	CatalogApiType *CatalogIntegrationCatalogApiType `ddl:"parameter" sql:"CATALOG_API_TYPE"`
+	CatalogName    string                            `ddl:"parameter,single_quotes" sql:"CATALOG_NAME"`
+}
+
+type CatalogIntegrationOAuthRestAuthentication struct {
+	restAuthenticationType string         `ddl:"static" sql:"TYPE = OAUTH"`
+	OauthClientId          string         `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_ID"`
+	OauthClientSecret      string         `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_SECRET"`
+	OauthAllowedScopes     []AllowedScope `ddl:"parameter,parentheses" sql:"OAUTH_ALLOWED_SCOPES"`
+}
+
+// AlterCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-catalog-integration.
+type AlterCatalogIntegrationOptions struct {
+	alter              bool                    `ddl:"static" sql:"ALTER"`
+	catalogIntegration bool                    `ddl:"static" sql:"CATALOG INTEGRATION"`
+	IfExists           *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+	Set                *CatalogIntegrationSet  `ddl:"keyword" sql:"SET"`
+	SetTags            []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags          []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+type CatalogIntegrationSet struct {
+	RestAuthentication     *CatalogIntegrationSetRestAuthentication `ddl:"list,parentheses,no_comma" sql:"REST_AUTHENTICATION ="`
+	RefreshIntervalSeconds *int                                     `ddl:"parameter" sql:"REFRESH_INTERVAL_SECONDS"`
+	Comment                *string                                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type CatalogIntegrationSetRestAuthentication struct {
+	OauthClientSecret string `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_SECRET"`
+}
+
+// DropCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-integration.
+type DropCatalogIntegrationOptions struct {
+	drop               bool                    `ddl:"static" sql:"DROP"`
+	catalogIntegration bool                    `ddl:"static" sql:"CATALOG INTEGRATION"`
+	IfExists           *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-catalog-integrations.
+type ShowCatalogIntegrationOptions struct {
+	show                bool  `ddl:"static" sql:"SHOW"`
+	catalogIntegrations bool  `ddl:"static" sql:"CATALOG INTEGRATIONS"`
+	Like                *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+type showCatalogIntegrationsDbRow struct {
+	Name      string         `db:"name"`
+	Type      string         `db:"type"`
+	Category  string         `db:"category"`
+	Enabled   bool           `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn time.Time      `db:"created_on"`
+}
+
+type CatalogIntegration struct {
+	Name      string
+	Type      string
+	Category  string
+	Enabled   bool
+	Comment   string
+	CreatedOn time.Time
+}
+
+func (v *CatalogIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *CatalogIntegration) ObjectType() ObjectType {
+	return ObjectTypeIntegration
+}
+
+// DescribeCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-integration.
+type DescribeCatalogIntegrationOptions struct {
+	describe           bool                    `ddl:"static" sql:"DESCRIBE"`
+	catalogIntegration bool                    `ddl:"static" sql:"CATALOG INTEGRATION"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type descCatalogIntegrationsDbRow struct {
+	Property        string `db:"property"`
+	PropertyType    string `db:"property_type"`
+	PropertyValue   string `db:"property_value"`
+	PropertyDefault string `db:"property_default"`
+}
+
+type CatalogIntegrationProperty struct {
+	Name    string
+	Type    string
+	Value   string
+	Default string
+}
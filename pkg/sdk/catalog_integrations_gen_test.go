@@ -0,0 +1,286 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogIntegrations_Create(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateCatalogIntegrationOptions
+	defaultOpts := func() *CreateCatalogIntegrationOptions {
+		return &CreateCatalogIntegrationOptions{
+			name:          id,
+			CatalogSource: CatalogIntegrationCatalogSourceObjectStore,
+			TableFormat:   CatalogIntegrationTableFormatIceberg,
+			Enabled:       true,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfNotExists opts.OrReplace]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OrReplace = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateCatalogIntegrationOptions", "IfNotExists", "OrReplace"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.GlueParams opts.RestConfig]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.GlueParams = &CatalogIntegrationGlueParams{GlueAwsRoleArn: "arn", GlueCatalogId: "123"}
+		opts.RestConfig = &CatalogIntegrationRestConfig{CatalogUri: "uri", CatalogName: "name"}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateCatalogIntegrationOptions", "GlueParams", "RestConfig"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CATALOG INTEGRATION %s CATALOG_SOURCE = OBJECT_STORE TABLE_FORMAT = ICEBERG ENABLED = true", id.FullyQualifiedName())
+	})
+
+	t.Run("glue", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.CatalogSource = CatalogIntegrationCatalogSourceGlue
+		opts.CatalogNamespace = String("namespace")
+		opts.GlueParams = &CatalogIntegrationGlueParams{
+			GlueAwsRoleArn: "arn:aws:iam::123456789012:role/role",
+			GlueCatalogId:  "123456789012",
+			GlueRegion:     String("us-west-2"),
+		}
+		opts.Enabled = false
+		opts.RefreshIntervalSeconds = Int(60)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE CATALOG INTEGRATION %s CATALOG_SOURCE = GLUE TABLE_FORMAT = ICEBERG CATALOG_NAMESPACE = 'namespace' GLUE_AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/role' GLUE_CATALOG_ID = '123456789012' GLUE_REGION = 'us-west-2' ENABLED = false REFRESH_INTERVAL_SECONDS = 60 COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("polaris", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.CatalogSource = CatalogIntegrationCatalogSourcePolaris
+		opts.CatalogNamespace = String("namespace")
+		opts.RestConfig = &CatalogIntegrationRestConfig{
+			CatalogUri:     "https://example.com/polaris/api/catalog",
+			CatalogApiType: Pointer(CatalogIntegrationCatalogApiTypePublic),
+			CatalogName:    "catalog",
+		}
+		opts.RestAuthentication = &CatalogIntegrationOAuthRestAuthentication{
+			OauthClientId:      "client_id",
+			OauthClientSecret:  "client_secret",
+			OauthAllowedScopes: []AllowedScope{{Scope: "PRINCIPAL_ROLE:ALL"}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CATALOG INTEGRATION IF NOT EXISTS %s CATALOG_SOURCE = POLARIS TABLE_FORMAT = ICEBERG CATALOG_NAMESPACE = 'namespace' REST_CONFIG = (CATALOG_URI = 'https://example.com/polaris/api/catalog' CATALOG_API_TYPE = PUBLIC CATALOG_NAME = 'catalog') REST_AUTHENTICATION = (TYPE = OAUTH OAUTH_CLIENT_ID = 'client_id' OAUTH_CLIENT_SECRET = 'client_secret' OAUTH_ALLOWED_SCOPES = ('PRINCIPAL_ROLE:ALL')) ENABLED = true", id.FullyQualifiedName())
+	})
+}
+
+func TestCatalogIntegrations_Alter(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterCatalogIntegrationOptions
+	defaultOpts := func() *AlterCatalogIntegrationOptions {
+		return &AlterCatalogIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		opts.Set = &CatalogIntegrationSet{Comment: String("comment")}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.SetTags]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.SetTags = []TagAssociation{{Name: randomAccountObjectIdentifier(), Value: "value"}}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterCatalogIntegrationOptions", "IfExists", "SetTags"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.UnsetTags]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.UnsetTags = []ObjectIdentifier{randomAccountObjectIdentifier()}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterCatalogIntegrationOptions", "IfExists", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterCatalogIntegrationOptions", "Set", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.RestAuthentication opts.Set.RefreshIntervalSeconds opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &CatalogIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterCatalogIntegrationOptions.Set", "RestAuthentication", "RefreshIntervalSeconds", "Comment"))
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &CatalogIntegrationSet{
+			RestAuthentication:     &CatalogIntegrationSetRestAuthentication{OauthClientSecret: "secret"},
+			RefreshIntervalSeconds: Int(120),
+			Comment:                String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CATALOG INTEGRATION IF EXISTS %s SET REST_AUTHENTICATION = (OAUTH_CLIENT_SECRET = 'secret') REFRESH_INTERVAL_SECONDS = 120 COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("second-name"),
+				Value: "second-value",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CATALOG INTEGRATION %s SET TAG "name" = 'value', "second-name" = 'second-value'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("name"),
+			NewAccountObjectIdentifier("second-name"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CATALOG INTEGRATION %s UNSET TAG "name", "second-name"`, id.FullyQualifiedName())
+	})
+}
+
+func TestCatalogIntegrations_Drop(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DropCatalogIntegrationOptions
+	defaultOpts := func() *DropCatalogIntegrationOptions {
+		return &DropCatalogIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP CATALOG INTEGRATION %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP CATALOG INTEGRATION IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestCatalogIntegrations_Show(t *testing.T) {
+	// Minimal valid ShowCatalogIntegrationOptions
+	defaultOpts := func() *ShowCatalogIntegrationOptions {
+		return &ShowCatalogIntegrationOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW CATALOG INTEGRATIONS")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW CATALOG INTEGRATIONS LIKE 'some pattern'")
+	})
+}
+
+func TestCatalogIntegrations_Describe(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DescribeCatalogIntegrationOptions
+	defaultOpts := func() *DescribeCatalogIntegrationOptions {
+		return &DescribeCatalogIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE CATALOG INTEGRATION %s", id.FullyQualifiedName())
+	})
+}
+
+func TestToCatalogIntegrationCatalogSource(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected CatalogIntegrationCatalogSource
+		Error    string
+	}{
+		{Input: "GLUE", Expected: CatalogIntegrationCatalogSourceGlue},
+		{Input: "object_store", Expected: CatalogIntegrationCatalogSourceObjectStore},
+		{Input: "Polaris", Expected: CatalogIntegrationCatalogSourcePolaris},
+		{Name: "validation: incorrect catalog source", Input: "incorrect", Error: "invalid catalog source: incorrect"},
+		{Name: "validation: empty input", Input: "", Error: "invalid catalog source: "},
+	}
+
+	for _, testCase := range testCases {
+		name := testCase.Name
+		if name == "" {
+			name = fmt.Sprintf("%v catalog source", testCase.Input)
+		}
+		t.Run(name, func(t *testing.T) {
+			value, err := ToCatalogIntegrationCatalogSource(testCase.Input)
+			if testCase.Error != "" {
+				assert.Empty(t, value)
+				assert.ErrorContains(t, err, testCase.Error)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.Expected, value)
+			}
+		})
+	}
+}
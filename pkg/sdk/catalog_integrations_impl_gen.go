@@ -0,0 +1,171 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ CatalogIntegrations = (*catalogIntegrations)(nil)
+
+type catalogIntegrations struct {
+	client *Client
+}
+
+func (v *catalogIntegrations) Create(ctx context.Context, request *CreateCatalogIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *catalogIntegrations) Alter(ctx context.Context, request *AlterCatalogIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *catalogIntegrations) Drop(ctx context.Context, request *DropCatalogIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *catalogIntegrations) DropSafely(ctx context.Context, id AccountObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropCatalogIntegrationRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *catalogIntegrations) Show(ctx context.Context, request *ShowCatalogIntegrationRequest) ([]CatalogIntegration, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showCatalogIntegrationsDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showCatalogIntegrationsDbRow, CatalogIntegration](dbRows)
+	return resultList, nil
+}
+
+func (v *catalogIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegration, error) {
+	request := NewShowCatalogIntegrationRequest().
+		WithLike(Like{Pattern: String(id.Name())})
+	catalogIntegrations, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(catalogIntegrations, func(r CatalogIntegration) bool { return r.Name == id.Name() })
+}
+
+func (v *catalogIntegrations) ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegration, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *catalogIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) ([]CatalogIntegrationProperty, error) {
+	opts := &DescribeCatalogIntegrationOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[descCatalogIntegrationsDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[descCatalogIntegrationsDbRow, CatalogIntegrationProperty](rows), nil
+}
+
+func (r *CreateCatalogIntegrationRequest) toOpts() *CreateCatalogIntegrationOptions {
+	opts := &CreateCatalogIntegrationOptions{
+		OrReplace:        r.OrReplace,
+		IfNotExists:      r.IfNotExists,
+		name:             r.name,
+		CatalogSource:    r.CatalogSource,
+		TableFormat:      r.TableFormat,
+		CatalogNamespace: r.CatalogNamespace,
+
+		Enabled:                r.Enabled,
+		RefreshIntervalSeconds: r.RefreshIntervalSeconds,
+		Comment:                r.Comment,
+	}
+	if r.GlueParams != nil {
+		opts.GlueParams = &CatalogIntegrationGlueParams{
+			GlueAwsRoleArn: r.GlueParams.GlueAwsRoleArn,
+			GlueCatalogId:  r.GlueParams.GlueCatalogId,
+			GlueRegion:     r.GlueParams.GlueRegion,
+		}
+	}
+	if r.RestConfig != nil {
+		opts.RestConfig = &CatalogIntegrationRestConfig{
+			CatalogUri:     r.RestConfig.CatalogUri,
+			CatalogApiType: r.RestConfig.CatalogApiType,
+			CatalogName:    r.RestConfig.CatalogName,
+		}
+	}
+	if r.RestAuthentication != nil {
+		opts.RestAuthentication = &CatalogIntegrationOAuthRestAuthentication{
+			OauthClientId:      r.RestAuthentication.OauthClientId,
+			OauthClientSecret:  r.RestAuthentication.OauthClientSecret,
+			OauthAllowedScopes: r.RestAuthentication.OauthAllowedScopes,
+		}
+	}
+	return opts
+}
+
+func (r *AlterCatalogIntegrationRequest) toOpts() *AlterCatalogIntegrationOptions {
+	opts := &AlterCatalogIntegrationOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &CatalogIntegrationSet{
+			RefreshIntervalSeconds: r.Set.RefreshIntervalSeconds,
+			Comment:                r.Set.Comment,
+		}
+		if r.Set.RestAuthentication != nil {
+			opts.Set.RestAuthentication = &CatalogIntegrationSetRestAuthentication{
+				OauthClientSecret: r.Set.RestAuthentication.OauthClientSecret,
+			}
+		}
+	}
+	return opts
+}
+
+func (r *DropCatalogIntegrationRequest) toOpts() *DropCatalogIntegrationOptions {
+	opts := &DropCatalogIntegrationOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowCatalogIntegrationRequest) toOpts() *ShowCatalogIntegrationOptions {
+	opts := &ShowCatalogIntegrationOptions{
+		Like: r.Like,
+	}
+	return opts
+}
+
+func (r showCatalogIntegrationsDbRow) convert() *CatalogIntegration {
+	s := &CatalogIntegration{
+		Name:      r.Name,
+		Type:      r.Type,
+		Category:  r.Category,
+		Enabled:   r.Enabled,
+		CreatedOn: r.CreatedOn,
+	}
+	if r.Comment.Valid {
+		s.Comment = r.Comment.String
+	}
+	return s
+}
+
+func (r *DescribeCatalogIntegrationRequest) toOpts() *DescribeCatalogIntegrationOptions {
+	opts := &DescribeCatalogIntegrationOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r descCatalogIntegrationsDbRow) convert() *CatalogIntegrationProperty {
+	return &CatalogIntegrationProperty{
+		Name:    r.Property,
+		Type:    r.PropertyType,
+		Value:   r.PropertyValue,
+		Default: r.PropertyDefault,
+	}
+}
@@ -0,0 +1,81 @@
+package sdk
+
+var (
+	_ validatable = new(CreateCatalogIntegrationOptions)
+	_ validatable = new(AlterCatalogIntegrationOptions)
+	_ validatable = new(DropCatalogIntegrationOptions)
+	_ validatable = new(ShowCatalogIntegrationOptions)
+	_ validatable = new(DescribeCatalogIntegrationOptions)
+)
+
+func (opts *CreateCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.IfNotExists, opts.OrReplace) {
+		errs = append(errs, errOneOf("CreateCatalogIntegrationOptions", "IfNotExists", "OrReplace"))
+	}
+	if everyValueSet(opts.GlueParams, opts.RestConfig) {
+		errs = append(errs, errOneOf("CreateCatalogIntegrationOptions", "GlueParams", "RestConfig"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.IfExists, opts.SetTags) {
+		errs = append(errs, errOneOf("AlterCatalogIntegrationOptions", "IfExists", "SetTags"))
+	}
+	if everyValueSet(opts.IfExists, opts.UnsetTags) {
+		errs = append(errs, errOneOf("AlterCatalogIntegrationOptions", "IfExists", "UnsetTags"))
+	}
+	if !exactlyOneValueSet(opts.Set, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterCatalogIntegrationOptions", "Set", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.RestAuthentication, opts.Set.RefreshIntervalSeconds, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterCatalogIntegrationOptions.Set", "RestAuthentication", "RefreshIntervalSeconds", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
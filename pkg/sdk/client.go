@@ -3,8 +3,12 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
 	"github.com/jmoiron/sqlx"
@@ -17,6 +21,19 @@ type Client struct {
 	sessionID      string
 	accountLocator string
 
+	// showResultCache is nil unless it was enabled with EnableShowResultCache.
+	showResultCache *showResultCache
+
+	// maxRetries and retryBackoff are set with EnableRetries; retries are disabled by default.
+	maxRetries   int
+	retryBackoff time.Duration
+
+	// queryTagConfig is nil unless it was enabled with EnableQueryTag.
+	queryTagConfig *tracking.QueryTagConfig
+
+	// sqlPreview is set only in the clients returned from NewSqlPreviewClient.
+	sqlPreview *SqlPreview
+
 	// System-Defined Functions
 	ContextFunctions     ContextFunctions
 	SystemFunctions      SystemFunctions
@@ -24,12 +41,14 @@ type Client struct {
 
 	// DDL Commands
 	Accounts                     Accounts
+	AggregationPolicies          AggregationPolicies
 	Alerts                       Alerts
 	ApiIntegrations              ApiIntegrations
 	ApplicationPackages          ApplicationPackages
 	ApplicationRoles             ApplicationRoles
 	Applications                 Applications
 	AuthenticationPolicies       AuthenticationPolicies
+	CatalogIntegrations          CatalogIntegrations
 	Comments                     Comments
 	ComputePools                 ComputePools
 	Connections                  Connections
@@ -37,17 +56,23 @@ type Client struct {
 	DatabaseRoles                DatabaseRoles
 	Databases                    Databases
 	DataMetricFunctionReferences DataMetricFunctionReferences
+	DataMetricFunctions          DataMetricFunctions
 	DynamicTables                DynamicTables
+	ExternalAccessIntegrations   ExternalAccessIntegrations
 	ExternalFunctions            ExternalFunctions
 	ExternalVolumes              ExternalVolumes
 	ExternalTables               ExternalTables
 	EventTables                  EventTables
+	ExecuteImmediate             ExecuteImmediate
 	FailoverGroups               FailoverGroups
 	FileFormats                  FileFormats
 	Functions                    Functions
 	GitRepositories              GitRepositories
 	Grants                       Grants
+	HybridTables                 HybridTables
+	IcebergTables                IcebergTables
 	ImageRepositories            ImageRepositories
+	JoinPolicies                 JoinPolicies
 	Listings                     Listings
 	ManagedAccounts              ManagedAccounts
 	MaskingPolicies              MaskingPolicies
@@ -61,18 +86,22 @@ type Client struct {
 	Pipes                        Pipes
 	PolicyReferences             PolicyReferences
 	Procedures                   Procedures
+	ProjectionPolicies           ProjectionPolicies
+	ReplicationGroups            ReplicationGroups
 	ResourceMonitors             ResourceMonitors
 	Roles                        Roles
 	RowAccessPolicies            RowAccessPolicies
 	Schemas                      Schemas
 	Secrets                      Secrets
 	SecurityIntegrations         SecurityIntegrations
+	SemanticViews                SemanticViews
 	Services                     Services
 	Sequences                    Sequences
 	SessionPolicies              SessionPolicies
 	Sessions                     Sessions
 	Shares                       Shares
 	Stages                       Stages
+	StageFiles                   StageFiles
 	StorageIntegrations          StorageIntegrations
 	Streamlits                   Streamlits
 	Streams                      Streams
@@ -112,6 +141,9 @@ func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Clien
 	if cfg.Authenticator == GosnowflakeAuthTypeEmpty {
 		cfg.Authenticator = gosnowflake.AuthTypeSnowflake
 	}
+	if err := ValidateWorkloadIdentityConfig(cfg); err != nil {
+		return nil, err
+	}
 
 	dsn, err := gosnowflake.DSN(cfg)
 	if err != nil {
@@ -153,12 +185,14 @@ func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Clien
 
 func (c *Client) initialize() {
 	c.Accounts = &accounts{client: c}
+	c.AggregationPolicies = &aggregationPolicies{client: c}
 	c.Alerts = &alerts{client: c}
 	c.ApiIntegrations = &apiIntegrations{client: c}
 	c.ApplicationPackages = &applicationPackages{client: c}
 	c.ApplicationRoles = &applicationRoles{client: c}
 	c.Applications = &applications{client: c}
 	c.AuthenticationPolicies = &authenticationPolicies{client: c}
+	c.CatalogIntegrations = &catalogIntegrations{client: c}
 	c.Comments = &comments{client: c}
 	c.ComputePools = &computePools{client: c}
 	c.Connections = &connections{client: c}
@@ -167,7 +201,10 @@ func (c *Client) initialize() {
 	c.DatabaseRoles = &databaseRoles{client: c}
 	c.Databases = &databases{client: c}
 	c.DataMetricFunctionReferences = &dataMetricFunctionReferences{client: c}
+	c.DataMetricFunctions = &dataMetricFunctions{client: c}
 	c.DynamicTables = &dynamicTables{client: c}
+	c.ExecuteImmediate = &executeImmediate{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrations{client: c}
 	c.ExternalFunctions = &externalFunctions{client: c}
 	c.ExternalVolumes = &externalVolumes{client: c}
 	c.ExternalTables = &externalTables{client: c}
@@ -177,7 +214,10 @@ func (c *Client) initialize() {
 	c.Functions = &functions{client: c}
 	c.GitRepositories = &gitRepositories{client: c}
 	c.Grants = &grants{client: c}
+	c.HybridTables = &hybridTables{client: c}
+	c.IcebergTables = &icebergTables{client: c}
 	c.ImageRepositories = &imageRepositories{client: c}
+	c.JoinPolicies = &joinPolicies{client: c}
 	c.Listings = &listings{client: c}
 	c.ManagedAccounts = &managedAccounts{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
@@ -191,19 +231,23 @@ func (c *Client) initialize() {
 	c.Pipes = &pipes{client: c}
 	c.PolicyReferences = &policyReference{client: c}
 	c.Procedures = &procedures{client: c}
+	c.ProjectionPolicies = &projectionPolicies{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
+	c.ReplicationGroups = &replicationGroups{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
 	c.RowAccessPolicies = &rowAccessPolicies{client: c}
 	c.Schemas = &schemas{client: c}
 	c.Secrets = &secrets{client: c}
 	c.SecurityIntegrations = &securityIntegrations{client: c}
+	c.SemanticViews = &semanticViews{client: c}
 	c.Sequences = &sequences{client: c}
 	c.Services = &services{client: c}
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
 	c.Stages = &stages{client: c}
+	c.StageFiles = &stageFiles{client: c}
 	c.StorageIntegrations = &storageIntegrations{client: c}
 	c.Streamlits = &streamlits{client: c}
 	c.Streams = &streams{client: c}
@@ -233,25 +277,82 @@ type accountLocatorContextKey struct{}
 var snowflakeAccountLocatorContextKey accountLocatorContextKey
 
 // Exec executes a query that does not return rows.
-func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
+func (c *Client) exec(ctx context.Context, sql string) (result sql.Result, err error) {
+	if ok, err := c.recordInSqlPreview(sql); ok {
+		return driver.RowsAffected(0), err
+	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	sql = appendQueryMetadata(ctx, sql)
-	result, err := c.db.ExecContext(ctx, sql)
-	return result, decodeDriverError(err)
+	ctx = c.contextWithQueryTag(ctx)
+	err = c.withRetries(ctx, sql, func() error {
+		var execErr error
+		result, execErr = c.db.ExecContext(ctx, appendQueryMetadata(ctx, sql))
+		return decodeDriverError(execErr)
+	})
+	if c.showResultCache != nil {
+		c.showResultCache.invalidate()
+	}
+	return result, err
 }
 
 // query runs a query and returns the rows. dest is expected to be a slice of structs.
 func (c *Client) query(ctx context.Context, dest interface{}, sql string) error {
+	if c.showResultCache != nil {
+		if !isReadOnlyQuery(sql) {
+			defer c.showResultCache.invalidate()
+		} else if !notFoundRetriesFromContext(ctx) {
+			// The cached result can miss the object that was just created, so such lookups always go to Snowflake.
+			if ok, err := c.showResultCache.query(ctx, c, dest, sql); ok {
+				return err
+			}
+		}
+	}
+	return c.queryDirectly(ctx, dest, sql)
+}
+
+// queryDirectly runs a query bypassing the SHOW result cache.
+func (c *Client) queryDirectly(ctx context.Context, dest interface{}, sql string) error {
+	if ok, err := c.recordInSqlPreview(sql); ok {
+		return err
+	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	sql = appendQueryMetadata(ctx, sql)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	ctx = c.contextWithQueryTag(ctx)
+	// The SHOW ... LIKE query does not fail when the object does not exist; it returns no rows instead.
+	_, _, isShowByIdQuery := parseShowByIdQuery(sql)
+	retryEmptyResult := isShowByIdQuery && notFoundRetriesFromContext(ctx)
+	err := c.withRetries(ctx, sql, func() error {
+		// The rows scanned before a failure are dropped, so that they are not duplicated by the retry.
+		v := reflect.ValueOf(dest)
+		isSlice := v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Slice
+		if isSlice {
+			v.Elem().SetLen(0)
+		}
+		if err := decodeDriverError(c.db.SelectContext(ctx, dest, appendQueryMetadata(ctx, sql))); err != nil {
+			return err
+		}
+		if retryEmptyResult && isSlice && v.Elem().Len() == 0 {
+			return errEmptyShowByIdResult
+		}
+		return nil
+	})
+	if errors.Is(err, errEmptyShowByIdResult) {
+		return nil
+	}
+	return err
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
 func (c *Client) queryOne(ctx context.Context, dest interface{}, sql string) error {
+	if ok, err := c.recordInSqlPreview(sql); ok {
+		return err
+	}
+	if c.showResultCache != nil && !isReadOnlyQuery(sql) {
+		defer c.showResultCache.invalidate()
+	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	sql = appendQueryMetadata(ctx, sql)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	ctx = c.contextWithQueryTag(ctx)
+	return c.withRetries(ctx, sql, func() error {
+		return decodeDriverError(c.db.GetContext(ctx, dest, appendQueryMetadata(ctx, sql)))
+	})
 }
 
 func appendQueryMetadata(ctx context.Context, sql string) string {
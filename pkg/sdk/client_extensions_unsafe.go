@@ -16,7 +16,13 @@ func (c *Client) ExecUnsafe(ctx context.Context, sql string) (sql.Result, error)
 //
 // Therefore, only single resultSet is processed.
 func (c *Client) QueryUnsafe(ctx context.Context, sql string) ([]map[string]*any, error) {
-	rows, err := c.db.QueryContext(ctx, sql)
+	if ok, err := c.recordInSqlPreview(sql); ok {
+		return nil, err
+	}
+	rows, err := c.db.QueryContext(c.contextWithQueryTag(ctx), sql)
+	if c.showResultCache != nil && !isReadOnlyQuery(sql) {
+		c.showResultCache.invalidate()
+	}
 	if err != nil {
 		return nil, err
 	}
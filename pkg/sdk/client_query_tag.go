@@ -0,0 +1,31 @@
+package sdk
+
+import (
+	"context"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// EnableQueryTag turns on setting the QUERY_TAG session parameter for every statement run by the client. The tag is built
+// from the tracking metadata in the context (resource or data source name and operation) and the given config.
+// The parameter is set only for the duration of the statement, so the QUERY_TAG set for the user or the session is
+// restored after the statement.
+func (c *Client) EnableQueryTag(config tracking.QueryTagConfig) {
+	c.queryTagConfig = &config
+}
+
+// contextWithQueryTag returns the context that makes the driver set QUERY_TAG for the statement.
+func (c *Client) contextWithQueryTag(ctx context.Context) context.Context {
+	if c.queryTagConfig == nil {
+		return ctx
+	}
+	metadata, _ := tracking.FromContext(ctx)
+	queryTag, err := tracking.NewQueryTag(*c.queryTagConfig, metadata).String()
+	if err != nil {
+		log.Printf("[ERROR] failed to build the query tag: %v", err)
+		return ctx
+	}
+	return gosnowflake.WithQueryTag(ctx, queryTag)
+}
@@ -0,0 +1,26 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Client_contextWithQueryTag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("query tag disabled", func(t *testing.T) {
+		client := &Client{}
+
+		assert.Equal(t, ctx, client.contextWithQueryTag(ctx))
+	})
+
+	t.Run("query tag enabled", func(t *testing.T) {
+		client := &Client{}
+		client.EnableQueryTag(tracking.QueryTagConfig{Tag: "tag"})
+
+		assert.NotEqual(t, ctx, client.contextWithQueryTag(ctx))
+	})
+}
@@ -0,0 +1,236 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxRetryBackoff caps the exponentially growing delay between the retries.
+const maxRetryBackoff = time.Minute
+
+// EnableRetries turns on retrying the idempotent statements that failed with a transient error (see IsRetryableError).
+// The statement is run again at most maxRetries times; the delay before the first retry is backoff, and it is doubled
+// before every next retry (up to one minute).
+func (c *Client) EnableRetries(maxRetries int, backoff time.Duration) {
+	c.maxRetries = maxRetries
+	c.retryBackoff = backoff
+}
+
+type notFoundRetriesContextKey struct{}
+
+// ContextWithNotFoundRetries marks the context, so that the read-only queries run with it are also retried when
+// the object does not exist. It should be used only where the object is expected to become visible soon, e.g. in
+// the lookup right after the object was created (possibly in another session). For the SHOW ... LIKE queries
+// run by the ShowByID methods, a missing object does not fail the query, so the empty result is retried instead
+// (and the SHOW result cache is bypassed).
+func ContextWithNotFoundRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, notFoundRetriesContextKey{}, true)
+}
+
+// errEmptyShowByIdResult is returned internally by the SHOW ... LIKE queries that found no rows while the not found
+// retries were enabled. It is never returned to the callers; the empty result is returned instead.
+var errEmptyShowByIdResult = errors.New("SHOW query returned no rows")
+
+func notFoundRetriesFromContext(ctx context.Context) bool {
+	v, ok := ctx.Value(notFoundRetriesContextKey{}).(bool)
+	return ok && v
+}
+
+// withRetries runs the operation and retries it when it fails with a retryable error, provided that the statement is idempotent.
+// The error returned by the operation is expected to be already decoded by decodeDriverError.
+func (c *Client) withRetries(ctx context.Context, sql string, operation func() error) error {
+	err := operation()
+	if c.maxRetries <= 0 || !isIdempotentStatement(sql) {
+		return err
+	}
+	retryNotFound := notFoundRetriesFromContext(ctx) && isReadOnlyQuery(sql)
+	isRetryable := func(err error) bool {
+		return IsRetryableError(err) || (retryNotFound && (isNotFoundError(err) || errors.Is(err, errEmptyShowByIdResult)))
+	}
+	backoff := c.retryBackoff
+	for attempt := 1; attempt <= c.maxRetries && isRetryable(err); attempt++ {
+		log.Printf("[DEBUG] Statement failed with a retryable error: %v, retrying (attempt %d of %d) in %v", err, attempt, c.maxRetries, backoff)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		err = operation()
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+	return err
+}
+
+// isIdempotentStatement returns true for the statements that can be safely run again when it's unknown whether
+// the previous run succeeded: queries, CREATE OR REPLACE, CREATE ... IF NOT EXISTS, DROP ... IF EXISTS, GRANT, REVOKE,
+// and ALTER <object> SET/UNSET. The decision is based on the tokens of the statement, so the keywords inside
+// the string literals, the quoted identifiers, and the comments are not taken into account.
+func isIdempotentStatement(sql string) bool {
+	if isReadOnlyQuery(sql) {
+		return true
+	}
+	tokens := tokenizeSql(sql)
+	if len(tokens) == 0 || tokens[0].kind != sqlTokenWord {
+		return false
+	}
+	switch tokens[0].value {
+	case "GRANT", "REVOKE", "USE":
+		return true
+	case "CREATE":
+		keywords := keywordsBeforeObjectName(tokens[1:])
+		return containsKeywords(keywords, "OR", "REPLACE") || containsKeywords(keywords, "OR", "ALTER") || containsKeywords(keywords, "IF", "NOT", "EXISTS")
+	case "DROP":
+		return containsKeywords(keywordsBeforeObjectName(tokens[1:]), "IF", "EXISTS")
+	case "ALTER":
+		action := alterAction(tokens)
+		return action == "SET" || action == "UNSET"
+	}
+	return false
+}
+
+// keywordsBeforeObjectName returns the words preceding the first quoted identifier, e.g. OR REPLACE VIEW for
+// CREATE OR REPLACE VIEW "db"."schema"."view" AS SELECT 1.
+func keywordsBeforeObjectName(tokens []sqlToken) []string {
+	keywords := make([]string, 0)
+	for _, token := range tokens {
+		if token.kind != sqlTokenWord {
+			break
+		}
+		keywords = append(keywords, token.value)
+	}
+	return keywords
+}
+
+func containsKeywords(keywords []string, sequence ...string) bool {
+	for i := range keywords {
+		if slices.Equal(keywords[i:min(i+len(sequence), len(keywords))], sequence) {
+			return true
+		}
+	}
+	return false
+}
+
+// alterAction returns the clause following the object name in the ALTER statement, e.g. SET for
+// ALTER WAREHOUSE "wh" SET COMMENT = 'abc'. The objects without names (ACCOUNT and SESSION) are handled separately.
+// An empty string is returned when the object name is not quoted, as it cannot be told apart from the keywords.
+func alterAction(tokens []sqlToken) string {
+	if len(tokens) > 2 && (tokens[1].value == "ACCOUNT" || tokens[1].value == "SESSION") && tokens[2].kind == sqlTokenWord {
+		return tokens[2].value
+	}
+	i := slices.IndexFunc(tokens, func(token sqlToken) bool { return token.kind == sqlTokenQuotedIdentifier })
+	if i < 0 {
+		return ""
+	}
+	// skip the remaining parts of the name, e.g. "db"."schema"."function"(NUMBER, VARCHAR)
+	for i++; i < len(tokens); i++ {
+		switch {
+		case tokens[i].value == "." || tokens[i].kind == sqlTokenQuotedIdentifier:
+			continue
+		case tokens[i].value == "(":
+			for depth := 0; i < len(tokens); i++ {
+				if tokens[i].value == "(" {
+					depth++
+				} else if tokens[i].value == ")" {
+					if depth--; depth == 0 {
+						break
+					}
+				}
+			}
+			continue
+		}
+		break
+	}
+	if i < len(tokens) && tokens[i].kind == sqlTokenWord {
+		return tokens[i].value
+	}
+	return ""
+}
+
+type sqlTokenKind int
+
+const (
+	// sqlTokenWord is a keyword or an unquoted identifier; its value is upper-cased.
+	sqlTokenWord sqlTokenKind = iota
+	sqlTokenQuotedIdentifier
+	sqlTokenLiteral
+	sqlTokenSymbol
+)
+
+type sqlToken struct {
+	kind  sqlTokenKind
+	value string
+}
+
+// tokenizeSql splits the statement into the tokens, skipping the whitespace and the comments. The values of the string
+// literals and the quoted identifiers are not unescaped, as they are not inspected.
+func tokenizeSql(sql string) []sqlToken {
+	tokens := make([]sqlToken, 0)
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.HasPrefix(sql[i:], "--") || strings.HasPrefix(sql[i:], "//"):
+			i = indexFrom(sql, i, "\n", 0)
+		case strings.HasPrefix(sql[i:], "/*"):
+			i = indexFrom(sql, i+2, "*/", 2)
+		case strings.HasPrefix(sql[i:], "$$"):
+			end := indexFrom(sql, i+2, "$$", 2)
+			tokens = append(tokens, sqlToken{kind: sqlTokenLiteral, value: sql[i:end]})
+			i = end
+		case c == '\'' || c == '"':
+			end := quotedEnd(sql, i)
+			kind := sqlTokenLiteral
+			if c == '"' {
+				kind = sqlTokenQuotedIdentifier
+			}
+			tokens = append(tokens, sqlToken{kind: kind, value: sql[i:end]})
+			i = end
+		case isWordCharacter(c):
+			end := i
+			for end < len(sql) && isWordCharacter(sql[end]) {
+				end++
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenWord, value: strings.ToUpper(sql[i:end])})
+			i = end
+		default:
+			tokens = append(tokens, sqlToken{kind: sqlTokenSymbol, value: string(c)})
+			i++
+		}
+	}
+	return tokens
+}
+
+// indexFrom returns the index right after the first occurrence of the separator (of the given length) found from the start index,
+// or the length of the sql if there is no such occurrence.
+func indexFrom(sql string, start int, separator string, separatorLength int) int {
+	if i := strings.Index(sql[start:], separator); i >= 0 {
+		return start + i + separatorLength
+	}
+	return len(sql)
+}
+
+// quotedEnd returns the index right after the closing quote of the literal or the identifier starting at the given index.
+// The quotes inside are escaped by doubling them, or with a backslash in the string literals.
+func quotedEnd(sql string, start int) int {
+	quote := sql[start]
+	for i := start + 1; i < len(sql); i++ {
+		switch {
+		case quote == '\'' && sql[i] == '\\':
+			i++
+		case sql[i] == quote && i+1 < len(sql) && sql[i+1] == quote:
+			i++
+		case sql[i] == quote:
+			return i + 1
+		}
+	}
+	return len(sql)
+}
+
+func isWordCharacter(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
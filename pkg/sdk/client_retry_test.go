@@ -0,0 +1,316 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsRetryableError(t *testing.T) {
+	testCases := []struct {
+		err       error
+		retryable bool
+	}{
+		{err: nil, retryable: false},
+		{err: errors.New("SQL compilation error: syntax error line 1"), retryable: false},
+		{err: ErrObjectNotExistOrAuthorized, retryable: false},
+		{err: ErrDoesNotExistOrOperationCannotBePerformed, retryable: false},
+		{err: driver.ErrBadConn, retryable: true},
+		{err: fmt.Errorf("wrapped: %w", driver.ErrBadConn), retryable: true},
+		{err: &gosnowflake.SnowflakeError{Number: 390114, Message: "Authentication token has expired. The user must authenticate again."}, retryable: true},
+		{err: &gosnowflake.SnowflakeError{Number: 2003, Message: "does not exist"}, retryable: false},
+		{err: errors.New("Statement 'x' has locked table 'T' in transaction 1 and this lock has not yet been released."), retryable: true},
+		{err: errors.New("Number of waiters for this lock exceeds the 20 statements limit."), retryable: true},
+		{err: errors.New("Warehouse 'WH' is resuming"), retryable: true},
+		{err: errors.New("read tcp: connection reset by peer"), retryable: true},
+		{err: ErrGrantPartiallyExecuted, retryable: false},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v", tc.err), func(t *testing.T) {
+			assert.Equal(t, tc.retryable, IsRetryableError(tc.err))
+		})
+	}
+}
+
+func Test_isIdempotentStatement(t *testing.T) {
+	testCases := []struct {
+		sql        string
+		idempotent bool
+	}{
+		{sql: `SHOW WAREHOUSES LIKE 'WH'`, idempotent: true},
+		{sql: `DESCRIBE TABLE "db"."schema"."table"`, idempotent: true},
+		{sql: `SELECT CURRENT_ACCOUNT()`, idempotent: true},
+		{sql: `CREATE OR REPLACE VIEW "db"."schema"."view" AS SELECT 1`, idempotent: true},
+		{sql: `CREATE DATABASE IF NOT EXISTS "db"`, idempotent: true},
+		{sql: `CREATE DATABASE "db"`, idempotent: false},
+		{sql: `DROP SCHEMA IF EXISTS "db"."schema"`, idempotent: true},
+		{sql: `DROP SCHEMA "db"."schema"`, idempotent: false},
+		{sql: `GRANT USAGE ON DATABASE "db" TO ROLE "role"`, idempotent: true},
+		{sql: `REVOKE USAGE ON DATABASE "db" FROM ROLE "role"`, idempotent: true},
+		{sql: `ALTER WAREHOUSE "wh" SET COMMENT = 'abc'`, idempotent: true},
+		{sql: `ALTER WAREHOUSE "wh" UNSET COMMENT`, idempotent: true},
+		{sql: `ALTER DATABASE "db" RENAME TO "db2"`, idempotent: false},
+		{sql: `ALTER TABLE "db"."schema"."table" ADD COLUMN "c" NUMBER`, idempotent: false},
+		{sql: `ALTER WAREHOUSE "wh" RESUME`, idempotent: false},
+		{sql: `ALTER WAREHOUSE "wh" SUSPEND`, idempotent: false},
+		{sql: `ALTER TABLE "db"."schema"."table" ALTER COLUMN "c" SET COMMENT 'abc'`, idempotent: false},
+		{sql: `ALTER FUNCTION "db"."schema"."function"(NUMBER, VARCHAR) SET COMMENT = 'abc'`, idempotent: true},
+		{sql: `ALTER TABLE IF EXISTS "db"."schema"."table" UNSET COMMENT`, idempotent: true},
+		{sql: `ALTER SESSION SET QUERY_TAG = 'abc'`, idempotent: true},
+		{sql: `ALTER ACCOUNT SET TIMEZONE = 'UTC'`, idempotent: true},
+		{sql: `ALTER WAREHOUSE wh SET COMMENT = 'abc'`, idempotent: false},
+		// the keywords inside the string literals, the quoted identifiers, and the comments are ignored
+		{sql: `ALTER WAREHOUSE "wh" SET COMMENT = ' ADD COLUMN or DROP it '`, idempotent: true},
+		{sql: `ALTER WAREHOUSE "wh" SET COMMENT = 'it''s a \' RENAME '`, idempotent: true},
+		{sql: `ALTER DATABASE "SET" RENAME TO "db2"`, idempotent: false},
+		{sql: `ALTER DATABASE "db" /* SET */ RENAME TO "db2"`, idempotent: false},
+		{sql: "ALTER DATABASE \"db\" -- SET\nRENAME TO \"db2\"", idempotent: false},
+		{sql: `CREATE VIEW "db"."schema"."view" COMMENT = ' IF NOT EXISTS ' AS SELECT 1`, idempotent: false},
+		{sql: `CREATE VIEW "db"."schema"."or replace" AS SELECT 1`, idempotent: false},
+		{sql: `CREATE FUNCTION "db"."schema"."function"() RETURNS VARCHAR AS $$ CREATE OR REPLACE $$`, idempotent: false},
+		{sql: `DROP TABLE "db"."schema"."table" -- IF EXISTS`, idempotent: false},
+		{sql: `INSERT INTO t VALUES (1)`, idempotent: false},
+		{sql: `CALL proc()`, idempotent: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			assert.Equal(t, tc.idempotent, isIdempotentStatement(tc.sql))
+		})
+	}
+}
+
+func Test_Client_withRetries(t *testing.T) {
+	retryableErr := driver.ErrBadConn
+	failingTimes := func(times int, err error) (func() error, *int) {
+		calls := 0
+		return func() error {
+			calls++
+			if calls <= times {
+				return err
+			}
+			return nil
+		}, &calls
+	}
+
+	t.Run("retries disabled", func(t *testing.T) {
+		client := &Client{}
+		operation, calls := failingTimes(1, retryableErr)
+
+		err := client.withRetries(context.Background(), "SHOW DATABASES", operation)
+
+		assert.ErrorIs(t, err, retryableErr)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("succeeds after retries", func(t *testing.T) {
+		client := &Client{}
+		client.EnableRetries(3, time.Millisecond)
+		operation, calls := failingTimes(2, retryableErr)
+
+		err := client.withRetries(context.Background(), "SHOW DATABASES", operation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		client := &Client{}
+		client.EnableRetries(2, time.Millisecond)
+		operation, calls := failingTimes(5, retryableErr)
+
+		err := client.withRetries(context.Background(), "SHOW DATABASES", operation)
+
+		assert.ErrorIs(t, err, retryableErr)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("does not retry non-retryable errors", func(t *testing.T) {
+		client := &Client{}
+		client.EnableRetries(2, time.Millisecond)
+		operation, calls := failingTimes(5, errors.New("SQL compilation error"))
+
+		err := client.withRetries(context.Background(), "SHOW DATABASES", operation)
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("does not retry non-idempotent statements", func(t *testing.T) {
+		client := &Client{}
+		client.EnableRetries(2, time.Millisecond)
+		operation, calls := failingTimes(5, retryableErr)
+
+		err := client.withRetries(context.Background(), `ALTER DATABASE "db" RENAME TO "db2"`, operation)
+
+		assert.ErrorIs(t, err, retryableErr)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("does not retry not found errors by default", func(t *testing.T) {
+		client := &Client{}
+		client.EnableRetries(2, time.Millisecond)
+		operation, calls := failingTimes(5, ErrObjectNotExistOrAuthorized)
+
+		err := client.withRetries(context.Background(), `DESCRIBE TABLE "db"."schema"."table"`, operation)
+
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("retries not found errors of queries when requested", func(t *testing.T) {
+		client := &Client{}
+		client.EnableRetries(3, time.Millisecond)
+		operation, calls := failingTimes(2, ErrObjectNotExistOrAuthorized)
+
+		err := client.withRetries(ContextWithNotFoundRetries(context.Background()), `SHOW TABLES LIKE 'table' IN SCHEMA "db"."schema"`, operation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("does not retry not found errors of other statements", func(t *testing.T) {
+		client := &Client{}
+		client.EnableRetries(3, time.Millisecond)
+		operation, calls := failingTimes(2, ErrDoesNotExistOrOperationCannotBePerformed)
+
+		err := client.withRetries(ContextWithNotFoundRetries(context.Background()), `ALTER TABLE "db"."schema"."table" SET COMMENT = 'abc'`, operation)
+
+		assert.ErrorIs(t, err, ErrDoesNotExistOrOperationCannotBePerformed)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		client := &Client{}
+		client.EnableRetries(5, time.Hour)
+		operation, calls := failingTimes(5, retryableErr)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := client.withRetries(ctx, "SHOW DATABASES", operation)
+
+		assert.ErrorIs(t, err, retryableErr)
+		assert.Equal(t, 1, *calls)
+	})
+}
+
+func Test_Client_ShowByID_notFoundRetries(t *testing.T) {
+	id := NewAccountObjectIdentifier("database")
+	newClient := func(emptyResults int) (*Client, *emptyShowResultsConnector) {
+		connector := &emptyShowResultsConnector{emptyResults: emptyResults, name: id.Name()}
+		client := &Client{db: sqlx.NewDb(sql.OpenDB(connector), "snowflake")}
+		client.initialize()
+		client.EnableRetries(3, time.Millisecond)
+		return client, connector
+	}
+
+	t.Run("does not retry the empty result by default", func(t *testing.T) {
+		client, connector := newClient(1)
+
+		_, err := client.Databases.ShowByID(context.Background(), id)
+
+		assert.ErrorIs(t, err, ErrObjectNotFound)
+		assert.Equal(t, 1, connector.queries)
+	})
+
+	t.Run("retries the empty result after creation", func(t *testing.T) {
+		client, connector := newClient(2)
+
+		database, err := client.Databases.ShowByIDSafely(ContextWithNotFoundRetries(context.Background()), id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id.Name(), database.Name)
+		assert.Equal(t, 3, connector.queries)
+	})
+
+	t.Run("returns not found after max retries", func(t *testing.T) {
+		client, connector := newClient(10)
+
+		_, err := client.Databases.ShowByID(ContextWithNotFoundRetries(context.Background()), id)
+
+		assert.ErrorIs(t, err, ErrObjectNotFound)
+		assert.Equal(t, 4, connector.queries)
+	})
+
+	t.Run("bypasses the SHOW result cache", func(t *testing.T) {
+		client, connector := newClient(2)
+		client.EnableShowResultCache()
+
+		_, err := client.Databases.ShowByID(context.Background(), id)
+		assert.ErrorIs(t, err, ErrObjectNotFound)
+
+		database, err := client.Databases.ShowByID(ContextWithNotFoundRetries(context.Background()), id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id.Name(), database.Name)
+		assert.Equal(t, 3, connector.queries)
+	})
+}
+
+// emptyShowResultsConnector is a database connector returning no rows for the first emptyResults queries,
+// and then a single row with the given name, like SHOW ... LIKE does for the object that becomes visible with a delay.
+type emptyShowResultsConnector struct {
+	emptyResults int
+	name         string
+	queries      int
+}
+
+func (c *emptyShowResultsConnector) Connect(context.Context) (driver.Conn, error) {
+	return emptyShowResultsConn{connector: c}, nil
+}
+
+func (c *emptyShowResultsConnector) Driver() driver.Driver {
+	return nil
+}
+
+type emptyShowResultsConn struct {
+	connector *emptyShowResultsConnector
+}
+
+func (c emptyShowResultsConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	c.connector.queries++
+	if c.connector.queries <= c.connector.emptyResults {
+		return &nameRows{}, nil
+	}
+	return &nameRows{names: []string{c.connector.name}}, nil
+}
+
+func (c emptyShowResultsConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c emptyShowResultsConn) Close() error {
+	return nil
+}
+
+func (c emptyShowResultsConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+type nameRows struct {
+	names []string
+}
+
+func (r *nameRows) Columns() []string {
+	return []string{"name"}
+}
+
+func (r *nameRows) Close() error {
+	return nil
+}
+
+func (r *nameRows) Next(dest []driver.Value) error {
+	if len(r.names) == 0 {
+		return io.EOF
+	}
+	dest[0], r.names = r.names[0], r.names[1:]
+	return nil
+}
@@ -0,0 +1,52 @@
+package sdk
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrSqlPreviewQuery is returned from the read-only queries run with the client returned from NewSqlPreviewClient.
+var ErrSqlPreviewQuery = errors.New("queries can't be run in the SQL preview, as the client is not connected to Snowflake")
+
+// SqlPreview collects the statements that the client returned from NewSqlPreviewClient would run.
+type SqlPreview struct {
+	mu         sync.Mutex
+	statements []string
+}
+
+func (p *SqlPreview) record(sql string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.statements = append(p.statements, sql)
+}
+
+// Statements returns the recorded statements in the order they were run.
+func (p *SqlPreview) Statements() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string{}, p.statements...)
+}
+
+// NewSqlPreviewClient returns the client that is not connected to Snowflake. It's used to render the statements
+// of the SDK operations from their requests (or options): the statements are validated and built as usual, but they are
+// recorded in the returned SqlPreview instead of being run. The read-only queries (SHOW, DESCRIBE, and SELECT) fail
+// with ErrSqlPreviewQuery, so the operations depending on the current state of the objects can't be previewed.
+func NewSqlPreviewClient() (*Client, *SqlPreview) {
+	preview := &SqlPreview{}
+	client := &Client{sqlPreview: preview}
+	client.initialize()
+	return client, preview
+}
+
+// recordInSqlPreview returns true when the client is the SQL preview client. In that case, the statement is recorded,
+// or ErrSqlPreviewQuery is returned for the read-only queries.
+func (c *Client) recordInSqlPreview(sql string) (bool, error) {
+	if c.sqlPreview == nil {
+		return false, nil
+	}
+	if isReadOnlyQuery(sql) {
+		return true, ErrSqlPreviewQuery
+	}
+	c.sqlPreview.record(sql)
+	return true, nil
+}
@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewSqlPreviewClient(t *testing.T) {
+	ctx := context.Background()
+	id := NewAccountObjectIdentifier("database")
+
+	t.Run("records the statements instead of running them", func(t *testing.T) {
+		client, preview := NewSqlPreviewClient()
+
+		require.NoError(t, client.Databases.Create(ctx, id, &CreateDatabaseOptions{Comment: String("comment")}))
+		require.NoError(t, client.Databases.Drop(ctx, id, &DropDatabaseOptions{IfExists: Bool(true)}))
+
+		assert.Equal(t, []string{
+			`CREATE DATABASE "database" COMMENT = 'comment'`,
+			`DROP DATABASE IF EXISTS "database"`,
+		}, preview.Statements())
+	})
+
+	t.Run("records the queries modifying the objects", func(t *testing.T) {
+		client, preview := NewSqlPreviewClient()
+
+		_, err := client.QueryUnsafe(ctx, "CALL procedure()")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"CALL procedure()"}, preview.Statements())
+	})
+
+	t.Run("does not run the read-only queries", func(t *testing.T) {
+		client, preview := NewSqlPreviewClient()
+
+		_, err := client.Databases.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrSqlPreviewQuery)
+
+		_, err = client.QueryUnsafe(ctx, "SELECT 1")
+		require.ErrorIs(t, err, ErrSqlPreviewQuery)
+
+		assert.Empty(t, preview.Statements())
+	})
+
+	t.Run("does not record the statements with the regular client", func(t *testing.T) {
+		ok, err := (&Client{}).recordInSqlPreview("DROP DATABASE x")
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("validates the statements", func(t *testing.T) {
+		client, preview := NewSqlPreviewClient()
+
+		require.Error(t, client.Databases.Create(ctx, NewAccountObjectIdentifier(""), nil))
+		assert.Empty(t, preview.Statements())
+	})
+}
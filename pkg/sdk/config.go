@@ -162,6 +162,21 @@ func (c *ConfigDTO) DriverConfig() (gosnowflake.Config, error) {
 	pointerAttributeSet(c.DisableQueryContextCache, &driverCfg.DisableQueryContextCache)
 	pointerConfigBoolAttributeSet(c.IncludeRetryReason, &driverCfg.IncludeRetryReason)
 	pointerConfigBoolAttributeSet(c.DisableConsoleLogin, &driverCfg.DisableConsoleLogin)
+	if c.WorkloadIdentityProvider != nil {
+		workloadIdentityProvider, err := ToWorkloadIdentityProvider(*c.WorkloadIdentityProvider)
+		if err != nil {
+			return gosnowflake.Config{}, err
+		}
+		driverCfg.WorkloadIdentityProvider = string(workloadIdentityProvider)
+	}
+	pointerAttributeSet(c.WorkloadIdentityEntraResource, &driverCfg.WorkloadIdentityEntraResource)
+	if c.OidcTokenFilePath != nil {
+		token, err := ReadOidcTokenFile(*c.OidcTokenFilePath)
+		if err != nil {
+			return gosnowflake.Config{}, err
+		}
+		driverCfg.Token = token
+	}
 
 	return driverCfg, nil
 }
@@ -281,6 +296,12 @@ func MergeConfig(baseConfig *gosnowflake.Config, mergeConfig *gosnowflake.Config
 	if !configBoolSet(baseConfig.DisableConsoleLogin) {
 		baseConfig.DisableConsoleLogin = mergeConfig.DisableConsoleLogin
 	}
+	if baseConfig.WorkloadIdentityProvider == "" {
+		baseConfig.WorkloadIdentityProvider = mergeConfig.WorkloadIdentityProvider
+	}
+	if baseConfig.WorkloadIdentityEntraResource == "" {
+		baseConfig.WorkloadIdentityEntraResource = mergeConfig.WorkloadIdentityEntraResource
+	}
 	return baseConfig
 }
 
@@ -419,6 +440,7 @@ const (
 	AuthenticationTypeTokenAccessor           AuthenticationType = "TOKENACCESSOR"
 	AuthenticationTypeUsernamePasswordMfa     AuthenticationType = "USERNAMEPASSWORDMFA"
 	AuthenticationTypeProgrammaticAccessToken AuthenticationType = "PROGRAMMATIC_ACCESS_TOKEN" //nolint:gosec
+	AuthenticationTypeWorkloadIdentity        AuthenticationType = "WORKLOAD_IDENTITY"
 
 	AuthenticationTypeEmpty AuthenticationType = ""
 )
@@ -432,6 +454,7 @@ var AllAuthenticationTypes = []AuthenticationType{
 	AuthenticationTypeTokenAccessor,
 	AuthenticationTypeUsernamePasswordMfa,
 	AuthenticationTypeProgrammaticAccessToken,
+	AuthenticationTypeWorkloadIdentity,
 }
 
 func ToAuthenticatorType(s string) (gosnowflake.AuthType, error) {
@@ -452,6 +475,8 @@ func ToAuthenticatorType(s string) (gosnowflake.AuthType, error) {
 		return gosnowflake.AuthTypeUsernamePasswordMFA, nil
 	case string(AuthenticationTypeProgrammaticAccessToken):
 		return gosnowflake.AuthTypePat, nil
+	case string(AuthenticationTypeWorkloadIdentity):
+		return gosnowflake.AuthTypeWorkloadIdentityFederation, nil
 	default:
 		return gosnowflake.AuthType(0), fmt.Errorf("invalid authenticator type: %s", s)
 	}
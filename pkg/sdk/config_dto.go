@@ -53,4 +53,7 @@ type ConfigDTO struct {
 	DisableQueryContextCache       *bool               `toml:"disable_query_context_cache"`
 	IncludeRetryReason             *bool               `toml:"include_retry_reason"`
 	DisableConsoleLogin            *bool               `toml:"disable_console_login"`
+	WorkloadIdentityProvider       *string             `toml:"workload_identity_provider"`
+	WorkloadIdentityEntraResource  *string             `toml:"workload_identity_entra_resource"`
+	OidcTokenFilePath              *string             `toml:"oidc_token_file_path"`
 }
@@ -206,3 +206,18 @@ func (s *ConfigDTO) WithDisableConsoleLogin(DisableConsoleLogin bool) *ConfigDTO
 	s.DisableConsoleLogin = &DisableConsoleLogin
 	return s
 }
+
+func (s *ConfigDTO) WithWorkloadIdentityProvider(WorkloadIdentityProvider string) *ConfigDTO {
+	s.WorkloadIdentityProvider = &WorkloadIdentityProvider
+	return s
+}
+
+func (s *ConfigDTO) WithWorkloadIdentityEntraResource(WorkloadIdentityEntraResource string) *ConfigDTO {
+	s.WorkloadIdentityEntraResource = &WorkloadIdentityEntraResource
+	return s
+}
+
+func (s *ConfigDTO) WithOidcTokenFilePath(OidcTokenFilePath string) *ConfigDTO {
+	s.OidcTokenFilePath = &OidcTokenFilePath
+	return s
+}
@@ -443,6 +443,8 @@ func Test_MergeConfig(t *testing.T) {
 		DisableQueryContextCache:       false,
 		IncludeRetryReason:             1,
 		DisableConsoleLogin:            gosnowflake.ConfigBoolFalse,
+		WorkloadIdentityProvider:       "AWS",
+		WorkloadIdentityEntraResource:  "resource1",
 	}
 
 	config2 := &gosnowflake.Config{
@@ -483,6 +485,8 @@ func Test_MergeConfig(t *testing.T) {
 		DisableQueryContextCache:       true,
 		IncludeRetryReason:             gosnowflake.ConfigBoolTrue,
 		DisableConsoleLogin:            gosnowflake.ConfigBoolTrue,
+		WorkloadIdentityProvider:       "AZURE",
+		WorkloadIdentityEntraResource:  "resource2",
 	}
 
 	t.Run("base config empty", func(t *testing.T) {
@@ -571,6 +575,7 @@ func Test_ToAuthenticationType(t *testing.T) {
 		{input: "SNOWFLAKE_JWT", want: gosnowflake.AuthTypeJwt},
 		{input: "TOKENACCESSOR", want: gosnowflake.AuthTypeTokenAccessor},
 		{input: "USERNAMEPASSWORDMFA", want: gosnowflake.AuthTypeUsernamePasswordMFA},
+		{input: "WORKLOAD_IDENTITY", want: gosnowflake.AuthTypeWorkloadIdentityFederation},
 	}
 
 	invalid := []test{
@@ -613,6 +618,7 @@ func Test_ToExtendedAuthenticatorType(t *testing.T) {
 		{input: "TOKENACCESSOR", want: gosnowflake.AuthTypeTokenAccessor},
 		{input: "USERNAMEPASSWORDMFA", want: gosnowflake.AuthTypeUsernamePasswordMFA},
 		{input: "PROGRAMMATIC_ACCESS_TOKEN", want: gosnowflake.AuthTypePat},
+		{input: "WORKLOAD_IDENTITY", want: gosnowflake.AuthTypeWorkloadIdentityFederation},
 		{input: "", want: GosnowflakeAuthTypeEmpty},
 	}
 
@@ -816,7 +822,9 @@ func TestConfigDTODriverConfig(t *testing.T) {
 				WithTmpDirPath("/tmp").
 				WithDisableQueryContextCache(true).
 				WithIncludeRetryReason(true).
-				WithDisableConsoleLogin(true),
+				WithDisableConsoleLogin(true).
+				WithWorkloadIdentityProvider("azure").
+				WithWorkloadIdentityEntraResource("api://resource"),
 			expected: func(t *testing.T, got gosnowflake.Config, err error) {
 				t.Helper()
 				require.NoError(t, err)
@@ -854,6 +862,8 @@ func TestConfigDTODriverConfig(t *testing.T) {
 				assert.True(t, got.DisableQueryContextCache)
 				assert.Equal(t, gosnowflake.ConfigBoolTrue, got.IncludeRetryReason)
 				assert.Equal(t, gosnowflake.ConfigBoolTrue, got.DisableConsoleLogin)
+				assert.Equal(t, "AZURE", got.WorkloadIdentityProvider)
+				assert.Equal(t, "api://resource", got.WorkloadIdentityEntraResource)
 
 				gotKey, err := x509.MarshalPKCS8PrivateKey(got.PrivateKey)
 				require.NoError(t, err)
@@ -897,6 +907,12 @@ func TestConfigDTODriverConfig(t *testing.T) {
 				WithPrivateKey("not_a_valid_pem"),
 			err: fmt.Errorf("could not parse private key, key is not in PEM format"),
 		},
+		{
+			name: "invalid workload identity provider",
+			input: NewConfigDTO().
+				WithWorkloadIdentityProvider("invalid"),
+			err: fmt.Errorf("invalid workload identity provider: INVALID"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/oswrapper"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+type WorkloadIdentityProvider string
+
+const (
+	WorkloadIdentityProviderAws   WorkloadIdentityProvider = "AWS"
+	WorkloadIdentityProviderGcp   WorkloadIdentityProvider = "GCP"
+	WorkloadIdentityProviderAzure WorkloadIdentityProvider = "AZURE"
+	WorkloadIdentityProviderOidc  WorkloadIdentityProvider = "OIDC"
+)
+
+var AllWorkloadIdentityProviders = []WorkloadIdentityProvider{
+	WorkloadIdentityProviderAws,
+	WorkloadIdentityProviderGcp,
+	WorkloadIdentityProviderAzure,
+	WorkloadIdentityProviderOidc,
+}
+
+func ToWorkloadIdentityProvider(s string) (WorkloadIdentityProvider, error) {
+	s = strings.ToUpper(s)
+	if !slices.Contains(AllWorkloadIdentityProviders, WorkloadIdentityProvider(s)) {
+		return "", fmt.Errorf("invalid workload identity provider: %s", s)
+	}
+	return WorkloadIdentityProvider(s), nil
+}
+
+// ReadOidcTokenFile reads the OIDC token (e.g. a Kubernetes service account token) used in the workload identity authentication.
+// The permissions are not verified, because the tokens are usually mounted as files readable by other users.
+func ReadOidcTokenFile(path string) (string, error) {
+	token, err := oswrapper.ReadFileSafe(path, false)
+	if err != nil {
+		return "", fmt.Errorf("could not read OIDC token file %s: %w", path, err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// ValidateWorkloadIdentityConfig checks that the attributes required by the workload identity provider are set.
+// It returns nil when a different authenticator is used.
+func ValidateWorkloadIdentityConfig(cfg *gosnowflake.Config) error {
+	if cfg == nil || cfg.Authenticator != gosnowflake.AuthTypeWorkloadIdentityFederation {
+		return nil
+	}
+	if cfg.WorkloadIdentityProvider == "" {
+		return fmt.Errorf("workload_identity_provider is required when authenticator is %s, valid options are: %v", AuthenticationTypeWorkloadIdentity, AllWorkloadIdentityProviders)
+	}
+	workloadIdentityProvider, err := ToWorkloadIdentityProvider(cfg.WorkloadIdentityProvider)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	switch workloadIdentityProvider {
+	case WorkloadIdentityProviderOidc:
+		if cfg.Token == "" {
+			errs = append(errs, fmt.Errorf("token or oidc_token_file_path is required when workload_identity_provider is %s", WorkloadIdentityProviderOidc))
+		}
+	case WorkloadIdentityProviderAws, WorkloadIdentityProviderGcp, WorkloadIdentityProviderAzure:
+		if cfg.Token != "" {
+			errs = append(errs, fmt.Errorf("token and oidc_token_file_path can be set only when workload_identity_provider is %s", WorkloadIdentityProviderOidc))
+		}
+	}
+	if cfg.WorkloadIdentityEntraResource != "" && workloadIdentityProvider != WorkloadIdentityProviderAzure {
+		errs = append(errs, fmt.Errorf("workload_identity_entra_resource can be set only when workload_identity_provider is %s", WorkloadIdentityProviderAzure))
+	}
+	return errors.Join(errs...)
+}
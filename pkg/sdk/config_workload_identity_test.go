@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToWorkloadIdentityProvider(t *testing.T) {
+	for _, input := range []string{"AWS", "aws", "GCP", "AZURE", "OIDC"} {
+		t.Run(input, func(t *testing.T) {
+			got, err := ToWorkloadIdentityProvider(input)
+			require.NoError(t, err)
+			assert.Contains(t, AllWorkloadIdentityProviders, got)
+		})
+	}
+
+	for _, input := range []string{"", "foo", "KUBERNETES"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ToWorkloadIdentityProvider(input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_ReadOidcTokenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("oidc-token\n"), 0o644))
+
+	token, err := ReadOidcTokenFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-token", token)
+
+	_, err = ReadOidcTokenFile(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "could not read OIDC token file")
+}
+
+func Test_ValidateWorkloadIdentityConfig(t *testing.T) {
+	workloadIdentityConfig := func(provider string) *gosnowflake.Config {
+		return &gosnowflake.Config{
+			Authenticator:            gosnowflake.AuthTypeWorkloadIdentityFederation,
+			WorkloadIdentityProvider: provider,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		config        *gosnowflake.Config
+		expectedError string
+	}{
+		{
+			name:   "different authenticator",
+			config: &gosnowflake.Config{Authenticator: gosnowflake.AuthTypeJwt, Token: "token"},
+		},
+		{
+			name:   "aws",
+			config: workloadIdentityConfig("AWS"),
+		},
+		{
+			name:          "missing provider",
+			config:        workloadIdentityConfig(""),
+			expectedError: "workload_identity_provider is required when authenticator is WORKLOAD_IDENTITY",
+		},
+		{
+			name:          "invalid provider",
+			config:        workloadIdentityConfig("foo"),
+			expectedError: "invalid workload identity provider: FOO",
+		},
+		{
+			name:          "oidc without token",
+			config:        workloadIdentityConfig("OIDC"),
+			expectedError: "token or oidc_token_file_path is required when workload_identity_provider is OIDC",
+		},
+		{
+			name: "token with aws",
+			config: func() *gosnowflake.Config {
+				c := workloadIdentityConfig("AWS")
+				c.Token = "token"
+				return c
+			}(),
+			expectedError: "token and oidc_token_file_path can be set only when workload_identity_provider is OIDC",
+		},
+		{
+			name: "entra resource with gcp",
+			config: func() *gosnowflake.Config {
+				c := workloadIdentityConfig("GCP")
+				c.WorkloadIdentityEntraResource = "api://resource"
+				return c
+			}(),
+			expectedError: "workload_identity_entra_resource can be set only when workload_identity_provider is AZURE",
+		},
+		{
+			name: "oidc with token",
+			config: func() *gosnowflake.Config {
+				c := workloadIdentityConfig("OIDC")
+				c.Token = "token"
+				return c
+			}(),
+		},
+		{
+			name:   "gcp",
+			config: workloadIdentityConfig("GCP"),
+		},
+		{
+			name: "azure with entra resource",
+			config: func() *gosnowflake.Config {
+				c := workloadIdentityConfig("AZURE")
+				c.WorkloadIdentityEntraResource = "api://resource"
+				return c
+			}(),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateWorkloadIdentityConfig(tc.config)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
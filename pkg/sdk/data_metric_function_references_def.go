@@ -12,7 +12,8 @@ import (
 type DataMetricFunctionRefEntityDomainOption string
 
 const (
-	DataMetricFunctionRefEntityDomainView DataMetricFunctionRefEntityDomainOption = "VIEW"
+	DataMetricFunctionRefEntityDomainView  DataMetricFunctionRefEntityDomainOption = "VIEW"
+	DataMetricFunctionRefEntityDomainTable DataMetricFunctionRefEntityDomainOption = "TABLE"
 )
 
 type DataMetricScheduleStatusOption string
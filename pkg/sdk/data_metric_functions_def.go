@@ -0,0 +1,185 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var dataMetricFunctionColumnDef = g.NewQueryStruct("DataMetricFunctionColumn").
+	Text("ColumnName", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("ColumnDataType", "datatypes.DataType", g.ParameterOptions().NoQuotes().NoEquals().Required())
+
+var dataMetricFunctionTableArgumentDef = g.NewQueryStruct("DataMetricFunctionTableArgument").
+	Text("TableName", g.KeywordOptions().DoubleQuotes().Required()).
+	ListQueryStructField(
+		"Columns",
+		dataMetricFunctionColumnDef,
+		g.ParameterOptions().Parentheses().NoEquals().SQL("TABLE"),
+	)
+
+var dataMetricFunctionOnObjectDef = g.NewQueryStruct("DataMetricFunctionOnObject").
+	Identifier("DataMetricFunction", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+	ListAssignment("ON", "Column", g.ParameterOptions().Required().NoEquals().Parentheses()).
+	WithValidation(g.ValidIdentifier, "DataMetricFunction")
+
+var dataMetricFunctionModifyOnObjectDef = g.NewQueryStruct("DataMetricFunctionModifyOnObject").
+	Identifier("DataMetricFunction", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+	ListAssignment("ON", "Column", g.ParameterOptions().Required().NoEquals().Parentheses()).
+	PredefinedQueryStructField("ScheduleStatus", "ViewDataMetricScheduleStatusOperationOption", g.KeywordOptions().NoQuotes().Required()).
+	WithValidation(g.ValidIdentifier, "DataMetricFunction")
+
+var DataMetricFunctionsDef = g.NewInterface(
+	"DataMetricFunctions",
+	"DataMetricFunction",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-data-metric-function",
+		g.NewQueryStruct("CreateDataMetricFunction").
+			Create().
+			OrReplace().
+			OptionalSQL("SECURE").
+			SQL("DATA METRIC FUNCTION").
+			IfNotExists().
+			Name().
+			ListQueryStructField(
+				"Arguments",
+				dataMetricFunctionTableArgumentDef,
+				g.ListOptions().MustParentheses(),
+			).
+			SQL("RETURNS NUMBER").
+			OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+			PredefinedQueryStructField("Expression", "string", g.ParameterOptions().NoEquals().SQL("AS").Required()).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "Expression").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		dataMetricFunctionTableArgumentDef,
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-data-metric-function",
+		g.NewQueryStruct("AlterDataMetricFunction").
+			Alter().
+			SQL("DATA METRIC FUNCTION").
+			IfExists().
+			Name().
+			PredefinedQueryStructField("Signature", "string", g.KeywordOptions().NoQuotes().Required()).
+			OptionalIdentifier("RenameTo", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("DataMetricFunctionSet").
+					OptionalSQL("SECURE").
+					OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+					WithValidation(g.AtLeastOneValueSet, "Secure", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("DataMetricFunctionUnset").
+					OptionalSQL("SECURE").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Secure", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "Signature").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-data-metric-function",
+		g.NewQueryStruct("DropDataMetricFunction").
+			Drop().
+			SQL("DATA METRIC FUNCTION").
+			IfExists().
+			Name().
+			PredefinedQueryStructField("Signature", "string", g.KeywordOptions().NoQuotes().Required()).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "Signature"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-data-metric-functions",
+		g.DbStruct("dataMetricFunctionRow").
+			Field("created_on", "string").
+			Field("name", "string").
+			Field("schema_name", "string").
+			Field("is_builtin", "string").
+			Field("is_aggregate", "string").
+			Field("is_ansi", "string").
+			Field("min_num_arguments", "int").
+			Field("max_num_arguments", "int").
+			Field("arguments", "string").
+			Field("description", "string").
+			Field("catalog_name", "string").
+			Field("is_table_function", "string").
+			Field("valid_for_clustering", "string").
+			Field("is_secure", "sql.NullString").
+			Field("language", "string"),
+		g.PlainStruct("DataMetricFunction").
+			Field("CreatedOn", "string").
+			Field("Name", "string").
+			Field("SchemaName", "string").
+			Field("IsBuiltin", "bool").
+			Field("IsAggregate", "bool").
+			Field("IsAnsi", "bool").
+			Field("MinNumArguments", "int").
+			Field("MaxNumArguments", "int").
+			Field("ArgumentsRaw", "string").
+			Field("Description", "string").
+			Field("CatalogName", "string").
+			Field("IsTableFunction", "bool").
+			Field("ValidForClustering", "bool").
+			Field("IsSecure", "bool").
+			Field("Language", "string"),
+		g.NewQueryStruct("ShowDataMetricFunctions").
+			Show().
+			SQL("DATA METRIC FUNCTIONS").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	CustomShowOperation(
+		"Describe",
+		g.ShowMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-data-metric-function",
+		g.DbStruct("dataMetricFunctionDetailRow").
+			Field("property", "string").
+			Field("value", "sql.NullString"),
+		g.PlainStruct("DataMetricFunctionDetail").
+			Text("Property").
+			Text("Value"),
+		g.NewQueryStruct("DescribeDataMetricFunction").
+			Describe().
+			SQL("DATA METRIC FUNCTION").
+			Name().
+			PredefinedQueryStructField("Signature", "string", g.KeywordOptions().NoQuotes().Required()).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "Signature"),
+	).
+	CustomOperation(
+		"AlterOnObject",
+		"https://docs.snowflake.com/en/user-guide/data-quality-working",
+		g.NewQueryStruct("AlterOnObjectDataMetricFunction").
+			Alter().
+			PredefinedQueryStructField("ObjectType", "ObjectType", g.KeywordOptions().NoQuotes().Required()).
+			Identifier("ObjectName", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+			OptionalQueryStructField(
+				"Add",
+				dataMetricFunctionOnObjectDef,
+				g.KeywordOptions().SQL("ADD DATA METRIC FUNCTION"),
+			).
+			OptionalQueryStructField(
+				"Drop",
+				dataMetricFunctionOnObjectDef,
+				g.KeywordOptions().SQL("DROP DATA METRIC FUNCTION"),
+			).
+			OptionalQueryStructField(
+				"Modify",
+				dataMetricFunctionModifyOnObjectDef,
+				g.KeywordOptions().SQL("MODIFY DATA METRIC FUNCTION"),
+			).
+			OptionalTextAssignment("SET DATA_METRIC_SCHEDULE", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET DATA_METRIC_SCHEDULE").
+			WithValidation(g.ValidIdentifier, "ObjectName").
+			WithValidation(g.ExactlyOneValueSet, "Add", "Drop", "Modify", "SetDataMetricSchedule", "UnsetDataMetricSchedule"),
+	)
@@ -0,0 +1,90 @@
+package sdk
+
+import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateDataMetricFunctionOptions]        = new(CreateDataMetricFunctionRequest)
+	_ optionsProvider[AlterDataMetricFunctionOptions]         = new(AlterDataMetricFunctionRequest)
+	_ optionsProvider[DropDataMetricFunctionOptions]          = new(DropDataMetricFunctionRequest)
+	_ optionsProvider[ShowDataMetricFunctionOptions]          = new(ShowDataMetricFunctionRequest)
+	_ optionsProvider[DescribeDataMetricFunctionOptions]      = new(DescribeDataMetricFunctionRequest)
+	_ optionsProvider[AlterOnObjectDataMetricFunctionOptions] = new(AlterOnObjectDataMetricFunctionRequest)
+)
+
+type CreateDataMetricFunctionRequest struct {
+	OrReplace   *bool
+	Secure      *bool
+	IfNotExists *bool
+	name        SchemaObjectIdentifier // required
+	Arguments   []DataMetricFunctionTableArgumentRequest
+	Comment     *string
+	Expression  string // required
+}
+
+type DataMetricFunctionTableArgumentRequest struct {
+	TableName string // required
+	Columns   []DataMetricFunctionColumnRequest
+}
+
+type DataMetricFunctionColumnRequest struct {
+	ColumnName     string             // required
+	ColumnDataType datatypes.DataType // required
+}
+
+type AlterDataMetricFunctionRequest struct {
+	IfExists  *bool
+	name      SchemaObjectIdentifier // required
+	Signature string                 // required
+	RenameTo  *SchemaObjectIdentifier
+	Set       *DataMetricFunctionSetRequest
+	Unset     *DataMetricFunctionUnsetRequest
+}
+
+type DataMetricFunctionSetRequest struct {
+	Secure  *bool
+	Comment *string
+}
+
+type DataMetricFunctionUnsetRequest struct {
+	Secure  *bool
+	Comment *bool
+}
+
+type DropDataMetricFunctionRequest struct {
+	IfExists  *bool
+	name      SchemaObjectIdentifier // required
+	Signature string                 // required
+}
+
+type ShowDataMetricFunctionRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribeDataMetricFunctionRequest struct {
+	name      SchemaObjectIdentifier // required
+	Signature string                 // required
+}
+
+type AlterOnObjectDataMetricFunctionRequest struct {
+	ObjectType              ObjectType             // required
+	ObjectName              SchemaObjectIdentifier // required
+	Add                     *DataMetricFunctionOnObjectRequest
+	Drop                    *DataMetricFunctionOnObjectRequest
+	Modify                  *DataMetricFunctionModifyOnObjectRequest
+	SetDataMetricSchedule   *string
+	UnsetDataMetricSchedule *bool
+}
+
+type DataMetricFunctionOnObjectRequest struct {
+	DataMetricFunction SchemaObjectIdentifier // required
+	On                 []Column               // required
+}
+
+type DataMetricFunctionModifyOnObjectRequest struct {
+	DataMetricFunction SchemaObjectIdentifier                      // required
+	On                 []Column                                    // required
+	ScheduleStatus     ViewDataMetricScheduleStatusOperationOption // required
+}
@@ -0,0 +1,42 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Signature returns the argument part of the data metric function definition (e.g. "(TABLE(NUMBER, VARCHAR))").
+// It is required to identify data metric function in ALTER, DESCRIBE, and DROP commands.
+// The arguments column in SHOW DATA METRIC FUNCTIONS output has the form: `NAME(TABLE(NUMBER, VARCHAR)) RETURN NUMBER`.
+func (v *DataMetricFunction) Signature() (string, error) {
+	raw := strings.TrimSpace(v.ArgumentsRaw)
+	start := strings.Index(raw, "(")
+	end := strings.LastIndex(raw, " RETURN ")
+	if start == -1 || end == -1 || end < start {
+		return "", fmt.Errorf("could not parse signature from data metric function arguments: %s", v.ArgumentsRaw)
+	}
+	return raw[start:end], nil
+}
+
+// AllDataMetricFunctionReferenceObjectTypes lists the object types that data metric functions can be attached to
+// with `ALTER <object_type> <object_name> ADD DATA METRIC FUNCTION`.
+var AllDataMetricFunctionReferenceObjectTypes = []ObjectType{
+	ObjectTypeTable,
+	ObjectTypeView,
+	ObjectTypeMaterializedView,
+	ObjectTypeDynamicTable,
+	ObjectTypeExternalTable,
+}
+
+// DataMetricFunctionRefEntityDomainForObjectType returns the REF_ENTITY_DOMAIN value that should be used in
+// DATA_METRIC_FUNCTION_REFERENCES for the given object type.
+func DataMetricFunctionRefEntityDomainForObjectType(objectType ObjectType) (DataMetricFunctionRefEntityDomainOption, error) {
+	switch objectType {
+	case ObjectTypeTable, ObjectTypeDynamicTable, ObjectTypeExternalTable:
+		return DataMetricFunctionRefEntityDomainTable, nil
+	case ObjectTypeView, ObjectTypeMaterializedView:
+		return DataMetricFunctionRefEntityDomainView, nil
+	default:
+		return "", fmt.Errorf("data metric functions are not supported for object type %s", objectType)
+	}
+}
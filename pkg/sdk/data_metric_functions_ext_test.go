@@ -0,0 +1,68 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataMetricFunction_Signature(t *testing.T) {
+	inputs := []struct {
+		rawInput string
+		expected string
+	}{
+		{"MY_DMF(TABLE(NUMBER)) RETURN NUMBER", "(TABLE(NUMBER))"},
+		{"MY_DMF(TABLE(NUMBER, VARCHAR)) RETURN NUMBER", "(TABLE(NUMBER, VARCHAR))"},
+		{"MY_DMF(TABLE(NUMBER), TABLE(VARCHAR)) RETURN NUMBER", "(TABLE(NUMBER), TABLE(VARCHAR))"},
+	}
+
+	badInputs := []string{
+		"",
+		"MY_DMF",
+		"MY_DMF(TABLE(NUMBER))",
+	}
+
+	for _, tc := range inputs {
+		t.Run(fmt.Sprintf("arguments: %s", tc.rawInput), func(t *testing.T) {
+			dataMetricFunction := DataMetricFunction{ArgumentsRaw: tc.rawInput}
+			signature, err := dataMetricFunction.Signature()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, signature)
+		})
+	}
+
+	for _, rawInput := range badInputs {
+		t.Run(fmt.Sprintf("incorrect arguments: %s", rawInput), func(t *testing.T) {
+			dataMetricFunction := DataMetricFunction{ArgumentsRaw: rawInput}
+			_, err := dataMetricFunction.Signature()
+			require.ErrorContains(t, err, "could not parse signature")
+		})
+	}
+}
+
+func TestDataMetricFunctionRefEntityDomainForObjectType(t *testing.T) {
+	testCases := []struct {
+		objectType ObjectType
+		expected   DataMetricFunctionRefEntityDomainOption
+	}{
+		{ObjectTypeTable, DataMetricFunctionRefEntityDomainTable},
+		{ObjectTypeDynamicTable, DataMetricFunctionRefEntityDomainTable},
+		{ObjectTypeExternalTable, DataMetricFunctionRefEntityDomainTable},
+		{ObjectTypeView, DataMetricFunctionRefEntityDomainView},
+		{ObjectTypeMaterializedView, DataMetricFunctionRefEntityDomainView},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("object type: %s", tc.objectType), func(t *testing.T) {
+			domain, err := DataMetricFunctionRefEntityDomainForObjectType(tc.objectType)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, domain)
+		})
+	}
+
+	t.Run("unsupported object type", func(t *testing.T) {
+		_, err := DataMetricFunctionRefEntityDomainForObjectType(ObjectTypeWarehouse)
+		require.ErrorContains(t, err, "data metric functions are not supported for object type WAREHOUSE")
+	})
+}
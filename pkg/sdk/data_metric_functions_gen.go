@@ -0,0 +1,164 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+)
+
+type DataMetricFunctions interface {
+	Create(ctx context.Context, request *CreateDataMetricFunctionRequest) error
+	Alter(ctx context.Context, request *AlterDataMetricFunctionRequest) error
+	Drop(ctx context.Context, request *DropDataMetricFunctionRequest) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowDataMetricFunctionRequest) ([]DataMetricFunction, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*DataMetricFunction, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*DataMetricFunction, error)
+	Describe(ctx context.Context, request *DescribeDataMetricFunctionRequest) ([]DataMetricFunctionDetail, error)
+	AlterOnObject(ctx context.Context, request *AlterOnObjectDataMetricFunctionRequest) error
+}
+
+// CreateDataMetricFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-data-metric-function.
+type CreateDataMetricFunctionOptions struct {
+	create             bool                              `ddl:"static" sql:"CREATE"`
+	OrReplace          *bool                             `ddl:"keyword" sql:"OR REPLACE"`
+	Secure             *bool                             `ddl:"keyword" sql:"SECURE"`
+	dataMetricFunction bool                              `ddl:"static" sql:"DATA METRIC FUNCTION"`
+	IfNotExists        *bool                             `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name               SchemaObjectIdentifier            `ddl:"identifier"`
+	Arguments          []DataMetricFunctionTableArgument `ddl:"list,must_parentheses"`
+	returnsNumber      bool                              `ddl:"static" sql:"RETURNS NUMBER"`
+	Comment            *string                           `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Expression         string                            `ddl:"parameter,no_equals" sql:"AS"`
+}
+
+type DataMetricFunctionTableArgument struct {
+	TableName string                     `ddl:"keyword,double_quotes"`
+	Columns   []DataMetricFunctionColumn `ddl:"parameter,parentheses,no_equals" sql:"TABLE"`
+}
+
+type DataMetricFunctionColumn struct {
+	ColumnName     string             `ddl:"keyword,double_quotes"`
+	ColumnDataType datatypes.DataType `ddl:"parameter,no_quotes,no_equals"`
+}
+
+// AlterDataMetricFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-data-metric-function.
+type AlterDataMetricFunctionOptions struct {
+	alter              bool                     `ddl:"static" sql:"ALTER"`
+	dataMetricFunction bool                     `ddl:"static" sql:"DATA METRIC FUNCTION"`
+	IfExists           *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name               SchemaObjectIdentifier   `ddl:"identifier"`
+	Signature          string                   `ddl:"keyword,no_quotes"`
+	RenameTo           *SchemaObjectIdentifier  `ddl:"identifier" sql:"RENAME TO"`
+	Set                *DataMetricFunctionSet   `ddl:"keyword" sql:"SET"`
+	Unset              *DataMetricFunctionUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+type DataMetricFunctionSet struct {
+	Secure  *bool   `ddl:"keyword" sql:"SECURE"`
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type DataMetricFunctionUnset struct {
+	Secure  *bool `ddl:"keyword" sql:"SECURE"`
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropDataMetricFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-data-metric-function.
+type DropDataMetricFunctionOptions struct {
+	drop               bool                   `ddl:"static" sql:"DROP"`
+	dataMetricFunction bool                   `ddl:"static" sql:"DATA METRIC FUNCTION"`
+	IfExists           *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name               SchemaObjectIdentifier `ddl:"identifier"`
+	Signature          string                 `ddl:"keyword,no_quotes"`
+}
+
+// ShowDataMetricFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-data-metric-functions.
+type ShowDataMetricFunctionOptions struct {
+	show                bool  `ddl:"static" sql:"SHOW"`
+	dataMetricFunctions bool  `ddl:"static" sql:"DATA METRIC FUNCTIONS"`
+	Like                *Like `ddl:"keyword" sql:"LIKE"`
+	In                  *In   `ddl:"keyword" sql:"IN"`
+}
+
+type dataMetricFunctionRow struct {
+	CreatedOn          string         `db:"created_on"`
+	Name               string         `db:"name"`
+	SchemaName         string         `db:"schema_name"`
+	IsBuiltin          string         `db:"is_builtin"`
+	IsAggregate        string         `db:"is_aggregate"`
+	IsAnsi             string         `db:"is_ansi"`
+	MinNumArguments    int            `db:"min_num_arguments"`
+	MaxNumArguments    int            `db:"max_num_arguments"`
+	Arguments          string         `db:"arguments"`
+	Description        string         `db:"description"`
+	CatalogName        string         `db:"catalog_name"`
+	IsTableFunction    string         `db:"is_table_function"`
+	ValidForClustering string         `db:"valid_for_clustering"`
+	IsSecure           sql.NullString `db:"is_secure"`
+	Language           string         `db:"language"`
+}
+
+type DataMetricFunction struct {
+	CreatedOn          string
+	Name               string
+	SchemaName         string
+	IsBuiltin          bool
+	IsAggregate        bool
+	IsAnsi             bool
+	MinNumArguments    int
+	MaxNumArguments    int
+	ArgumentsRaw       string
+	Description        string
+	CatalogName        string
+	IsTableFunction    bool
+	ValidForClustering bool
+	IsSecure           bool
+	Language           string
+}
+
+func (v *DataMetricFunction) ObjectType() ObjectType {
+	return ObjectTypeDataMetricFunction
+}
+
+// DescribeDataMetricFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-data-metric-function.
+type DescribeDataMetricFunctionOptions struct {
+	describe           bool                   `ddl:"static" sql:"DESCRIBE"`
+	dataMetricFunction bool                   `ddl:"static" sql:"DATA METRIC FUNCTION"`
+	name               SchemaObjectIdentifier `ddl:"identifier"`
+	Signature          string                 `ddl:"keyword,no_quotes"`
+}
+
+type dataMetricFunctionDetailRow struct {
+	Property string         `db:"property"`
+	Value    sql.NullString `db:"value"`
+}
+
+type DataMetricFunctionDetail struct {
+	Property string
+	Value    string
+}
+
+// AlterOnObjectDataMetricFunctionOptions is based on https://docs.snowflake.com/en/user-guide/data-quality-working.
+type AlterOnObjectDataMetricFunctionOptions struct {
+	alter                   bool                              `ddl:"static" sql:"ALTER"`
+	ObjectType              ObjectType                        `ddl:"keyword,no_quotes"`
+	ObjectName              SchemaObjectIdentifier            `ddl:"identifier"`
+	Add                     *DataMetricFunctionOnObject       `ddl:"keyword" sql:"ADD DATA METRIC FUNCTION"`
+	Drop                    *DataMetricFunctionOnObject       `ddl:"keyword" sql:"DROP DATA METRIC FUNCTION"`
+	Modify                  *DataMetricFunctionModifyOnObject `ddl:"keyword" sql:"MODIFY DATA METRIC FUNCTION"`
+	SetDataMetricSchedule   *string                           `ddl:"parameter,single_quotes" sql:"SET DATA_METRIC_SCHEDULE"`
+	UnsetDataMetricSchedule *bool                             `ddl:"keyword" sql:"UNSET DATA_METRIC_SCHEDULE"`
+}
+
+type DataMetricFunctionOnObject struct {
+	DataMetricFunction SchemaObjectIdentifier `ddl:"identifier"`
+	On                 []Column               `ddl:"parameter,parentheses,no_equals" sql:"ON"`
+}
+
+type DataMetricFunctionModifyOnObject struct {
+	DataMetricFunction SchemaObjectIdentifier                      `ddl:"identifier"`
+	On                 []Column                                    `ddl:"parameter,parentheses,no_equals" sql:"ON"`
+	ScheduleStatus     ViewDataMetricScheduleStatusOperationOption `ddl:"keyword,no_quotes"`
+}
@@ -0,0 +1,342 @@
+package sdk
+
+import "testing"
+
+func TestDataMetricFunctions_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	// Minimal valid CreateDataMetricFunctionOptions
+	defaultOpts := func() *CreateDataMetricFunctionOptions {
+		return &CreateDataMetricFunctionOptions{
+			name: id,
+			Arguments: []DataMetricFunctionTableArgument{
+				{
+					TableName: "arg_t",
+					Columns: []DataMetricFunctionColumn{
+						{ColumnName: "arg_c", ColumnDataType: dataTypeNumber},
+					},
+				},
+			},
+			Expression: "$$SELECT COUNT(*) FROM arg_t$$",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateDataMetricFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.Expression] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Expression = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateDataMetricFunctionOptions", "Expression"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateDataMetricFunctionOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE DATA METRIC FUNCTION %s ("arg_t" TABLE ("arg_c" NUMBER(38, 0))) RETURNS NUMBER AS $$SELECT COUNT(*) FROM arg_t$$`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Secure = Bool(true)
+		opts.Arguments[0].Columns = append(opts.Arguments[0].Columns, DataMetricFunctionColumn{ColumnName: "arg_c2", ColumnDataType: dataTypeVarchar_100})
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE SECURE DATA METRIC FUNCTION %s ("arg_t" TABLE ("arg_c" NUMBER(38, 0), "arg_c2" VARCHAR(100))) RETURNS NUMBER COMMENT = 'comment' AS $$SELECT COUNT(*) FROM arg_t$$`, id.FullyQualifiedName())
+	})
+}
+
+func TestDataMetricFunctions_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	// Minimal valid AlterDataMetricFunctionOptions
+	defaultOpts := func() *AlterDataMetricFunctionOptions {
+		return &AlterDataMetricFunctionOptions{
+			name:      id,
+			Signature: "(TABLE(NUMBER))",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterDataMetricFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		opts.Set = &DataMetricFunctionSet{Secure: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.Signature] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Signature = ""
+		opts.Set = &DataMetricFunctionSet{Secure: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("AlterDataMetricFunctionOptions", "Signature"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Set opts.Unset] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterDataMetricFunctionOptions", "RenameTo", "Set", "Unset"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Set opts.Unset] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DataMetricFunctionSet{Secure: Bool(true)}
+		opts.Unset = &DataMetricFunctionUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterDataMetricFunctionOptions", "RenameTo", "Set", "Unset"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Secure opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DataMetricFunctionSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterDataMetricFunctionOptions.Set", "Secure", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Secure opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DataMetricFunctionUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterDataMetricFunctionOptions.Unset", "Secure", "Comment"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER DATA METRIC FUNCTION IF EXISTS %s (TABLE(NUMBER)) RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DataMetricFunctionSet{
+			Secure:  Bool(true),
+			Comment: String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER DATA METRIC FUNCTION %s (TABLE(NUMBER)) SET SECURE COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DataMetricFunctionUnset{
+			Secure:  Bool(true),
+			Comment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER DATA METRIC FUNCTION %s (TABLE(NUMBER)) UNSET SECURE, COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestDataMetricFunctions_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	// Minimal valid DropDataMetricFunctionOptions
+	defaultOpts := func() *DropDataMetricFunctionOptions {
+		return &DropDataMetricFunctionOptions{
+			name:      id,
+			Signature: "(TABLE(NUMBER))",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropDataMetricFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.Signature] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Signature = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("DropDataMetricFunctionOptions", "Signature"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP DATA METRIC FUNCTION %s (TABLE(NUMBER))", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP DATA METRIC FUNCTION IF EXISTS %s (TABLE(NUMBER))", id.FullyQualifiedName())
+	})
+}
+
+func TestDataMetricFunctions_Show(t *testing.T) {
+	// Minimal valid ShowDataMetricFunctionOptions
+	defaultOpts := func() *ShowDataMetricFunctionOptions {
+		return &ShowDataMetricFunctionOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowDataMetricFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW DATA METRIC FUNCTIONS")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("pattern")}
+		opts.In = &In{Schema: schemaId}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW DATA METRIC FUNCTIONS LIKE 'pattern' IN SCHEMA %s", schemaId.FullyQualifiedName())
+	})
+}
+
+func TestDataMetricFunctions_Describe(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	// Minimal valid DescribeDataMetricFunctionOptions
+	defaultOpts := func() *DescribeDataMetricFunctionOptions {
+		return &DescribeDataMetricFunctionOptions{
+			name:      id,
+			Signature: "(TABLE(NUMBER))",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeDataMetricFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.Signature] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Signature = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("DescribeDataMetricFunctionOptions", "Signature"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE DATA METRIC FUNCTION %s (TABLE(NUMBER))", id.FullyQualifiedName())
+	})
+}
+
+func TestDataMetricFunctions_AlterOnObject(t *testing.T) {
+	objectId := randomSchemaObjectIdentifier()
+	functionId := randomSchemaObjectIdentifier()
+	// Minimal valid AlterOnObjectDataMetricFunctionOptions
+	defaultOpts := func() *AlterOnObjectDataMetricFunctionOptions {
+		return &AlterOnObjectDataMetricFunctionOptions{
+			ObjectType: ObjectTypeTable,
+			ObjectName: objectId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterOnObjectDataMetricFunctionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.ObjectName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ObjectName = emptySchemaObjectIdentifier
+		opts.UnsetDataMetricSchedule = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Add opts.Drop opts.Modify opts.SetDataMetricSchedule opts.UnsetDataMetricSchedule] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOnObjectDataMetricFunctionOptions", "Add", "Drop", "Modify", "SetDataMetricSchedule", "UnsetDataMetricSchedule"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Add opts.Drop opts.Modify opts.SetDataMetricSchedule opts.UnsetDataMetricSchedule] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetDataMetricSchedule = String("5 MINUTE")
+		opts.UnsetDataMetricSchedule = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOnObjectDataMetricFunctionOptions", "Add", "Drop", "Modify", "SetDataMetricSchedule", "UnsetDataMetricSchedule"))
+	})
+
+	t.Run("validation: valid identifier for [opts.Add.DataMetricFunction]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &DataMetricFunctionOnObject{
+			DataMetricFunction: emptySchemaObjectIdentifier,
+			On:                 []Column{{Value: "COLUMN_1"}},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.Drop.DataMetricFunction]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Drop = &DataMetricFunctionOnObject{
+			DataMetricFunction: emptySchemaObjectIdentifier,
+			On:                 []Column{{Value: "COLUMN_1"}},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.Modify.DataMetricFunction]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Modify = &DataMetricFunctionModifyOnObject{
+			DataMetricFunction: emptySchemaObjectIdentifier,
+			On:                 []Column{{Value: "COLUMN_1"}},
+			ScheduleStatus:     ViewDataMetricScheduleStatusOperationResume,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("add", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &DataMetricFunctionOnObject{
+			DataMetricFunction: functionId,
+			On:                 []Column{{Value: "COLUMN_1"}, {Value: "COLUMN_2"}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s ADD DATA METRIC FUNCTION %s ON ("COLUMN_1", "COLUMN_2")`, objectId.FullyQualifiedName(), functionId.FullyQualifiedName())
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ObjectType = ObjectTypeView
+		opts.Drop = &DataMetricFunctionOnObject{
+			DataMetricFunction: functionId,
+			On:                 []Column{{Value: "COLUMN_1"}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER VIEW %s DROP DATA METRIC FUNCTION %s ON ("COLUMN_1")`, objectId.FullyQualifiedName(), functionId.FullyQualifiedName())
+	})
+
+	t.Run("modify", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Modify = &DataMetricFunctionModifyOnObject{
+			DataMetricFunction: functionId,
+			On:                 []Column{{Value: "COLUMN_1"}},
+			ScheduleStatus:     ViewDataMetricScheduleStatusOperationSuspend,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s MODIFY DATA METRIC FUNCTION %s ON ("COLUMN_1") SUSPEND`, objectId.FullyQualifiedName(), functionId.FullyQualifiedName())
+	})
+
+	t.Run("set data metric schedule", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetDataMetricSchedule = String("5 MINUTE")
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s SET DATA_METRIC_SCHEDULE = '5 MINUTE'`, objectId.FullyQualifiedName())
+	})
+
+	t.Run("unset data metric schedule", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetDataMetricSchedule = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s UNSET DATA_METRIC_SCHEDULE`, objectId.FullyQualifiedName())
+	})
+}
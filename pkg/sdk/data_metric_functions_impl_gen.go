@@ -0,0 +1,226 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ DataMetricFunctions = (*dataMetricFunctions)(nil)
+
+type dataMetricFunctions struct {
+	client *Client
+}
+
+func (v *dataMetricFunctions) Create(ctx context.Context, request *CreateDataMetricFunctionRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *dataMetricFunctions) Alter(ctx context.Context, request *AlterDataMetricFunctionRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *dataMetricFunctions) Drop(ctx context.Context, request *DropDataMetricFunctionRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *dataMetricFunctions) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error {
+		dataMetricFunction, err := v.ShowByIDSafely(ctx, id)
+		if errors.Is(err, ErrObjectNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		signature, err := dataMetricFunction.Signature()
+		if err != nil {
+			return err
+		}
+		return v.Drop(ctx, NewDropDataMetricFunctionRequest(id, signature).WithIfExists(true))
+	}, ctx, id)
+}
+
+func (v *dataMetricFunctions) Show(ctx context.Context, request *ShowDataMetricFunctionRequest) ([]DataMetricFunction, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[dataMetricFunctionRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[dataMetricFunctionRow, DataMetricFunction](dbRows)
+	return resultList, nil
+}
+
+func (v *dataMetricFunctions) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*DataMetricFunction, error) {
+	request := NewShowDataMetricFunctionRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
+	dataMetricFunctions, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(dataMetricFunctions, func(r DataMetricFunction) bool { return r.Name == id.Name() })
+}
+
+func (v *dataMetricFunctions) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*DataMetricFunction, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *dataMetricFunctions) Describe(ctx context.Context, request *DescribeDataMetricFunctionRequest) ([]DataMetricFunctionDetail, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[dataMetricFunctionDetailRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[dataMetricFunctionDetailRow, DataMetricFunctionDetail](dbRows)
+	return resultList, nil
+}
+
+func (v *dataMetricFunctions) AlterOnObject(ctx context.Context, request *AlterOnObjectDataMetricFunctionRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (r *CreateDataMetricFunctionRequest) toOpts() *CreateDataMetricFunctionOptions {
+	opts := &CreateDataMetricFunctionOptions{
+		OrReplace:   r.OrReplace,
+		Secure:      r.Secure,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		Comment:     r.Comment,
+		Expression:  r.Expression,
+	}
+	if r.Arguments != nil {
+		s := make([]DataMetricFunctionTableArgument, len(r.Arguments))
+		for i, v := range r.Arguments {
+			s[i] = DataMetricFunctionTableArgument{
+				TableName: v.TableName,
+			}
+			if v.Columns != nil {
+				columns := make([]DataMetricFunctionColumn, len(v.Columns))
+				for j, c := range v.Columns {
+					columns[j] = DataMetricFunctionColumn{
+						ColumnName:     c.ColumnName,
+						ColumnDataType: c.ColumnDataType,
+					}
+				}
+				s[i].Columns = columns
+			}
+		}
+		opts.Arguments = s
+	}
+	return opts
+}
+
+func (r *AlterDataMetricFunctionRequest) toOpts() *AlterDataMetricFunctionOptions {
+	opts := &AlterDataMetricFunctionOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		Signature: r.Signature,
+		RenameTo:  r.RenameTo,
+	}
+	if r.Set != nil {
+		opts.Set = &DataMetricFunctionSet{
+			Secure:  r.Set.Secure,
+			Comment: r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &DataMetricFunctionUnset{
+			Secure:  r.Unset.Secure,
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropDataMetricFunctionRequest) toOpts() *DropDataMetricFunctionOptions {
+	opts := &DropDataMetricFunctionOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		Signature: r.Signature,
+	}
+	return opts
+}
+
+func (r *ShowDataMetricFunctionRequest) toOpts() *ShowDataMetricFunctionOptions {
+	opts := &ShowDataMetricFunctionOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r dataMetricFunctionRow) convert() *DataMetricFunction {
+	dataMetricFunction := &DataMetricFunction{
+		CreatedOn:          r.CreatedOn,
+		Name:               r.Name,
+		SchemaName:         r.SchemaName,
+		IsBuiltin:          r.IsBuiltin == "Y",
+		IsAggregate:        r.IsAggregate == "Y",
+		IsAnsi:             r.IsAnsi == "Y",
+		MinNumArguments:    r.MinNumArguments,
+		MaxNumArguments:    r.MaxNumArguments,
+		ArgumentsRaw:       r.Arguments,
+		Description:        r.Description,
+		CatalogName:        r.CatalogName,
+		IsTableFunction:    r.IsTableFunction == "Y",
+		ValidForClustering: r.ValidForClustering == "Y",
+		Language:           r.Language,
+	}
+	if r.IsSecure.Valid {
+		dataMetricFunction.IsSecure = r.IsSecure.String == "Y"
+	}
+	return dataMetricFunction
+}
+
+func (r *DescribeDataMetricFunctionRequest) toOpts() *DescribeDataMetricFunctionOptions {
+	opts := &DescribeDataMetricFunctionOptions{
+		name:      r.name,
+		Signature: r.Signature,
+	}
+	return opts
+}
+
+func (r dataMetricFunctionDetailRow) convert() *DataMetricFunctionDetail {
+	detail := &DataMetricFunctionDetail{
+		Property: r.Property,
+	}
+	if r.Value.Valid {
+		detail.Value = r.Value.String
+	}
+	return detail
+}
+
+func (r *AlterOnObjectDataMetricFunctionRequest) toOpts() *AlterOnObjectDataMetricFunctionOptions {
+	opts := &AlterOnObjectDataMetricFunctionOptions{
+		ObjectType:              r.ObjectType,
+		ObjectName:              r.ObjectName,
+		SetDataMetricSchedule:   r.SetDataMetricSchedule,
+		UnsetDataMetricSchedule: r.UnsetDataMetricSchedule,
+	}
+	if r.Add != nil {
+		opts.Add = &DataMetricFunctionOnObject{
+			DataMetricFunction: r.Add.DataMetricFunction,
+			On:                 r.Add.On,
+		}
+	}
+	if r.Drop != nil {
+		opts.Drop = &DataMetricFunctionOnObject{
+			DataMetricFunction: r.Drop.DataMetricFunction,
+			On:                 r.Drop.On,
+		}
+	}
+	if r.Modify != nil {
+		opts.Modify = &DataMetricFunctionModifyOnObject{
+			DataMetricFunction: r.Modify.DataMetricFunction,
+			On:                 r.Modify.On,
+			ScheduleStatus:     r.Modify.ScheduleStatus,
+		}
+	}
+	return opts
+}
@@ -0,0 +1,119 @@
+package sdk
+
+var (
+	_ validatable = new(CreateDataMetricFunctionOptions)
+	_ validatable = new(AlterDataMetricFunctionOptions)
+	_ validatable = new(DropDataMetricFunctionOptions)
+	_ validatable = new(ShowDataMetricFunctionOptions)
+	_ validatable = new(DescribeDataMetricFunctionOptions)
+	_ validatable = new(AlterOnObjectDataMetricFunctionOptions)
+)
+
+func (opts *CreateDataMetricFunctionOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.Expression) {
+		errs = append(errs, errNotSet("CreateDataMetricFunctionOptions", "Expression"))
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateDataMetricFunctionOptions", "OrReplace", "IfNotExists"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterDataMetricFunctionOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.Signature) {
+		errs = append(errs, errNotSet("AlterDataMetricFunctionOptions", "Signature"))
+	}
+	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterDataMetricFunctionOptions", "RenameTo", "Set", "Unset"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.Secure, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterDataMetricFunctionOptions.Set", "Secure", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Secure, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterDataMetricFunctionOptions.Unset", "Secure", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropDataMetricFunctionOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.Signature) {
+		errs = append(errs, errNotSet("DropDataMetricFunctionOptions", "Signature"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowDataMetricFunctionOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeDataMetricFunctionOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.Signature) {
+		errs = append(errs, errNotSet("DescribeDataMetricFunctionOptions", "Signature"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterOnObjectDataMetricFunctionOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.ObjectName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Add, opts.Drop, opts.Modify, opts.SetDataMetricSchedule, opts.UnsetDataMetricSchedule) {
+		errs = append(errs, errExactlyOneOf("AlterOnObjectDataMetricFunctionOptions", "Add", "Drop", "Modify", "SetDataMetricSchedule", "UnsetDataMetricSchedule"))
+	}
+	if valueSet(opts.Add) {
+		if !ValidObjectIdentifier(opts.Add.DataMetricFunction) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+	}
+	if valueSet(opts.Drop) {
+		if !ValidObjectIdentifier(opts.Drop.DataMetricFunction) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+	}
+	if valueSet(opts.Modify) {
+		if !ValidObjectIdentifier(opts.Modify.DataMetricFunction) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+	}
+	return JoinErrors(errs...)
+}
@@ -1,14 +1,17 @@
 package sdk
 
 import (
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"log"
 	"regexp"
 	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/snowflakedb/gosnowflake"
 )
 
 var (
@@ -114,6 +117,51 @@ func decodeDriverError(err error) error {
 	return err
 }
 
+// retryableErrorCodes are the Snowflake error codes of the transient failures.
+var retryableErrorCodes = []int{
+	390114, // Authentication token has expired. The user must authenticate again.
+	390112, // Your session has expired. Please login again.
+}
+
+// retryableErrorRegexes match the messages of the transient failures that have no dedicated error code.
+var retryableErrorRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)warehouse .* (is|was) (resuming|suspending|resizing)`),
+	regexp.MustCompile(`(?i)has locked table .* and this lock has not yet been released`),
+	regexp.MustCompile(`(?i)number of waiters for this lock exceeds`),
+	regexp.MustCompile(`(?i)concurrent (alter|modification|update)`),
+	regexp.MustCompile(`(?i)connection reset by peer`),
+	regexp.MustCompile(`(?i)service unavailable|too many requests`),
+}
+
+// IsRetryableError returns true if the error (decoded by decodeDriverError) is a transient failure, after which the
+// idempotent statement can be run again: expired session tokens, warehouses that are resuming, lock conflicts with
+// concurrent statements, and broken connections. The objects that do not exist are not treated as transient failures
+// here (see isNotFoundError), because in most cases the object was dropped, and retrying would only delay the error.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	var snowflakeErr *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeErr) && slices.Contains(retryableErrorCodes, snowflakeErr.Number) {
+		return true
+	}
+	for _, regex := range retryableErrorRegexes {
+		if regex.MatchString(err.Error()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNotFoundError returns true if the error (decoded by decodeDriverError) means that the object does not exist or
+// is not visible to the current role.
+func isNotFoundError(err error) bool {
+	return errors.Is(err, ErrObjectNotExistOrAuthorized) || errors.Is(err, ErrDoesNotExistOrOperationCannotBePerformed)
+}
+
 const errorIndentRune = '›'
 
 var errorFileInfoRegexp = regexp.MustCompile(`\[\w+\.\w+:\d+\] `)
@@ -106,14 +106,16 @@ var EventTablesDef = g.NewInterface(
 	g.ShowByIDInFiltering,
 	g.ShowByIDLikeFiltering,
 ).DescribeOperation(
-	g.DescriptionMappingKindSingleValue,
+	g.DescriptionMappingKindSlice,
 	"https://docs.snowflake.com/en/sql-reference/sql/desc-event-table",
 	g.DbStruct("eventTableDetailsRow").
 		Field("name", "string").
+		Field("type", "string").
 		Field("kind", "string").
 		Field("comment", "string"),
 	g.PlainStruct("EventTableDetails").
 		Field("Name", "string").
+		Field("Type", "string").
 		Field("Kind", "string").
 		Field("Comment", "string"),
 	g.NewQueryStruct("DescribeEventTable").
@@ -11,10 +11,12 @@ type EventTables interface {
 	Show(ctx context.Context, request *ShowEventTableRequest) ([]EventTable, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*EventTable, error)
 	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*EventTable, error)
-	Describe(ctx context.Context, id SchemaObjectIdentifier) (*EventTableDetails, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]EventTableDetails, error)
 	Drop(ctx context.Context, request *DropEventTableRequest) error
 	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
 	Alter(ctx context.Context, request *AlterEventTableRequest) error
+
+	ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error)
 }
 
 // CreateEventTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-event-table.
@@ -79,12 +81,14 @@ type DescribeEventTableOptions struct {
 
 type eventTableDetailsRow struct {
 	Name    string `db:"name"`
+	Type    string `db:"type"`
 	Kind    string `db:"kind"`
 	Comment string `db:"comment"`
 }
 
 type EventTableDetails struct {
 	Name    string
+	Type    string
 	Kind    string
 	Comment string
 }
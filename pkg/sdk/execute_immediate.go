@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+)
+
+var _ ExecuteImmediate = (*executeImmediate)(nil)
+
+var _ validatable = new(ExecuteImmediateFromOptions)
+
+type ExecuteImmediate interface {
+	// From executes the SQL script from the given stage location (e.g. @repo/branches/main/script.sql).
+	From(ctx context.Context, location Location, opts *ExecuteImmediateFromOptions) error
+}
+
+// executeImmediate implements ExecuteImmediate.
+type executeImmediate struct {
+	client *Client
+}
+
+// ExecuteImmediateFromOptions is based on https://docs.snowflake.com/en/sql-reference/sql/execute-immediate-from.
+type ExecuteImmediateFromOptions struct {
+	executeImmediateFrom bool                            `ddl:"static" sql:"EXECUTE IMMEDIATE FROM"`
+	location             Location                        `ddl:"parameter,no_quotes,no_equals"`
+	Using                []ExecuteImmediateUsingArgument `ddl:"keyword,parentheses" sql:"USING"`
+	DryRun               *bool                           `ddl:"parameter" sql:"DRY_RUN"`
+}
+
+// ExecuteImmediateUsingArgument is a variable passed to the Jinja2 template of the executed file.
+type ExecuteImmediateUsingArgument struct {
+	Key   string `ddl:"keyword,no_quotes"`
+	arrow bool   `ddl:"static" sql:"=>"`
+	Value string `ddl:"keyword,single_quotes"`
+}
+
+func (opts *ExecuteImmediateFromOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if opts.location == nil || opts.location.ToSql() == "" {
+		errs = append(errs, errNotSet("ExecuteImmediateFromOptions", "location"))
+	}
+	for _, argument := range opts.Using {
+		if argument.Key == "" {
+			errs = append(errs, errNotSet("ExecuteImmediateUsingArgument", "Key"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (v *executeImmediate) From(ctx context.Context, location Location, opts *ExecuteImmediateFromOptions) error {
+	if opts == nil {
+		opts = &ExecuteImmediateFromOptions{}
+	}
+	opts.location = location
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
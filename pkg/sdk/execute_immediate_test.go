@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestExecuteImmediateFrom(t *testing.T) {
+	repositoryId := NewSchemaObjectIdentifier("db", "schema", "repo")
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ExecuteImmediateFromOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: location not set", func(t *testing.T) {
+		opts := &ExecuteImmediateFromOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("ExecuteImmediateFromOptions", "location"))
+	})
+
+	t.Run("validation: using key not set", func(t *testing.T) {
+		opts := &ExecuteImmediateFromOptions{
+			location: NewStageLocation(repositoryId, "branches/main/script.sql"),
+			Using:    []ExecuteImmediateUsingArgument{{Value: "prod"}},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("ExecuteImmediateUsingArgument", "Key"))
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &ExecuteImmediateFromOptions{
+			location: NewStageLocation(repositoryId, "branches/main/script.sql"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `EXECUTE IMMEDIATE FROM @"db"."schema"."repo"/branches/main/script.sql`)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &ExecuteImmediateFromOptions{
+			location: NewStageLocation(repositoryId, "commits/abc123/script.sql"),
+			Using: []ExecuteImmediateUsingArgument{
+				{Key: "environment", Value: "prod"},
+				{Key: "retention_days", Value: "7"},
+			},
+			DryRun: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `EXECUTE IMMEDIATE FROM @"db"."schema"."repo"/commits/abc123/script.sql USING (environment => 'prod', retention_days => '7') DRY_RUN = true`)
+	})
+}
@@ -0,0 +1,114 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+// TODO [SNOW-1016561]: all integrations reuse almost the same show, drop, and describe. For now we are copying it. Consider reusing in linked issue.
+var ExternalAccessIntegrationsDef = g.NewInterface(
+	"ExternalAccessIntegrations",
+	"ExternalAccessIntegration",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration",
+		g.NewQueryStruct("CreateExternalAccessIntegration").
+			Create().
+			OrReplace().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfNotExists().
+			Name().
+			ListAssignment("ALLOWED_NETWORK_RULES", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses().Required()).
+			ListAssignment("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+			ListAssignment("ALLOWED_AUTHENTICATION_SECRETS", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+			BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-external-access-integration",
+		g.NewQueryStruct("AlterExternalAccessIntegration").
+			Alter().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("ExternalAccessIntegrationSet").
+					ListAssignment("ALLOWED_NETWORK_RULES", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+					ListAssignment("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+					ListAssignment("ALLOWED_AUTHENTICATION_SECRETS", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+					OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "AllowedNetworkRules", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Enabled", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("ExternalAccessIntegrationUnset").
+					OptionalSQL("ALLOWED_API_AUTHENTICATION_INTEGRATIONS").
+					OptionalSQL("ALLOWED_AUTHENTICATION_SECRETS").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "IfExists", "SetTags").
+			WithValidation(g.ConflictingFields, "IfExists", "UnsetTags").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-integration",
+		g.NewQueryStruct("DropExternalAccessIntegration").
+			Drop().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-external-access-integrations",
+		g.DbStruct("showExternalAccessIntegrationsDbRow").
+			Text("name").
+			Text("type").
+			Text("category").
+			Bool("enabled").
+			OptionalText("comment").
+			Time("created_on"),
+		g.PlainStruct("ExternalAccessIntegration").
+			Text("Name").
+			Text("Type").
+			Text("Category").
+			Bool("Enabled").
+			Text("Comment").
+			Time("CreatedOn"),
+		g.NewQueryStruct("ShowExternalAccessIntegrations").
+			Show().
+			SQL("EXTERNAL ACCESS INTEGRATIONS").
+			OptionalLike(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-integration",
+		g.DbStruct("descExternalAccessIntegrationsDbRow").
+			Text("property").
+			Text("property_type").
+			Text("property_value").
+			Text("property_default"),
+		g.PlainStruct("ExternalAccessIntegrationProperty").
+			Text("Name").
+			Text("Type").
+			Text("Value").
+			Text("Default"),
+		g.NewQueryStruct("DescribeExternalAccessIntegration").
+			Describe().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateExternalAccessIntegrationRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
@@ -47,6 +47,7 @@ var validGrantOwnershipObjectTypes = []ObjectType{
 	ObjectTypeMaskingPolicy,
 	ObjectTypePasswordPolicy,
 	ObjectTypeProjectionPolicy,
+	ObjectTypeJoinPolicy,
 	ObjectTypeReplicationGroup,
 	ObjectTypeResourceMonitor,
 	ObjectTypeRole,
@@ -91,6 +92,7 @@ var validGrantToObjectTypes = []ObjectType{
 	ObjectTypePipe,
 	ObjectTypeProcedure,
 	ObjectTypeProjectionPolicy,
+	ObjectTypeJoinPolicy,
 	ObjectTypeRowAccessPolicy,
 	ObjectTypeSecret,
 	ObjectTypeService,
@@ -116,6 +118,7 @@ var invalidGrantToFutureObjectTypes = []ObjectType{
 	ObjectTypeMaskingPolicy,
 	ObjectTypePackagesPolicy,
 	ObjectTypeProjectionPolicy,
+	ObjectTypeJoinPolicy,
 	ObjectTypeRowAccessPolicy,
 	ObjectTypeSessionPolicy,
 	ObjectTypeTag,
@@ -0,0 +1,213 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var hybridTableColumn = g.NewQueryStruct("HybridTableColumn").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("DataType", "datatypes.DataType", g.ParameterOptions().NoQuotes().NoEquals().Required()).
+	OptionalSQL("NOT NULL").
+	OptionalTextAssignment("DEFAULT", g.ParameterOptions().NoEquals()).
+	OptionalTextAssignment("COLLATE", g.ParameterOptions().SingleQuotes().NoEquals()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals())
+
+var hybridTableForeignKey = g.NewQueryStruct("HybridTableForeignKey").
+	Identifier("TableName", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("REFERENCES").Required()).
+	List("Columns", "Column", g.ListOptions().Parentheses())
+
+var hybridTableOutOfLineConstraint = g.NewQueryStruct("HybridTableOutOfLineConstraint").
+	OptionalTextAssignment("CONSTRAINT", g.ParameterOptions().DoubleQuotes().NoEquals()).
+	PredefinedQueryStructField("Type", "ColumnConstraintType", g.KeywordOptions().Required()).
+	List("Columns", "Column", g.ListOptions().Parentheses().Required()).
+	OptionalQueryStructField("ForeignKey", hybridTableForeignKey, g.KeywordOptions())
+
+var hybridTableOutOfLineIndex = g.NewQueryStruct("HybridTableOutOfLineIndex").
+	SQL("INDEX").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	List("Columns", "Column", g.ListOptions().Parentheses().Required()).
+	ListAssignment("INCLUDE", "Column", g.ParameterOptions().NoEquals().Parentheses())
+
+var hybridTableColumnsConstraintsAndIndexes = g.NewQueryStruct("HybridTableColumnsConstraintsAndIndexes").
+	ListQueryStructField("Columns", hybridTableColumn, g.KeywordOptions().Required()).
+	ListQueryStructField("OutOfLineConstraints", hybridTableOutOfLineConstraint, g.ListOptions().NoParentheses()).
+	ListQueryStructField("OutOfLineIndexes", hybridTableOutOfLineIndex, g.ListOptions().NoParentheses())
+
+var hybridTableSet = g.NewQueryStruct("HybridTableSet").
+	OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "Comment")
+
+var hybridTableUnset = g.NewQueryStruct("HybridTableUnset").
+	OptionalSQL("DATA_RETENTION_TIME_IN_DAYS").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "Comment")
+
+var HybridTablesDef = g.NewInterface(
+	"HybridTables",
+	"HybridTable",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table",
+		g.NewQueryStruct("CreateHybridTable").
+			Create().
+			OrReplace().
+			SQL("HYBRID TABLE").
+			IfNotExists().
+			Name().
+			QueryStructField("ColumnsAndConstraints", hybridTableColumnsConstraintsAndIndexes, g.ListOptions().Parentheses().Required()).
+			OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions()).
+			OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-table",
+		g.NewQueryStruct("AlterHybridTable").
+			Alter().
+			SQL("TABLE").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalQueryStructField(
+				"Set",
+				hybridTableSet,
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				hybridTableUnset,
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-table",
+		g.NewQueryStruct("DropHybridTable").
+			Drop().
+			SQL("TABLE").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-hybrid-tables",
+		g.DbStruct("hybridTableRow").
+			Field("created_on", "time.Time").
+			Field("name", "string").
+			Field("database_name", "string").
+			Field("schema_name", "string").
+			Field("owner", "sql.NullString").
+			Field("rows", "sql.NullInt64").
+			Field("bytes", "sql.NullInt64").
+			Field("comment", "sql.NullString").
+			Field("owner_role_type", "sql.NullString"),
+		g.PlainStruct("HybridTable").
+			Field("CreatedOn", "time.Time").
+			Field("Name", "string").
+			Field("DatabaseName", "string").
+			Field("SchemaName", "string").
+			Field("Owner", "string").
+			Field("Rows", "int").
+			Field("Bytes", "int").
+			Field("Comment", "string").
+			Field("OwnerRoleType", "string"),
+		g.NewQueryStruct("ShowHybridTables").
+			Show().
+			SQL("HYBRID TABLES").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimit(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-table",
+		g.DbStruct("hybridTableDetailsRow").
+			Field("name", "string").
+			Field("type", "string").
+			Field("kind", "string").
+			Field("null", "string").
+			Field("default", "sql.NullString").
+			Field("primary_key", "string").
+			Field("unique_key", "string").
+			Field("comment", "sql.NullString"),
+		g.PlainStruct("HybridTableDetails").
+			Field("Name", "string").
+			Field("Type", "string").
+			Field("Kind", "string").
+			Field("IsNullable", "bool").
+			Field("Default", "string").
+			Field("PrimaryKey", "bool").
+			Field("UniqueKey", "bool").
+			Field("Comment", "string"),
+		g.NewQueryStruct("DescribeHybridTable").
+			Describe().
+			SQL("TABLE").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	CustomOperation(
+		"CreateIndex",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-index",
+		g.NewQueryStruct("CreateHybridTableIndex").
+			Create().
+			OrReplace().
+			SQL("INDEX").
+			IfNotExists().
+			Text("IndexName", g.KeywordOptions().DoubleQuotes().Required()).
+			Identifier("TableName", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("ON").Required()).
+			List("Columns", "Column", g.ListOptions().Parentheses().Required()).
+			ListAssignment("INCLUDE", "Column", g.ParameterOptions().NoEquals().Parentheses()).
+			WithValidation(g.ValidIdentifier, "TableName").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	CustomOperation(
+		"DropIndex",
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-index",
+		g.NewQueryStruct("DropHybridTableIndex").
+			Drop().
+			SQL("INDEX").
+			IfExists().
+			Identifier("Index", g.KindOfT[TableColumnIdentifier](), g.IdentifierOptions().Required()).
+			WithValidation(g.ValidIdentifier, "Index"),
+	).
+	CustomShowOperation(
+		"ShowIndexes",
+		g.ShowMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/show-indexes",
+		g.DbStruct("hybridTableIndexRow").
+			Field("created_on", "time.Time").
+			Field("name", "string").
+			Field("is_unique", "sql.NullString").
+			Field("columns", "string").
+			Field("included_columns", "sql.NullString").
+			Field("table", "string").
+			Field("database_name", "string").
+			Field("schema_name", "string").
+			Field("owner", "sql.NullString").
+			Field("owner_role_type", "sql.NullString"),
+		g.PlainStruct("HybridTableIndex").
+			Field("CreatedOn", "time.Time").
+			Field("Name", "string").
+			Field("IsUnique", "bool").
+			Field("Columns", "[]string").
+			Field("IncludedColumns", "[]string").
+			Field("Table", "string").
+			Field("DatabaseName", "string").
+			Field("SchemaName", "string").
+			Field("Owner", "string").
+			Field("OwnerRoleType", "string"),
+		g.NewQueryStruct("ShowHybridTableIndexes").
+			Show().
+			SQL("INDEXES").
+			OptionalLike().
+			Identifier("In", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("IN TABLE")),
+	)
@@ -0,0 +1,111 @@
+package sdk
+
+import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateHybridTableOptions]      = new(CreateHybridTableRequest)
+	_ optionsProvider[AlterHybridTableOptions]       = new(AlterHybridTableRequest)
+	_ optionsProvider[DropHybridTableOptions]        = new(DropHybridTableRequest)
+	_ optionsProvider[ShowHybridTableOptions]        = new(ShowHybridTableRequest)
+	_ optionsProvider[DescribeHybridTableOptions]    = new(DescribeHybridTableRequest)
+	_ optionsProvider[CreateIndexHybridTableOptions] = new(CreateIndexHybridTableRequest)
+	_ optionsProvider[DropIndexHybridTableOptions]   = new(DropIndexHybridTableRequest)
+	_ optionsProvider[ShowIndexesHybridTableOptions] = new(ShowIndexesHybridTableRequest)
+)
+
+type CreateHybridTableRequest struct {
+	OrReplace               *bool
+	IfNotExists             *bool
+	name                    SchemaObjectIdentifier                         // required
+	ColumnsAndConstraints   HybridTableColumnsConstraintsAndIndexesRequest // required
+	DataRetentionTimeInDays *int
+	Comment                 *string
+}
+
+type HybridTableColumnsConstraintsAndIndexesRequest struct {
+	Columns              []HybridTableColumnRequest // required
+	OutOfLineConstraints []HybridTableOutOfLineConstraintRequest
+	OutOfLineIndexes     []HybridTableOutOfLineIndexRequest
+}
+
+type HybridTableColumnRequest struct {
+	Name     string             // required
+	DataType datatypes.DataType // required
+	NotNull  *bool
+	Default  *string
+	Collate  *string
+	Comment  *string
+}
+
+type HybridTableOutOfLineConstraintRequest struct {
+	Constraint *string
+	Type       ColumnConstraintType // required
+	Columns    []Column             // required
+	ForeignKey *HybridTableForeignKeyRequest
+}
+
+type HybridTableForeignKeyRequest struct {
+	TableName SchemaObjectIdentifier // required
+	Columns   []Column
+}
+
+type HybridTableOutOfLineIndexRequest struct {
+	Name    string   // required
+	Columns []Column // required
+	Include []Column
+}
+
+type AlterHybridTableRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+	RenameTo *SchemaObjectIdentifier
+	Set      *HybridTableSetRequest
+	Unset    *HybridTableUnsetRequest
+}
+
+type HybridTableSetRequest struct {
+	DataRetentionTimeInDays *int
+	Comment                 *string
+}
+
+type HybridTableUnsetRequest struct {
+	DataRetentionTimeInDays *bool
+	Comment                 *bool
+}
+
+type DropHybridTableRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowHybridTableRequest struct {
+	Like       *Like
+	In         *In
+	StartsWith *string
+	Limit      *LimitFrom
+}
+
+type DescribeHybridTableRequest struct {
+	name SchemaObjectIdentifier // required
+}
+
+type CreateIndexHybridTableRequest struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	IndexName   string                 // required
+	TableName   SchemaObjectIdentifier // required
+	Columns     []Column               // required
+	Include     []Column
+}
+
+type DropIndexHybridTableRequest struct {
+	IfExists *bool
+	Index    TableColumnIdentifier // required
+}
+
+type ShowIndexesHybridTableRequest struct {
+	Like *Like
+	In   *SchemaObjectIdentifier
+}
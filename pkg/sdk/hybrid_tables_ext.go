@@ -0,0 +1,9 @@
+package sdk
+
+func (r *CreateHybridTableRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+func (v *HybridTableIndex) ID() TableColumnIdentifier {
+	return NewTableColumnIdentifier(v.DatabaseName, v.SchemaName, v.Table, v.Name)
+}
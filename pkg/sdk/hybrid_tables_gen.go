@@ -0,0 +1,223 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+)
+
+type HybridTables interface {
+	Create(ctx context.Context, request *CreateHybridTableRequest) error
+	Alter(ctx context.Context, request *AlterHybridTableRequest) error
+	Drop(ctx context.Context, request *DropHybridTableRequest) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowHybridTableRequest) ([]HybridTable, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*HybridTable, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*HybridTable, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]HybridTableDetails, error)
+	CreateIndex(ctx context.Context, request *CreateIndexHybridTableRequest) error
+	DropIndex(ctx context.Context, request *DropIndexHybridTableRequest) error
+	ShowIndexes(ctx context.Context, request *ShowIndexesHybridTableRequest) ([]HybridTableIndex, error)
+}
+
+// CreateHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table.
+type CreateHybridTableOptions struct {
+	create                  bool                                    `ddl:"static" sql:"CREATE"`
+	OrReplace               *bool                                   `ddl:"keyword" sql:"OR REPLACE"`
+	hybridTable             bool                                    `ddl:"static" sql:"HYBRID TABLE"`
+	IfNotExists             *bool                                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                    SchemaObjectIdentifier                  `ddl:"identifier"`
+	ColumnsAndConstraints   HybridTableColumnsConstraintsAndIndexes `ddl:"list,parentheses"`
+	DataRetentionTimeInDays *int                                    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	Comment                 *string                                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type HybridTableColumnsConstraintsAndIndexes struct {
+	Columns              []HybridTableColumn              `ddl:"keyword"`
+	OutOfLineConstraints []HybridTableOutOfLineConstraint `ddl:"list,no_parentheses"`
+	OutOfLineIndexes     []HybridTableOutOfLineIndex      `ddl:"list,no_parentheses"`
+}
+
+type HybridTableColumn struct {
+	Name     string             `ddl:"keyword,double_quotes"`
+	DataType datatypes.DataType `ddl:"parameter,no_quotes,no_equals"`
+	NotNull  *bool              `ddl:"keyword" sql:"NOT NULL"`
+	Default  *string            `ddl:"parameter,no_equals" sql:"DEFAULT"`
+	Collate  *string            `ddl:"parameter,single_quotes,no_equals" sql:"COLLATE"`
+	Comment  *string            `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+}
+
+type HybridTableOutOfLineConstraint struct {
+	Constraint *string                `ddl:"parameter,double_quotes,no_equals" sql:"CONSTRAINT"`
+	Type       ColumnConstraintType   `ddl:"keyword"`
+	Columns    []Column               `ddl:"list,parentheses"`
+	ForeignKey *HybridTableForeignKey `ddl:"keyword"`
+}
+
+type HybridTableForeignKey struct {
+	TableName SchemaObjectIdentifier `ddl:"identifier" sql:"REFERENCES"`
+	Columns   []Column               `ddl:"list,parentheses"`
+}
+
+type HybridTableOutOfLineIndex struct {
+	index   bool     `ddl:"static" sql:"INDEX"`
+	Name    string   `ddl:"keyword,double_quotes"`
+	Columns []Column `ddl:"list,parentheses"`
+	Include []Column `ddl:"parameter,parentheses,no_equals" sql:"INCLUDE"`
+}
+
+// AlterHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-table.
+type AlterHybridTableOptions struct {
+	alter    bool                    `ddl:"static" sql:"ALTER"`
+	table    bool                    `ddl:"static" sql:"TABLE"`
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier  `ddl:"identifier"`
+	RenameTo *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set      *HybridTableSet         `ddl:"keyword" sql:"SET"`
+	Unset    *HybridTableUnset       `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+type HybridTableSet struct {
+	DataRetentionTimeInDays *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	Comment                 *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type HybridTableUnset struct {
+	DataRetentionTimeInDays *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	Comment                 *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-table.
+type DropHybridTableOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`
+	table    bool                   `ddl:"static" sql:"TABLE"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-hybrid-tables.
+type ShowHybridTableOptions struct {
+	show         bool       `ddl:"static" sql:"SHOW"`
+	hybridTables bool       `ddl:"static" sql:"HYBRID TABLES"`
+	Like         *Like      `ddl:"keyword" sql:"LIKE"`
+	In           *In        `ddl:"keyword" sql:"IN"`
+	StartsWith   *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit        *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type hybridTableRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Owner         sql.NullString `db:"owner"`
+	Rows          sql.NullInt64  `db:"rows"`
+	Bytes         sql.NullInt64  `db:"bytes"`
+	Comment       sql.NullString `db:"comment"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+type HybridTable struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Owner         string
+	Rows          int
+	Bytes         int
+	Comment       string
+	OwnerRoleType string
+}
+
+func (v *HybridTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *HybridTable) ObjectType() ObjectType {
+	return ObjectTypeHybridTable
+}
+
+// DescribeHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-table.
+type DescribeHybridTableOptions struct {
+	describe bool                   `ddl:"static" sql:"DESCRIBE"`
+	table    bool                   `ddl:"static" sql:"TABLE"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type hybridTableDetailsRow struct {
+	Name       string         `db:"name"`
+	Type       string         `db:"type"`
+	Kind       string         `db:"kind"`
+	Null       string         `db:"null?"`
+	Default    sql.NullString `db:"default"`
+	PrimaryKey string         `db:"primary key"`
+	UniqueKey  string         `db:"unique key"`
+	Comment    sql.NullString `db:"comment"`
+}
+
+type HybridTableDetails struct {
+	Name       string
+	Type       string
+	Kind       string
+	IsNullable bool
+	Default    string
+	PrimaryKey bool
+	UniqueKey  bool
+	Comment    string
+}
+
+// CreateIndexHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-index.
+type CreateIndexHybridTableOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	index       bool                   `ddl:"static" sql:"INDEX"`
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	IndexName   string                 `ddl:"keyword,double_quotes"`
+	TableName   SchemaObjectIdentifier `ddl:"identifier" sql:"ON"`
+	Columns     []Column               `ddl:"list,parentheses"`
+	Include     []Column               `ddl:"parameter,parentheses,no_equals" sql:"INCLUDE"`
+}
+
+// DropIndexHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-index.
+type DropIndexHybridTableOptions struct {
+	drop     bool                  `ddl:"static" sql:"DROP"`
+	index    bool                  `ddl:"static" sql:"INDEX"`
+	IfExists *bool                 `ddl:"keyword" sql:"IF EXISTS"`
+	Index    TableColumnIdentifier `ddl:"identifier"`
+}
+
+// ShowIndexesHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-indexes.
+type ShowIndexesHybridTableOptions struct {
+	show    bool                    `ddl:"static" sql:"SHOW"`
+	indexes bool                    `ddl:"static" sql:"INDEXES"`
+	Like    *Like                   `ddl:"keyword" sql:"LIKE"`
+	In      *SchemaObjectIdentifier `ddl:"identifier" sql:"IN TABLE"`
+}
+
+type hybridTableIndexRow struct {
+	CreatedOn       time.Time      `db:"created_on"`
+	Name            string         `db:"name"`
+	IsUnique        sql.NullString `db:"is_unique"`
+	Columns         string         `db:"columns"`
+	IncludedColumns sql.NullString `db:"included_columns"`
+	Table           string         `db:"table"`
+	DatabaseName    string         `db:"database_name"`
+	SchemaName      string         `db:"schema_name"`
+	Owner           sql.NullString `db:"owner"`
+	OwnerRoleType   sql.NullString `db:"owner_role_type"`
+}
+
+type HybridTableIndex struct {
+	CreatedOn       time.Time
+	Name            string
+	IsUnique        bool
+	Columns         []string
+	IncludedColumns []string
+	Table           string
+	DatabaseName    string
+	SchemaName      string
+	Owner           string
+	OwnerRoleType   string
+}
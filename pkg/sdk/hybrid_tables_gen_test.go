@@ -0,0 +1,396 @@
+package sdk
+
+import "testing"
+
+func TestHybridTables_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateHybridTableOptions
+	defaultOpts := func() *CreateHybridTableOptions {
+		return &CreateHybridTableOptions{
+			name: id,
+			ColumnsAndConstraints: HybridTableColumnsConstraintsAndIndexes{
+				Columns: []HybridTableColumn{
+					{Name: "ID", DataType: dataTypeNumber},
+				},
+				OutOfLineConstraints: []HybridTableOutOfLineConstraint{
+					{Type: ColumnConstraintTypePrimaryKey, Columns: []Column{{Value: "ID"}}},
+				},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateHybridTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: [opts.ColumnsAndConstraints.Columns] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ColumnsAndConstraints.Columns = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateHybridTableOptions", "Columns"))
+	})
+
+	t.Run("validation: primary key should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ColumnsAndConstraints.OutOfLineConstraints = []HybridTableOutOfLineConstraint{
+			{Type: ColumnConstraintTypeUnique, Columns: []Column{{Value: "ID"}}},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateHybridTableOptions", "PrimaryKey"))
+	})
+
+	t.Run("validation: valid identifier for foreign key table", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ColumnsAndConstraints.OutOfLineConstraints = append(opts.ColumnsAndConstraints.OutOfLineConstraints, HybridTableOutOfLineConstraint{
+			Type:       ColumnConstraintTypeForeignKey,
+			Columns:    []Column{{Value: "ID"}},
+			ForeignKey: &HybridTableForeignKey{TableName: emptySchemaObjectIdentifier},
+		})
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("HybridTableForeignKey", "TableName"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE HYBRID TABLE %s ("ID" NUMBER(38, 0), PRIMARY KEY ("ID"))`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		referencedTableId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.ColumnsAndConstraints = HybridTableColumnsConstraintsAndIndexes{
+			Columns: []HybridTableColumn{
+				{Name: "ID", DataType: dataTypeNumber, NotNull: Bool(true)},
+				{Name: "CUSTOMER_ID", DataType: dataTypeNumber, Comment: String("column comment")},
+				{Name: "NAME", DataType: dataTypeVarchar, Default: String("'unknown'"), Collate: String("en-ci")},
+			},
+			OutOfLineConstraints: []HybridTableOutOfLineConstraint{
+				{Constraint: String("PK"), Type: ColumnConstraintTypePrimaryKey, Columns: []Column{{Value: "ID"}}},
+				{Type: ColumnConstraintTypeUnique, Columns: []Column{{Value: "NAME"}}},
+				{
+					Constraint: String("FK"),
+					Type:       ColumnConstraintTypeForeignKey,
+					Columns:    []Column{{Value: "CUSTOMER_ID"}},
+					ForeignKey: &HybridTableForeignKey{TableName: referencedTableId, Columns: []Column{{Value: "ID"}}},
+				},
+			},
+			OutOfLineIndexes: []HybridTableOutOfLineIndex{
+				{Name: "IDX_CUSTOMER", Columns: []Column{{Value: "CUSTOMER_ID"}}, Include: []Column{{Value: "NAME"}}},
+				{Name: "IDX_NAME", Columns: []Column{{Value: "NAME"}, {Value: "ID"}}},
+			},
+		}
+		opts.DataRetentionTimeInDays = Int(1)
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE HYBRID TABLE %s`+
+			` ("ID" NUMBER(38, 0) NOT NULL, "CUSTOMER_ID" NUMBER(38, 0) COMMENT 'column comment', "NAME" VARCHAR(16777216) DEFAULT 'unknown' COLLATE 'en-ci',`+
+			` CONSTRAINT "PK" PRIMARY KEY ("ID"), UNIQUE ("NAME"), CONSTRAINT "FK" FOREIGN KEY ("CUSTOMER_ID") REFERENCES %s ("ID"),`+
+			` INDEX "IDX_CUSTOMER" ("CUSTOMER_ID") INCLUDE ("NAME"), INDEX "IDX_NAME" ("NAME", "ID"))`+
+			` DATA_RETENTION_TIME_IN_DAYS = 1 COMMENT = 'comment'`, id.FullyQualifiedName(), referencedTableId.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterHybridTableOptions
+	defaultOpts := func() *AlterHybridTableOptions {
+		return &AlterHybridTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		opts.Set = &HybridTableSet{Comment: String("comment")}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RenameTo = &emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Set opts.Unset] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterHybridTableOptions", "RenameTo", "Set", "Unset"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Set opts.Unset] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &HybridTableSet{Comment: String("comment")}
+		opts.Unset = &HybridTableUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterHybridTableOptions", "RenameTo", "Set", "Unset"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.DataRetentionTimeInDays opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &HybridTableSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterHybridTableOptions.Set", "DataRetentionTimeInDays", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.DataRetentionTimeInDays opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &HybridTableUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterHybridTableOptions.Unset", "DataRetentionTimeInDays", "Comment"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &HybridTableSet{
+			DataRetentionTimeInDays: Int(1),
+			Comment:                 String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s SET DATA_RETENTION_TIME_IN_DAYS = 1 COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &HybridTableUnset{
+			DataRetentionTimeInDays: Bool(true),
+			Comment:                 Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s UNSET DATA_RETENTION_TIME_IN_DAYS, COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropHybridTableOptions
+	defaultOpts := func() *DropHybridTableOptions {
+		return &DropHybridTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP TABLE %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP TABLE IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_Show(t *testing.T) {
+	// Minimal valid ShowHybridTableOptions
+	defaultOpts := func() *ShowHybridTableOptions {
+		return &ShowHybridTableOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW HYBRID TABLES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		schemaId := randomDatabaseObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &In{
+			Schema: schemaId,
+		}
+		opts.StartsWith = String("abc")
+		opts.Limit = &LimitFrom{
+			Rows: Int(10),
+			From: String("foo"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW HYBRID TABLES LIKE 'pattern' IN SCHEMA %s STARTS WITH 'abc' LIMIT 10 FROM 'foo'", schemaId.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_Describe(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeHybridTableOptions
+	defaultOpts := func() *DescribeHybridTableOptions {
+		return &DescribeHybridTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE TABLE %s", id.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_CreateIndex(t *testing.T) {
+	tableId := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateIndexHybridTableOptions
+	defaultOpts := func() *CreateIndexHybridTableOptions {
+		return &CreateIndexHybridTableOptions{
+			IndexName: "IDX",
+			TableName: tableId,
+			Columns:   []Column{{Value: "A"}},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateIndexHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.TableName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.TableName = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateIndexHybridTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: [opts.Columns] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Columns = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateIndexHybridTableOptions", "Columns"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE INDEX "IDX" ON %s ("A")`, tableId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Columns = []Column{{Value: "A"}, {Value: "B"}}
+		opts.Include = []Column{{Value: "C"}, {Value: "D"}}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE INDEX IF NOT EXISTS "IDX" ON %s ("A", "B") INCLUDE ("C", "D")`, tableId.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_DropIndex(t *testing.T) {
+	indexId := randomTableColumnIdentifier()
+
+	// Minimal valid DropIndexHybridTableOptions
+	defaultOpts := func() *DropIndexHybridTableOptions {
+		return &DropIndexHybridTableOptions{
+			Index: indexId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropIndexHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.Index]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Index = NewTableColumnIdentifier("", "", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP INDEX %s", indexId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP INDEX IF EXISTS %s", indexId.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_ShowIndexes(t *testing.T) {
+	// Minimal valid ShowIndexesHybridTableOptions
+	defaultOpts := func() *ShowIndexesHybridTableOptions {
+		return &ShowIndexesHybridTableOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowIndexesHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.In]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.In = &emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW INDEXES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		tableId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &tableId
+		assertOptsValidAndSQLEquals(t, opts, "SHOW INDEXES LIKE 'pattern' IN TABLE %s", tableId.FullyQualifiedName())
+	})
+}
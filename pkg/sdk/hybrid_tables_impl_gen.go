@@ -0,0 +1,285 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ HybridTables = (*hybridTables)(nil)
+
+type hybridTables struct {
+	client *Client
+}
+
+func (v *hybridTables) Create(ctx context.Context, request *CreateHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) Alter(ctx context.Context, request *AlterHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) Drop(ctx context.Context, request *DropHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropHybridTableRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *hybridTables) Show(ctx context.Context, request *ShowHybridTableRequest) ([]HybridTable, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[hybridTableRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[hybridTableRow, HybridTable](dbRows)
+	return resultList, nil
+}
+
+func (v *hybridTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*HybridTable, error) {
+	request := NewShowHybridTableRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
+	hybridTables, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(hybridTables, func(r HybridTable) bool { return r.Name == id.Name() })
+}
+
+func (v *hybridTables) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*HybridTable, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *hybridTables) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]HybridTableDetails, error) {
+	opts := &DescribeHybridTableOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[hybridTableDetailsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[hybridTableDetailsRow, HybridTableDetails](rows), nil
+}
+
+func (v *hybridTables) CreateIndex(ctx context.Context, request *CreateIndexHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) DropIndex(ctx context.Context, request *DropIndexHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) ShowIndexes(ctx context.Context, request *ShowIndexesHybridTableRequest) ([]HybridTableIndex, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[hybridTableIndexRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[hybridTableIndexRow, HybridTableIndex](dbRows)
+	return resultList, nil
+}
+
+func (r *CreateHybridTableRequest) toOpts() *CreateHybridTableOptions {
+	opts := &CreateHybridTableOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+
+		DataRetentionTimeInDays: r.DataRetentionTimeInDays,
+		Comment:                 r.Comment,
+	}
+	opts.ColumnsAndConstraints = HybridTableColumnsConstraintsAndIndexes{}
+	if r.ColumnsAndConstraints.Columns != nil {
+		s := make([]HybridTableColumn, len(r.ColumnsAndConstraints.Columns))
+		for i, v := range r.ColumnsAndConstraints.Columns {
+			s[i] = HybridTableColumn{
+				Name:     v.Name,
+				DataType: v.DataType,
+				NotNull:  v.NotNull,
+				Default:  v.Default,
+				Collate:  v.Collate,
+				Comment:  v.Comment,
+			}
+		}
+		opts.ColumnsAndConstraints.Columns = s
+	}
+	if r.ColumnsAndConstraints.OutOfLineConstraints != nil {
+		s := make([]HybridTableOutOfLineConstraint, len(r.ColumnsAndConstraints.OutOfLineConstraints))
+		for i, v := range r.ColumnsAndConstraints.OutOfLineConstraints {
+			s[i] = HybridTableOutOfLineConstraint{
+				Constraint: v.Constraint,
+				Type:       v.Type,
+				Columns:    v.Columns,
+			}
+			if v.ForeignKey != nil {
+				s[i].ForeignKey = &HybridTableForeignKey{
+					TableName: v.ForeignKey.TableName,
+					Columns:   v.ForeignKey.Columns,
+				}
+			}
+		}
+		opts.ColumnsAndConstraints.OutOfLineConstraints = s
+	}
+	if r.ColumnsAndConstraints.OutOfLineIndexes != nil {
+		s := make([]HybridTableOutOfLineIndex, len(r.ColumnsAndConstraints.OutOfLineIndexes))
+		for i, v := range r.ColumnsAndConstraints.OutOfLineIndexes {
+			s[i] = HybridTableOutOfLineIndex{
+				Name:    v.Name,
+				Columns: v.Columns,
+				Include: v.Include,
+			}
+		}
+		opts.ColumnsAndConstraints.OutOfLineIndexes = s
+	}
+
+	return opts
+}
+
+func (r *AlterHybridTableRequest) toOpts() *AlterHybridTableOptions {
+	opts := &AlterHybridTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+		RenameTo: r.RenameTo,
+	}
+	if r.Set != nil {
+		opts.Set = &HybridTableSet{
+			DataRetentionTimeInDays: r.Set.DataRetentionTimeInDays,
+			Comment:                 r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &HybridTableUnset{
+			DataRetentionTimeInDays: r.Unset.DataRetentionTimeInDays,
+			Comment:                 r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropHybridTableRequest) toOpts() *DropHybridTableOptions {
+	opts := &DropHybridTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowHybridTableRequest) toOpts() *ShowHybridTableOptions {
+	opts := &ShowHybridTableOptions{
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r hybridTableRow) convert() *HybridTable {
+	t := &HybridTable{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+	}
+	if r.Owner.Valid {
+		t.Owner = r.Owner.String
+	}
+	if r.Rows.Valid {
+		t.Rows = int(r.Rows.Int64)
+	}
+	if r.Bytes.Valid {
+		t.Bytes = int(r.Bytes.Int64)
+	}
+	if r.Comment.Valid {
+		t.Comment = r.Comment.String
+	}
+	if r.OwnerRoleType.Valid {
+		t.OwnerRoleType = r.OwnerRoleType.String
+	}
+	return t
+}
+
+func (r *DescribeHybridTableRequest) toOpts() *DescribeHybridTableOptions {
+	opts := &DescribeHybridTableOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r hybridTableDetailsRow) convert() *HybridTableDetails {
+	details := &HybridTableDetails{
+		Name:       r.Name,
+		Type:       r.Type,
+		Kind:       r.Kind,
+		IsNullable: r.Null == "Y",
+		PrimaryKey: r.PrimaryKey == "Y",
+		UniqueKey:  r.UniqueKey == "Y",
+	}
+	if r.Default.Valid {
+		details.Default = r.Default.String
+	}
+	if r.Comment.Valid {
+		details.Comment = r.Comment.String
+	}
+	return details
+}
+
+func (r *CreateIndexHybridTableRequest) toOpts() *CreateIndexHybridTableOptions {
+	opts := &CreateIndexHybridTableOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		IndexName:   r.IndexName,
+		TableName:   r.TableName,
+		Columns:     r.Columns,
+		Include:     r.Include,
+	}
+	return opts
+}
+
+func (r *DropIndexHybridTableRequest) toOpts() *DropIndexHybridTableOptions {
+	opts := &DropIndexHybridTableOptions{
+		IfExists: r.IfExists,
+		Index:    r.Index,
+	}
+	return opts
+}
+
+func (r *ShowIndexesHybridTableRequest) toOpts() *ShowIndexesHybridTableOptions {
+	opts := &ShowIndexesHybridTableOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r hybridTableIndexRow) convert() *HybridTableIndex {
+	index := &HybridTableIndex{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		Columns:      ParseCommaSeparatedStringArray(r.Columns, true),
+		Table:        r.Table,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+	}
+	if r.IsUnique.Valid {
+		index.IsUnique = r.IsUnique.String == "Y"
+	}
+	if r.IncludedColumns.Valid {
+		index.IncludedColumns = ParseCommaSeparatedStringArray(r.IncludedColumns.String, true)
+	}
+	if r.Owner.Valid {
+		index.Owner = r.Owner.String
+	}
+	if r.OwnerRoleType.Valid {
+		index.OwnerRoleType = r.OwnerRoleType.String
+	}
+	return index
+}
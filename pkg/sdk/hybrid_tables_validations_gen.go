@@ -0,0 +1,137 @@
+package sdk
+
+import "slices"
+
+var (
+	_ validatable = new(CreateHybridTableOptions)
+	_ validatable = new(AlterHybridTableOptions)
+	_ validatable = new(DropHybridTableOptions)
+	_ validatable = new(ShowHybridTableOptions)
+	_ validatable = new(DescribeHybridTableOptions)
+	_ validatable = new(CreateIndexHybridTableOptions)
+	_ validatable = new(DropIndexHybridTableOptions)
+	_ validatable = new(ShowIndexesHybridTableOptions)
+)
+
+func (opts *CreateHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateHybridTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if len(opts.ColumnsAndConstraints.Columns) == 0 {
+		errs = append(errs, errNotSet("CreateHybridTableOptions", "Columns"))
+	}
+	if !slices.ContainsFunc(opts.ColumnsAndConstraints.OutOfLineConstraints, func(c HybridTableOutOfLineConstraint) bool {
+		return c.Type == ColumnConstraintTypePrimaryKey
+	}) {
+		errs = append(errs, errNotSet("CreateHybridTableOptions", "PrimaryKey"))
+	}
+	for _, constraint := range opts.ColumnsAndConstraints.OutOfLineConstraints {
+		if constraint.ForeignKey != nil && !ValidObjectIdentifier(constraint.ForeignKey.TableName) {
+			errs = append(errs, errInvalidIdentifier("HybridTableForeignKey", "TableName"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterHybridTableOptions", "RenameTo", "Set", "Unset"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.DataRetentionTimeInDays, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterHybridTableOptions.Set", "DataRetentionTimeInDays", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.DataRetentionTimeInDays, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterHybridTableOptions.Unset", "DataRetentionTimeInDays", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateIndexHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.TableName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateIndexHybridTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if len(opts.Columns) == 0 {
+		errs = append(errs, errNotSet("CreateIndexHybridTableOptions", "Columns"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropIndexHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.Index) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowIndexesHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if opts.In != nil && !ValidObjectIdentifier(opts.In) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
@@ -0,0 +1,187 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var icebergTableColumn = g.NewQueryStruct("IcebergTableColumn").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("DataType", "datatypes.DataType", g.ParameterOptions().NoQuotes().NoEquals().Required()).
+	OptionalSQL("NOT NULL").
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals())
+
+var icebergTableRefresh = g.NewQueryStruct("IcebergTableRefresh").
+	OptionalText("MetadataFilePath", g.KeywordOptions().SingleQuotes())
+
+var icebergTableConvertToManaged = g.NewQueryStruct("IcebergTableConvertToManaged").
+	OptionalTextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes())
+
+var icebergTableSet = g.NewQueryStruct("IcebergTableSet").
+	OptionalIdentifier("CatalogSync", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("CATALOG_SYNC")).
+	OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+	OptionalBooleanAssignment("AUTO_REFRESH", g.ParameterOptions()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+	WithValidation(g.AtLeastOneValueSet, "CatalogSync", "ReplaceInvalidCharacters", "AutoRefresh", "Comment")
+
+var icebergTableUnset = g.NewQueryStruct("IcebergTableUnset").
+	OptionalSQL("CATALOG_SYNC").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "CatalogSync", "Comment")
+
+var IcebergTablesDef = g.NewInterface(
+	"IcebergTables",
+	"IcebergTable",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table",
+		g.NewQueryStruct("CreateIcebergTable").
+			Create().
+			OrReplace().
+			SQL("ICEBERG TABLE").
+			IfNotExists().
+			Name().
+			ListQueryStructField("Columns", icebergTableColumn, g.ListOptions().Parentheses()).
+			OptionalIdentifier("ExternalVolume", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("EXTERNAL_VOLUME")).
+			OptionalIdentifier("Catalog", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("CATALOG")).
+			OptionalTextAssignment("CATALOG_TABLE_NAME", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("METADATA_FILE_PATH", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes()).
+			OptionalIdentifier("CatalogSync", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("CATALOG_SYNC")).
+			OptionalAssignment("STORAGE_SERIALIZATION_POLICY", g.KindOfTPointer[StorageSerializationPolicy](), g.ParameterOptions()).
+			OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+			OptionalBooleanAssignment("AUTO_REFRESH", g.ParameterOptions()).
+			OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "ExternalVolume").
+			WithValidation(g.ValidIdentifierIfSet, "Catalog").
+			WithValidation(g.ValidIdentifierIfSet, "CatalogSync").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ConflictingFields, "CatalogTableName", "MetadataFilePath"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-iceberg-table",
+		g.NewQueryStruct("AlterIcebergTable").
+			Alter().
+			SQL("ICEBERG TABLE").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalQueryStructField(
+				"Refresh",
+				icebergTableRefresh,
+				g.KeywordOptions().SQL("REFRESH"),
+			).
+			OptionalQueryStructField(
+				"ConvertToManaged",
+				icebergTableConvertToManaged,
+				g.KeywordOptions().SQL("CONVERT TO MANAGED"),
+			).
+			OptionalQueryStructField(
+				"AddColumn",
+				icebergTableColumn,
+				g.KeywordOptions().SQL("ADD COLUMN"),
+			).
+			ListAssignment("DROP COLUMN", "Column", g.ParameterOptions().NoEquals()).
+			OptionalQueryStructField(
+				"Set",
+				icebergTableSet,
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				icebergTableUnset,
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Refresh", "ConvertToManaged", "AddColumn", "DropColumn", "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-iceberg-table",
+		g.NewQueryStruct("DropIcebergTable").
+			Drop().
+			SQL("ICEBERG TABLE").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables",
+		g.DbStruct("icebergTableRow").
+			Field("created_on", "time.Time").
+			Field("name", "string").
+			Field("database_name", "string").
+			Field("schema_name", "string").
+			Field("owner", "sql.NullString").
+			Field("external_volume_name", "sql.NullString").
+			Field("catalog_name", "sql.NullString").
+			Field("iceberg_table_type", "sql.NullString").
+			Field("catalog_table_name", "sql.NullString").
+			Field("catalog_namespace", "sql.NullString").
+			Field("base_location", "sql.NullString").
+			Field("comment", "sql.NullString").
+			Field("owner_role_type", "sql.NullString").
+			Field("can_write_metadata", "sql.NullString").
+			Field("invalid", "sql.NullString").
+			Field("invalid_reason", "sql.NullString").
+			Field("auto_refresh_status", "sql.NullString").
+			Field("auto_refresh", "sql.NullString"),
+		g.PlainStruct("IcebergTable").
+			Field("CreatedOn", "time.Time").
+			Field("Name", "string").
+			Field("DatabaseName", "string").
+			Field("SchemaName", "string").
+			Field("Owner", "string").
+			Field("ExternalVolumeName", "string").
+			Field("CatalogName", "string").
+			Field("IcebergTableType", "string").
+			Field("CatalogTableName", "string").
+			Field("CatalogNamespace", "string").
+			Field("BaseLocation", "string").
+			Field("Comment", "string").
+			Field("OwnerRoleType", "string").
+			Field("CanWriteMetadata", "bool").
+			Field("Invalid", "bool").
+			Field("InvalidReason", "string").
+			Field("AutoRefreshStatus", "string").
+			Field("AutoRefresh", "bool"),
+		g.NewQueryStruct("ShowIcebergTables").
+			Show().
+			SQL("ICEBERG TABLES").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimit(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-iceberg-table",
+		g.DbStruct("icebergTableDetailsRow").
+			Field("name", "string").
+			Field("type", "string").
+			Field("kind", "string").
+			Field("null", "string").
+			Field("default", "sql.NullString").
+			Field("comment", "sql.NullString"),
+		g.PlainStruct("IcebergTableDetails").
+			Field("Name", "string").
+			Field("Type", "string").
+			Field("Kind", "string").
+			Field("IsNullable", "bool").
+			Field("Default", "string").
+			Field("Comment", "string"),
+		g.NewQueryStruct("DescribeIcebergTable").
+			Describe().
+			SQL("ICEBERG TABLE").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,89 @@
+package sdk
+
+import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateIcebergTableOptions]   = new(CreateIcebergTableRequest)
+	_ optionsProvider[AlterIcebergTableOptions]    = new(AlterIcebergTableRequest)
+	_ optionsProvider[DropIcebergTableOptions]     = new(DropIcebergTableRequest)
+	_ optionsProvider[ShowIcebergTableOptions]     = new(ShowIcebergTableRequest)
+	_ optionsProvider[DescribeIcebergTableOptions] = new(DescribeIcebergTableRequest)
+)
+
+type CreateIcebergTableRequest struct {
+	OrReplace                  *bool
+	IfNotExists                *bool
+	name                       SchemaObjectIdentifier // required
+	Columns                    []IcebergTableColumnRequest
+	ExternalVolume             *AccountObjectIdentifier
+	Catalog                    *AccountObjectIdentifier
+	CatalogTableName           *string
+	MetadataFilePath           *string
+	CatalogNamespace           *string
+	BaseLocation               *string
+	CatalogSync                *AccountObjectIdentifier
+	StorageSerializationPolicy *StorageSerializationPolicy
+	ReplaceInvalidCharacters   *bool
+	AutoRefresh                *bool
+	Comment                    *string
+	Tag                        []TagAssociation
+}
+
+type IcebergTableColumnRequest struct {
+	Name     string             // required
+	DataType datatypes.DataType // required
+	NotNull  *bool
+	Comment  *string
+}
+
+type AlterIcebergTableRequest struct {
+	IfExists         *bool
+	name             SchemaObjectIdentifier // required
+	RenameTo         *SchemaObjectIdentifier
+	Refresh          *IcebergTableRefreshRequest
+	ConvertToManaged *IcebergTableConvertToManagedRequest
+	AddColumn        *IcebergTableColumnRequest
+	DropColumn       []Column
+	Set              *IcebergTableSetRequest
+	Unset            *IcebergTableUnsetRequest
+	SetTags          []TagAssociation
+	UnsetTags        []ObjectIdentifier
+}
+
+type IcebergTableRefreshRequest struct {
+	MetadataFilePath *string
+}
+
+type IcebergTableConvertToManagedRequest struct {
+	BaseLocation *string
+}
+
+type IcebergTableSetRequest struct {
+	CatalogSync              *AccountObjectIdentifier
+	ReplaceInvalidCharacters *bool
+	AutoRefresh              *bool
+	Comment                  *string
+}
+
+type IcebergTableUnsetRequest struct {
+	CatalogSync *bool
+	Comment     *bool
+}
+
+type DropIcebergTableRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowIcebergTableRequest struct {
+	Like       *Like
+	In         *In
+	StartsWith *string
+	Limit      *LimitFrom
+}
+
+type DescribeIcebergTableRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -0,0 +1,17 @@
+package sdk
+
+import "strings"
+
+func (r *CreateIcebergTableRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+// icebergTableBoolFromString handles boolean-like columns of SHOW ICEBERG TABLES which, depending on the column, are returned as Y/N or true/false.
+func icebergTableBoolFromString(s string) bool {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "Y", "YES", "TRUE":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,179 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+)
+
+type IcebergTables interface {
+	Create(ctx context.Context, request *CreateIcebergTableRequest) error
+	Alter(ctx context.Context, request *AlterIcebergTableRequest) error
+	Drop(ctx context.Context, request *DropIcebergTableRequest) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowIcebergTableRequest) ([]IcebergTable, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]IcebergTableDetails, error)
+}
+
+// CreateIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table.
+type CreateIcebergTableOptions struct {
+	create                     bool                        `ddl:"static" sql:"CREATE"`
+	OrReplace                  *bool                       `ddl:"keyword" sql:"OR REPLACE"`
+	icebergTable               bool                        `ddl:"static" sql:"ICEBERG TABLE"`
+	IfNotExists                *bool                       `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaObjectIdentifier      `ddl:"identifier"`
+	Columns                    []IcebergTableColumn        `ddl:"list,parentheses"`
+	ExternalVolume             *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"EXTERNAL_VOLUME"`
+	Catalog                    *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"CATALOG"`
+	CatalogTableName           *string                     `ddl:"parameter,single_quotes" sql:"CATALOG_TABLE_NAME"`
+	MetadataFilePath           *string                     `ddl:"parameter,single_quotes" sql:"METADATA_FILE_PATH"`
+	CatalogNamespace           *string                     `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+	BaseLocation               *string                     `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+	CatalogSync                *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"CATALOG_SYNC"`
+	StorageSerializationPolicy *StorageSerializationPolicy `ddl:"parameter" sql:"STORAGE_SERIALIZATION_POLICY"`
+	ReplaceInvalidCharacters   *bool                       `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	AutoRefresh                *bool                       `ddl:"parameter" sql:"AUTO_REFRESH"`
+	Comment                    *string                     `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag                        []TagAssociation            `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+type IcebergTableColumn struct {
+	Name     string             `ddl:"keyword,double_quotes"`
+	DataType datatypes.DataType `ddl:"parameter,no_quotes,no_equals"`
+	NotNull  *bool              `ddl:"keyword" sql:"NOT NULL"`
+	Comment  *string            `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+}
+
+// AlterIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-iceberg-table.
+type AlterIcebergTableOptions struct {
+	alter            bool                          `ddl:"static" sql:"ALTER"`
+	icebergTable     bool                          `ddl:"static" sql:"ICEBERG TABLE"`
+	IfExists         *bool                         `ddl:"keyword" sql:"IF EXISTS"`
+	name             SchemaObjectIdentifier        `ddl:"identifier"`
+	RenameTo         *SchemaObjectIdentifier       `ddl:"identifier" sql:"RENAME TO"`
+	Refresh          *IcebergTableRefresh          `ddl:"keyword" sql:"REFRESH"`
+	ConvertToManaged *IcebergTableConvertToManaged `ddl:"keyword" sql:"CONVERT TO MANAGED"`
+	AddColumn        *IcebergTableColumn           `ddl:"keyword" sql:"ADD COLUMN"`
+	DropColumn       []Column                      `ddl:"parameter,no_equals" sql:"DROP COLUMN"`
+	Set              *IcebergTableSet              `ddl:"keyword" sql:"SET"`
+	Unset            *IcebergTableUnset            `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTags          []TagAssociation              `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags        []ObjectIdentifier            `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+type IcebergTableRefresh struct {
+	MetadataFilePath *string `ddl:"keyword,single_quotes"`
+}
+
+type IcebergTableConvertToManaged struct {
+	BaseLocation *string `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+}
+
+type IcebergTableSet struct {
+	CatalogSync              *AccountObjectIdentifier `ddl:"identifier,equals" sql:"CATALOG_SYNC"`
+	ReplaceInvalidCharacters *bool                    `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	AutoRefresh              *bool                    `ddl:"parameter" sql:"AUTO_REFRESH"`
+	Comment                  *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type IcebergTableUnset struct {
+	CatalogSync *bool `ddl:"keyword" sql:"CATALOG_SYNC"`
+	Comment     *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-iceberg-table.
+type DropIcebergTableOptions struct {
+	drop         bool                   `ddl:"static" sql:"DROP"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables.
+type ShowIcebergTableOptions struct {
+	show          bool       `ddl:"static" sql:"SHOW"`
+	icebergTables bool       `ddl:"static" sql:"ICEBERG TABLES"`
+	Like          *Like      `ddl:"keyword" sql:"LIKE"`
+	In            *In        `ddl:"keyword" sql:"IN"`
+	StartsWith    *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit         *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type icebergTableRow struct {
+	CreatedOn          time.Time      `db:"created_on"`
+	Name               string         `db:"name"`
+	DatabaseName       string         `db:"database_name"`
+	SchemaName         string         `db:"schema_name"`
+	Owner              sql.NullString `db:"owner"`
+	ExternalVolumeName sql.NullString `db:"external_volume_name"`
+	CatalogName        sql.NullString `db:"catalog_name"`
+	IcebergTableType   sql.NullString `db:"iceberg_table_type"`
+	CatalogTableName   sql.NullString `db:"catalog_table_name"`
+	CatalogNamespace   sql.NullString `db:"catalog_namespace"`
+	BaseLocation       sql.NullString `db:"base_location"`
+	Comment            sql.NullString `db:"comment"`
+	OwnerRoleType      sql.NullString `db:"owner_role_type"`
+	CanWriteMetadata   sql.NullString `db:"can_write_metadata"`
+	Invalid            sql.NullString `db:"invalid"`
+	InvalidReason      sql.NullString `db:"invalid_reason"`
+	AutoRefreshStatus  sql.NullString `db:"auto_refresh_status"`
+	AutoRefresh        sql.NullString `db:"auto_refresh"`
+}
+
+type IcebergTable struct {
+	CreatedOn          time.Time
+	Name               string
+	DatabaseName       string
+	SchemaName         string
+	Owner              string
+	ExternalVolumeName string
+	CatalogName        string
+	IcebergTableType   string
+	CatalogTableName   string
+	CatalogNamespace   string
+	BaseLocation       string
+	Comment            string
+	OwnerRoleType      string
+	CanWriteMetadata   bool
+	Invalid            bool
+	InvalidReason      string
+	AutoRefreshStatus  string
+	AutoRefresh        bool
+}
+
+func (v *IcebergTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *IcebergTable) ObjectType() ObjectType {
+	return ObjectTypeIcebergTable
+}
+
+// DescribeIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-iceberg-table.
+type DescribeIcebergTableOptions struct {
+	describe     bool                   `ddl:"static" sql:"DESCRIBE"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type icebergTableDetailsRow struct {
+	Name       string         `db:"name"`
+	Type       string         `db:"type"`
+	Kind       string         `db:"kind"`
+	IsNullable string         `db:"null?"`
+	Default    sql.NullString `db:"default"`
+	Comment    sql.NullString `db:"comment"`
+}
+
+type IcebergTableDetails struct {
+	Name       string
+	Type       string
+	Kind       string
+	IsNullable bool
+	Default    string
+	Comment    string
+}
@@ -0,0 +1,340 @@
+package sdk
+
+import "testing"
+
+func TestIcebergTables_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateIcebergTableOptions
+	defaultOpts := func() *CreateIcebergTableOptions {
+		return &CreateIcebergTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.ExternalVolume] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ExternalVolume = Pointer(emptyAccountObjectIdentifier)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.Catalog] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Catalog = Pointer(emptyAccountObjectIdentifier)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.CatalogSync] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.CatalogSync = Pointer(emptyAccountObjectIdentifier)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateIcebergTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.CatalogTableName opts.MetadataFilePath]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.CatalogTableName = String("table")
+		opts.MetadataFilePath = String("path/metadata.json")
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateIcebergTableOptions", "CatalogTableName", "MetadataFilePath"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ICEBERG TABLE %s", id.FullyQualifiedName())
+	})
+
+	t.Run("snowflake catalog", func(t *testing.T) {
+		externalVolumeId := randomAccountObjectIdentifier()
+		catalogSyncId := randomAccountObjectIdentifier()
+		tagId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Columns = []IcebergTableColumn{
+			{Name: "id", DataType: dataTypeNumber, NotNull: Bool(true)},
+			{Name: "name", DataType: dataTypeVarchar, Comment: String("column comment")},
+		}
+		opts.ExternalVolume = Pointer(externalVolumeId)
+		opts.Catalog = Pointer(NewAccountObjectIdentifier("SNOWFLAKE"))
+		opts.BaseLocation = String("some/location")
+		opts.CatalogSync = Pointer(catalogSyncId)
+		opts.StorageSerializationPolicy = Pointer(StorageSerializationPolicyOptimized)
+		opts.Comment = String("comment")
+		opts.Tag = []TagAssociation{{Name: tagId, Value: "value"}}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE ICEBERG TABLE %s ("id" NUMBER(38, 0) NOT NULL, "name" VARCHAR(16777216) COMMENT 'column comment') EXTERNAL_VOLUME = %s CATALOG = "SNOWFLAKE" BASE_LOCATION = 'some/location' CATALOG_SYNC = %s STORAGE_SERIALIZATION_POLICY = OPTIMIZED COMMENT = 'comment' TAG (%s = 'value')`,
+			id.FullyQualifiedName(), externalVolumeId.FullyQualifiedName(), catalogSyncId.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("external catalog", func(t *testing.T) {
+		externalVolumeId := randomAccountObjectIdentifier()
+		catalogId := randomAccountObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.ExternalVolume = Pointer(externalVolumeId)
+		opts.Catalog = Pointer(catalogId)
+		opts.CatalogTableName = String("table")
+		opts.CatalogNamespace = String("namespace")
+		opts.ReplaceInvalidCharacters = Bool(true)
+		opts.AutoRefresh = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ICEBERG TABLE IF NOT EXISTS %s EXTERNAL_VOLUME = %s CATALOG = %s CATALOG_TABLE_NAME = 'table' CATALOG_NAMESPACE = 'namespace' REPLACE_INVALID_CHARACTERS = true AUTO_REFRESH = true",
+			id.FullyQualifiedName(), externalVolumeId.FullyQualifiedName(), catalogId.FullyQualifiedName())
+	})
+
+	t.Run("object store catalog", func(t *testing.T) {
+		catalogId := randomAccountObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.Catalog = Pointer(catalogId)
+		opts.MetadataFilePath = String("path/to/metadata/v1.metadata.json")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ICEBERG TABLE %s CATALOG = %s METADATA_FILE_PATH = 'path/to/metadata/v1.metadata.json'",
+			id.FullyQualifiedName(), catalogId.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterIcebergTableOptions
+	defaultOpts := func() *AlterIcebergTableOptions {
+		return &AlterIcebergTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		opts.Set = &IcebergTableSet{Comment: String("comment")}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RenameTo = Pointer(emptySchemaObjectIdentifier)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Refresh opts.ConvertToManaged opts.AddColumn opts.DropColumn opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterIcebergTableOptions", "RenameTo", "Refresh", "ConvertToManaged", "AddColumn", "DropColumn", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Refresh opts.ConvertToManaged opts.AddColumn opts.DropColumn opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &IcebergTableSet{Comment: String("comment")}
+		opts.Unset = &IcebergTableUnset{CatalogSync: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterIcebergTableOptions", "RenameTo", "Refresh", "ConvertToManaged", "AddColumn", "DropColumn", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.CatalogSync opts.Set.ReplaceInvalidCharacters opts.Set.AutoRefresh opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &IcebergTableSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterIcebergTableOptions.Set", "CatalogSync", "ReplaceInvalidCharacters", "AutoRefresh", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.CatalogSync opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &IcebergTableUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterIcebergTableOptions.Unset", "CatalogSync", "Comment"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = &IcebergTableRefresh{}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s REFRESH", id.FullyQualifiedName())
+	})
+
+	t.Run("refresh with metadata file path", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = &IcebergTableRefresh{MetadataFilePath: String("path/v2.metadata.json")}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s REFRESH 'path/v2.metadata.json'", id.FullyQualifiedName())
+	})
+
+	t.Run("convert to managed", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ConvertToManaged = &IcebergTableConvertToManaged{BaseLocation: String("some/location")}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s CONVERT TO MANAGED BASE_LOCATION = 'some/location'", id.FullyQualifiedName())
+	})
+
+	t.Run("add column", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddColumn = &IcebergTableColumn{Name: "c", DataType: dataTypeVarchar, NotNull: Bool(true), Comment: String("comment")}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s ADD COLUMN "c" VARCHAR(16777216) NOT NULL COMMENT 'comment'`, id.FullyQualifiedName())
+	})
+
+	t.Run("drop columns", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropColumn = []Column{{Value: "a"}, {Value: "b"}}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s DROP COLUMN "a", "b"`, id.FullyQualifiedName())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		catalogSyncId := randomAccountObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.Set = &IcebergTableSet{
+			CatalogSync:              Pointer(catalogSyncId),
+			ReplaceInvalidCharacters: Bool(true),
+			AutoRefresh:              Bool(false),
+			Comment:                  String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s SET CATALOG_SYNC = %s REPLACE_INVALID_CHARACTERS = true AUTO_REFRESH = false COMMENT = 'comment'", id.FullyQualifiedName(), catalogSyncId.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &IcebergTableUnset{
+			CatalogSync: Bool(true),
+			Comment:     Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s UNSET CATALOG_SYNC, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("second-name"),
+				Value: "second-value",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s SET TAG "name" = 'value', "second-name" = 'second-value'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("name"),
+			NewAccountObjectIdentifier("second-name"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s UNSET TAG "name", "second-name"`, id.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropIcebergTableOptions
+	defaultOpts := func() *DropIcebergTableOptions {
+		return &DropIcebergTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP ICEBERG TABLE %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP ICEBERG TABLE IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_Show(t *testing.T) {
+	// Minimal valid ShowIcebergTableOptions
+	defaultOpts := func() *ShowIcebergTableOptions {
+		return &ShowIcebergTableOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW ICEBERG TABLES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		schemaId := randomDatabaseObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		opts.In = &In{
+			Schema: schemaId,
+		}
+		opts.StartsWith = String("abc")
+		opts.Limit = &LimitFrom{Rows: Int(10)}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW ICEBERG TABLES LIKE 'some pattern' IN SCHEMA %s STARTS WITH 'abc' LIMIT 10", schemaId.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTables_Describe(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeIcebergTableOptions
+	defaultOpts := func() *DescribeIcebergTableOptions {
+		return &DescribeIcebergTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE ICEBERG TABLE %s", id.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,241 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ IcebergTables = (*icebergTables)(nil)
+
+type icebergTables struct {
+	client *Client
+}
+
+func (v *icebergTables) Create(ctx context.Context, request *CreateIcebergTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) Alter(ctx context.Context, request *AlterIcebergTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) Drop(ctx context.Context, request *DropIcebergTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropIcebergTableRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *icebergTables) Show(ctx context.Context, request *ShowIcebergTableRequest) ([]IcebergTable, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[icebergTableRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[icebergTableRow, IcebergTable](dbRows)
+	return resultList, nil
+}
+
+func (v *icebergTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error) {
+	request := NewShowIcebergTableRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
+	icebergTables, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(icebergTables, func(r IcebergTable) bool { return r.Name == id.Name() })
+}
+
+func (v *icebergTables) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *icebergTables) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]IcebergTableDetails, error) {
+	opts := &DescribeIcebergTableOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[icebergTableDetailsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[icebergTableDetailsRow, IcebergTableDetails](rows), nil
+}
+
+func (r *CreateIcebergTableRequest) toOpts() *CreateIcebergTableOptions {
+	opts := &CreateIcebergTableOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+
+		ExternalVolume:             r.ExternalVolume,
+		Catalog:                    r.Catalog,
+		CatalogTableName:           r.CatalogTableName,
+		MetadataFilePath:           r.MetadataFilePath,
+		CatalogNamespace:           r.CatalogNamespace,
+		BaseLocation:               r.BaseLocation,
+		CatalogSync:                r.CatalogSync,
+		StorageSerializationPolicy: r.StorageSerializationPolicy,
+		ReplaceInvalidCharacters:   r.ReplaceInvalidCharacters,
+		AutoRefresh:                r.AutoRefresh,
+		Comment:                    r.Comment,
+		Tag:                        r.Tag,
+	}
+	if r.Columns != nil {
+		s := make([]IcebergTableColumn, len(r.Columns))
+		for i, v := range r.Columns {
+			s[i] = IcebergTableColumn{
+				Name:     v.Name,
+				DataType: v.DataType,
+				NotNull:  v.NotNull,
+				Comment:  v.Comment,
+			}
+		}
+		opts.Columns = s
+	}
+	return opts
+}
+
+func (r *AlterIcebergTableRequest) toOpts() *AlterIcebergTableOptions {
+	opts := &AlterIcebergTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+		RenameTo: r.RenameTo,
+
+		DropColumn: r.DropColumn,
+
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Refresh != nil {
+		opts.Refresh = &IcebergTableRefresh{
+			MetadataFilePath: r.Refresh.MetadataFilePath,
+		}
+	}
+	if r.ConvertToManaged != nil {
+		opts.ConvertToManaged = &IcebergTableConvertToManaged{
+			BaseLocation: r.ConvertToManaged.BaseLocation,
+		}
+	}
+	if r.AddColumn != nil {
+		opts.AddColumn = &IcebergTableColumn{
+			Name:     r.AddColumn.Name,
+			DataType: r.AddColumn.DataType,
+			NotNull:  r.AddColumn.NotNull,
+			Comment:  r.AddColumn.Comment,
+		}
+	}
+	if r.Set != nil {
+		opts.Set = &IcebergTableSet{
+			CatalogSync:              r.Set.CatalogSync,
+			ReplaceInvalidCharacters: r.Set.ReplaceInvalidCharacters,
+			AutoRefresh:              r.Set.AutoRefresh,
+			Comment:                  r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &IcebergTableUnset{
+			CatalogSync: r.Unset.CatalogSync,
+			Comment:     r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropIcebergTableRequest) toOpts() *DropIcebergTableOptions {
+	opts := &DropIcebergTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowIcebergTableRequest) toOpts() *ShowIcebergTableOptions {
+	opts := &ShowIcebergTableOptions{
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r icebergTableRow) convert() *IcebergTable {
+	t := &IcebergTable{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+	}
+	if r.Owner.Valid {
+		t.Owner = r.Owner.String
+	}
+	if r.ExternalVolumeName.Valid {
+		t.ExternalVolumeName = r.ExternalVolumeName.String
+	}
+	if r.CatalogName.Valid {
+		t.CatalogName = r.CatalogName.String
+	}
+	if r.IcebergTableType.Valid {
+		t.IcebergTableType = r.IcebergTableType.String
+	}
+	if r.CatalogTableName.Valid {
+		t.CatalogTableName = r.CatalogTableName.String
+	}
+	if r.CatalogNamespace.Valid {
+		t.CatalogNamespace = r.CatalogNamespace.String
+	}
+	if r.BaseLocation.Valid {
+		t.BaseLocation = r.BaseLocation.String
+	}
+	if r.Comment.Valid {
+		t.Comment = r.Comment.String
+	}
+	if r.OwnerRoleType.Valid {
+		t.OwnerRoleType = r.OwnerRoleType.String
+	}
+	if r.CanWriteMetadata.Valid {
+		t.CanWriteMetadata = icebergTableBoolFromString(r.CanWriteMetadata.String)
+	}
+	if r.Invalid.Valid {
+		t.Invalid = icebergTableBoolFromString(r.Invalid.String)
+	}
+	if r.InvalidReason.Valid {
+		t.InvalidReason = r.InvalidReason.String
+	}
+	if r.AutoRefreshStatus.Valid {
+		t.AutoRefreshStatus = r.AutoRefreshStatus.String
+	}
+	if r.AutoRefresh.Valid {
+		t.AutoRefresh = icebergTableBoolFromString(r.AutoRefresh.String)
+	}
+	return t
+}
+
+func (r *DescribeIcebergTableRequest) toOpts() *DescribeIcebergTableOptions {
+	opts := &DescribeIcebergTableOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r icebergTableDetailsRow) convert() *IcebergTableDetails {
+	details := &IcebergTableDetails{
+		Name:       r.Name,
+		Type:       r.Type,
+		Kind:       r.Kind,
+		IsNullable: r.IsNullable == "Y",
+	}
+	if r.Default.Valid {
+		details.Default = r.Default.String
+	}
+	if r.Comment.Valid {
+		details.Comment = r.Comment.String
+	}
+	return details
+}
@@ -0,0 +1,92 @@
+package sdk
+
+var (
+	_ validatable = new(CreateIcebergTableOptions)
+	_ validatable = new(AlterIcebergTableOptions)
+	_ validatable = new(DropIcebergTableOptions)
+	_ validatable = new(ShowIcebergTableOptions)
+	_ validatable = new(DescribeIcebergTableOptions)
+)
+
+func (opts *CreateIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.ExternalVolume != nil && !ValidObjectIdentifier(opts.ExternalVolume) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.Catalog != nil && !ValidObjectIdentifier(opts.Catalog) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.CatalogSync != nil && !ValidObjectIdentifier(opts.CatalogSync) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateIcebergTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if everyValueSet(opts.CatalogTableName, opts.MetadataFilePath) {
+		errs = append(errs, errOneOf("CreateIcebergTableOptions", "CatalogTableName", "MetadataFilePath"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RenameTo, opts.Refresh, opts.ConvertToManaged, opts.AddColumn, opts.DropColumn, opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterIcebergTableOptions", "RenameTo", "Refresh", "ConvertToManaged", "AddColumn", "DropColumn", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.CatalogSync, opts.Set.ReplaceInvalidCharacters, opts.Set.AutoRefresh, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterIcebergTableOptions.Set", "CatalogSync", "ReplaceInvalidCharacters", "AutoRefresh", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.CatalogSync, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterIcebergTableOptions.Unset", "CatalogSync", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
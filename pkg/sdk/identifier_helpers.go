@@ -453,3 +453,8 @@ func (i TableColumnIdentifier) FullyQualifiedName() string {
 func (i TableColumnIdentifier) SchemaObjectId() SchemaObjectIdentifier {
 	return NewSchemaObjectIdentifier(i.databaseName, i.schemaName, i.tableName)
 }
+
+// QuoteIdentifierPart encloses a single identifier part (e.g. a column name) in double quotes, escaping the double quotes inside it.
+func QuoteIdentifierPart(name string) string {
+	return DoubleQuotes.Modify(name)
+}
@@ -82,3 +82,8 @@ func TestDatabaseObjectIdentifier(t *testing.T) {
 		assert.Equal(t, `"aaa"."bbb"`, identifier.FullyQualifiedName())
 	})
 }
+
+func TestQuoteIdentifierPart(t *testing.T) {
+	assert.Equal(t, `"COLUMN_1"`, QuoteIdentifierPart("COLUMN_1"))
+	assert.Equal(t, `"column ""with"" quotes"`, QuoteIdentifierPart(`column "with" quotes`))
+}
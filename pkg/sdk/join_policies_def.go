@@ -0,0 +1,103 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var JoinPoliciesDef = g.NewInterface(
+	"JoinPolicies",
+	"JoinPolicy",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-join-policy",
+		g.NewQueryStruct("CreateJoinPolicy").
+			Create().
+			OrReplace().
+			SQL("JOIN POLICY").
+			IfNotExists().
+			Name().
+			SQLWithCustomFieldName("returnsJoinConstraint", "AS () RETURNS JOIN_CONSTRAINT").
+			BodyWithPrecedingArrow().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-join-policy",
+		g.NewQueryStruct("AlterJoinPolicy").
+			Alter().
+			SQL("JOIN POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-join-policy",
+		g.NewQueryStruct("DropJoinPolicy").
+			Drop().
+			SQL("JOIN POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-join-policies",
+		g.DbStruct("joinPolicyDBRow").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			OptionalText("comment").
+			Text("options").
+			Text("owner_role_type"),
+		g.PlainStruct("JoinPolicy").
+			Text("CreatedOn").
+			Text("Name").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Kind").
+			Text("Owner").
+			Text("Comment").
+			Text("Options").
+			Text("OwnerRoleType"),
+		g.NewQueryStruct("ShowJoinPolicies").
+			Show().
+			SQL("JOIN POLICIES").
+			OptionalLike().
+			OptionalExtendedIn().
+			OptionalLimitFrom(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDExtendedInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-join-policy",
+		g.DbStruct("describeJoinPolicyDBRow").
+			Field("name", "string").
+			Field("signature", "string").
+			Field("return_type", "string").
+			Field("body", "string"),
+		g.PlainStruct("JoinPolicyDescription").
+			Field("Name", "string").
+			Field("Signature", "string").
+			Field("ReturnType", "string").
+			Field("Body", "string"),
+		g.NewQueryStruct("DescribeJoinPolicy").
+			Describe().
+			SQL("JOIN POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,45 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateJoinPolicyOptions]   = new(CreateJoinPolicyRequest)
+	_ optionsProvider[AlterJoinPolicyOptions]    = new(AlterJoinPolicyRequest)
+	_ optionsProvider[DropJoinPolicyOptions]     = new(DropJoinPolicyRequest)
+	_ optionsProvider[ShowJoinPolicyOptions]     = new(ShowJoinPolicyRequest)
+	_ optionsProvider[DescribeJoinPolicyOptions] = new(DescribeJoinPolicyRequest)
+)
+
+type CreateJoinPolicyRequest struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	name        SchemaObjectIdentifier // required
+	body        string                 // required
+	Comment     *string
+}
+
+type AlterJoinPolicyRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier // required
+	RenameTo     *SchemaObjectIdentifier
+	SetBody      *string
+	SetTags      []TagAssociation
+	UnsetTags    []ObjectIdentifier
+	SetComment   *string
+	UnsetComment *bool
+}
+
+type DropJoinPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowJoinPolicyRequest struct {
+	Like  *Like
+	In    *ExtendedIn
+	Limit *LimitFrom
+}
+
+type DescribeJoinPolicyRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -0,0 +1,113 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+type JoinPolicies interface {
+	Create(ctx context.Context, request *CreateJoinPolicyRequest) error
+	Alter(ctx context.Context, request *AlterJoinPolicyRequest) error
+	Drop(ctx context.Context, request *DropJoinPolicyRequest) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowJoinPolicyRequest) ([]JoinPolicy, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*JoinPolicy, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*JoinPolicy, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*JoinPolicyDescription, error)
+}
+
+// CreateJoinPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-join-policy.
+type CreateJoinPolicyOptions struct {
+	create                bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace             *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	joinPolicy            bool                   `ddl:"static" sql:"JOIN POLICY"`
+	IfNotExists           *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                  SchemaObjectIdentifier `ddl:"identifier"`
+	returnsJoinConstraint bool                   `ddl:"static" sql:"AS () RETURNS JOIN_CONSTRAINT"`
+	body                  string                 `ddl:"parameter,no_quotes,no_equals" sql:"->"`
+	Comment               *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// AlterJoinPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-join-policy.
+type AlterJoinPolicyOptions struct {
+	alter        bool                    `ddl:"static" sql:"ALTER"`
+	joinPolicy   bool                    `ddl:"static" sql:"JOIN POLICY"`
+	IfExists     *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier  `ddl:"identifier"`
+	RenameTo     *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	SetBody      *string                 `ddl:"parameter,no_quotes,no_equals" sql:"SET BODY ->"`
+	SetTags      []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags    []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+	SetComment   *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	UnsetComment *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
+}
+
+// DropJoinPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-join-policy.
+type DropJoinPolicyOptions struct {
+	drop       bool                   `ddl:"static" sql:"DROP"`
+	joinPolicy bool                   `ddl:"static" sql:"JOIN POLICY"`
+	IfExists   *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name       SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowJoinPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-join-policies.
+type ShowJoinPolicyOptions struct {
+	show         bool        `ddl:"static" sql:"SHOW"`
+	joinPolicies bool        `ddl:"static" sql:"JOIN POLICIES"`
+	Like         *Like       `ddl:"keyword" sql:"LIKE"`
+	In           *ExtendedIn `ddl:"keyword" sql:"IN"`
+	Limit        *LimitFrom  `ddl:"keyword" sql:"LIMIT"`
+}
+
+type joinPolicyDBRow struct {
+	CreatedOn     string         `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Kind          string         `db:"kind"`
+	Owner         string         `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	Options       string         `db:"options"`
+	OwnerRoleType string         `db:"owner_role_type"`
+}
+
+type JoinPolicy struct {
+	CreatedOn     string
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Kind          string
+	Owner         string
+	Comment       string
+	Options       string
+	OwnerRoleType string
+}
+
+func (v *JoinPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *JoinPolicy) ObjectType() ObjectType {
+	return ObjectTypeJoinPolicy
+}
+
+// DescribeJoinPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-join-policy.
+type DescribeJoinPolicyOptions struct {
+	describe   bool                   `ddl:"static" sql:"DESCRIBE"`
+	joinPolicy bool                   `ddl:"static" sql:"JOIN POLICY"`
+	name       SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type describeJoinPolicyDBRow struct {
+	Name       string `db:"name"`
+	Signature  string `db:"signature"`
+	ReturnType string `db:"return_type"`
+	Body       string `db:"body"`
+}
+
+type JoinPolicyDescription struct {
+	Name       string
+	Signature  string
+	ReturnType string
+	Body       string
+}
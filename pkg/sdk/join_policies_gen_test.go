@@ -0,0 +1,230 @@
+package sdk
+
+import "testing"
+
+func TestJoinPolicies_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateJoinPolicyOptions
+	defaultOpts := func() *CreateJoinPolicyOptions {
+		return &CreateJoinPolicyOptions{
+			name: id,
+			body: "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateJoinPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.body] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.body = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateJoinPolicyOptions", "body"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateJoinPolicyOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE JOIN POLICY %s AS () RETURNS JOIN_CONSTRAINT -> JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE JOIN POLICY %s AS () RETURNS JOIN_CONSTRAINT -> JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE) COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+}
+
+func TestJoinPolicies_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterJoinPolicyOptions
+	defaultOpts := func() *AlterJoinPolicyOptions {
+		return &AlterJoinPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterJoinPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetBody opts.SetTags opts.UnsetTags opts.SetComment opts.UnsetComment] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterJoinPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetBody opts.SetTags opts.UnsetTags opts.SetComment opts.UnsetComment] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterJoinPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER JOIN POLICY IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set body", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetBody = String("JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER JOIN POLICY %s SET BODY -> JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)", id.FullyQualifiedName())
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER JOIN POLICY %s SET COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetComment = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER JOIN POLICY %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("tag2"),
+				Value: "value2",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER JOIN POLICY %s SET TAG "tag1" = 'value1', "tag2" = 'value2'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+			NewAccountObjectIdentifier("tag2"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER JOIN POLICY %s UNSET TAG "tag1", "tag2"`, id.FullyQualifiedName())
+	})
+}
+
+func TestJoinPolicies_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropJoinPolicyOptions
+	defaultOpts := func() *DropJoinPolicyOptions {
+		return &DropJoinPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropJoinPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP JOIN POLICY %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP JOIN POLICY IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestJoinPolicies_Show(t *testing.T) {
+	// Minimal valid ShowJoinPolicyOptions
+	defaultOpts := func() *ShowJoinPolicyOptions {
+		return &ShowJoinPolicyOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowJoinPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW JOIN POLICIES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &ExtendedIn{
+			In: In{
+				Account: Bool(true),
+			},
+		}
+		opts.Limit = &LimitFrom{
+			Rows: Pointer(10),
+			From: Pointer("foo"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW JOIN POLICIES LIKE 'pattern' IN ACCOUNT LIMIT 10 FROM 'foo'")
+	})
+}
+
+func TestJoinPolicies_Describe(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeJoinPolicyOptions
+	defaultOpts := func() *DescribeJoinPolicyOptions {
+		return &DescribeJoinPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeJoinPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE JOIN POLICY %s", id.FullyQualifiedName())
+	})
+}
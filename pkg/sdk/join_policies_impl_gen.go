@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ JoinPolicies = (*joinPolicies)(nil)
+
+type joinPolicies struct {
+	client *Client
+}
+
+func (v *joinPolicies) Create(ctx context.Context, request *CreateJoinPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *joinPolicies) Alter(ctx context.Context, request *AlterJoinPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *joinPolicies) Drop(ctx context.Context, request *DropJoinPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *joinPolicies) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropJoinPolicyRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *joinPolicies) Show(ctx context.Context, request *ShowJoinPolicyRequest) ([]JoinPolicy, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[joinPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[joinPolicyDBRow, JoinPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *joinPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*JoinPolicy, error) {
+	request := NewShowJoinPolicyRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}})
+	joinPolicies, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(joinPolicies, func(r JoinPolicy) bool { return r.Name == id.Name() })
+}
+
+func (v *joinPolicies) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*JoinPolicy, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *joinPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*JoinPolicyDescription, error) {
+	opts := &DescribeJoinPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describeJoinPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateJoinPolicyRequest) toOpts() *CreateJoinPolicyOptions {
+	opts := &CreateJoinPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		body:        r.body,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterJoinPolicyRequest) toOpts() *AlterJoinPolicyOptions {
+	opts := &AlterJoinPolicyOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		RenameTo:     r.RenameTo,
+		SetBody:      r.SetBody,
+		SetTags:      r.SetTags,
+		UnsetTags:    r.UnsetTags,
+		SetComment:   r.SetComment,
+		UnsetComment: r.UnsetComment,
+	}
+	return opts
+}
+
+func (r *DropJoinPolicyRequest) toOpts() *DropJoinPolicyOptions {
+	opts := &DropJoinPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowJoinPolicyRequest) toOpts() *ShowJoinPolicyOptions {
+	opts := &ShowJoinPolicyOptions{
+		Like:  r.Like,
+		In:    r.In,
+		Limit: r.Limit,
+	}
+	return opts
+}
+
+func (r joinPolicyDBRow) convert() *JoinPolicy {
+	joinPolicy := &JoinPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Options:       r.Options,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	if r.Comment.Valid {
+		joinPolicy.Comment = r.Comment.String
+	}
+	return joinPolicy
+}
+
+func (r *DescribeJoinPolicyRequest) toOpts() *DescribeJoinPolicyOptions {
+	opts := &DescribeJoinPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeJoinPolicyDBRow) convert() *JoinPolicyDescription {
+	return &JoinPolicyDescription{
+		Name:       r.Name,
+		Signature:  r.Signature,
+		ReturnType: r.ReturnType,
+		Body:       r.Body,
+	}
+}
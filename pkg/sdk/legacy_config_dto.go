@@ -55,6 +55,9 @@ type LegacyConfigDTO struct {
 	DisableQueryContextCache       *bool               `toml:"disablequerycontextcache"`
 	IncludeRetryReason             *bool               `toml:"includeretryreason"`
 	DisableConsoleLogin            *bool               `toml:"disableconsolelogin"`
+	WorkloadIdentityProvider       *string             `toml:"workloadidentityprovider"`
+	WorkloadIdentityEntraResource  *string             `toml:"workloadidentityentraresource"`
+	OidcTokenFilePath              *string             `toml:"oidctokenfilepath"`
 }
 
 func (c *LegacyConfigDTO) DriverConfig() (gosnowflake.Config, error) {
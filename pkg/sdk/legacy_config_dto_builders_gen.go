@@ -204,3 +204,18 @@ func (s *LegacyConfigDTO) WithDisableConsoleLogin(DisableConsoleLogin bool) *Leg
 	s.DisableConsoleLogin = &DisableConsoleLogin
 	return s
 }
+
+func (s *LegacyConfigDTO) WithWorkloadIdentityProvider(WorkloadIdentityProvider string) *LegacyConfigDTO {
+	s.WorkloadIdentityProvider = &WorkloadIdentityProvider
+	return s
+}
+
+func (s *LegacyConfigDTO) WithWorkloadIdentityEntraResource(WorkloadIdentityEntraResource string) *LegacyConfigDTO {
+	s.WorkloadIdentityEntraResource = &WorkloadIdentityEntraResource
+	return s
+}
+
+func (s *LegacyConfigDTO) WithOidcTokenFilePath(OidcTokenFilePath string) *LegacyConfigDTO {
+	s.OidcTokenFilePath = &OidcTokenFilePath
+	return s
+}
@@ -76,11 +76,13 @@ const (
 	ObjectTypeHybridTable          ObjectType = "HYBRID TABLE"
 	ObjectTypeImageRepository      ObjectType = "IMAGE REPOSITORY"
 	ObjectTypeProjectionPolicy     ObjectType = "PROJECTION POLICY"
+	ObjectTypeJoinPolicy           ObjectType = "JOIN POLICY"
 	ObjectTypeDataMetricFunction   ObjectType = "DATA METRIC FUNCTION"
 	ObjectTypeGitRepository        ObjectType = "GIT REPOSITORY"
 	ObjectTypeModel                ObjectType = "MODEL"
 	ObjectTypeService              ObjectType = "SERVICE"
 	ObjectTypeStorageIntegration   ObjectType = "STORAGE INTEGRATION"
+	ObjectTypeSemanticView         ObjectType = "SEMANTIC VIEW"
 	// ObjectTypeProgrammaticAccessToken is a pseudo-object, as it does not support the usual operations in Snowflake, but it is handled by user functions.
 	// Programmatic access tokens do not have grants and cannot be tagged.
 	ObjectTypeProgrammaticAccessToken ObjectType = "PROGRAMMATIC ACCESS TOKEN" //nolint:gosec
@@ -156,11 +158,13 @@ var allObjectTypes = []ObjectType{
 	ObjectTypeHybridTable,
 	ObjectTypeImageRepository,
 	ObjectTypeProjectionPolicy,
+	ObjectTypeJoinPolicy,
 	ObjectTypeDataMetricFunction,
 	ObjectTypeGitRepository,
 	ObjectTypeModel,
 	ObjectTypeService,
 	ObjectTypeStorageIntegration,
+	ObjectTypeSemanticView,
 	ObjectTypeProgrammaticAccessToken,
 }
 
@@ -234,12 +238,14 @@ func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 		ObjectTypeHybridTable:             PluralObjectTypeHybridTables,
 		ObjectTypeImageRepository:         PluralObjectTypeImageRepositories,
 		ObjectTypeProjectionPolicy:        PluralObjectTypeProjectionPolicies,
+		ObjectTypeJoinPolicy:              PluralObjectTypeJoinPolicies,
 		ObjectTypeDataMetricFunction:      PluralObjectTypeDataMetricFunctions,
 		ObjectTypeGitRepository:           PluralObjectTypeGitRepositories,
 		ObjectTypeModel:                   PluralObjectTypeModels,
 		ObjectTypeService:                 PluralObjectTypeServices,
 		ObjectTypeProgrammaticAccessToken: PluralObjectTypeProgrammaticAccessTokens,
 		ObjectTypeStorageIntegration:      PluralObjectTypeStorageIntegrations,
+		ObjectTypeSemanticView:            PluralObjectTypeSemanticViews,
 	}
 }
 
@@ -347,12 +353,14 @@ const (
 	PluralObjectTypeHybridTables             PluralObjectType = "HYBRID TABLES"
 	PluralObjectTypeImageRepositories        PluralObjectType = "IMAGE REPOSITORIES"
 	PluralObjectTypeProjectionPolicies       PluralObjectType = "PROJECTION POLICIES"
+	PluralObjectTypeJoinPolicies             PluralObjectType = "JOIN POLICIES"
 	PluralObjectTypeDataMetricFunctions      PluralObjectType = "DATA METRIC FUNCTIONS"
 	PluralObjectTypeGitRepositories          PluralObjectType = "GIT REPOSITORIES"
 	PluralObjectTypeModels                   PluralObjectType = "MODELS"
 	PluralObjectTypeServices                 PluralObjectType = "SERVICES"
 	PluralObjectTypeProgrammaticAccessTokens PluralObjectType = "PROGRAMMATIC ACCESS TOKENS" //nolint:gosec
 	PluralObjectTypeStorageIntegrations      PluralObjectType = "STORAGE INTEGRATIONS"
+	PluralObjectTypeSemanticViews            PluralObjectType = "SEMANTIC VIEWS"
 )
 
 func (p PluralObjectType) String() string {
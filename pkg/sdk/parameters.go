@@ -2145,6 +2145,7 @@ const (
 	ParameterTypeDatabase         ParameterType = "DATABASE"
 	ParameterTypeSchema           ParameterType = "SCHEMA"
 	ParameterTypeTask             ParameterType = "TASK"
+	ParameterTypeTable            ParameterType = "TABLE"
 	ParameterTypeFunction         ParameterType = "FUNCTION"
 	ParameterTypeProcedure        ParameterType = "PROCEDURE"
 )
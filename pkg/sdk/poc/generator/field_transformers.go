@@ -93,6 +93,7 @@ type ParameterTransformer struct {
 	quotes      string
 	parentheses string
 	equals      string
+	reverse     string
 }
 
 func ParameterOptions() *ParameterTransformer {
@@ -159,6 +160,11 @@ func (v *ParameterTransformer) MustParentheses() *ParameterTransformer {
 	return v
 }
 
+func (v *ParameterTransformer) Reverse() *ParameterTransformer {
+	v.reverse = "reverse"
+	return v
+}
+
 func (v *ParameterTransformer) Transform(f *Field) *Field {
 	addTagIfMissing(f.Tags, "ddl", "parameter")
 	if v.required {
@@ -168,6 +174,7 @@ func (v *ParameterTransformer) Transform(f *Field) *Field {
 	addTagIfMissing(f.Tags, "ddl", v.quotes)
 	addTagIfMissing(f.Tags, "ddl", v.parentheses)
 	addTagIfMissing(f.Tags, "ddl", v.equals)
+	addTagIfMissing(f.Tags, "ddl", v.reverse)
 	return f
 }
 
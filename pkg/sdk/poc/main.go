@@ -56,6 +56,15 @@ var definitionMapping = map[string]*generator.Interface{
 	"user_programmatic_access_tokens_def.go": sdk.UserProgrammaticAccessTokensDef,
 	"listings_def.go":                        sdk.ListingsDef,
 	"organization_accounts_def.go":           sdk.OrganizationAccountsDef,
+	"data_metric_functions_def.go":           sdk.DataMetricFunctionsDef,
+	"external_access_integrations_def.go":    sdk.ExternalAccessIntegrationsDef,
+	"catalog_integrations_def.go":            sdk.CatalogIntegrationsDef,
+	"iceberg_tables_def.go":                  sdk.IcebergTablesDef,
+	"aggregation_policies_def.go":            sdk.AggregationPoliciesDef,
+	"projection_policies_def.go":             sdk.ProjectionPoliciesDef,
+	"join_policies_def.go":                   sdk.JoinPoliciesDef,
+	"semantic_views_def.go":                  sdk.SemanticViewsDef,
+	"hybrid_tables_def.go":                   sdk.HybridTablesDef,
 }
 
 func main() {
@@ -74,6 +74,7 @@ const (
 	PolicyKindAggregationPolicy    PolicyKind = "AGGREGATION_POLICY"
 	PolicyKindAuthenticationPolicy PolicyKind = "AUTHENTICATION_POLICY"
 	PolicyKindFeaturePolicy        PolicyKind = "FEATURE_POLICY"
+	PolicyKindJoinPolicy           PolicyKind = "JOIN_POLICY"
 	PolicyKindMaskingPolicy        PolicyKind = "MASKING_POLICY"
 	PolicyKindPackagesPolicy       PolicyKind = "PACKAGES_POLICY"
 	PolicyKindPasswordPolicy       PolicyKind = "PASSWORD_POLICY"
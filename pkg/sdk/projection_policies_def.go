@@ -0,0 +1,103 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var ProjectionPoliciesDef = g.NewInterface(
+	"ProjectionPolicies",
+	"ProjectionPolicy",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-projection-policy",
+		g.NewQueryStruct("CreateProjectionPolicy").
+			Create().
+			OrReplace().
+			SQL("PROJECTION POLICY").
+			IfNotExists().
+			Name().
+			SQLWithCustomFieldName("returnsProjectionConstraint", "AS () RETURNS PROJECTION_CONSTRAINT").
+			BodyWithPrecedingArrow().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-projection-policy",
+		g.NewQueryStruct("AlterProjectionPolicy").
+			Alter().
+			SQL("PROJECTION POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-projection-policy",
+		g.NewQueryStruct("DropProjectionPolicy").
+			Drop().
+			SQL("PROJECTION POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-projection-policies",
+		g.DbStruct("projectionPolicyDBRow").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			OptionalText("comment").
+			Text("options").
+			Text("owner_role_type"),
+		g.PlainStruct("ProjectionPolicy").
+			Text("CreatedOn").
+			Text("Name").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Kind").
+			Text("Owner").
+			Text("Comment").
+			Text("Options").
+			Text("OwnerRoleType"),
+		g.NewQueryStruct("ShowProjectionPolicies").
+			Show().
+			SQL("PROJECTION POLICIES").
+			OptionalLike().
+			OptionalExtendedIn().
+			OptionalLimitFrom(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDExtendedInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-projection-policy",
+		g.DbStruct("describeProjectionPolicyDBRow").
+			Field("name", "string").
+			Field("signature", "string").
+			Field("return_type", "string").
+			Field("body", "string"),
+		g.PlainStruct("ProjectionPolicyDescription").
+			Field("Name", "string").
+			Field("Signature", "string").
+			Field("ReturnType", "string").
+			Field("Body", "string"),
+		g.NewQueryStruct("DescribeProjectionPolicy").
+			Describe().
+			SQL("PROJECTION POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
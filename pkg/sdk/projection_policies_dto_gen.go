@@ -0,0 +1,45 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateProjectionPolicyOptions]   = new(CreateProjectionPolicyRequest)
+	_ optionsProvider[AlterProjectionPolicyOptions]    = new(AlterProjectionPolicyRequest)
+	_ optionsProvider[DropProjectionPolicyOptions]     = new(DropProjectionPolicyRequest)
+	_ optionsProvider[ShowProjectionPolicyOptions]     = new(ShowProjectionPolicyRequest)
+	_ optionsProvider[DescribeProjectionPolicyOptions] = new(DescribeProjectionPolicyRequest)
+)
+
+type CreateProjectionPolicyRequest struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	name        SchemaObjectIdentifier // required
+	body        string                 // required
+	Comment     *string
+}
+
+type AlterProjectionPolicyRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier // required
+	RenameTo     *SchemaObjectIdentifier
+	SetBody      *string
+	SetTags      []TagAssociation
+	UnsetTags    []ObjectIdentifier
+	SetComment   *string
+	UnsetComment *bool
+}
+
+type DropProjectionPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowProjectionPolicyRequest struct {
+	Like  *Like
+	In    *ExtendedIn
+	Limit *LimitFrom
+}
+
+type DescribeProjectionPolicyRequest struct {
+	name SchemaObjectIdentifier // required
+}
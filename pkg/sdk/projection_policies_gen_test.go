@@ -0,0 +1,230 @@
+package sdk
+
+import "testing"
+
+func TestProjectionPolicies_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateProjectionPolicyOptions
+	defaultOpts := func() *CreateProjectionPolicyOptions {
+		return &CreateProjectionPolicyOptions{
+			name: id,
+			body: "PROJECTION_CONSTRAINT(ALLOW => false)",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.body] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.body = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateProjectionPolicyOptions", "body"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateProjectionPolicyOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => false)", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => false) COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+}
+
+func TestProjectionPolicies_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterProjectionPolicyOptions
+	defaultOpts := func() *AlterProjectionPolicyOptions {
+		return &AlterProjectionPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetBody opts.SetTags opts.UnsetTags opts.SetComment opts.UnsetComment] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterProjectionPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetBody opts.SetTags opts.UnsetTags opts.SetComment opts.UnsetComment] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterProjectionPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set body", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetBody = String("PROJECTION_CONSTRAINT(ALLOW => false)")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s SET BODY -> PROJECTION_CONSTRAINT(ALLOW => false)", id.FullyQualifiedName())
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s SET COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetComment = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("tag2"),
+				Value: "value2",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROJECTION POLICY %s SET TAG "tag1" = 'value1', "tag2" = 'value2'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+			NewAccountObjectIdentifier("tag2"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROJECTION POLICY %s UNSET TAG "tag1", "tag2"`, id.FullyQualifiedName())
+	})
+}
+
+func TestProjectionPolicies_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropProjectionPolicyOptions
+	defaultOpts := func() *DropProjectionPolicyOptions {
+		return &DropProjectionPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP PROJECTION POLICY %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP PROJECTION POLICY IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestProjectionPolicies_Show(t *testing.T) {
+	// Minimal valid ShowProjectionPolicyOptions
+	defaultOpts := func() *ShowProjectionPolicyOptions {
+		return &ShowProjectionPolicyOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PROJECTION POLICIES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &ExtendedIn{
+			In: In{
+				Account: Bool(true),
+			},
+		}
+		opts.Limit = &LimitFrom{
+			Rows: Pointer(10),
+			From: Pointer("foo"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PROJECTION POLICIES LIKE 'pattern' IN ACCOUNT LIMIT 10 FROM 'foo'")
+	})
+}
+
+func TestProjectionPolicies_Describe(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeProjectionPolicyOptions
+	defaultOpts := func() *DescribeProjectionPolicyOptions {
+		return &DescribeProjectionPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE PROJECTION POLICY %s", id.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ ProjectionPolicies = (*projectionPolicies)(nil)
+
+type projectionPolicies struct {
+	client *Client
+}
+
+func (v *projectionPolicies) Create(ctx context.Context, request *CreateProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Alter(ctx context.Context, request *AlterProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Drop(ctx context.Context, request *DropProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropProjectionPolicyRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *projectionPolicies) Show(ctx context.Context, request *ShowProjectionPolicyRequest) ([]ProjectionPolicy, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[projectionPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[projectionPolicyDBRow, ProjectionPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *projectionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error) {
+	request := NewShowProjectionPolicyRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}})
+	projectionPolicies, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(projectionPolicies, func(r ProjectionPolicy) bool { return r.Name == id.Name() })
+}
+
+func (v *projectionPolicies) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *projectionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicyDescription, error) {
+	opts := &DescribeProjectionPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describeProjectionPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateProjectionPolicyRequest) toOpts() *CreateProjectionPolicyOptions {
+	opts := &CreateProjectionPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		body:        r.body,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterProjectionPolicyRequest) toOpts() *AlterProjectionPolicyOptions {
+	opts := &AlterProjectionPolicyOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		RenameTo:     r.RenameTo,
+		SetBody:      r.SetBody,
+		SetTags:      r.SetTags,
+		UnsetTags:    r.UnsetTags,
+		SetComment:   r.SetComment,
+		UnsetComment: r.UnsetComment,
+	}
+	return opts
+}
+
+func (r *DropProjectionPolicyRequest) toOpts() *DropProjectionPolicyOptions {
+	opts := &DropProjectionPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowProjectionPolicyRequest) toOpts() *ShowProjectionPolicyOptions {
+	opts := &ShowProjectionPolicyOptions{
+		Like:  r.Like,
+		In:    r.In,
+		Limit: r.Limit,
+	}
+	return opts
+}
+
+func (r projectionPolicyDBRow) convert() *ProjectionPolicy {
+	projectionPolicy := &ProjectionPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Options:       r.Options,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	if r.Comment.Valid {
+		projectionPolicy.Comment = r.Comment.String
+	}
+	return projectionPolicy
+}
+
+func (r *DescribeProjectionPolicyRequest) toOpts() *DescribeProjectionPolicyOptions {
+	opts := &DescribeProjectionPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeProjectionPolicyDBRow) convert() *ProjectionPolicyDescription {
+	return &ProjectionPolicyDescription{
+		Name:       r.Name,
+		Signature:  r.Signature,
+		ReturnType: r.ReturnType,
+		Body:       r.Body,
+	}
+}
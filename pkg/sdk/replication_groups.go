@@ -1,4 +1,585 @@
 package sdk
 
-// note: once Replication Groups is implemented, Databases Integration test for CreateSecondary needs to be implemented
-// also: TestInt_AlterReplication
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ ReplicationGroups = (*replicationGroups)(nil)
+
+var (
+	_ validatable = new(CreateReplicationGroupOptions)
+	_ validatable = new(CreateReplicaReplicationGroupOptions)
+	_ validatable = new(AlterSourceReplicationGroupOptions)
+	_ validatable = new(AlterTargetReplicationGroupOptions)
+	_ validatable = new(DropReplicationGroupOptions)
+	_ validatable = new(ShowReplicationGroupOptions)
+	_ validatable = new(showReplicationGroupDatabasesOptions)
+	_ validatable = new(showReplicationGroupSharesOptions)
+)
+
+type ReplicationGroups interface {
+	Create(ctx context.Context, id AccountObjectIdentifier, objectTypes []PluralObjectType, allowedAccounts []AccountIdentifier, opts *CreateReplicationGroupOptions) error
+	CreateReplica(ctx context.Context, id AccountObjectIdentifier, primaryReplicationGroupID ExternalObjectIdentifier, opts *CreateReplicaReplicationGroupOptions) error
+	AlterSource(ctx context.Context, id AccountObjectIdentifier, opts *AlterSourceReplicationGroupOptions) error
+	AlterTarget(ctx context.Context, id AccountObjectIdentifier, opts *AlterTargetReplicationGroupOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropReplicationGroupOptions) error
+	DropSafely(ctx context.Context, id AccountObjectIdentifier) error
+	Show(ctx context.Context, opts *ShowReplicationGroupOptions) ([]ReplicationGroup, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error)
+	ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error)
+	ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error)
+	ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error)
+}
+
+// replicationGroups implements ReplicationGroups.
+type replicationGroups struct {
+	client *Client
+}
+
+// CreateReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.
+type CreateReplicationGroupOptions struct {
+	create           bool                    `ddl:"static" sql:"CREATE"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfNotExists      *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+
+	objectTypes             []PluralObjectType        `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedDatabases        []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_DATABASES"`
+	AllowedShares           []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_SHARES"`
+	AllowedIntegrationTypes []IntegrationType         `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	allowedAccounts         []AccountIdentifier       `ddl:"parameter" sql:"ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck      *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+	ReplicationSchedule     *string                   `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (opts *CreateReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if len(opts.objectTypes) == 0 {
+		errs = append(errs, errNotSet("CreateReplicationGroupOptions", "objectTypes"))
+	}
+	if len(opts.allowedAccounts) == 0 {
+		errs = append(errs, errNotSet("CreateReplicationGroupOptions", "allowedAccounts"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *replicationGroups) Create(ctx context.Context, id AccountObjectIdentifier, objectTypes []PluralObjectType, allowedAccounts []AccountIdentifier, opts *CreateReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &CreateReplicationGroupOptions{}
+	}
+	opts.name = id
+	opts.allowedAccounts = allowedAccounts
+	opts.objectTypes = objectTypes
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// CreateReplicaReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.
+type CreateReplicaReplicationGroupOptions struct {
+	create                  bool                     `ddl:"static" sql:"CREATE"`
+	replicationGroup        bool                     `ddl:"static" sql:"REPLICATION GROUP"`
+	IfNotExists             *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                    AccountObjectIdentifier  `ddl:"identifier"`
+	primaryReplicationGroup ExternalObjectIdentifier `ddl:"identifier" sql:"AS REPLICA OF"`
+}
+
+func (opts *CreateReplicaReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.primaryReplicationGroup) {
+		errs = append(errs, errInvalidIdentifier("CreateReplicaReplicationGroupOptions", "primaryReplicationGroup"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *replicationGroups) CreateReplica(ctx context.Context, id AccountObjectIdentifier, primaryReplicationGroupID ExternalObjectIdentifier, opts *CreateReplicaReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &CreateReplicaReplicationGroupOptions{}
+	}
+	opts.name = id
+	opts.primaryReplicationGroup = primaryReplicationGroupID
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterSourceReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group.
+type AlterSourceReplicationGroupOptions struct {
+	alter            bool                    `ddl:"static" sql:"ALTER"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+	NewName          AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set              *ReplicationGroupSet    `ddl:"keyword" sql:"SET"`
+	Unset            *ReplicationGroupUnset  `ddl:"list,no_parentheses" sql:"UNSET"`
+	Add              *ReplicationGroupAdd    `ddl:"keyword" sql:"ADD"`
+	Remove           *ReplicationGroupRemove `ddl:"keyword" sql:"REMOVE"`
+}
+
+func (opts *AlterSourceReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.Add, opts.Remove, opts.NewName) {
+		errs = append(errs, errExactlyOneOf("AlterSourceReplicationGroupOptions", "Set", "Unset", "Add", "Remove", "NewName"))
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Add) {
+		if err := opts.Add.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Remove) {
+		if err := opts.Remove.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type ReplicationGroupSet struct {
+	ObjectTypes             []PluralObjectType `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedIntegrationTypes []IntegrationType  `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	ReplicationSchedule     *string            `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (v *ReplicationGroupSet) validate() error {
+	var errs []error
+	if len(v.ObjectTypes) == 0 && len(v.AllowedIntegrationTypes) == 0 && v.ReplicationSchedule == nil {
+		errs = append(errs, errAtLeastOneOf("ReplicationGroupSet", "ObjectTypes", "AllowedIntegrationTypes", "ReplicationSchedule"))
+	}
+	// INTEGRATIONS must be set in object types
+	if len(v.AllowedIntegrationTypes) > 0 && !slices.Contains(v.ObjectTypes, PluralObjectTypeIntegrations) {
+		errs = append(errs, errors.New("INTEGRATIONS must be set in OBJECT_TYPES when setting allowed integration types"))
+	}
+	return errors.Join(errs...)
+}
+
+type ReplicationGroupUnset struct {
+	ReplicationSchedule *bool `ddl:"keyword" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (v *ReplicationGroupUnset) validate() error {
+	if everyValueNil(v.ReplicationSchedule) {
+		return errAtLeastOneOf("ReplicationGroupUnset", "ReplicationSchedule")
+	}
+	return nil
+}
+
+type ReplicationGroupAdd struct {
+	AllowedDatabases   []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_DATABASES"`
+	AllowedShares      []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_SHARES"`
+	AllowedAccounts    []AccountIdentifier       `ddl:"parameter,reverse" sql:"TO ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+}
+
+func (v *ReplicationGroupAdd) validate() error {
+	if !exactlyOneValueSet(v.AllowedDatabases, v.AllowedShares, v.AllowedAccounts) {
+		return errExactlyOneOf("ReplicationGroupAdd", "AllowedDatabases", "AllowedShares", "AllowedAccounts")
+	}
+	return nil
+}
+
+type ReplicationGroupRemove struct {
+	AllowedDatabases []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"FROM ALLOWED_DATABASES"`
+	AllowedShares    []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"FROM ALLOWED_SHARES"`
+	AllowedAccounts  []AccountIdentifier       `ddl:"parameter,reverse" sql:"FROM ALLOWED_ACCOUNTS"`
+}
+
+func (v *ReplicationGroupRemove) validate() error {
+	if !exactlyOneValueSet(v.AllowedDatabases, v.AllowedShares, v.AllowedAccounts) {
+		return errExactlyOneOf("ReplicationGroupRemove", "AllowedDatabases", "AllowedShares", "AllowedAccounts")
+	}
+	return nil
+}
+
+func (v *replicationGroups) AlterSource(ctx context.Context, id AccountObjectIdentifier, opts *AlterSourceReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &AlterSourceReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterTargetReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group.
+type AlterTargetReplicationGroupOptions struct {
+	alter            bool                    `ddl:"static" sql:"ALTER"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+	Refresh          *bool                   `ddl:"keyword" sql:"REFRESH"`
+	Suspend          *bool                   `ddl:"keyword" sql:"SUSPEND"`
+	Resume           *bool                   `ddl:"keyword" sql:"RESUME"`
+}
+
+func (opts *AlterTargetReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Refresh, opts.Suspend, opts.Resume) {
+		errs = append(errs, errExactlyOneOf("AlterTargetReplicationGroupOptions", "Refresh", "Suspend", "Resume"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *replicationGroups) AlterTarget(ctx context.Context, id AccountObjectIdentifier, opts *AlterTargetReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &AlterTargetReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-replication-group.
+type DropReplicationGroupOptions struct {
+	drop             bool                    `ddl:"static" sql:"DROP"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *replicationGroups) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &DropReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *replicationGroups) DropSafely(ctx context.Context, id AccountObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, id, &DropReplicationGroupOptions{IfExists: Bool(true)}) }, ctx, id)
+}
+
+// ShowReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-replication-groups.
+type ShowReplicationGroupOptions struct {
+	show              bool              `ddl:"static" sql:"SHOW"`
+	replicationGroups bool              `ddl:"static" sql:"REPLICATION GROUPS"`
+	InAccount         AccountIdentifier `ddl:"identifier" sql:"IN ACCOUNT"`
+}
+
+func (opts *ShowReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	return nil
+}
+
+type ReplicationGroupSecondaryState string
+
+const (
+	ReplicationGroupSecondaryStateSuspended ReplicationGroupSecondaryState = "SUSPENDED"
+	ReplicationGroupSecondaryStateStarted   ReplicationGroupSecondaryState = "STARTED"
+	ReplicationGroupSecondaryStateNull      ReplicationGroupSecondaryState = "NULL"
+)
+
+// ReplicationGroup is a user friendly result for a SHOW REPLICATION GROUPS query.
+type ReplicationGroup struct {
+	RegionGroup             string
+	SnowflakeRegion         string
+	CreatedOn               time.Time
+	AccountName             string
+	Name                    string
+	Type                    string
+	Comment                 string
+	IsPrimary               bool
+	Primary                 ExternalObjectIdentifier
+	ObjectTypes             []PluralObjectType
+	AllowedIntegrationTypes []IntegrationType
+	AllowedAccounts         []AccountIdentifier
+	OrganizationName        string
+	AccountLocator          string
+	ReplicationSchedule     string
+	SecondaryState          ReplicationGroupSecondaryState
+	NextScheduledRefresh    string
+	Owner                   string
+}
+
+func (v *ReplicationGroup) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ReplicationGroup) ExternalID() ExternalObjectIdentifier {
+	return NewExternalObjectIdentifier(AccountIdentifier{
+		organizationName: v.OrganizationName,
+		accountName:      v.AccountName,
+		accountLocator:   v.AccountLocator,
+	}, v.ID())
+}
+
+func (v *ReplicationGroup) ObjectType() ObjectType {
+	return ObjectTypeReplicationGroup
+}
+
+// replicationGroupDBRow is used to decode the result of a SHOW REPLICATION GROUPS query.
+type replicationGroupDBRow struct {
+	RegionGroup             string         `db:"region_group"`
+	SnowflakeRegion         string         `db:"snowflake_region"`
+	CreatedOn               time.Time      `db:"created_on"`
+	AccountName             string         `db:"account_name"`
+	Name                    string         `db:"name"`
+	Type                    string         `db:"type"`
+	Comment                 sql.NullString `db:"comment"`
+	IsPrimary               bool           `db:"is_primary"`
+	Primary                 string         `db:"primary"`
+	ObjectTypes             string         `db:"object_types"`
+	AllowedIntegrationTypes string         `db:"allowed_integration_types"`
+	AllowedAccounts         string         `db:"allowed_accounts"`
+	OrganizationName        string         `db:"organization_name"`
+	AccountLocator          string         `db:"account_locator"`
+	ReplicationSchedule     sql.NullString `db:"replication_schedule"`
+	SecondaryState          sql.NullString `db:"secondary_state"`
+	NextScheduledRefresh    sql.NullString `db:"next_scheduled_refresh"`
+	Owner                   sql.NullString `db:"owner"`
+}
+
+func (row replicationGroupDBRow) convert() *ReplicationGroup {
+	ots := strings.Split(row.ObjectTypes, ",")
+	pluralObjectTypes := make([]PluralObjectType, 0, len(ots))
+	for _, ot := range ots {
+		pot := PluralObjectType(strings.TrimSpace(ot))
+		if pot == "" {
+			continue
+		}
+		if pot == PluralObjectTypeParameters {
+			pluralObjectTypes = append(pluralObjectTypes, PluralObjectType("ACCOUNT PARAMETERS"))
+		} else {
+			pluralObjectTypes = append(pluralObjectTypes, pot)
+		}
+	}
+	its := strings.Split(row.AllowedIntegrationTypes, ",")
+	allowedIntegrationTypes := make([]IntegrationType, 0, len(its))
+	for _, it := range its {
+		if it == "" {
+			continue
+		}
+		allowedIntegrationTypes = append(allowedIntegrationTypes, IntegrationType(strings.ReplaceAll(strings.TrimSpace(it), "_", " ")+" INTEGRATIONS"))
+	}
+	aas := strings.Split(row.AllowedAccounts, ",")
+	allowedAccounts := make([]AccountIdentifier, 0, len(aas))
+	for _, aa := range aas {
+		p := strings.Split(strings.TrimSpace(aa), ".")
+		if len(p) != 2 {
+			continue
+		}
+		allowedAccounts = append(allowedAccounts, NewAccountIdentifier(p[0], p[1]))
+	}
+	secondaryState := ReplicationGroupSecondaryStateNull
+	if row.SecondaryState.Valid {
+		secondaryState = ReplicationGroupSecondaryState(row.SecondaryState.String)
+	}
+	return &ReplicationGroup{
+		RegionGroup:             row.RegionGroup,
+		SnowflakeRegion:         row.SnowflakeRegion,
+		CreatedOn:               row.CreatedOn,
+		AccountName:             row.AccountName,
+		OrganizationName:        row.OrganizationName,
+		AccountLocator:          row.AccountLocator,
+		Name:                    row.Name,
+		Type:                    row.Type,
+		Comment:                 row.Comment.String,
+		IsPrimary:               row.IsPrimary,
+		Primary:                 NewExternalObjectIdentifierFromFullyQualifiedName(row.Primary),
+		ObjectTypes:             pluralObjectTypes,
+		AllowedIntegrationTypes: allowedIntegrationTypes,
+		AllowedAccounts:         allowedAccounts,
+		ReplicationSchedule:     row.ReplicationSchedule.String,
+		SecondaryState:          secondaryState,
+		NextScheduledRefresh:    row.NextScheduledRefresh.String,
+		Owner:                   row.Owner.String,
+	}
+}
+
+func (v *replicationGroups) Show(ctx context.Context, opts *ShowReplicationGroupOptions) ([]ReplicationGroup, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[replicationGroupDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[replicationGroupDBRow, ReplicationGroup](dbRows), nil
+}
+
+func (v *replicationGroups) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error) {
+	currentAccount, err := v.client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replicationGroups, err := v.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return collections.FindFirst(replicationGroups, func(group ReplicationGroup) bool {
+		return group.ID().FullyQualifiedName() == id.FullyQualifiedName() && group.AccountLocator == currentAccount
+	})
+}
+
+func (v *replicationGroups) ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+// showReplicationGroupDatabasesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-databases-in-replication-group.
+type showReplicationGroupDatabasesOptions struct {
+	show      bool                    `ddl:"static" sql:"SHOW"`
+	databases bool                    `ddl:"static" sql:"DATABASES"`
+	in        AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupDatabasesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.in) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupDatabasesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewAccountObjectIdentifier(row.Name)
+	}
+	return resultList, nil
+}
+
+// showReplicationGroupSharesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-shares-in-replication-group.
+type showReplicationGroupSharesOptions struct {
+	show   bool                    `ddl:"static" sql:"SHOW"`
+	shares bool                    `ddl:"static" sql:"SHARES"`
+	in     AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupSharesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.in) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupSharesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewAccountObjectIdentifier(row.Name)
+	}
+	return resultList, nil
+}
@@ -0,0 +1,268 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestReplicationGroupsCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("rg1")
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name:            emptyAccountObjectIdentifier,
+			objectTypes:     []PluralObjectType{PluralObjectTypeDatabases},
+			allowedAccounts: []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: object types and allowed accounts not set", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name: id,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateReplicationGroupOptions", "objectTypes"), errNotSet("CreateReplicationGroupOptions", "allowedAccounts"))
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			objectTypes: []PluralObjectType{
+				PluralObjectTypeShares,
+				PluralObjectTypeDatabases,
+			},
+			AllowedDatabases: []AccountObjectIdentifier{
+				NewAccountObjectIdentifier("db1"),
+			},
+			AllowedShares: []AccountObjectIdentifier{
+				NewAccountObjectIdentifier("share1"),
+			},
+			allowedAccounts: []AccountIdentifier{
+				NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"),
+			},
+			IgnoreEditionCheck:  Bool(true),
+			ReplicationSchedule: String("10 MINUTE"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP IF NOT EXISTS "rg1" OBJECT_TYPES = SHARES, DATABASES ALLOWED_DATABASES = "db1" ALLOWED_SHARES = "share1" ALLOWED_ACCOUNTS = "MY_ORG"."MY_ACCOUNT" IGNORE EDITION CHECK REPLICATION_SCHEDULE = '10 MINUTE'`)
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name: id,
+			objectTypes: []PluralObjectType{
+				PluralObjectTypeDatabases,
+			},
+			allowedAccounts: []AccountIdentifier{
+				NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP "rg1" OBJECT_TYPES = DATABASES ALLOWED_ACCOUNTS = "MY_ORG"."MY_ACCOUNT"`)
+	})
+}
+
+func TestReplicationGroupsCreateReplica(t *testing.T) {
+	t.Run("validation: invalid primary identifier", func(t *testing.T) {
+		opts := &CreateReplicaReplicationGroupOptions{
+			name:                    NewAccountObjectIdentifier("rg1"),
+			primaryReplicationGroup: NewExternalObjectIdentifier(NewAccountIdentifier("myorg", "myaccount"), emptyAccountObjectIdentifier),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("CreateReplicaReplicationGroupOptions", "primaryReplicationGroup"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := &CreateReplicaReplicationGroupOptions{
+			IfNotExists:             Bool(true),
+			name:                    NewAccountObjectIdentifier("rg1"),
+			primaryReplicationGroup: NewExternalObjectIdentifierFromFullyQualifiedName("myorg.myaccount.rg1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP IF NOT EXISTS "rg1" AS REPLICA OF "myorg"."myaccount"."rg1"`)
+	})
+}
+
+func TestReplicationGroupsAlterSource(t *testing.T) {
+	id := NewAccountObjectIdentifier("rg1")
+
+	t.Run("validation: no alter option", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSourceReplicationGroupOptions", "Set", "Unset", "Add", "Remove", "NewName"))
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Set:  &ReplicationGroupSet{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("ReplicationGroupSet", "ObjectTypes", "AllowedIntegrationTypes", "ReplicationSchedule"))
+	})
+
+	t.Run("validation: empty unset", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name:  id,
+			Unset: &ReplicationGroupUnset{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("ReplicationGroupUnset", "ReplicationSchedule"))
+	})
+
+	t.Run("validation: add databases and shares at once", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Add: &ReplicationGroupAdd{
+				AllowedDatabases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+				AllowedShares:    []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ReplicationGroupAdd", "AllowedDatabases", "AllowedShares", "AllowedAccounts"))
+	})
+
+	t.Run("validation: empty remove", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name:   id,
+			Remove: &ReplicationGroupRemove{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ReplicationGroupRemove", "AllowedDatabases", "AllowedShares", "AllowedAccounts"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name:    id,
+			NewName: NewAccountObjectIdentifier("rg2"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" RENAME TO "rg2"`)
+	})
+
+	t.Run("set object types and replication schedule", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Set: &ReplicationGroupSet{
+				ObjectTypes:         []PluralObjectType{PluralObjectTypeDatabases, PluralObjectTypeShares},
+				ReplicationSchedule: String("USING CRON 0 0 * * * UTC"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" SET OBJECT_TYPES = DATABASES, SHARES REPLICATION_SCHEDULE = 'USING CRON 0 0 * * * UTC'`)
+	})
+
+	t.Run("unset replication schedule", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Unset: &ReplicationGroupUnset{
+				ReplicationSchedule: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" UNSET REPLICATION_SCHEDULE`)
+	})
+
+	t.Run("add databases", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Add: &ReplicationGroupAdd{
+				AllowedDatabases: []AccountObjectIdentifier{
+					NewAccountObjectIdentifier("db1"),
+					NewAccountObjectIdentifier("db2"),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" ADD "db1", "db2" TO ALLOWED_DATABASES`)
+	})
+
+	t.Run("add accounts with ignore edition check", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Add: &ReplicationGroupAdd{
+				AllowedAccounts: []AccountIdentifier{
+					NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"),
+				},
+				IgnoreEditionCheck: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" ADD "MY_ORG"."MY_ACCOUNT" TO ALLOWED_ACCOUNTS IGNORE EDITION CHECK`)
+	})
+
+	t.Run("remove shares", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Remove: &ReplicationGroupRemove{
+				AllowedShares: []AccountObjectIdentifier{
+					NewAccountObjectIdentifier("share1"),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" REMOVE "share1" FROM ALLOWED_SHARES`)
+	})
+}
+
+func TestReplicationGroupsAlterTarget(t *testing.T) {
+	id := NewAccountObjectIdentifier("rg1")
+
+	t.Run("validation: more than one option", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:    id,
+			Suspend: Bool(true),
+			Resume:  Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterTargetReplicationGroupOptions", "Refresh", "Suspend", "Resume"))
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:    id,
+			Refresh: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" REFRESH`)
+	})
+
+	t.Run("suspend", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:     id,
+			IfExists: Bool(true),
+			Suspend:  Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP IF EXISTS "rg1" SUSPEND`)
+	})
+}
+
+func TestReplicationGroupsDrop(t *testing.T) {
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &DropReplicationGroupOptions{
+			name: emptyAccountObjectIdentifier,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("with IfExists", func(t *testing.T) {
+		opts := &DropReplicationGroupOptions{
+			name:     NewAccountObjectIdentifier("rg1"),
+			IfExists: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP REPLICATION GROUP IF EXISTS "rg1"`)
+	})
+}
+
+func TestReplicationGroupsShow(t *testing.T) {
+	t.Run("without show options", func(t *testing.T) {
+		opts := &ShowReplicationGroupOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION GROUPS`)
+	})
+
+	t.Run("in account", func(t *testing.T) {
+		opts := &ShowReplicationGroupOptions{
+			InAccount: NewAccountIdentifierFromAccountLocator("abcd123"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION GROUPS IN ACCOUNT "abcd123"`)
+	})
+}
+
+func TestReplicationGroupsShowDatabases(t *testing.T) {
+	opts := &showReplicationGroupDatabasesOptions{
+		in: NewAccountObjectIdentifier("rg1"),
+	}
+	assertOptsValidAndSQLEquals(t, opts, `SHOW DATABASES IN REPLICATION GROUP "rg1"`)
+}
+
+func TestReplicationGroupsShowShares(t *testing.T) {
+	opts := &showReplicationGroupSharesOptions{
+		in: NewAccountObjectIdentifier("rg1"),
+	}
+	assertOptsValidAndSQLEquals(t, opts, `SHOW SHARES IN REPLICATION GROUP "rg1"`)
+}
@@ -0,0 +1,144 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var semanticViewSynonymDef = g.NewQueryStruct("SemanticViewSynonym").
+	Text("Value", g.KeywordOptions().SingleQuotes().Required())
+
+var semanticViewUniqueKeyDef = g.NewQueryStruct("SemanticViewUniqueKey").
+	ListAssignment("UNIQUE", "Column", g.ParameterOptions().NoEquals().Parentheses().Required())
+
+var semanticViewTableDef = g.NewQueryStruct("SemanticViewTable").
+	PredefinedQueryStructField("TableAlias", "*string", g.ParameterOptions().SQL("AS").Reverse()).
+	Identifier("TableName", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+	ListAssignment("PRIMARY KEY", "Column", g.ParameterOptions().NoEquals().Parentheses()).
+	ListQueryStructField("UniqueKeys", semanticViewUniqueKeyDef, g.ListOptions().NoParentheses().NoComma()).
+	ListAssignment("WITH SYNONYMS", "SemanticViewSynonym", g.ParameterOptions().Parentheses()).
+	OptionalComment()
+
+var semanticViewRelationshipDef = g.NewQueryStruct("SemanticViewRelationship").
+	PredefinedQueryStructField("RelationshipIdentifier", "*string", g.ParameterOptions().SQL("AS").Reverse()).
+	Text("TableAlias", g.KeywordOptions().Required()).
+	List("Columns", "Column", g.ListOptions().Parentheses().Required()).
+	SQL("REFERENCES").
+	Text("RefTableAlias", g.KeywordOptions().Required()).
+	List("RefColumns", "Column", g.ListOptions().Parentheses())
+
+var semanticViewExpressionDef = g.NewQueryStruct("SemanticViewExpression").
+	Text("QualifiedExpressionName", g.KeywordOptions().Required()).
+	SQLWithCustomFieldName("as", "AS").
+	Text("SqlExpression", g.KeywordOptions().Required()).
+	ListAssignment("WITH SYNONYMS", "SemanticViewSynonym", g.ParameterOptions().Parentheses()).
+	OptionalComment()
+
+var semanticViewDbRow = g.DbStruct("semanticViewDBRow").
+	Text("created_on").
+	Text("name").
+	OptionalText("kind").
+	Text("database_name").
+	Text("schema_name").
+	OptionalText("comment").
+	Text("owner").
+	Text("owner_role_type").
+	OptionalText("extension")
+
+var semanticView = g.PlainStruct("SemanticView").
+	Text("CreatedOn").
+	Text("Name").
+	OptionalText("Kind").
+	Text("DatabaseName").
+	Text("SchemaName").
+	OptionalText("Comment").
+	Text("Owner").
+	Text("OwnerRoleType").
+	OptionalText("Extension")
+
+var semanticViewDetailsDbRow = g.DbStruct("semanticViewDetailsRow").
+	OptionalText("object_kind").
+	OptionalText("object_name").
+	OptionalText("parent_entity").
+	Text("property").
+	Text("property_value")
+
+var semanticViewDetails = g.PlainStruct("SemanticViewDetails").
+	OptionalText("ObjectKind").
+	OptionalText("ObjectName").
+	OptionalText("ParentEntity").
+	Text("Property").
+	Text("PropertyValue")
+
+var SemanticViewsDef = g.NewInterface(
+	"SemanticViews",
+	"SemanticView",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-semantic-view",
+		g.NewQueryStruct("CreateSemanticView").
+			Create().
+			OrReplace().
+			SQL("SEMANTIC VIEW").
+			IfNotExists().
+			Name().
+			ListQueryStructField("Tables", semanticViewTableDef, g.ParameterOptions().SQL("TABLES").NoEquals().Parentheses().Required()).
+			ListQueryStructField("Relationships", semanticViewRelationshipDef, g.ParameterOptions().SQL("RELATIONSHIPS").NoEquals().Parentheses()).
+			ListQueryStructField("Facts", semanticViewExpressionDef, g.ParameterOptions().SQL("FACTS").NoEquals().Parentheses()).
+			ListQueryStructField("Dimensions", semanticViewExpressionDef, g.ParameterOptions().SQL("DIMENSIONS").NoEquals().Parentheses()).
+			ListQueryStructField("Metrics", semanticViewExpressionDef, g.ParameterOptions().SQL("METRICS").NoEquals().Parentheses()).
+			OptionalComment().
+			OptionalCopyGrants().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		semanticViewSynonymDef,
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-semantic-view",
+		g.NewQueryStruct("AlterSemanticView").
+			Alter().
+			SQL("SEMANTIC VIEW").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-semantic-view",
+		g.NewQueryStruct("DropSemanticView").
+			Drop().
+			SQL("SEMANTIC VIEW").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-semantic-views",
+		semanticViewDbRow,
+		semanticView,
+		g.NewQueryStruct("ShowSemanticViews").
+			Show().
+			SQL("SEMANTIC VIEWS").
+			OptionalLike().
+			OptionalExtendedIn().
+			OptionalStartsWith().
+			OptionalLimitFrom(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDExtendedInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-semantic-view",
+		semanticViewDetailsDbRow,
+		semanticViewDetails,
+		g.NewQueryStruct("DescribeSemanticView").
+			Describe().
+			SQL("SEMANTIC VIEW").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
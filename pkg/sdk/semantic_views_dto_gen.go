@@ -0,0 +1,76 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateSemanticViewOptions]   = new(CreateSemanticViewRequest)
+	_ optionsProvider[AlterSemanticViewOptions]    = new(AlterSemanticViewRequest)
+	_ optionsProvider[DropSemanticViewOptions]     = new(DropSemanticViewRequest)
+	_ optionsProvider[ShowSemanticViewOptions]     = new(ShowSemanticViewRequest)
+	_ optionsProvider[DescribeSemanticViewOptions] = new(DescribeSemanticViewRequest)
+)
+
+type CreateSemanticViewRequest struct {
+	OrReplace     *bool
+	IfNotExists   *bool
+	name          SchemaObjectIdentifier     // required
+	Tables        []SemanticViewTableRequest // required
+	Relationships []SemanticViewRelationshipRequest
+	Facts         []SemanticViewExpressionRequest
+	Dimensions    []SemanticViewExpressionRequest
+	Metrics       []SemanticViewExpressionRequest
+	Comment       *string
+	CopyGrants    *bool
+}
+
+type SemanticViewTableRequest struct {
+	TableAlias   *string
+	TableName    SchemaObjectIdentifier // required
+	PrimaryKey   []Column
+	UniqueKeys   []SemanticViewUniqueKeyRequest
+	WithSynonyms []SemanticViewSynonym
+	Comment      *string
+}
+
+type SemanticViewUniqueKeyRequest struct {
+	Unique []Column // required
+}
+
+type SemanticViewRelationshipRequest struct {
+	RelationshipIdentifier *string
+	TableAlias             string   // required
+	Columns                []Column // required
+	RefTableAlias          string   // required
+	RefColumns             []Column
+}
+
+type SemanticViewExpressionRequest struct {
+	QualifiedExpressionName string // required
+	SqlExpression           string // required
+	WithSynonyms            []SemanticViewSynonym
+	Comment                 *string
+}
+
+type AlterSemanticViewRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier // required
+	RenameTo     *SchemaObjectIdentifier
+	SetComment   *string
+	UnsetComment *bool
+}
+
+type DropSemanticViewRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowSemanticViewRequest struct {
+	Like       *Like
+	In         *ExtendedIn
+	StartsWith *string
+	Limit      *LimitFrom
+}
+
+type DescribeSemanticViewRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateSemanticViewRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
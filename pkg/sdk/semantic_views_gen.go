@@ -0,0 +1,151 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+type SemanticViews interface {
+	Create(ctx context.Context, request *CreateSemanticViewRequest) error
+	Alter(ctx context.Context, request *AlterSemanticViewRequest) error
+	Drop(ctx context.Context, request *DropSemanticViewRequest) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowSemanticViewRequest) ([]SemanticView, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SemanticView, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*SemanticView, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]SemanticViewDetails, error)
+}
+
+// CreateSemanticViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-semantic-view.
+type CreateSemanticViewOptions struct {
+	create        bool                       `ddl:"static" sql:"CREATE"`
+	OrReplace     *bool                      `ddl:"keyword" sql:"OR REPLACE"`
+	semanticView  bool                       `ddl:"static" sql:"SEMANTIC VIEW"`
+	IfNotExists   *bool                      `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name          SchemaObjectIdentifier     `ddl:"identifier"`
+	Tables        []SemanticViewTable        `ddl:"parameter,parentheses,no_equals" sql:"TABLES"`
+	Relationships []SemanticViewRelationship `ddl:"parameter,parentheses,no_equals" sql:"RELATIONSHIPS"`
+	Facts         []SemanticViewExpression   `ddl:"parameter,parentheses,no_equals" sql:"FACTS"`
+	Dimensions    []SemanticViewExpression   `ddl:"parameter,parentheses,no_equals" sql:"DIMENSIONS"`
+	Metrics       []SemanticViewExpression   `ddl:"parameter,parentheses,no_equals" sql:"METRICS"`
+	Comment       *string                    `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	CopyGrants    *bool                      `ddl:"keyword" sql:"COPY GRANTS"`
+}
+
+type SemanticViewSynonym struct {
+	Value string `ddl:"keyword,single_quotes"`
+}
+
+type SemanticViewTable struct {
+	TableAlias   *string                 `ddl:"parameter,reverse" sql:"AS"`
+	TableName    SchemaObjectIdentifier  `ddl:"identifier"`
+	PrimaryKey   []Column                `ddl:"parameter,parentheses,no_equals" sql:"PRIMARY KEY"`
+	UniqueKeys   []SemanticViewUniqueKey `ddl:"list,no_parentheses,no_comma"`
+	WithSynonyms []SemanticViewSynonym   `ddl:"parameter,parentheses" sql:"WITH SYNONYMS"`
+	Comment      *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type SemanticViewUniqueKey struct {
+	Unique []Column `ddl:"parameter,parentheses,no_equals" sql:"UNIQUE"`
+}
+
+type SemanticViewRelationship struct {
+	RelationshipIdentifier *string  `ddl:"parameter,reverse" sql:"AS"`
+	TableAlias             string   `ddl:"keyword"`
+	Columns                []Column `ddl:"list,parentheses"`
+	references             bool     `ddl:"static" sql:"REFERENCES"`
+	RefTableAlias          string   `ddl:"keyword"`
+	RefColumns             []Column `ddl:"list,parentheses"`
+}
+
+type SemanticViewExpression struct {
+	QualifiedExpressionName string                `ddl:"keyword"`
+	as                      bool                  `ddl:"static" sql:"AS"`
+	SqlExpression           string                `ddl:"keyword"`
+	WithSynonyms            []SemanticViewSynonym `ddl:"parameter,parentheses" sql:"WITH SYNONYMS"`
+	Comment                 *string               `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// AlterSemanticViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-semantic-view.
+type AlterSemanticViewOptions struct {
+	alter        bool                    `ddl:"static" sql:"ALTER"`
+	semanticView bool                    `ddl:"static" sql:"SEMANTIC VIEW"`
+	IfExists     *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier  `ddl:"identifier"`
+	RenameTo     *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	SetComment   *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	UnsetComment *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
+}
+
+// DropSemanticViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-semantic-view.
+type DropSemanticViewOptions struct {
+	drop         bool                   `ddl:"static" sql:"DROP"`
+	semanticView bool                   `ddl:"static" sql:"SEMANTIC VIEW"`
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowSemanticViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-semantic-views.
+type ShowSemanticViewOptions struct {
+	show          bool        `ddl:"static" sql:"SHOW"`
+	semanticViews bool        `ddl:"static" sql:"SEMANTIC VIEWS"`
+	Like          *Like       `ddl:"keyword" sql:"LIKE"`
+	In            *ExtendedIn `ddl:"keyword" sql:"IN"`
+	StartsWith    *string     `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit         *LimitFrom  `ddl:"keyword" sql:"LIMIT"`
+}
+
+type semanticViewDBRow struct {
+	CreatedOn     string         `db:"created_on"`
+	Name          string         `db:"name"`
+	Kind          sql.NullString `db:"kind"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Comment       sql.NullString `db:"comment"`
+	Owner         string         `db:"owner"`
+	OwnerRoleType string         `db:"owner_role_type"`
+	Extension     sql.NullString `db:"extension"`
+}
+
+type SemanticView struct {
+	CreatedOn     string
+	Name          string
+	Kind          *string
+	DatabaseName  string
+	SchemaName    string
+	Comment       *string
+	Owner         string
+	OwnerRoleType string
+	Extension     *string
+}
+
+func (v *SemanticView) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *SemanticView) ObjectType() ObjectType {
+	return ObjectTypeSemanticView
+}
+
+// DescribeSemanticViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-semantic-view.
+type DescribeSemanticViewOptions struct {
+	describe     bool                   `ddl:"static" sql:"DESCRIBE"`
+	semanticView bool                   `ddl:"static" sql:"SEMANTIC VIEW"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type semanticViewDetailsRow struct {
+	ObjectKind    sql.NullString `db:"object_kind"`
+	ObjectName    sql.NullString `db:"object_name"`
+	ParentEntity  sql.NullString `db:"parent_entity"`
+	Property      string         `db:"property"`
+	PropertyValue string         `db:"property_value"`
+}
+
+type SemanticViewDetails struct {
+	ObjectKind    *string
+	ObjectName    *string
+	ParentEntity  *string
+	Property      string
+	PropertyValue string
+}
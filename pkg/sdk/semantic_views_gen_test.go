@@ -0,0 +1,276 @@
+package sdk
+
+import "testing"
+
+func TestSemanticViews_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	tableId := randomSchemaObjectIdentifier()
+	otherTableId := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateSemanticViewOptions
+	defaultOpts := func() *CreateSemanticViewOptions {
+		return &CreateSemanticViewOptions{
+			name: id,
+			Tables: []SemanticViewTable{
+				{
+					TableName: tableId,
+				},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateSemanticViewOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateSemanticViewOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: [opts.Tables] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Tables = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateSemanticViewOptions", "Tables"))
+	})
+
+	t.Run("validation: valid identifier for [opts.Tables.TableName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Tables[0].TableName = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("SemanticViewTable", "TableName"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SEMANTIC VIEW %s TABLES (%s)", id.FullyQualifiedName(), tableId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Tables = []SemanticViewTable{
+			{
+				TableAlias: String("orders"),
+				TableName:  tableId,
+				PrimaryKey: []Column{{Value: "ID"}},
+				UniqueKeys: []SemanticViewUniqueKey{
+					{Unique: []Column{{Value: "ORDER_NUMBER"}}},
+					{Unique: []Column{{Value: "A"}, {Value: "B"}}},
+				},
+				WithSynonyms: []SemanticViewSynonym{{Value: "sales"}, {Value: "purchases"}},
+				Comment:      String("orders table"),
+			},
+			{
+				TableAlias: String("customers"),
+				TableName:  otherTableId,
+				PrimaryKey: []Column{{Value: "ID"}},
+			},
+		}
+		opts.Relationships = []SemanticViewRelationship{
+			{
+				RelationshipIdentifier: String("orders_to_customers"),
+				TableAlias:             "orders",
+				Columns:                []Column{{Value: "CUSTOMER_ID"}},
+				RefTableAlias:          "customers",
+				RefColumns:             []Column{{Value: "ID"}},
+			},
+			{
+				TableAlias:    "orders",
+				Columns:       []Column{{Value: "CUSTOMER_ID"}},
+				RefTableAlias: "customers",
+			},
+		}
+		opts.Facts = []SemanticViewExpression{
+			{
+				QualifiedExpressionName: "orders.amount",
+				SqlExpression:           "orders.total_amount",
+				WithSynonyms:            []SemanticViewSynonym{{Value: "value"}},
+				Comment:                 String("order amount"),
+			},
+		}
+		opts.Dimensions = []SemanticViewExpression{
+			{
+				QualifiedExpressionName: "customers.name",
+				SqlExpression:           "customers.name",
+			},
+		}
+		opts.Metrics = []SemanticViewExpression{
+			{
+				QualifiedExpressionName: "orders.total",
+				SqlExpression:           "SUM(orders.amount)",
+			},
+			{
+				QualifiedExpressionName: "orders.count",
+				SqlExpression:           "COUNT(orders.id)",
+			},
+		}
+		opts.Comment = String("some comment")
+		opts.CopyGrants = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE SEMANTIC VIEW %s`+
+			` TABLES (orders AS %s PRIMARY KEY ("ID") UNIQUE ("ORDER_NUMBER") UNIQUE ("A", "B") WITH SYNONYMS = ('sales', 'purchases') COMMENT = 'orders table', customers AS %s PRIMARY KEY ("ID"))`+
+			` RELATIONSHIPS (orders_to_customers AS orders ("CUSTOMER_ID") REFERENCES customers ("ID"), orders ("CUSTOMER_ID") REFERENCES customers)`+
+			` FACTS (orders.amount AS orders.total_amount WITH SYNONYMS = ('value') COMMENT = 'order amount')`+
+			` DIMENSIONS (customers.name AS customers.name)`+
+			` METRICS (orders.total AS SUM(orders.amount), orders.count AS COUNT(orders.id))`+
+			` COMMENT = 'some comment' COPY GRANTS`, id.FullyQualifiedName(), tableId.FullyQualifiedName(), otherTableId.FullyQualifiedName())
+	})
+}
+
+func TestSemanticViews_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterSemanticViewOptions
+	defaultOpts := func() *AlterSemanticViewOptions {
+		return &AlterSemanticViewOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterSemanticViewOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSemanticViewOptions", "RenameTo", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSemanticViewOptions", "RenameTo", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SEMANTIC VIEW IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SEMANTIC VIEW %s SET COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetComment = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SEMANTIC VIEW %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestSemanticViews_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropSemanticViewOptions
+	defaultOpts := func() *DropSemanticViewOptions {
+		return &DropSemanticViewOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropSemanticViewOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP SEMANTIC VIEW %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP SEMANTIC VIEW IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestSemanticViews_Show(t *testing.T) {
+	// Minimal valid ShowSemanticViewOptions
+	defaultOpts := func() *ShowSemanticViewOptions {
+		return &ShowSemanticViewOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowSemanticViewOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SEMANTIC VIEWS")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &ExtendedIn{
+			In: In{
+				Account: Bool(true),
+			},
+		}
+		opts.StartsWith = String("abc")
+		opts.Limit = &LimitFrom{
+			Rows: Pointer(10),
+			From: Pointer("foo"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SEMANTIC VIEWS LIKE 'pattern' IN ACCOUNT STARTS WITH 'abc' LIMIT 10 FROM 'foo'")
+	})
+}
+
+func TestSemanticViews_Describe(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeSemanticViewOptions
+	defaultOpts := func() *DescribeSemanticViewOptions {
+		return &DescribeSemanticViewOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeSemanticViewOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE SEMANTIC VIEW %s", id.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,225 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ SemanticViews = (*semanticViews)(nil)
+
+type semanticViews struct {
+	client *Client
+}
+
+func (v *semanticViews) Create(ctx context.Context, request *CreateSemanticViewRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *semanticViews) Alter(ctx context.Context, request *AlterSemanticViewRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *semanticViews) Drop(ctx context.Context, request *DropSemanticViewRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *semanticViews) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropSemanticViewRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *semanticViews) Show(ctx context.Context, request *ShowSemanticViewRequest) ([]SemanticView, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[semanticViewDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[semanticViewDBRow, SemanticView](dbRows)
+	return resultList, nil
+}
+
+func (v *semanticViews) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SemanticView, error) {
+	request := NewShowSemanticViewRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}})
+	semanticViews, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(semanticViews, func(r SemanticView) bool { return r.Name == id.Name() })
+}
+
+func (v *semanticViews) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*SemanticView, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *semanticViews) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]SemanticViewDetails, error) {
+	opts := &DescribeSemanticViewOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[semanticViewDetailsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[semanticViewDetailsRow, SemanticViewDetails](rows), nil
+}
+
+func (r *CreateSemanticViewRequest) toOpts() *CreateSemanticViewOptions {
+	opts := &CreateSemanticViewOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+
+		Comment:    r.Comment,
+		CopyGrants: r.CopyGrants,
+	}
+	if r.Tables != nil {
+		s := make([]SemanticViewTable, len(r.Tables))
+		for i, v := range r.Tables {
+			s[i] = SemanticViewTable{
+				TableAlias:   v.TableAlias,
+				TableName:    v.TableName,
+				PrimaryKey:   v.PrimaryKey,
+				WithSynonyms: v.WithSynonyms,
+				Comment:      v.Comment,
+			}
+			if v.UniqueKeys != nil {
+				uniqueKeys := make([]SemanticViewUniqueKey, len(v.UniqueKeys))
+				for j, uniqueKey := range v.UniqueKeys {
+					uniqueKeys[j] = SemanticViewUniqueKey{
+						Unique: uniqueKey.Unique,
+					}
+				}
+				s[i].UniqueKeys = uniqueKeys
+			}
+		}
+		opts.Tables = s
+	}
+	if r.Relationships != nil {
+		s := make([]SemanticViewRelationship, len(r.Relationships))
+		for i, v := range r.Relationships {
+			s[i] = SemanticViewRelationship{
+				RelationshipIdentifier: v.RelationshipIdentifier,
+				TableAlias:             v.TableAlias,
+				Columns:                v.Columns,
+				RefTableAlias:          v.RefTableAlias,
+				RefColumns:             v.RefColumns,
+			}
+		}
+		opts.Relationships = s
+	}
+	if r.Facts != nil {
+		s := make([]SemanticViewExpression, len(r.Facts))
+		for i, v := range r.Facts {
+			s[i] = SemanticViewExpression{
+				QualifiedExpressionName: v.QualifiedExpressionName,
+				SqlExpression:           v.SqlExpression,
+				WithSynonyms:            v.WithSynonyms,
+				Comment:                 v.Comment,
+			}
+		}
+		opts.Facts = s
+	}
+	if r.Dimensions != nil {
+		s := make([]SemanticViewExpression, len(r.Dimensions))
+		for i, v := range r.Dimensions {
+			s[i] = SemanticViewExpression{
+				QualifiedExpressionName: v.QualifiedExpressionName,
+				SqlExpression:           v.SqlExpression,
+				WithSynonyms:            v.WithSynonyms,
+				Comment:                 v.Comment,
+			}
+		}
+		opts.Dimensions = s
+	}
+	if r.Metrics != nil {
+		s := make([]SemanticViewExpression, len(r.Metrics))
+		for i, v := range r.Metrics {
+			s[i] = SemanticViewExpression{
+				QualifiedExpressionName: v.QualifiedExpressionName,
+				SqlExpression:           v.SqlExpression,
+				WithSynonyms:            v.WithSynonyms,
+				Comment:                 v.Comment,
+			}
+		}
+		opts.Metrics = s
+	}
+	return opts
+}
+
+func (r *AlterSemanticViewRequest) toOpts() *AlterSemanticViewOptions {
+	opts := &AlterSemanticViewOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		RenameTo:     r.RenameTo,
+		SetComment:   r.SetComment,
+		UnsetComment: r.UnsetComment,
+	}
+	return opts
+}
+
+func (r *DropSemanticViewRequest) toOpts() *DropSemanticViewOptions {
+	opts := &DropSemanticViewOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowSemanticViewRequest) toOpts() *ShowSemanticViewOptions {
+	opts := &ShowSemanticViewOptions{
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r semanticViewDBRow) convert() *SemanticView {
+	semanticView := &SemanticView{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Owner:         r.Owner,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	if r.Kind.Valid {
+		semanticView.Kind = String(r.Kind.String)
+	}
+	if r.Comment.Valid {
+		semanticView.Comment = String(r.Comment.String)
+	}
+	if r.Extension.Valid {
+		semanticView.Extension = String(r.Extension.String)
+	}
+	return semanticView
+}
+
+func (r *DescribeSemanticViewRequest) toOpts() *DescribeSemanticViewOptions {
+	opts := &DescribeSemanticViewOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r semanticViewDetailsRow) convert() *SemanticViewDetails {
+	details := &SemanticViewDetails{
+		Property:      r.Property,
+		PropertyValue: r.PropertyValue,
+	}
+	if r.ObjectKind.Valid {
+		details.ObjectKind = String(r.ObjectKind.String)
+	}
+	if r.ObjectName.Valid {
+		details.ObjectName = String(r.ObjectName.String)
+	}
+	if r.ParentEntity.Valid {
+		details.ParentEntity = String(r.ParentEntity.String)
+	}
+	return details
+}
@@ -0,0 +1,75 @@
+package sdk
+
+var (
+	_ validatable = new(CreateSemanticViewOptions)
+	_ validatable = new(AlterSemanticViewOptions)
+	_ validatable = new(DropSemanticViewOptions)
+	_ validatable = new(ShowSemanticViewOptions)
+	_ validatable = new(DescribeSemanticViewOptions)
+)
+
+func (opts *CreateSemanticViewOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateSemanticViewOptions", "OrReplace", "IfNotExists"))
+	}
+	if len(opts.Tables) == 0 {
+		errs = append(errs, errNotSet("CreateSemanticViewOptions", "Tables"))
+	}
+	for _, table := range opts.Tables {
+		if !ValidObjectIdentifier(table.TableName) {
+			errs = append(errs, errInvalidIdentifier("SemanticViewTable", "TableName"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterSemanticViewOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RenameTo, opts.SetComment, opts.UnsetComment) {
+		errs = append(errs, errExactlyOneOf("AlterSemanticViewOptions", "RenameTo", "SetComment", "UnsetComment"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropSemanticViewOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowSemanticViewOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeSemanticViewOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
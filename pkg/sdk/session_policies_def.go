@@ -4,6 +4,12 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
+var sessionPolicySecondaryRolesDef = g.NewQueryStruct("SessionPolicySecondaryRoles").
+	PredefinedQueryStructField("None", "*bool", g.StaticOptions().SQL("()")).
+	PredefinedQueryStructField("All", "*bool", g.StaticOptions().SQL("('ALL')")).
+	List("Roles", g.KindOfT[AccountObjectIdentifier](), g.ListOptions().Parentheses()).
+	WithValidation(g.ExactlyOneValueSet, "None", "All", "Roles")
+
 var SessionPoliciesDef = g.NewInterface(
 	"SessionPolicies",
 	"SessionPolicy",
@@ -19,6 +25,7 @@ var SessionPoliciesDef = g.NewInterface(
 			Name().
 			OptionalNumberAssignment("SESSION_IDLE_TIMEOUT_MINS", g.ParameterOptions().NoQuotes()).
 			OptionalNumberAssignment("SESSION_UI_IDLE_TIMEOUT_MINS", g.ParameterOptions().NoQuotes()).
+			OptionalQueryStructField("AllowedSecondaryRoles", sessionPolicySecondaryRolesDef, g.ParameterOptions().SQL("ALLOWED_SECONDARY_ROLES")).
 			OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 			WithValidation(g.ValidIdentifier, "name").
 			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
@@ -36,8 +43,9 @@ var SessionPoliciesDef = g.NewInterface(
 				g.NewQueryStruct("SessionPolicySet").
 					OptionalNumberAssignment("SESSION_IDLE_TIMEOUT_MINS", g.ParameterOptions().NoQuotes()).
 					OptionalNumberAssignment("SESSION_UI_IDLE_TIMEOUT_MINS", g.ParameterOptions().NoQuotes()).
+					OptionalQueryStructField("AllowedSecondaryRoles", sessionPolicySecondaryRolesDef, g.ParameterOptions().SQL("ALLOWED_SECONDARY_ROLES")).
 					OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
-					WithValidation(g.AtLeastOneValueSet, "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"),
+					WithValidation(g.AtLeastOneValueSet, "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"),
 				g.KeywordOptions().SQL("SET"),
 			).
 			OptionalSetTags().
@@ -47,9 +55,10 @@ var SessionPoliciesDef = g.NewInterface(
 				g.NewQueryStruct("SessionPolicyUnset").
 					OptionalSQL("SESSION_IDLE_TIMEOUT_MINS").
 					OptionalSQL("SESSION_UI_IDLE_TIMEOUT_MINS").
+					OptionalSQL("ALLOWED_SECONDARY_ROLES").
 					OptionalSQL("COMMENT").
-					WithValidation(g.AtLeastOneValueSet, "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"),
-				g.KeywordOptions().SQL("UNSET"),
+					WithValidation(g.AtLeastOneValueSet, "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
 			).
 			WithValidation(g.ValidIdentifier, "name").
 			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "SetTags", "UnsetTags", "Unset"),
@@ -87,9 +96,14 @@ var SessionPoliciesDef = g.NewInterface(
 			Field("OwnerRoleType", "string"),
 		g.NewQueryStruct("ShowSessionPolicies").
 			Show().
-			SQL("SESSION POLICIES"),
+			SQL("SESSION POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDInFiltering,
+		g.ShowByIDLikeFiltering,
 	).
-	ShowByIdOperationWithNoFiltering().
 	DescribeOperation(
 		g.DescriptionMappingKindSingleValue,
 		"https://docs.snowflake.com/en/sql-reference/sql/desc-session-policy",
@@ -98,12 +112,14 @@ var SessionPoliciesDef = g.NewInterface(
 			Field("name", "string").
 			Field("session_idle_timeout_mins", "int").
 			Field("session_ui_idle_timeout_mins", "int").
+			Field("allowed_secondary_roles", "string").
 			Field("comment", "string"),
 		g.PlainStruct("SessionPolicyDescription").
 			Field("CreatedOn", "string").
 			Field("Name", "string").
 			Field("SessionIdleTimeoutMins", "int").
 			Field("SessionUIIdleTimeoutMins", "int").
+			Field("AllowedSecondaryRoles", "[]string").
 			Field("Comment", "string"),
 		g.NewQueryStruct("DescribeSessionPolicy").
 			Describe().
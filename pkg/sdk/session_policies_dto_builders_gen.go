@@ -10,28 +10,52 @@ func NewCreateSessionPolicyRequest(
 	return &s
 }
 
-func (s *CreateSessionPolicyRequest) WithOrReplace(OrReplace *bool) *CreateSessionPolicyRequest {
-	s.OrReplace = OrReplace
+func (s *CreateSessionPolicyRequest) WithOrReplace(OrReplace bool) *CreateSessionPolicyRequest {
+	s.OrReplace = &OrReplace
 	return s
 }
 
-func (s *CreateSessionPolicyRequest) WithIfNotExists(IfNotExists *bool) *CreateSessionPolicyRequest {
-	s.IfNotExists = IfNotExists
+func (s *CreateSessionPolicyRequest) WithIfNotExists(IfNotExists bool) *CreateSessionPolicyRequest {
+	s.IfNotExists = &IfNotExists
 	return s
 }
 
-func (s *CreateSessionPolicyRequest) WithSessionIdleTimeoutMins(SessionIdleTimeoutMins *int) *CreateSessionPolicyRequest {
-	s.SessionIdleTimeoutMins = SessionIdleTimeoutMins
+func (s *CreateSessionPolicyRequest) WithSessionIdleTimeoutMins(SessionIdleTimeoutMins int) *CreateSessionPolicyRequest {
+	s.SessionIdleTimeoutMins = &SessionIdleTimeoutMins
 	return s
 }
 
-func (s *CreateSessionPolicyRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleTimeoutMins *int) *CreateSessionPolicyRequest {
-	s.SessionUiIdleTimeoutMins = SessionUiIdleTimeoutMins
+func (s *CreateSessionPolicyRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleTimeoutMins int) *CreateSessionPolicyRequest {
+	s.SessionUiIdleTimeoutMins = &SessionUiIdleTimeoutMins
 	return s
 }
 
-func (s *CreateSessionPolicyRequest) WithComment(Comment *string) *CreateSessionPolicyRequest {
-	s.Comment = Comment
+func (s *CreateSessionPolicyRequest) WithAllowedSecondaryRoles(AllowedSecondaryRoles SessionPolicySecondaryRolesRequest) *CreateSessionPolicyRequest {
+	s.AllowedSecondaryRoles = &AllowedSecondaryRoles
+	return s
+}
+
+func (s *CreateSessionPolicyRequest) WithComment(Comment string) *CreateSessionPolicyRequest {
+	s.Comment = &Comment
+	return s
+}
+
+func NewSessionPolicySecondaryRolesRequest() *SessionPolicySecondaryRolesRequest {
+	return &SessionPolicySecondaryRolesRequest{}
+}
+
+func (s *SessionPolicySecondaryRolesRequest) WithNone(None bool) *SessionPolicySecondaryRolesRequest {
+	s.None = &None
+	return s
+}
+
+func (s *SessionPolicySecondaryRolesRequest) WithAll(All bool) *SessionPolicySecondaryRolesRequest {
+	s.All = &All
+	return s
+}
+
+func (s *SessionPolicySecondaryRolesRequest) WithRoles(Roles []AccountObjectIdentifier) *SessionPolicySecondaryRolesRequest {
+	s.Roles = Roles
 	return s
 }
 
@@ -43,18 +67,18 @@ func NewAlterSessionPolicyRequest(
 	return &s
 }
 
-func (s *AlterSessionPolicyRequest) WithIfExists(IfExists *bool) *AlterSessionPolicyRequest {
-	s.IfExists = IfExists
+func (s *AlterSessionPolicyRequest) WithIfExists(IfExists bool) *AlterSessionPolicyRequest {
+	s.IfExists = &IfExists
 	return s
 }
 
-func (s *AlterSessionPolicyRequest) WithRenameTo(RenameTo *SchemaObjectIdentifier) *AlterSessionPolicyRequest {
-	s.RenameTo = RenameTo
+func (s *AlterSessionPolicyRequest) WithRenameTo(RenameTo SchemaObjectIdentifier) *AlterSessionPolicyRequest {
+	s.RenameTo = &RenameTo
 	return s
 }
 
-func (s *AlterSessionPolicyRequest) WithSet(Set *SessionPolicySetRequest) *AlterSessionPolicyRequest {
-	s.Set = Set
+func (s *AlterSessionPolicyRequest) WithSet(Set SessionPolicySetRequest) *AlterSessionPolicyRequest {
+	s.Set = &Set
 	return s
 }
 
@@ -68,8 +92,8 @@ func (s *AlterSessionPolicyRequest) WithUnsetTags(UnsetTags []ObjectIdentifier)
 	return s
 }
 
-func (s *AlterSessionPolicyRequest) WithUnset(Unset *SessionPolicyUnsetRequest) *AlterSessionPolicyRequest {
-	s.Unset = Unset
+func (s *AlterSessionPolicyRequest) WithUnset(Unset SessionPolicyUnsetRequest) *AlterSessionPolicyRequest {
+	s.Unset = &Unset
 	return s
 }
 
@@ -77,18 +101,23 @@ func NewSessionPolicySetRequest() *SessionPolicySetRequest {
 	return &SessionPolicySetRequest{}
 }
 
-func (s *SessionPolicySetRequest) WithSessionIdleTimeoutMins(SessionIdleTimeoutMins *int) *SessionPolicySetRequest {
-	s.SessionIdleTimeoutMins = SessionIdleTimeoutMins
+func (s *SessionPolicySetRequest) WithSessionIdleTimeoutMins(SessionIdleTimeoutMins int) *SessionPolicySetRequest {
+	s.SessionIdleTimeoutMins = &SessionIdleTimeoutMins
+	return s
+}
+
+func (s *SessionPolicySetRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleTimeoutMins int) *SessionPolicySetRequest {
+	s.SessionUiIdleTimeoutMins = &SessionUiIdleTimeoutMins
 	return s
 }
 
-func (s *SessionPolicySetRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleTimeoutMins *int) *SessionPolicySetRequest {
-	s.SessionUiIdleTimeoutMins = SessionUiIdleTimeoutMins
+func (s *SessionPolicySetRequest) WithAllowedSecondaryRoles(AllowedSecondaryRoles SessionPolicySecondaryRolesRequest) *SessionPolicySetRequest {
+	s.AllowedSecondaryRoles = &AllowedSecondaryRoles
 	return s
 }
 
-func (s *SessionPolicySetRequest) WithComment(Comment *string) *SessionPolicySetRequest {
-	s.Comment = Comment
+func (s *SessionPolicySetRequest) WithComment(Comment string) *SessionPolicySetRequest {
+	s.Comment = &Comment
 	return s
 }
 
@@ -96,18 +125,23 @@ func NewSessionPolicyUnsetRequest() *SessionPolicyUnsetRequest {
 	return &SessionPolicyUnsetRequest{}
 }
 
-func (s *SessionPolicyUnsetRequest) WithSessionIdleTimeoutMins(SessionIdleTimeoutMins *bool) *SessionPolicyUnsetRequest {
-	s.SessionIdleTimeoutMins = SessionIdleTimeoutMins
+func (s *SessionPolicyUnsetRequest) WithSessionIdleTimeoutMins(SessionIdleTimeoutMins bool) *SessionPolicyUnsetRequest {
+	s.SessionIdleTimeoutMins = &SessionIdleTimeoutMins
+	return s
+}
+
+func (s *SessionPolicyUnsetRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleTimeoutMins bool) *SessionPolicyUnsetRequest {
+	s.SessionUiIdleTimeoutMins = &SessionUiIdleTimeoutMins
 	return s
 }
 
-func (s *SessionPolicyUnsetRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleTimeoutMins *bool) *SessionPolicyUnsetRequest {
-	s.SessionUiIdleTimeoutMins = SessionUiIdleTimeoutMins
+func (s *SessionPolicyUnsetRequest) WithAllowedSecondaryRoles(AllowedSecondaryRoles bool) *SessionPolicyUnsetRequest {
+	s.AllowedSecondaryRoles = &AllowedSecondaryRoles
 	return s
 }
 
-func (s *SessionPolicyUnsetRequest) WithComment(Comment *bool) *SessionPolicyUnsetRequest {
-	s.Comment = Comment
+func (s *SessionPolicyUnsetRequest) WithComment(Comment bool) *SessionPolicyUnsetRequest {
+	s.Comment = &Comment
 	return s
 }
 
@@ -119,8 +153,8 @@ func NewDropSessionPolicyRequest(
 	return &s
 }
 
-func (s *DropSessionPolicyRequest) WithIfExists(IfExists *bool) *DropSessionPolicyRequest {
-	s.IfExists = IfExists
+func (s *DropSessionPolicyRequest) WithIfExists(IfExists bool) *DropSessionPolicyRequest {
+	s.IfExists = &IfExists
 	return s
 }
 
@@ -128,6 +162,16 @@ func NewShowSessionPolicyRequest() *ShowSessionPolicyRequest {
 	return &ShowSessionPolicyRequest{}
 }
 
+func (s *ShowSessionPolicyRequest) WithLike(Like Like) *ShowSessionPolicyRequest {
+	s.Like = &Like
+	return s
+}
+
+func (s *ShowSessionPolicyRequest) WithIn(In In) *ShowSessionPolicyRequest {
+	s.In = &In
+	return s
+}
+
 func NewDescribeSessionPolicyRequest(
 	name SchemaObjectIdentifier,
 ) *DescribeSessionPolicyRequest {
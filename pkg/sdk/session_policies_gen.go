@@ -18,14 +18,21 @@ type SessionPolicies interface {
 
 // CreateSessionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-session-policy.
 type CreateSessionPolicyOptions struct {
-	create                   bool                   `ddl:"static" sql:"CREATE"`
-	OrReplace                *bool                  `ddl:"keyword" sql:"OR REPLACE"`
-	sessionPolicy            bool                   `ddl:"static" sql:"SESSION POLICY"`
-	IfNotExists              *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
-	name                     SchemaObjectIdentifier `ddl:"identifier"`
-	SessionIdleTimeoutMins   *int                   `ddl:"parameter,no_quotes" sql:"SESSION_IDLE_TIMEOUT_MINS"`
-	SessionUiIdleTimeoutMins *int                   `ddl:"parameter,no_quotes" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
-	Comment                  *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create                   bool                         `ddl:"static" sql:"CREATE"`
+	OrReplace                *bool                        `ddl:"keyword" sql:"OR REPLACE"`
+	sessionPolicy            bool                         `ddl:"static" sql:"SESSION POLICY"`
+	IfNotExists              *bool                        `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                     SchemaObjectIdentifier       `ddl:"identifier"`
+	SessionIdleTimeoutMins   *int                         `ddl:"parameter,no_quotes" sql:"SESSION_IDLE_TIMEOUT_MINS"`
+	SessionUiIdleTimeoutMins *int                         `ddl:"parameter,no_quotes" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+	AllowedSecondaryRoles    *SessionPolicySecondaryRoles `ddl:"parameter" sql:"ALLOWED_SECONDARY_ROLES"`
+	Comment                  *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type SessionPolicySecondaryRoles struct {
+	None  *bool                     `ddl:"static" sql:"()"`
+	All   *bool                     `ddl:"static" sql:"('ALL')"`
+	Roles []AccountObjectIdentifier `ddl:"list,parentheses"`
 }
 
 // AlterSessionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-session-policy.
@@ -38,18 +45,20 @@ type AlterSessionPolicyOptions struct {
 	Set           *SessionPolicySet       `ddl:"keyword" sql:"SET"`
 	SetTags       []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
 	UnsetTags     []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
-	Unset         *SessionPolicyUnset     `ddl:"keyword" sql:"UNSET"`
+	Unset         *SessionPolicyUnset     `ddl:"list,no_parentheses" sql:"UNSET"`
 }
 
 type SessionPolicySet struct {
-	SessionIdleTimeoutMins   *int    `ddl:"parameter,no_quotes" sql:"SESSION_IDLE_TIMEOUT_MINS"`
-	SessionUiIdleTimeoutMins *int    `ddl:"parameter,no_quotes" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
-	Comment                  *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	SessionIdleTimeoutMins   *int                         `ddl:"parameter,no_quotes" sql:"SESSION_IDLE_TIMEOUT_MINS"`
+	SessionUiIdleTimeoutMins *int                         `ddl:"parameter,no_quotes" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+	AllowedSecondaryRoles    *SessionPolicySecondaryRoles `ddl:"parameter" sql:"ALLOWED_SECONDARY_ROLES"`
+	Comment                  *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type SessionPolicyUnset struct {
 	SessionIdleTimeoutMins   *bool `ddl:"keyword" sql:"SESSION_IDLE_TIMEOUT_MINS"`
 	SessionUiIdleTimeoutMins *bool `ddl:"keyword" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+	AllowedSecondaryRoles    *bool `ddl:"keyword" sql:"ALLOWED_SECONDARY_ROLES"`
 	Comment                  *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
@@ -63,8 +72,10 @@ type DropSessionPolicyOptions struct {
 
 // ShowSessionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-session-policies.
 type ShowSessionPolicyOptions struct {
-	show            bool `ddl:"static" sql:"SHOW"`
-	sessionPolicies bool `ddl:"static" sql:"SESSION POLICIES"`
+	show            bool  `ddl:"static" sql:"SHOW"`
+	sessionPolicies bool  `ddl:"static" sql:"SESSION POLICIES"`
+	Like            *Like `ddl:"keyword" sql:"LIKE"`
+	In              *In   `ddl:"keyword" sql:"IN"`
 }
 
 type showSessionPolicyDBRow struct {
@@ -91,6 +102,14 @@ type SessionPolicy struct {
 	OwnerRoleType string
 }
 
+func (v *SessionPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *SessionPolicy) ObjectType() ObjectType {
+	return ObjectTypeSessionPolicy
+}
+
 // DescribeSessionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-session-policy.
 type DescribeSessionPolicyOptions struct {
 	describe      bool                   `ddl:"static" sql:"DESCRIBE"`
@@ -103,6 +122,7 @@ type describeSessionPolicyDBRow struct {
 	Name                     string         `db:"name"`
 	SessionIdleTimeoutMins   int            `db:"session_idle_timeout_mins"`
 	SessionUiIdleTimeoutMins int            `db:"session_ui_idle_timeout_mins"`
+	AllowedSecondaryRoles    sql.NullString `db:"allowed_secondary_roles"`
 	Comment                  sql.NullString `db:"comment"`
 }
 
@@ -111,9 +131,6 @@ type SessionPolicyDescription struct {
 	Name                     string
 	SessionIdleTimeoutMins   int
 	SessionUIIdleTimeoutMins int
+	AllowedSecondaryRoles    []string
 	Comment                  string
 }
-
-func (v *SessionPolicy) ID() SchemaObjectIdentifier {
-	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
-}
@@ -30,6 +30,21 @@ func TestSessionPolicies_Create(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateSessionPolicyOptions", "OrReplace", "IfNotExists"))
 	})
 
+	t.Run("validation: exactly one field from [opts.AllowedSecondaryRoles.None opts.AllowedSecondaryRoles.All opts.AllowedSecondaryRoles.Roles] should be present - none present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateSessionPolicyOptions.AllowedSecondaryRoles", "None", "All", "Roles"))
+	})
+
+	t.Run("validation: exactly one field from [opts.AllowedSecondaryRoles.None opts.AllowedSecondaryRoles.All opts.AllowedSecondaryRoles.Roles] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{
+			All:   Bool(true),
+			Roles: []AccountObjectIdentifier{randomAccountObjectIdentifier()},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateSessionPolicyOptions.AllowedSecondaryRoles", "None", "All", "Roles"))
+	})
+
 	t.Run("basic", func(t *testing.T) {
 		opts := defaultOpts()
 		assertOptsValidAndSQLEquals(t, opts, "CREATE SESSION POLICY %s", id.FullyQualifiedName())
@@ -40,8 +55,29 @@ func TestSessionPolicies_Create(t *testing.T) {
 		opts.OrReplace = Bool(true)
 		opts.SessionIdleTimeoutMins = Int(5)
 		opts.SessionUiIdleTimeoutMins = Int(34)
+		opts.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{
+			All: Bool(true),
+		}
 		opts.Comment = String("some comment")
-		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SESSION POLICY %s SESSION_IDLE_TIMEOUT_MINS = 5 SESSION_UI_IDLE_TIMEOUT_MINS = 34 COMMENT = 'some comment'", id.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SESSION POLICY %s SESSION_IDLE_TIMEOUT_MINS = 5 SESSION_UI_IDLE_TIMEOUT_MINS = 34 ALLOWED_SECONDARY_ROLES = ('ALL') COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("allowed secondary roles - none", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{
+			None: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SESSION POLICY %s ALLOWED_SECONDARY_ROLES = ()", id.FullyQualifiedName())
+	})
+
+	t.Run("allowed secondary roles - roles", func(t *testing.T) {
+		opts := defaultOpts()
+		roleId1 := randomAccountObjectIdentifier()
+		roleId2 := randomAccountObjectIdentifier()
+		opts.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{
+			Roles: []AccountObjectIdentifier{roleId1, roleId2},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SESSION POLICY %s ALLOWED_SECONDARY_ROLES = (%s, %s)", id.FullyQualifiedName(), roleId1.FullyQualifiedName(), roleId2.FullyQualifiedName())
 	})
 }
 
@@ -82,16 +118,24 @@ func TestSessionPolicies_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSessionPolicyOptions", "RenameTo", "Set", "SetTags", "UnsetTags", "Unset"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Set.SessionIdleTimeoutMins opts.Set.SessionUiIdleTimeoutMins opts.Set.Comment] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set.SessionIdleTimeoutMins opts.Set.SessionUiIdleTimeoutMins opts.Set.AllowedSecondaryRoles opts.Set.Comment] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &SessionPolicySet{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSessionPolicyOptions.Set", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSessionPolicyOptions.Set", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set.AllowedSecondaryRoles.None opts.Set.AllowedSecondaryRoles.All opts.Set.AllowedSecondaryRoles.Roles] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &SessionPolicySet{
+			AllowedSecondaryRoles: &SessionPolicySecondaryRoles{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSessionPolicyOptions.Set.AllowedSecondaryRoles", "None", "All", "Roles"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Unset.SessionIdleTimeoutMins opts.Unset.SessionUiIdleTimeoutMins opts.Unset.Comment] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Unset.SessionIdleTimeoutMins opts.Unset.SessionUiIdleTimeoutMins opts.Unset.AllowedSecondaryRoles opts.Unset.Comment] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Unset = &SessionPolicyUnset{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSessionPolicyOptions.Unset", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSessionPolicyOptions.Unset", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"))
 	})
 
 	t.Run("alter set", func(t *testing.T) {
@@ -102,6 +146,20 @@ func TestSessionPolicies_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER SESSION POLICY %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
 	})
 
+	t.Run("alter set all", func(t *testing.T) {
+		opts := defaultOpts()
+		roleId := randomAccountObjectIdentifier()
+		opts.Set = &SessionPolicySet{
+			SessionIdleTimeoutMins:   Int(10),
+			SessionUiIdleTimeoutMins: Int(20),
+			AllowedSecondaryRoles: &SessionPolicySecondaryRoles{
+				Roles: []AccountObjectIdentifier{roleId},
+			},
+			Comment: String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SESSION POLICY %s SET SESSION_IDLE_TIMEOUT_MINS = 10 SESSION_UI_IDLE_TIMEOUT_MINS = 20 ALLOWED_SECONDARY_ROLES = (%s) COMMENT = 'some comment'", id.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+
 	t.Run("alter unset", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Unset = &SessionPolicyUnset{
@@ -110,6 +168,17 @@ func TestSessionPolicies_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER SESSION POLICY %s UNSET COMMENT", id.FullyQualifiedName())
 	})
 
+	t.Run("alter unset all", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &SessionPolicyUnset{
+			SessionIdleTimeoutMins:   Bool(true),
+			SessionUiIdleTimeoutMins: Bool(true),
+			AllowedSecondaryRoles:    Bool(true),
+			Comment:                  Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SESSION POLICY %s UNSET SESSION_IDLE_TIMEOUT_MINS, SESSION_UI_IDLE_TIMEOUT_MINS, ALLOWED_SECONDARY_ROLES, COMMENT", id.FullyQualifiedName())
+	})
+
 	t.Run("alter rename", func(t *testing.T) {
 		opts := defaultOpts()
 		newId := randomSchemaObjectIdentifier()
@@ -184,6 +253,18 @@ func TestSessionPolicies_Show(t *testing.T) {
 		opts := defaultOpts()
 		assertOptsValidAndSQLEquals(t, opts, "SHOW SESSION POLICIES")
 	})
+
+	t.Run("show with like and in", func(t *testing.T) {
+		opts := defaultOpts()
+		schemaId := randomDatabaseObjectIdentifier()
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &In{
+			Schema: schemaId,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SESSION POLICIES LIKE 'pattern' IN SCHEMA %s", schemaId.FullyQualifiedName())
+	})
 }
 
 func TestSessionPolicies_Describe(t *testing.T) {
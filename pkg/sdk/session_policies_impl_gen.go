@@ -28,7 +28,7 @@ func (v *sessionPolicies) Drop(ctx context.Context, request *DropSessionPolicyRe
 }
 
 func (v *sessionPolicies) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
-	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropSessionPolicyRequest(id).WithIfExists(Bool(true))) }, ctx, id)
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropSessionPolicyRequest(id).WithIfExists(true)) }, ctx, id)
 }
 
 func (v *sessionPolicies) Show(ctx context.Context, request *ShowSessionPolicyRequest) ([]SessionPolicy, error) {
@@ -42,7 +42,9 @@ func (v *sessionPolicies) Show(ctx context.Context, request *ShowSessionPolicyRe
 }
 
 func (v *sessionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicy, error) {
-	request := NewShowSessionPolicyRequest()
+	request := NewShowSessionPolicyRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
 	sessionPolicies, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
@@ -72,7 +74,15 @@ func (r *CreateSessionPolicyRequest) toOpts() *CreateSessionPolicyOptions {
 		name:                     r.name,
 		SessionIdleTimeoutMins:   r.SessionIdleTimeoutMins,
 		SessionUiIdleTimeoutMins: r.SessionUiIdleTimeoutMins,
-		Comment:                  r.Comment,
+
+		Comment: r.Comment,
+	}
+	if r.AllowedSecondaryRoles != nil {
+		opts.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{
+			None:  r.AllowedSecondaryRoles.None,
+			All:   r.AllowedSecondaryRoles.All,
+			Roles: r.AllowedSecondaryRoles.Roles,
+		}
 	}
 	return opts
 }
@@ -90,13 +100,22 @@ func (r *AlterSessionPolicyRequest) toOpts() *AlterSessionPolicyOptions {
 		opts.Set = &SessionPolicySet{
 			SessionIdleTimeoutMins:   r.Set.SessionIdleTimeoutMins,
 			SessionUiIdleTimeoutMins: r.Set.SessionUiIdleTimeoutMins,
-			Comment:                  r.Set.Comment,
+
+			Comment: r.Set.Comment,
+		}
+		if r.Set.AllowedSecondaryRoles != nil {
+			opts.Set.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{
+				None:  r.Set.AllowedSecondaryRoles.None,
+				All:   r.Set.AllowedSecondaryRoles.All,
+				Roles: r.Set.AllowedSecondaryRoles.Roles,
+			}
 		}
 	}
 	if r.Unset != nil {
 		opts.Unset = &SessionPolicyUnset{
 			SessionIdleTimeoutMins:   r.Unset.SessionIdleTimeoutMins,
 			SessionUiIdleTimeoutMins: r.Unset.SessionUiIdleTimeoutMins,
+			AllowedSecondaryRoles:    r.Unset.AllowedSecondaryRoles,
 			Comment:                  r.Unset.Comment,
 		}
 	}
@@ -112,7 +131,10 @@ func (r *DropSessionPolicyRequest) toOpts() *DropSessionPolicyOptions {
 }
 
 func (r *ShowSessionPolicyRequest) toOpts() *ShowSessionPolicyOptions {
-	opts := &ShowSessionPolicyOptions{}
+	opts := &ShowSessionPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
 	return opts
 }
 
@@ -143,6 +165,10 @@ func (r describeSessionPolicyDBRow) convert() *SessionPolicyDescription {
 		Name:                     r.Name,
 		SessionIdleTimeoutMins:   r.SessionIdleTimeoutMins,
 		SessionUIIdleTimeoutMins: r.SessionUiIdleTimeoutMins,
+		AllowedSecondaryRoles:    make([]string, 0),
+	}
+	if r.AllowedSecondaryRoles.Valid {
+		sessionPolicyDescription.AllowedSecondaryRoles = ParseCommaSeparatedStringArray(r.AllowedSecondaryRoles.String, true)
 	}
 	if r.Comment.Valid {
 		sessionPolicyDescription.Comment = r.Comment.String
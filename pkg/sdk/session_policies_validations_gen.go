@@ -1,7 +1,5 @@
 package sdk
 
-import "errors"
-
 var (
 	_ validatable = new(CreateSessionPolicyOptions)
 	_ validatable = new(AlterSessionPolicyOptions)
@@ -12,7 +10,7 @@ var (
 
 func (opts *CreateSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
@@ -21,59 +19,69 @@ func (opts *CreateSessionPolicyOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateSessionPolicyOptions", "OrReplace", "IfNotExists"))
 	}
-	return errors.Join(errs...)
+	if valueSet(opts.AllowedSecondaryRoles) {
+		if !exactlyOneValueSet(opts.AllowedSecondaryRoles.None, opts.AllowedSecondaryRoles.All, opts.AllowedSecondaryRoles.Roles) {
+			errs = append(errs, errExactlyOneOf("CreateSessionPolicyOptions.AllowedSecondaryRoles", "None", "All", "Roles"))
+		}
+	}
+	return JoinErrors(errs...)
 }
 
 func (opts *AlterSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.RenameTo, opts.Set, opts.SetTags, opts.UnsetTags, opts.Unset); !ok {
+	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.SetTags, opts.UnsetTags, opts.Unset) {
 		errs = append(errs, errExactlyOneOf("AlterSessionPolicyOptions", "RenameTo", "Set", "SetTags", "UnsetTags", "Unset"))
 	}
 	if valueSet(opts.Set) {
-		if ok := anyValueSet(opts.Set.SessionIdleTimeoutMins, opts.Set.SessionUiIdleTimeoutMins, opts.Set.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Set", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"))
+		if !anyValueSet(opts.Set.SessionIdleTimeoutMins, opts.Set.SessionUiIdleTimeoutMins, opts.Set.AllowedSecondaryRoles, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Set", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"))
+		}
+		if valueSet(opts.Set.AllowedSecondaryRoles) {
+			if !exactlyOneValueSet(opts.Set.AllowedSecondaryRoles.None, opts.Set.AllowedSecondaryRoles.All, opts.Set.AllowedSecondaryRoles.Roles) {
+				errs = append(errs, errExactlyOneOf("AlterSessionPolicyOptions.Set.AllowedSecondaryRoles", "None", "All", "Roles"))
+			}
 		}
 	}
 	if valueSet(opts.Unset) {
-		if ok := anyValueSet(opts.Unset.SessionIdleTimeoutMins, opts.Unset.SessionUiIdleTimeoutMins, opts.Unset.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Unset", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"))
+		if !anyValueSet(opts.Unset.SessionIdleTimeoutMins, opts.Unset.SessionUiIdleTimeoutMins, opts.Unset.AllowedSecondaryRoles, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Unset", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"))
 		}
 	}
-	return errors.Join(errs...)
+	return JoinErrors(errs...)
 }
 
 func (opts *DropSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	return JoinErrors(errs...)
 }
 
 func (opts *ShowSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
-	return errors.Join(errs...)
+	return JoinErrors(errs...)
 }
 
 func (opts *DescribeSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	return JoinErrors(errs...)
 }
@@ -0,0 +1,214 @@
+package sdk
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// showResultCacheRowLimit is the maximum number of rows returned by a single SHOW command.
+// Results that reach it may be incomplete, so they are never served from the cache.
+const showResultCacheRowLimit = 10000
+
+// showByIdQueryRegex matches the queries issued by the ShowByID methods, e.g. SHOW TABLES LIKE 'name' IN SCHEMA "db"."schema".
+// Only the account, database, and schema containers are supported. Queries with any other clauses (e.g. STARTS WITH or LIMIT)
+// are not matched and are always sent to Snowflake.
+var showByIdQueryRegex = regexp.MustCompile(`^(SHOW [A-Z ]+?) LIKE '((?:[^'\\]|\\.)*)'((?: IN (?:ACCOUNT|DATABASE "[^"]*"|SCHEMA "[^"]*"\."[^"]*"))?)$`)
+
+// showResultCache serves SHOW ... LIKE queries from the result of a single SHOW query per object type and container
+// (e.g. one SHOW TABLES IN SCHEMA for all the tables in the given schema). It is populated lazily, and it is cleared
+// whenever a statement that can change the objects is run through the client.
+type showResultCache struct {
+	mu      sync.Mutex
+	entries map[showResultCacheKey]*showResultCacheEntry
+	// generation is incremented on every invalidation. The entries loaded while the cache was invalidated are not published,
+	// because their rows may have been fetched before the change.
+	generation uint64
+}
+
+type showResultCacheKey struct {
+	sql     string
+	rowType reflect.Type
+}
+
+type showResultCacheEntry struct {
+	done       chan struct{}
+	generation uint64
+	rows       reflect.Value
+	err        error
+	// stale is set when the cache was invalidated during the load; the rows are then not served to any of the callers.
+	stale bool
+}
+
+func newShowResultCache() *showResultCache {
+	return &showResultCache{
+		entries: make(map[showResultCacheKey]*showResultCacheEntry),
+	}
+}
+
+// EnableShowResultCache turns on the SHOW result cache for the client. It is meant for the short-lived clients
+// (like the one used by the provider during a single Terraform operation), where the same containers are listed many times.
+func (c *Client) EnableShowResultCache() {
+	c.showResultCache = newShowResultCache()
+}
+
+func (c *showResultCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	if len(c.entries) > 0 {
+		log.Printf("[DEBUG] Invalidating SHOW result cache with %d entries", len(c.entries))
+		c.entries = make(map[showResultCacheKey]*showResultCacheEntry)
+	}
+}
+
+// query tries to serve the given query from the cache. It returns false if the query is not cacheable; in that case,
+// the query has to be run by the caller.
+func (c *showResultCache) query(ctx context.Context, client *Client, dest any, sql string) (bool, error) {
+	showSql, pattern, ok := parseShowByIdQuery(sql)
+	// Patterns with the escape character are rare, and they are left to Snowflake to interpret.
+	if !ok || strings.ContainsRune(pattern, '\\') {
+		return false, nil
+	}
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Pointer || destValue.Elem().Kind() != reflect.Slice {
+		return false, nil
+	}
+	sliceType := destValue.Elem().Type()
+	nameFieldIndex, ok := showResultNameFieldIndex(sliceType.Elem())
+	if !ok {
+		return false, nil
+	}
+
+	entry := c.load(ctx, showResultCacheKey{sql: showSql, rowType: sliceType}, sliceType, client.queryDirectly)
+	if entry.err != nil || entry.stale || entry.rows.Len() >= showResultCacheRowLimit {
+		return false, nil
+	}
+
+	matcher := showLikePatternRegex(pattern)
+	result := reflect.MakeSlice(sliceType, 0, 1)
+	for i := 0; i < entry.rows.Len(); i++ {
+		row := entry.rows.Index(i)
+		if matcher.MatchString(reflect.Indirect(row).Field(nameFieldIndex).String()) {
+			result = reflect.Append(result, row)
+		}
+	}
+	destValue.Elem().Set(result)
+	return true, nil
+}
+
+// load returns the cache entry for the given key, running the SHOW query with queryFunc if needed. Concurrent callers asking
+// for the same key wait for a single query.
+func (c *showResultCache) load(ctx context.Context, key showResultCacheKey, sliceType reflect.Type, queryFunc func(ctx context.Context, dest any, sql string) error) *showResultCacheEntry {
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		<-entry.done
+		return entry
+	}
+	entry := &showResultCacheEntry{done: make(chan struct{}), generation: c.generation}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	log.Printf("[DEBUG] Populating SHOW result cache with: %s", key.sql)
+	rows := reflect.New(sliceType)
+	entry.err = queryFunc(ctx, rows.Interface(), key.sql)
+	entry.rows = rows.Elem()
+
+	c.mu.Lock()
+	entry.stale = entry.generation != c.generation
+	if entry.stale {
+		log.Printf("[DEBUG] SHOW result cache was invalidated while populating it with: %s", key.sql)
+	}
+	// Errors and stale results are not cached; the original query is run by the callers instead.
+	if (entry.err != nil || entry.stale) && c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(entry.done)
+	return entry
+}
+
+// parseShowByIdQuery splits SHOW ... LIKE '<pattern>' [IN ...] query into the query without the LIKE clause and the pattern.
+func parseShowByIdQuery(sql string) (string, string, bool) {
+	matches := showByIdQueryRegex.FindStringSubmatch(strings.TrimSpace(sql))
+	if matches == nil {
+		return "", "", false
+	}
+	return matches[1] + matches[3], unescapeSingleQuotedString(matches[2]), true
+}
+
+// unescapeSingleQuotedString reverses the escaping done for the single-quoted strings by the SQL builder.
+func unescapeSingleQuotedString(s string) string {
+	var sb strings.Builder
+	escaped := false
+	for _, r := range s {
+		if !escaped {
+			if r == '\\' {
+				escaped = true
+			} else {
+				sb.WriteRune(r)
+			}
+			continue
+		}
+		escaped = false
+		for _, pair := range singleQuoteEscapes {
+			if pair.replacement == `\`+string(r) {
+				r = []rune(pair.original)[0]
+				break
+			}
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// showResultNameFieldIndex returns the index of the field mapped to the name column of the SHOW output.
+func showResultNameFieldIndex(rowType reflect.Type) (int, bool) {
+	if rowType.Kind() == reflect.Pointer {
+		rowType = rowType.Elem()
+	}
+	if rowType.Kind() != reflect.Struct {
+		return 0, false
+	}
+	for i := 0; i < rowType.NumField(); i++ {
+		field := rowType.Field(i)
+		if field.Tag.Get("db") == "name" && field.Type.Kind() == reflect.String {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// showLikePatternRegex translates the pattern of the LIKE clause to a regular expression. Like in Snowflake,
+// the matching is case-insensitive, % matches any sequence of characters, and _ matches any single character.
+func showLikePatternRegex(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString(`(?is)^`)
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			sb.WriteString(`.*`)
+		case '_':
+			sb.WriteString(`.`)
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString(`$`)
+	return regexp.MustCompile(sb.String())
+}
+
+// isReadOnlyQuery returns true for the statements that cannot change the objects listed by the SHOW queries.
+func isReadOnlyQuery(sql string) bool {
+	upper := strings.ToUpper(strings.TrimSpace(sql))
+	for _, prefix := range []string{"SHOW ", "DESC ", "DESCRIBE ", "SELECT ", "LIST "} {
+		if strings.HasPrefix(upper, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseShowByIdQuery(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	testCases := []struct {
+		name            string
+		opts            any
+		expectedSql     string
+		expectedPattern string
+	}{
+		{
+			name:            "account-level objects",
+			opts:            &ShowWarehouseOptions{Like: &Like{Pattern: String("WH")}},
+			expectedSql:     "SHOW WAREHOUSES",
+			expectedPattern: "WH",
+		},
+		{
+			name:            "database-level objects",
+			opts:            &ShowSchemaOptions{In: &SchemaIn{Database: Bool(true), Name: id.DatabaseId()}, Like: &Like{Pattern: String("SCHEMA")}},
+			expectedSql:     `SHOW SCHEMAS IN DATABASE ` + id.DatabaseId().FullyQualifiedName(),
+			expectedPattern: "SCHEMA",
+		},
+		{
+			name:            "schema-level objects",
+			opts:            NewShowTableRequest().WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}}).WithLike(Like{Pattern: String(id.Name())}).toOpts(),
+			expectedSql:     `SHOW TABLES IN SCHEMA ` + id.SchemaId().FullyQualifiedName(),
+			expectedPattern: id.Name(),
+		},
+		{
+			name:            "escaped characters",
+			opts:            &ShowWarehouseOptions{Like: &Like{Pattern: String(`a'b"c`)}},
+			expectedSql:     "SHOW WAREHOUSES",
+			expectedPattern: `a'b"c`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, err := structToSQL(tc.opts)
+			require.NoError(t, err)
+
+			showSql, pattern, ok := parseShowByIdQuery(sql)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedSql, showSql)
+			assert.Equal(t, tc.expectedPattern, pattern)
+		})
+	}
+
+	t.Run("not cacheable queries", func(t *testing.T) {
+		for _, sql := range []string{
+			"SHOW WAREHOUSES",
+			"SHOW TABLES IN SCHEMA \"a\".\"b\"",
+			"SHOW TABLES LIKE 'a' IN SCHEMA \"a\".\"b\" STARTS WITH 'a'",
+			"SHOW TABLES LIKE 'a' IN SCHEMA \"a\".\"b\" LIMIT 1",
+			"SHOW PARAMETERS LIKE 'a' IN ACCOUNT FOR USER \"u\"",
+			"SHOW APPLICATION ROLES LIKE 'a' IN APPLICATION \"a\"",
+			"DESCRIBE TABLE \"a\".\"b\".\"c\"",
+			"SELECT 'a'",
+		} {
+			_, _, ok := parseShowByIdQuery(sql)
+			assert.False(t, ok, sql)
+		}
+	})
+}
+
+func Test_showLikePatternRegex(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		value   string
+		matches bool
+	}{
+		{pattern: "abc", value: "abc", matches: true},
+		{pattern: "abc", value: "ABC", matches: true},
+		{pattern: "abc", value: "abcd", matches: false},
+		{pattern: "abc", value: "xabc", matches: false},
+		{pattern: "a_c", value: "abc", matches: true},
+		{pattern: "a_c", value: "a_c", matches: true},
+		{pattern: "a_c", value: "ac", matches: false},
+		{pattern: "a%", value: "a", matches: true},
+		{pattern: "a%", value: "abc", matches: true},
+		{pattern: "a.c", value: "abc", matches: false},
+		{pattern: "a(b)*", value: "a(b)*", matches: true},
+		{pattern: "a\nb", value: "a\nb", matches: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.pattern+" "+tc.value, func(t *testing.T) {
+			assert.Equal(t, tc.matches, showLikePatternRegex(tc.pattern).MatchString(tc.value))
+		})
+	}
+}
+
+func Test_showResultNameFieldIndex(t *testing.T) {
+	t.Run("struct with name", func(t *testing.T) {
+		index, ok := showResultNameFieldIndex(reflect.TypeOf(warehouseDBRow{}))
+		require.True(t, ok)
+		assert.Equal(t, "Name", reflect.TypeOf(warehouseDBRow{}).Field(index).Name)
+	})
+
+	t.Run("pointer to struct with name", func(t *testing.T) {
+		_, ok := showResultNameFieldIndex(reflect.TypeOf(&warehouseDBRow{}))
+		assert.True(t, ok)
+	})
+
+	t.Run("struct without name", func(t *testing.T) {
+		_, ok := showResultNameFieldIndex(reflect.TypeOf(struct {
+			Key string `db:"key"`
+		}{}))
+		assert.False(t, ok)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, ok := showResultNameFieldIndex(reflect.TypeOf(""))
+		assert.False(t, ok)
+	})
+}
+
+func Test_isReadOnlyQuery(t *testing.T) {
+	for _, sql := range []string{"SHOW TABLES", "show tables", "DESC TABLE t", "DESCRIBE TABLE t", "SELECT 1", " SELECT 1", "LIST @db.schema.stage"} {
+		assert.True(t, isReadOnlyQuery(sql), sql)
+	}
+	for _, sql := range []string{"CREATE TABLE t (id INT)", "ALTER TABLE t SET COMMENT = 'a'", "DROP TABLE t", "CALL p()", "USE ROLE r", "GRANT ROLE r TO USER u", "PUT 'file:///tmp/a.txt' @db.schema.stage", "REMOVE @db.schema.stage/a.txt"} {
+		assert.False(t, isReadOnlyQuery(sql), sql)
+	}
+}
+
+func Test_showResultCache_load(t *testing.T) {
+	sliceType := reflect.TypeOf([]warehouseDBRow{})
+	key := showResultCacheKey{sql: "SHOW WAREHOUSES", rowType: sliceType}
+	queryFunc := func(rows ...warehouseDBRow) func(context.Context, any, string) error {
+		return func(_ context.Context, dest any, _ string) error {
+			reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(rows))
+			return nil
+		}
+	}
+
+	t.Run("entry published", func(t *testing.T) {
+		cache := newShowResultCache()
+
+		entry := cache.load(context.Background(), key, sliceType, queryFunc(warehouseDBRow{Name: "WH"}))
+
+		require.NoError(t, entry.err)
+		assert.False(t, entry.stale)
+		assert.Equal(t, 1, entry.rows.Len())
+		assert.Same(t, entry, cache.entries[key])
+	})
+
+	t.Run("entry not published when invalidated during the load", func(t *testing.T) {
+		cache := newShowResultCache()
+
+		entry := cache.load(context.Background(), key, sliceType, func(ctx context.Context, dest any, sql string) error {
+			// e.g. the object was altered in another goroutine while the SHOW query was running
+			cache.invalidate()
+			return queryFunc(warehouseDBRow{Name: "WH"})(ctx, dest, sql)
+		})
+
+		assert.True(t, entry.stale)
+		assert.NotContains(t, cache.entries, key)
+
+		entry = cache.load(context.Background(), key, sliceType, queryFunc(warehouseDBRow{Name: "WH"}))
+
+		assert.False(t, entry.stale)
+		assert.Same(t, entry, cache.entries[key])
+	})
+
+	t.Run("errors not published", func(t *testing.T) {
+		cache := newShowResultCache()
+
+		entry := cache.load(context.Background(), key, sliceType, func(context.Context, any, string) error {
+			return errors.New("error")
+		})
+
+		require.Error(t, entry.err)
+		assert.NotContains(t, cache.entries, key)
+	})
+}
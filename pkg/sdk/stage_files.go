@@ -0,0 +1,202 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+var _ StageFiles = (*stageFiles)(nil)
+
+var (
+	_ validatable = new(PutStageFileOptions)
+	_ validatable = new(ListStageFilesOptions)
+	_ validatable = new(RemoveStageFilesOptions)
+)
+
+// StageFiles manages the files on the stages (PUT, LIST, and REMOVE commands).
+type StageFiles interface {
+	// Put uploads the local file (e.g. "/tmp/data.csv") to the given internal stage location.
+	Put(ctx context.Context, localFilePath string, location Location, opts *PutStageFileOptions) error
+	// PutStream uploads the content read from the given reader as a file with the given name to the given internal stage location.
+	PutStream(ctx context.Context, content io.Reader, fileName string, location Location, opts *PutStageFileOptions) error
+	List(ctx context.Context, location Location, opts *ListStageFilesOptions) ([]StageFile, error)
+	Remove(ctx context.Context, location Location, opts *RemoveStageFilesOptions) error
+}
+
+// stageFiles implements StageFiles.
+type stageFiles struct {
+	client *Client
+}
+
+// PutStageFileOptions is based on https://docs.snowflake.com/en/sql-reference/sql/put.
+type PutStageFileOptions struct {
+	put       bool     `ddl:"static" sql:"PUT"`
+	localFile string   `ddl:"keyword,single_quotes"`
+	location  Location `ddl:"parameter,no_quotes,no_equals"`
+
+	Parallel          *int                        `ddl:"parameter" sql:"PARALLEL"`
+	AutoCompress      *bool                       `ddl:"parameter" sql:"AUTO_COMPRESS"`
+	SourceCompression *StageFileSourceCompression `ddl:"parameter,no_quotes" sql:"SOURCE_COMPRESSION"`
+	Overwrite         *bool                       `ddl:"parameter" sql:"OVERWRITE"`
+}
+
+type StageFileSourceCompression string
+
+const (
+	StageFileSourceCompressionAutoDetect StageFileSourceCompression = "AUTO_DETECT"
+	StageFileSourceCompressionGzip       StageFileSourceCompression = "GZIP"
+	StageFileSourceCompressionBz2        StageFileSourceCompression = "BZ2"
+	StageFileSourceCompressionBrotli     StageFileSourceCompression = "BROTLI"
+	StageFileSourceCompressionZstd       StageFileSourceCompression = "ZSTD"
+	StageFileSourceCompressionDeflate    StageFileSourceCompression = "DEFLATE"
+	StageFileSourceCompressionRawDeflate StageFileSourceCompression = "RAW_DEFLATE"
+	StageFileSourceCompressionNone       StageFileSourceCompression = "NONE"
+)
+
+func (opts *PutStageFileOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if opts.localFile == "" || opts.localFile == "file://" {
+		errs = append(errs, errNotSet("PutStageFileOptions", "localFile"))
+	}
+	if opts.location == nil || opts.location.ToSql() == "" {
+		errs = append(errs, errNotSet("PutStageFileOptions", "location"))
+	}
+	if opts.Parallel != nil && (*opts.Parallel < 1 || *opts.Parallel > 99) {
+		errs = append(errs, errIntBetween("PutStageFileOptions", "Parallel", 1, 99))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *stageFiles) Put(ctx context.Context, localFilePath string, location Location, opts *PutStageFileOptions) error {
+	if opts == nil {
+		opts = &PutStageFileOptions{}
+	}
+	opts.localFile = fmt.Sprintf("file://%s", localFilePath)
+	opts.location = location
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *stageFiles) PutStream(ctx context.Context, content io.Reader, fileName string, location Location, opts *PutStageFileOptions) error {
+	if content == nil {
+		return errNotSet("PutStageFileOptions", "content")
+	}
+	// The driver uploads the stream instead of reading the file; the file name from the statement is used as the name of the staged file.
+	return v.Put(gosnowflake.WithFileStream(ctx, content), fileName, location, opts)
+}
+
+// ListStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/list.
+type ListStageFilesOptions struct {
+	list     bool     `ddl:"static" sql:"LIST"`
+	location Location `ddl:"parameter,no_quotes,no_equals"`
+	Pattern  *string  `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *ListStageFilesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if opts.location == nil || opts.location.ToSql() == "" {
+		return errors.Join(errNotSet("ListStageFilesOptions", "location"))
+	}
+	return nil
+}
+
+type stageFileRow struct {
+	Name         string         `db:"name"`
+	Size         int64          `db:"size"`
+	Md5          sql.NullString `db:"md5"`
+	LastModified string         `db:"last_modified"`
+}
+
+// StageFile is a single row returned by the LIST command.
+type StageFile struct {
+	// Name is the path of the file including the stage name (for named stages) or the cloud storage URL (for external stages).
+	Name         string
+	Size         int64
+	Md5          *string
+	LastModified string
+}
+
+func (row stageFileRow) convert() *StageFile {
+	file := &StageFile{
+		Name:         row.Name,
+		Size:         row.Size,
+		LastModified: row.LastModified,
+	}
+	if row.Md5.Valid {
+		file.Md5 = &row.Md5.String
+	}
+	return file
+}
+
+func (v *stageFiles) List(ctx context.Context, location Location, opts *ListStageFilesOptions) ([]StageFile, error) {
+	if opts == nil {
+		opts = &ListStageFilesOptions{}
+	}
+	opts.location = location
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	var rows []stageFileRow
+	if err := v.client.query(ctx, &rows, sql); err != nil {
+		return nil, err
+	}
+	files := make([]StageFile, len(rows))
+	for i, row := range rows {
+		files[i] = *row.convert()
+	}
+	return files, nil
+}
+
+// RemoveStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/remove.
+type RemoveStageFilesOptions struct {
+	remove   bool     `ddl:"static" sql:"REMOVE"`
+	location Location `ddl:"parameter,no_quotes,no_equals"`
+	Pattern  *string  `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *RemoveStageFilesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if opts.location == nil || opts.location.ToSql() == "" {
+		return errors.Join(errNotSet("RemoveStageFilesOptions", "location"))
+	}
+	return nil
+}
+
+func (v *stageFiles) Remove(ctx context.Context, location Location, opts *RemoveStageFilesOptions) error {
+	if opts == nil {
+		opts = &RemoveStageFilesOptions{}
+	}
+	opts.location = location
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestStageFilesPut(t *testing.T) {
+	stageId := NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *PutStageFileOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: local file and location not set", func(t *testing.T) {
+		opts := &PutStageFileOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("PutStageFileOptions", "localFile"), errNotSet("PutStageFileOptions", "location"))
+	})
+
+	t.Run("validation: parallel out of range", func(t *testing.T) {
+		opts := &PutStageFileOptions{
+			localFile: "file:///tmp/data.csv",
+			location:  NewStageLocation(stageId, ""),
+			Parallel:  Int(100),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errIntBetween("PutStageFileOptions", "Parallel", 1, 99))
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &PutStageFileOptions{
+			localFile: "file:///tmp/data.csv",
+			location:  NewStageLocation(stageId, ""),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/data.csv' @"db"."schema"."stage"`)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &PutStageFileOptions{
+			localFile:         "file:///tmp/data.csv",
+			location:          NewStageLocation(stageId, "some/path"),
+			Parallel:          Int(4),
+			AutoCompress:      Bool(false),
+			SourceCompression: Pointer(StageFileSourceCompressionGzip),
+			Overwrite:         Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/data.csv' @"db"."schema"."stage"/some/path PARALLEL = 4 AUTO_COMPRESS = false SOURCE_COMPRESSION = GZIP OVERWRITE = true`)
+	})
+}
+
+func TestStageFilesList(t *testing.T) {
+	stageId := NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ListStageFilesOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: location not set", func(t *testing.T) {
+		opts := &ListStageFilesOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("ListStageFilesOptions", "location"))
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &ListStageFilesOptions{
+			location: NewStageLocation(stageId, ""),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `LIST @"db"."schema"."stage"`)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &ListStageFilesOptions{
+			location: NewStageLocation(stageId, "some/path"),
+			Pattern:  String(".*[.]csv"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `LIST @"db"."schema"."stage"/some/path PATTERN = '.*[.]csv'`)
+	})
+}
+
+func TestStageFilesRemove(t *testing.T) {
+	stageId := NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *RemoveStageFilesOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: location not set", func(t *testing.T) {
+		opts := &RemoveStageFilesOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("RemoveStageFilesOptions", "location"))
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &RemoveStageFilesOptions{
+			location: NewStageLocation(stageId, "some/path/data.csv.gz"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE @"db"."schema"."stage"/some/path/data.csv.gz`)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &RemoveStageFilesOptions{
+			location: NewStageLocation(stageId, "some/path"),
+			Pattern:  String(".*[.]csv"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE @"db"."schema"."stage"/some/path PATTERN = '.*[.]csv'`)
+	})
+}
@@ -31,7 +31,7 @@ func (s *CreateStreamlitRequest) WithQueryWarehouse(QueryWarehouse AccountObject
 	return s
 }
 
-func (s *CreateStreamlitRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations ExternalAccessIntegrationsRequest) *CreateStreamlitRequest {
+func (s *CreateStreamlitRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations StreamlitExternalAccessIntegrationsRequest) *CreateStreamlitRequest {
 	s.ExternalAccessIntegrations = &ExternalAccessIntegrations
 	return s
 }
@@ -46,10 +46,10 @@ func (s *CreateStreamlitRequest) WithComment(Comment string) *CreateStreamlitReq
 	return s
 }
 
-func NewExternalAccessIntegrationsRequest(
+func NewStreamlitExternalAccessIntegrationsRequest(
 	ExternalAccessIntegrations []AccountObjectIdentifier,
-) *ExternalAccessIntegrationsRequest {
-	s := ExternalAccessIntegrationsRequest{}
+) *StreamlitExternalAccessIntegrationsRequest {
+	s := StreamlitExternalAccessIntegrationsRequest{}
 	s.ExternalAccessIntegrations = ExternalAccessIntegrations
 	return &s
 }
@@ -101,7 +101,7 @@ func (s *StreamlitSetRequest) WithQueryWarehouse(QueryWarehouse AccountObjectIde
 	return s
 }
 
-func (s *StreamlitSetRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations ExternalAccessIntegrationsRequest) *StreamlitSetRequest {
+func (s *StreamlitSetRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations StreamlitExternalAccessIntegrationsRequest) *StreamlitSetRequest {
 	s.ExternalAccessIntegrations = &ExternalAccessIntegrations
 	return s
 }
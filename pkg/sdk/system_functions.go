@@ -19,6 +19,8 @@ type SystemFunctions interface {
 	PipeForceResume(pipeId SchemaObjectIdentifier, options []ForceResumePipeOption) error
 	EnableBehaviorChangeBundle(ctx context.Context, bundle string) error
 	DisableBehaviorChangeBundle(ctx context.Context, bundle string) error
+	// TaskDependentsEnable recursively resumes all the dependent tasks of the given root task, and then the root task itself.
+	TaskDependentsEnable(ctx context.Context, rootTaskId SchemaObjectIdentifier) error
 }
 
 var _ SystemFunctions = (*systemFunctions)(nil)
@@ -139,3 +141,8 @@ func (c *systemFunctions) DisableBehaviorChangeBundle(ctx context.Context, bundl
 	_, err := c.client.exec(ctx, fmt.Sprintf("SELECT SYSTEM$DISABLE_BEHAVIOR_CHANGE_BUNDLE('%s')", bundle))
 	return err
 }
+
+func (c *systemFunctions) TaskDependentsEnable(ctx context.Context, rootTaskId SchemaObjectIdentifier) error {
+	_, err := c.client.exec(ctx, fmt.Sprintf("SELECT SYSTEM$TASK_DEPENDENTS_ENABLE('%s')", rootTaskId.FullyQualifiedName()))
+	return err
+}
@@ -225,6 +225,10 @@ type alterTableOptions struct {
 	DropRowAccessPolicy       *TableDropRowAccessPolicy       `ddl:"keyword"`
 	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy `ddl:"list,no_parentheses"`
 	DropAllAccessRowPolicies  *bool                           `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	SetAggregationPolicy      *TableSetAggregationPolicy      `ddl:"keyword"`
+	UnsetAggregationPolicy    *bool                           `ddl:"keyword" sql:"UNSET AGGREGATION POLICY"`
+	SetJoinPolicy             *TableSetJoinPolicy             `ddl:"keyword"`
+	UnsetJoinPolicy           *bool                           `ddl:"keyword" sql:"UNSET JOIN POLICY"`
 }
 
 type TableClusteringAction struct {
@@ -254,14 +258,16 @@ const (
 
 type TableColumnAction struct {
 	// One of
-	Add                *TableColumnAddAction                     `ddl:"keyword" sql:"ADD"`
-	Rename             *TableColumnRenameAction                  `ddl:"keyword"`
-	Alter              []TableColumnAlterAction                  `ddl:"keyword" sql:"ALTER"`
-	SetMaskingPolicy   *TableColumnAlterSetMaskingPolicyAction   `ddl:"keyword"`
-	UnsetMaskingPolicy *TableColumnAlterUnsetMaskingPolicyAction `ddl:"keyword"`
-	SetTags            *TableColumnAlterSetTagsAction            `ddl:"keyword"`
-	UnsetTags          *TableColumnAlterUnsetTagsAction          `ddl:"keyword"`
-	DropColumns        *TableColumnAlterDropColumns              `ddl:"keyword"`
+	Add                   *TableColumnAddAction                        `ddl:"keyword" sql:"ADD"`
+	Rename                *TableColumnRenameAction                     `ddl:"keyword"`
+	Alter                 []TableColumnAlterAction                     `ddl:"keyword" sql:"ALTER"`
+	SetMaskingPolicy      *TableColumnAlterSetMaskingPolicyAction      `ddl:"keyword"`
+	UnsetMaskingPolicy    *TableColumnAlterUnsetMaskingPolicyAction    `ddl:"keyword"`
+	SetProjectionPolicy   *TableColumnAlterSetProjectionPolicyAction   `ddl:"keyword"`
+	UnsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyAction `ddl:"keyword"`
+	SetTags               *TableColumnAlterSetTagsAction               `ddl:"keyword"`
+	UnsetTags             *TableColumnAlterUnsetTagsAction             `ddl:"keyword"`
+	DropColumns           *TableColumnAlterDropColumns                 `ddl:"keyword"`
 }
 
 type TableColumnAddAction struct {
@@ -323,6 +329,20 @@ type TableColumnAlterUnsetMaskingPolicyAction struct {
 	setMaskingPolicy bool   `ddl:"static" sql:"UNSET MASKING POLICY"`
 }
 
+type TableColumnAlterSetProjectionPolicyAction struct {
+	alter                bool                   `ddl:"static" sql:"ALTER COLUMN"`
+	ColumnName           string                 `ddl:"keyword"`
+	setProjectionPolicy  bool                   `ddl:"static" sql:"SET PROJECTION POLICY"`
+	ProjectionPolicyName SchemaObjectIdentifier `ddl:"identifier"`
+	Force                *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
+type TableColumnAlterUnsetProjectionPolicyAction struct {
+	alter                 bool   `ddl:"static" sql:"ALTER COLUMN"`
+	ColumnName            string `ddl:"keyword"`
+	unsetProjectionPolicy bool   `ddl:"static" sql:"UNSET PROJECTION POLICY"`
+}
+
 type TableColumnAlterSetTagsAction struct {
 	alter      bool             `ddl:"static" sql:"ALTER COLUMN"`
 	ColumnName string           `ddl:"keyword"`
@@ -481,6 +501,19 @@ type TableDropRowAccessPolicy struct {
 	RowAccessPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"ROW ACCESS POLICY"`
 }
 
+type TableSetAggregationPolicy struct {
+	set               bool                   `ddl:"static" sql:"SET"`
+	AggregationPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"AGGREGATION POLICY"`
+	EntityKey         []string               `ddl:"keyword,parentheses" sql:"ENTITY KEY"`
+	Force             *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
+type TableSetJoinPolicy struct {
+	set        bool                   `ddl:"static" sql:"SET"`
+	JoinPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"JOIN POLICY"`
+	Force      *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
 type TableDropAndAddRowAccessPolicy struct {
 	Drop TableDropRowAccessPolicy `ddl:"keyword"`
 	Add  TableAddRowAccessPolicy  `ddl:"keyword"`
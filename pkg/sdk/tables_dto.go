@@ -188,6 +188,10 @@ type AlterTableRequest struct {
 	DropRowAccessPolicy       *TableDropRowAccessPolicyRequest
 	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy
 	DropAllAccessRowPolicies  *bool
+	SetAggregationPolicy      *TableSetAggregationPolicyRequest
+	UnsetAggregationPolicy    *bool
+	SetJoinPolicy             *TableSetJoinPolicyRequest
+	UnsetJoinPolicy           *bool
 }
 
 type DropTableRequest struct {
@@ -240,6 +244,17 @@ type TableDropRowAccessPolicyRequest struct {
 	RowAccessPolicy SchemaObjectIdentifier // required
 }
 
+type TableSetAggregationPolicyRequest struct {
+	AggregationPolicy SchemaObjectIdentifier // required
+	EntityKey         []string
+	Force             *bool
+}
+
+type TableSetJoinPolicyRequest struct {
+	JoinPolicy SchemaObjectIdentifier // required
+	Force      *bool
+}
+
 type TableDropAndAddRowAccessPolicyRequest struct {
 	Drop TableDropRowAccessPolicyRequest // required
 	Add  TableAddRowAccessPolicyRequest  // required
@@ -354,15 +369,17 @@ type TableReclusterChangeStateRequest struct {
 }
 
 type TableColumnActionRequest struct {
-	Add                 *TableColumnAddActionRequest
-	Rename              *TableColumnRenameActionRequest
-	Alter               []TableColumnAlterActionRequest
-	SetMaskingPolicy    *TableColumnAlterSetMaskingPolicyActionRequest
-	UnsetMaskingPolicy  *TableColumnAlterUnsetMaskingPolicyActionRequest
-	SetTags             *TableColumnAlterSetTagsActionRequest
-	UnsetTags           *TableColumnAlterUnsetTagsActionRequest
-	DropColumnsIfExists *bool
-	DropColumns         []string
+	Add                   *TableColumnAddActionRequest
+	Rename                *TableColumnRenameActionRequest
+	Alter                 []TableColumnAlterActionRequest
+	SetMaskingPolicy      *TableColumnAlterSetMaskingPolicyActionRequest
+	UnsetMaskingPolicy    *TableColumnAlterUnsetMaskingPolicyActionRequest
+	SetProjectionPolicy   *TableColumnAlterSetProjectionPolicyActionRequest
+	UnsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyActionRequest
+	SetTags               *TableColumnAlterSetTagsActionRequest
+	UnsetTags             *TableColumnAlterUnsetTagsActionRequest
+	DropColumnsIfExists   *bool
+	DropColumns           []string
 }
 
 type TableColumnAddActionRequest struct {
@@ -419,6 +436,16 @@ type TableColumnAlterUnsetMaskingPolicyActionRequest struct {
 	ColumnName string // required
 }
 
+type TableColumnAlterSetProjectionPolicyActionRequest struct {
+	ColumnName           string                 // required
+	ProjectionPolicyName SchemaObjectIdentifier // required
+	Force                *bool
+}
+
+type TableColumnAlterUnsetProjectionPolicyActionRequest struct {
+	ColumnName string // required
+}
+
 type TableColumnAlterSetTagsActionRequest struct {
 	ColumnName string           // required
 	Tags       []TagAssociation // required
@@ -652,6 +652,26 @@ func (s *AlterTableRequest) WithDropAllAccessRowPolicies(dropAllAccessRowPolicie
 	return s
 }
 
+func (s *AlterTableRequest) WithSetAggregationPolicy(setAggregationPolicy *TableSetAggregationPolicyRequest) *AlterTableRequest {
+	s.SetAggregationPolicy = setAggregationPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithUnsetAggregationPolicy(unsetAggregationPolicy *bool) *AlterTableRequest {
+	s.UnsetAggregationPolicy = unsetAggregationPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithSetJoinPolicy(setJoinPolicy *TableSetJoinPolicyRequest) *AlterTableRequest {
+	s.SetJoinPolicy = setJoinPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithUnsetJoinPolicy(unsetJoinPolicy *bool) *AlterTableRequest {
+	s.UnsetJoinPolicy = unsetJoinPolicy
+	return s
+}
+
 func NewDropTableRequest(
 	name SchemaObjectIdentifier,
 ) *DropTableRequest {
@@ -685,6 +705,37 @@ func NewTableAddRowAccessPolicyRequest(
 	return &s
 }
 
+func NewTableSetAggregationPolicyRequest(
+	aggregationPolicy SchemaObjectIdentifier,
+) *TableSetAggregationPolicyRequest {
+	s := TableSetAggregationPolicyRequest{}
+	s.AggregationPolicy = aggregationPolicy
+	return &s
+}
+
+func (s *TableSetAggregationPolicyRequest) WithEntityKey(entityKey []string) *TableSetAggregationPolicyRequest {
+	s.EntityKey = entityKey
+	return s
+}
+
+func (s *TableSetAggregationPolicyRequest) WithForce(force *bool) *TableSetAggregationPolicyRequest {
+	s.Force = force
+	return s
+}
+
+func NewTableSetJoinPolicyRequest(
+	joinPolicy SchemaObjectIdentifier,
+) *TableSetJoinPolicyRequest {
+	s := TableSetJoinPolicyRequest{}
+	s.JoinPolicy = joinPolicy
+	return &s
+}
+
+func (s *TableSetJoinPolicyRequest) WithForce(force *bool) *TableSetJoinPolicyRequest {
+	s.Force = force
+	return s
+}
+
 func NewTableDropRowAccessPolicyRequest(
 	rowAccessPolicy SchemaObjectIdentifier,
 ) *TableDropRowAccessPolicyRequest {
@@ -1127,6 +1178,16 @@ func (s *TableColumnActionRequest) WithUnsetMaskingPolicy(unsetMaskingPolicy *Ta
 	return s
 }
 
+func (s *TableColumnActionRequest) WithSetProjectionPolicy(setProjectionPolicy *TableColumnAlterSetProjectionPolicyActionRequest) *TableColumnActionRequest {
+	s.SetProjectionPolicy = setProjectionPolicy
+	return s
+}
+
+func (s *TableColumnActionRequest) WithUnsetProjectionPolicy(unsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyActionRequest) *TableColumnActionRequest {
+	s.UnsetProjectionPolicy = unsetProjectionPolicy
+	return s
+}
+
 func (s *TableColumnActionRequest) WithSetTags(setTags *TableColumnAlterSetTagsActionRequest) *TableColumnActionRequest {
 	s.SetTags = setTags
 	return s
@@ -1313,6 +1374,29 @@ func NewTableColumnAlterUnsetMaskingPolicyActionRequest(
 	return &s
 }
 
+func NewTableColumnAlterSetProjectionPolicyActionRequest(
+	columnName string,
+	projectionPolicyName SchemaObjectIdentifier,
+) *TableColumnAlterSetProjectionPolicyActionRequest {
+	s := TableColumnAlterSetProjectionPolicyActionRequest{}
+	s.ColumnName = columnName
+	s.ProjectionPolicyName = projectionPolicyName
+	return &s
+}
+
+func (s *TableColumnAlterSetProjectionPolicyActionRequest) WithForce(force *bool) *TableColumnAlterSetProjectionPolicyActionRequest {
+	s.Force = force
+	return s
+}
+
+func NewTableColumnAlterUnsetProjectionPolicyActionRequest(
+	columnName string,
+) *TableColumnAlterUnsetProjectionPolicyActionRequest {
+	s := TableColumnAlterUnsetProjectionPolicyActionRequest{}
+	s.ColumnName = columnName
+	return &s
+}
+
 func NewTableColumnAlterSetTagsActionRequest(
 	columnName string,
 	tags []TagAssociation,
@@ -196,6 +196,22 @@ func (s *AlterTableRequest) toOpts() *alterTableOptions {
 		}
 	}
 
+	var setAggregationPolicy *TableSetAggregationPolicy
+	if s.SetAggregationPolicy != nil {
+		setAggregationPolicy = &TableSetAggregationPolicy{
+			AggregationPolicy: s.SetAggregationPolicy.AggregationPolicy,
+			EntityKey:         s.SetAggregationPolicy.EntityKey,
+			Force:             s.SetAggregationPolicy.Force,
+		}
+	}
+	var setJoinPolicy *TableSetJoinPolicy
+	if s.SetJoinPolicy != nil {
+		setJoinPolicy = &TableSetJoinPolicy{
+			JoinPolicy: s.SetJoinPolicy.JoinPolicy,
+			Force:      s.SetJoinPolicy.Force,
+		}
+	}
+
 	return &alterTableOptions{
 		IfExists:                  s.IfExists,
 		name:                      s.name,
@@ -214,6 +230,10 @@ func (s *AlterTableRequest) toOpts() *alterTableOptions {
 		DropRowAccessPolicy:       dropRowAccessPolicy,
 		DropAndAddRowAccessPolicy: dropAndAddRowAccessPolicy,
 		DropAllAccessRowPolicies:  s.DropAllAccessRowPolicies,
+		SetAggregationPolicy:      setAggregationPolicy,
+		UnsetAggregationPolicy:    s.UnsetAggregationPolicy,
+		SetJoinPolicy:             setJoinPolicy,
+		UnsetJoinPolicy:           s.UnsetJoinPolicy,
 	}
 }
 
@@ -457,6 +477,22 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 			},
 		}
 	}
+	if r.SetProjectionPolicy != nil {
+		return &TableColumnAction{
+			SetProjectionPolicy: &TableColumnAlterSetProjectionPolicyAction{
+				ColumnName:           r.SetProjectionPolicy.ColumnName,
+				ProjectionPolicyName: r.SetProjectionPolicy.ProjectionPolicyName,
+				Force:                r.SetProjectionPolicy.Force,
+			},
+		}
+	}
+	if r.UnsetProjectionPolicy != nil {
+		return &TableColumnAction{
+			UnsetProjectionPolicy: &TableColumnAlterUnsetProjectionPolicyAction{
+				ColumnName: r.UnsetProjectionPolicy.ColumnName,
+			},
+		}
+	}
 	if r.SetTags != nil {
 		return &TableColumnAction{
 			SetTags: &TableColumnAlterSetTagsAction{
@@ -734,7 +734,7 @@ func TestTableAlter(t *testing.T) {
 
 	t.Run("validation: no action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy"))
 	})
 
 	t.Run("validation: incorrect identifier", func(t *testing.T) {
@@ -748,7 +748,7 @@ func TestTableAlter(t *testing.T) {
 		opts.NewName = Pointer(randomSchemaObjectIdentifier())
 		opts.SwapWith = Pointer(randomSchemaObjectIdentifier())
 
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy"))
 	})
 
 	t.Run("validation: NewName's incorrect identifier", func(t *testing.T) {
@@ -784,7 +784,7 @@ func TestTableAlter(t *testing.T) {
 	t.Run("validation: column action - no option present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.ColumnAction = &TableColumnAction{}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
 	})
 
 	t.Run("validation: column action - two options present", func(t *testing.T) {
@@ -796,7 +796,7 @@ func TestTableAlter(t *testing.T) {
 				OldName: "old",
 			},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
 	})
 
 	t.Run("validation: column action alter - no option present", func(t *testing.T) {
@@ -1089,6 +1089,33 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 UNSET MASKING POLICY", id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set projection policy", func(t *testing.T) {
+		projectionPolicyName := randomSchemaObjectIdentifier()
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				SetProjectionPolicy: &TableColumnAlterSetProjectionPolicyAction{
+					ColumnName:           "COLUMN_1",
+					ProjectionPolicyName: projectionPolicyName,
+					Force:                Bool(true),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 SET PROJECTION POLICY %s FORCE", id.FullyQualifiedName(), projectionPolicyName.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset projection policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				UnsetProjectionPolicy: &TableColumnAlterUnsetProjectionPolicyAction{
+					ColumnName: "COLUMN_1",
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 UNSET PROJECTION POLICY", id.FullyQualifiedName())
+	})
+
 	t.Run("alter: set tags", func(t *testing.T) {
 		tagId1 := randomSchemaObjectIdentifier()
 		tagId2 := randomSchemaObjectIdentifierInSchema(tagId1.SchemaId())
@@ -1450,6 +1477,47 @@ func TestTableAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s DROP ALL ROW ACCESS POLICIES`, id.FullyQualifiedName())
 	})
+
+	t.Run("set aggregation policy", func(t *testing.T) {
+		aggregationPolicyId := randomSchemaObjectIdentifier()
+		opts := &alterTableOptions{
+			name: id,
+			SetAggregationPolicy: &TableSetAggregationPolicy{
+				AggregationPolicy: aggregationPolicyId,
+				EntityKey:         []string{"FOO", "BAR"},
+				Force:             Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s SET AGGREGATION POLICY %s ENTITY KEY (FOO, BAR) FORCE`, id.FullyQualifiedName(), aggregationPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset aggregation policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name:                   id,
+			UnsetAggregationPolicy: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s UNSET AGGREGATION POLICY`, id.FullyQualifiedName())
+	})
+
+	t.Run("set join policy", func(t *testing.T) {
+		joinPolicyId := randomSchemaObjectIdentifier()
+		opts := &alterTableOptions{
+			name: id,
+			SetJoinPolicy: &TableSetJoinPolicy{
+				JoinPolicy: joinPolicyId,
+				Force:      Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s SET JOIN POLICY %s FORCE`, id.FullyQualifiedName(), joinPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset join policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name:            id,
+			UnsetJoinPolicy: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s UNSET JOIN POLICY`, id.FullyQualifiedName())
+	})
 }
 
 func TestTableDrop(t *testing.T) {
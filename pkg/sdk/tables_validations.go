@@ -159,8 +159,12 @@ func (opts *alterTableOptions) validate() error {
 		opts.DropRowAccessPolicy,
 		opts.DropAndAddRowAccessPolicy,
 		opts.DropAllAccessRowPolicies,
+		opts.SetAggregationPolicy,
+		opts.UnsetAggregationPolicy,
+		opts.SetJoinPolicy,
+		opts.UnsetJoinPolicy,
 	); !ok {
-		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy"))
 	}
 	if opts.NewName != nil {
 		if !ValidObjectIdentifier(*opts.NewName) {
@@ -172,6 +176,16 @@ func (opts *alterTableOptions) validate() error {
 			errs = append(errs, errInvalidIdentifier("alterTableOptions", "SwapWith"))
 		}
 	}
+	if opts.SetAggregationPolicy != nil {
+		if !ValidObjectIdentifier(opts.SetAggregationPolicy.AggregationPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableSetAggregationPolicy", "AggregationPolicy"))
+		}
+	}
+	if opts.SetJoinPolicy != nil {
+		if !ValidObjectIdentifier(opts.SetJoinPolicy.JoinPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableSetJoinPolicy", "JoinPolicy"))
+		}
+	}
 	if clusteringAction := opts.ClusteringAction; valueSet(clusteringAction) {
 		if ok := exactlyOneValueSet(
 			clusteringAction.ClusterBy,
@@ -189,11 +203,13 @@ func (opts *alterTableOptions) validate() error {
 			columnAction.Alter,
 			columnAction.SetMaskingPolicy,
 			columnAction.UnsetMaskingPolicy,
+			columnAction.SetProjectionPolicy,
+			columnAction.UnsetProjectionPolicy,
 			columnAction.SetTags,
 			columnAction.UnsetTags,
 			columnAction.DropColumns,
 		); !ok {
-			errs = append(errs, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+			errs = append(errs, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
 		}
 		for _, alterAction := range columnAction.Alter {
 			if ok := exactlyOneValueSet(
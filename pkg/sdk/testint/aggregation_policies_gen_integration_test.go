@@ -0,0 +1,203 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_AggregationPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+
+	assertAggregationPolicy := func(t *testing.T, aggregationPolicy *sdk.AggregationPolicy, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.NotEmpty(t, aggregationPolicy.CreatedOn)
+		assert.Equal(t, id.Name(), aggregationPolicy.Name)
+		assert.Equal(t, id.SchemaName(), aggregationPolicy.SchemaName)
+		assert.Equal(t, id.DatabaseName(), aggregationPolicy.DatabaseName)
+		assert.Equal(t, "ACCOUNTADMIN", aggregationPolicy.Owner)
+		assert.Equal(t, expectedComment, aggregationPolicy.Comment)
+		assert.Equal(t, "AGGREGATION_POLICY", aggregationPolicy.Kind)
+		assert.Equal(t, "", aggregationPolicy.Options)
+		assert.Equal(t, "ROLE", aggregationPolicy.OwnerRoleType)
+	}
+
+	assertAggregationPolicyDescription := func(t *testing.T, aggregationPolicyDescription *sdk.AggregationPolicyDescription, id sdk.SchemaObjectIdentifier, expectedBody string) {
+		t.Helper()
+		assert.Equal(t, id.Name(), aggregationPolicyDescription.Name)
+		assert.Equal(t, "()", aggregationPolicyDescription.Signature)
+		assert.Equal(t, "AGGREGATION_CONSTRAINT", aggregationPolicyDescription.ReturnType)
+		assert.Equal(t, expectedBody, aggregationPolicyDescription.Body)
+	}
+
+	cleanupAggregationPolicyProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.AggregationPolicies.Drop(ctx, sdk.NewDropAggregationPolicyRequest(id).WithIfExists(true))
+			require.NoError(t, err)
+		}
+	}
+
+	createAggregationPolicy := func(t *testing.T) *sdk.AggregationPolicy {
+		t.Helper()
+		aggregationPolicy, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicyWithOptions(t, sdk.NewCreateAggregationPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body))
+		t.Cleanup(cleanup)
+		return aggregationPolicy
+	}
+
+	t.Run("create: complete case", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		request := sdk.NewCreateAggregationPolicyRequest(id, body).
+			WithOrReplace(true).
+			WithComment(comment)
+
+		err := client.AggregationPolicies.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupAggregationPolicyProvider(id))
+
+		aggregationPolicy, err := client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, aggregationPolicy, id, comment)
+
+		aggregationPolicyDescription, err := client.AggregationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assertAggregationPolicyDescription(t, aggregationPolicyDescription, id, body)
+	})
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AggregationPolicies.Create(ctx, sdk.NewCreateAggregationPolicyRequest(id, body).WithIfNotExists(true))
+		require.NoError(t, err)
+		t.Cleanup(cleanupAggregationPolicyProvider(id))
+
+		aggregationPolicy, err := client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, aggregationPolicy, id, "")
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AggregationPolicies.Create(ctx, sdk.NewCreateAggregationPolicyRequest(id, body))
+		require.NoError(t, err)
+
+		err = client.AggregationPolicies.Drop(ctx, sdk.NewDropAggregationPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.AggregationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.AggregationPolicies.Drop(ctx, sdk.NewDropAggregationPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		aggregationPolicy := createAggregationPolicy(t)
+		id := aggregationPolicy.ID()
+		comment := random.Comment()
+
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetComment(comment))
+		require.NoError(t, err)
+
+		alteredAggregationPolicy, err := client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, comment, alteredAggregationPolicy.Comment)
+
+		err = client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		alteredAggregationPolicy, err = client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, alteredAggregationPolicy.Comment)
+	})
+
+	t.Run("alter: set body", func(t *testing.T) {
+		aggregationPolicy := createAggregationPolicy(t)
+		id := aggregationPolicy.ID()
+		newBody := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 10)"
+
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetBody(newBody))
+		require.NoError(t, err)
+
+		aggregationPolicyDescription, err := client.AggregationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assertAggregationPolicyDescription(t, aggregationPolicyDescription, id, newBody)
+	})
+
+	t.Run("alter: set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		aggregationPolicy := createAggregationPolicy(t)
+		id := aggregationPolicy.ID()
+
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}}))
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeAggregationPolicy)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", *returnedTagValue)
+
+		err = client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}))
+		require.NoError(t, err)
+
+		returnedTagValue, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeAggregationPolicy)
+		require.NoError(t, err)
+		assert.Nil(t, returnedTagValue)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AggregationPolicies.Create(ctx, sdk.NewCreateAggregationPolicyRequest(id, body))
+		require.NoError(t, err)
+
+		err = client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithRenameTo(newId))
+		if err != nil {
+			t.Cleanup(cleanupAggregationPolicyProvider(id))
+		} else {
+			t.Cleanup(cleanupAggregationPolicyProvider(newId))
+		}
+		require.NoError(t, err)
+
+		_, err = client.AggregationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		aggregationPolicy, err := client.AggregationPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, aggregationPolicy, newId, "")
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		aggregationPolicy1 := createAggregationPolicy(t)
+		aggregationPolicy2 := createAggregationPolicy(t)
+
+		returnedAggregationPolicies, err := client.AggregationPolicies.Show(ctx, sdk.NewShowAggregationPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(aggregationPolicy1.Name)}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: testClientHelper().Ids.SchemaId()}}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedAggregationPolicies, 1)
+		assert.Contains(t, returnedAggregationPolicies, *aggregationPolicy1)
+		assert.NotContains(t, returnedAggregationPolicies, *aggregationPolicy2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.AggregationPolicies.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
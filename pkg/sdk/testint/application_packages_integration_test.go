@@ -229,5 +229,36 @@ func TestInt_ApplicationPackagesVersionAndReleaseDirective(t *testing.T) {
 		r2 := sdk.NewAlterApplicationPackageRequest(id).WithSetDefaultReleaseDirective(rr)
 		err = client.ApplicationPackages.Alter(ctx, r2)
 		require.NoError(t, err)
+
+		releaseDirectives, err := client.ApplicationPackages.ShowReleaseDirectives(ctx, sdk.NewShowReleaseDirectivesApplicationPackageRequest(id))
+		require.NoError(t, err)
+		require.Len(t, releaseDirectives, 1)
+		require.Equal(t, "DEFAULT", releaseDirectives[0].Name)
+		require.Equal(t, version, releaseDirectives[0].Version)
+		require.Equal(t, 0, releaseDirectives[0].Patch)
+	})
+
+	t.Run("show versions", func(t *testing.T) {
+		e := createApplicationPackageHandle(t)
+		stage, stageCleanup := testClientHelper().Stage.CreateStage(t)
+		t.Cleanup(stageCleanup)
+		testClientHelper().Stage.PutOnStage(t, stage.ID(), "manifest.yml")
+		testClientHelper().Stage.PutOnStage(t, stage.ID(), "setup.sql")
+
+		version := "V001"
+		using := "@" + stage.ID().FullyQualifiedName()
+		id := e.ID()
+		vr := sdk.NewAddVersionRequest(using).WithVersionIdentifier(&version).WithLabel(sdk.String("add version V001"))
+		err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithAddVersion(vr))
+		require.NoError(t, err)
+
+		versions, err := client.ApplicationPackages.ShowVersions(ctx, sdk.NewShowVersionsApplicationPackageRequest(id))
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		require.Equal(t, version, versions[0].Version)
+		require.Equal(t, 0, versions[0].Patch)
+		require.NotNil(t, versions[0].Label)
+		require.Equal(t, "add version V001", *versions[0].Label)
+		require.NotEmpty(t, versions[0].CreatedOn)
 	})
 }
@@ -0,0 +1,168 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_CatalogIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertCatalogIntegration := func(t *testing.T, integration *sdk.CatalogIntegration, id sdk.AccountObjectIdentifier, enabled bool, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, integration.CreatedOn)
+		assert.Equal(t, id.Name(), integration.Name)
+		assert.Equal(t, "CATALOG", integration.Category)
+		assert.Equal(t, enabled, integration.Enabled)
+		assert.Equal(t, comment, integration.Comment)
+	}
+
+	createCatalogIntegration := func(t *testing.T) *sdk.CatalogIntegration {
+		t.Helper()
+		request := sdk.NewCreateCatalogIntegrationRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), sdk.CatalogIntegrationCatalogSourceObjectStore, sdk.CatalogIntegrationTableFormatIceberg, true)
+
+		integration, cleanup := testClientHelper().CatalogIntegration.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		return integration
+	}
+
+	t.Run("create: object store", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		comment := random.Comment()
+		request := sdk.NewCreateCatalogIntegrationRequest(id, sdk.CatalogIntegrationCatalogSourceObjectStore, sdk.CatalogIntegrationTableFormatIceberg, false).
+			WithComment(comment)
+
+		err := client.CatalogIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().CatalogIntegration.DropFunc(t, id))
+
+		integration, err := client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assertCatalogIntegration(t, integration, id, false, comment)
+
+		details, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, details, sdk.CatalogIntegrationProperty{Name: "CATALOG_SOURCE", Type: "String", Value: "OBJECT_STORE", Default: ""})
+		assert.Contains(t, details, sdk.CatalogIntegrationProperty{Name: "TABLE_FORMAT", Type: "String", Value: "ICEBERG", Default: ""})
+	})
+
+	t.Run("create: glue", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateCatalogIntegrationRequest(id, sdk.CatalogIntegrationCatalogSourceGlue, sdk.CatalogIntegrationTableFormatIceberg, true).
+			WithCatalogNamespace("namespace").
+			WithGlueParams(*sdk.NewCatalogIntegrationGlueParamsRequest("arn:aws:iam::123456789012:role/myrole", "123456789012").WithGlueRegion("us-west-2")).
+			WithRefreshIntervalSeconds(60)
+
+		err := client.CatalogIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().CatalogIntegration.DropFunc(t, id))
+
+		integration, err := client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assertCatalogIntegration(t, integration, id, true, "")
+
+		details, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, details, sdk.CatalogIntegrationProperty{Name: "CATALOG_SOURCE", Type: "String", Value: "GLUE", Default: ""})
+		assert.Contains(t, details, sdk.CatalogIntegrationProperty{Name: "GLUE_CATALOG_ID", Type: "String", Value: "123456789012", Default: ""})
+	})
+
+	t.Run("alter: set", func(t *testing.T) {
+		integration := createCatalogIntegration(t)
+		id := integration.ID()
+		comment := random.Comment()
+
+		err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(
+			*sdk.NewCatalogIntegrationSetRequest().
+				WithRefreshIntervalSeconds(120).
+				WithComment(comment),
+		))
+		require.NoError(t, err)
+
+		integration, err = client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertCatalogIntegration(t, integration, id, true, comment)
+
+		err = client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(
+			*sdk.NewCatalogIntegrationSetRequest().
+				WithComment(""),
+		))
+		require.NoError(t, err)
+
+		integration, err = client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertCatalogIntegration(t, integration, id, true, "")
+	})
+
+	t.Run("alter: set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		integration := createCatalogIntegration(t)
+		id := integration.ID()
+
+		tagValue := "abc"
+		err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).
+			WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: tagValue}}),
+		)
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIntegration)
+		require.NoError(t, err)
+		assert.Equal(t, tagValue, returnedTagValue)
+
+		err = client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).
+			WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}),
+		)
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIntegration)
+		require.Error(t, err)
+	})
+
+	t.Run("drop: existing and non-existing", func(t *testing.T) {
+		integration := createCatalogIntegration(t)
+		id := integration.ID()
+
+		err := client.CatalogIntegrations.Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.CatalogIntegrations.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+
+		err = client.CatalogIntegrations.Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id))
+		require.Error(t, err)
+
+		err = client.CatalogIntegrations.Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("show: with like", func(t *testing.T) {
+		integration1 := createCatalogIntegration(t)
+		integration2 := createCatalogIntegration(t)
+
+		integrations, err := client.CatalogIntegrations.Show(ctx, sdk.NewShowCatalogIntegrationRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(integration1.Name)}),
+		)
+		require.NoError(t, err)
+
+		assert.Len(t, integrations, 1)
+		assert.Contains(t, integrations, *integration1)
+		assert.NotContains(t, integrations, *integration2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.CatalogIntegrations.Describe(ctx, NonExistingAccountObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
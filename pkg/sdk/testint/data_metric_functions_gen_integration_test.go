@@ -0,0 +1,187 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_DataMetricFunctions(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	numberDataType, err := datatypes.ParseDataType("NUMBER")
+	require.NoError(t, err)
+
+	expression := "$$SELECT COUNT(*) FROM arg_t WHERE arg_c IS NULL$$"
+	arguments := []sdk.DataMetricFunctionTableArgumentRequest{
+		*sdk.NewDataMetricFunctionTableArgumentRequest("arg_t").
+			WithColumns([]sdk.DataMetricFunctionColumnRequest{*sdk.NewDataMetricFunctionColumnRequest("arg_c", numberDataType)}),
+	}
+
+	createDataMetricFunction := func(t *testing.T, id sdk.SchemaObjectIdentifier) *sdk.DataMetricFunction {
+		t.Helper()
+
+		err := client.DataMetricFunctions.Create(ctx, sdk.NewCreateDataMetricFunctionRequest(id, expression).WithArguments(arguments))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, client.DataMetricFunctions.DropSafely(ctx, id))
+		})
+
+		dataMetricFunction, err := client.DataMetricFunctions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		return dataMetricFunction
+	}
+
+	t.Run("create: basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		dataMetricFunction := createDataMetricFunction(t, id)
+
+		assert.NotEmpty(t, dataMetricFunction.CreatedOn)
+		assert.Equal(t, id.Name(), dataMetricFunction.Name)
+		assert.Equal(t, id.SchemaName(), dataMetricFunction.SchemaName)
+		assert.Equal(t, id.DatabaseName(), dataMetricFunction.CatalogName)
+		assert.False(t, dataMetricFunction.IsBuiltin)
+		assert.False(t, dataMetricFunction.IsSecure)
+		assert.Equal(t, "SQL", dataMetricFunction.Language)
+
+		signature, err := dataMetricFunction.Signature()
+		require.NoError(t, err)
+		assert.Equal(t, "(TABLE(NUMBER))", signature)
+	})
+
+	t.Run("create: all options", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.DataMetricFunctions.Create(ctx, sdk.NewCreateDataMetricFunctionRequest(id, expression).
+			WithOrReplace(true).
+			WithSecure(true).
+			WithArguments(arguments).
+			WithComment("comment"))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, client.DataMetricFunctions.DropSafely(ctx, id))
+		})
+
+		dataMetricFunction, err := client.DataMetricFunctions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, dataMetricFunction.IsSecure)
+		assert.Equal(t, "comment", dataMetricFunction.Description)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		dataMetricFunction := createDataMetricFunction(t, id)
+		signature, err := dataMetricFunction.Signature()
+		require.NoError(t, err)
+
+		details, err := client.DataMetricFunctions.Describe(ctx, sdk.NewDescribeDataMetricFunctionRequest(id, signature))
+		require.NoError(t, err)
+
+		body, err := collections.FindFirst(details, func(d sdk.DataMetricFunctionDetail) bool { return d.Property == "body" })
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT COUNT(*) FROM arg_t WHERE arg_c IS NULL", body.Value)
+
+		returns, err := collections.FindFirst(details, func(d sdk.DataMetricFunctionDetail) bool { return d.Property == "returns" })
+		require.NoError(t, err)
+		assert.Equal(t, "NUMBER(38,0)", returns.Value)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		dataMetricFunction := createDataMetricFunction(t, id)
+		signature, err := dataMetricFunction.Signature()
+		require.NoError(t, err)
+
+		err = client.DataMetricFunctions.Alter(ctx, sdk.NewAlterDataMetricFunctionRequest(id, signature).
+			WithSet(*sdk.NewDataMetricFunctionSetRequest().WithSecure(true).WithComment("new comment")))
+		require.NoError(t, err)
+
+		dataMetricFunction, err = client.DataMetricFunctions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, dataMetricFunction.IsSecure)
+		assert.Equal(t, "new comment", dataMetricFunction.Description)
+
+		err = client.DataMetricFunctions.Alter(ctx, sdk.NewAlterDataMetricFunctionRequest(id, signature).
+			WithUnset(*sdk.NewDataMetricFunctionUnsetRequest().WithSecure(true).WithComment(true)))
+		require.NoError(t, err)
+
+		dataMetricFunction, err = client.DataMetricFunctions.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, dataMetricFunction.IsSecure)
+		assert.Equal(t, sdk.DefaultFunctionComment, dataMetricFunction.Description)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		dataMetricFunction := createDataMetricFunction(t, id)
+		signature, err := dataMetricFunction.Signature()
+		require.NoError(t, err)
+
+		err = client.DataMetricFunctions.Alter(ctx, sdk.NewAlterDataMetricFunctionRequest(id, signature).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, client.DataMetricFunctions.DropSafely(ctx, newId))
+		})
+
+		_, err = client.DataMetricFunctions.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+		_, err = client.DataMetricFunctions.ShowByID(ctx, newId)
+		require.NoError(t, err)
+	})
+
+	t.Run("alter on object: add, modify and drop", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		createDataMetricFunction(t, id)
+
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+
+		err := client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(sdk.ObjectTypeTable, table.ID()).
+			WithSetDataMetricSchedule("5 MINUTE"))
+		require.NoError(t, err)
+
+		err = client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(sdk.ObjectTypeTable, table.ID()).
+			WithAdd(*sdk.NewDataMetricFunctionOnObjectRequest(id, []sdk.Column{{Value: "id"}})))
+		require.NoError(t, err)
+
+		references := testClientHelper().DataMetricFunctionReferences.GetDataMetricFunctionReferences(t, table.ID(), sdk.DataMetricFunctionRefEntityDomainTable)
+		require.Len(t, references, 1)
+		assert.Equal(t, id.Name(), references[0].MetricName)
+		assert.Equal(t, string(sdk.DataMetricScheduleStatusStarted), references[0].ScheduleStatus)
+
+		err = client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(sdk.ObjectTypeTable, table.ID()).
+			WithModify(*sdk.NewDataMetricFunctionModifyOnObjectRequest(id, []sdk.Column{{Value: "id"}}, sdk.ViewDataMetricScheduleStatusOperationSuspend)))
+		require.NoError(t, err)
+
+		references = testClientHelper().DataMetricFunctionReferences.GetDataMetricFunctionReferences(t, table.ID(), sdk.DataMetricFunctionRefEntityDomainTable)
+		require.Len(t, references, 1)
+		assert.Equal(t, string(sdk.DataMetricScheduleStatusSuspended), references[0].ScheduleStatus)
+
+		err = client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(sdk.ObjectTypeTable, table.ID()).
+			WithDrop(*sdk.NewDataMetricFunctionOnObjectRequest(id, []sdk.Column{{Value: "id"}})))
+		require.NoError(t, err)
+
+		references = testClientHelper().DataMetricFunctionReferences.GetDataMetricFunctionReferences(t, table.ID(), sdk.DataMetricFunctionRefEntityDomainTable)
+		require.Empty(t, references)
+
+		err = client.DataMetricFunctions.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionRequest(sdk.ObjectTypeTable, table.ID()).
+			WithUnsetDataMetricSchedule(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("drop safely: non-existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.DataMetricFunctions.DropSafely(ctx, id)
+		require.NoError(t, err)
+	})
+}
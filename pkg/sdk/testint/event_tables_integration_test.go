@@ -110,8 +110,10 @@ func TestInt_EventTables(t *testing.T) {
 
 		details, err := client.EventTables.Describe(ctx, dt.ID())
 		require.NoError(t, err)
-		assert.Equal(t, "TIMESTAMP", details.Name)
-		assert.NotEmpty(t, details.Kind)
+		require.NotEmpty(t, details)
+		assert.Equal(t, "TIMESTAMP", details[0].Name)
+		assert.NotEmpty(t, details[0].Type)
+		assert.Equal(t, "COLUMN", details[0].Kind)
 	})
 
 	t.Run("alter event table: set and unset comment", func(t *testing.T) {
@@ -0,0 +1,204 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalAccessIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	networkRule, networkRuleCleanup := testClientHelper().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	networkRule2, networkRule2Cleanup := testClientHelper().NetworkRule.Create(t)
+	t.Cleanup(networkRule2Cleanup)
+
+	secretId, secretCleanup := testClientHelper().Secret.CreateRandomPasswordSecret(t)
+	t.Cleanup(secretCleanup)
+
+	apiAuthenticationIntegration, apiAuthenticationIntegrationCleanup := testClientHelper().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(apiAuthenticationIntegrationCleanup)
+
+	assertExternalAccessIntegration := func(t *testing.T, integration *sdk.ExternalAccessIntegration, id sdk.AccountObjectIdentifier, enabled bool, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, integration.CreatedOn)
+		assert.Equal(t, id.Name(), integration.Name)
+		assert.Equal(t, "EXTERNAL_ACCESS", integration.Type)
+		assert.Equal(t, "SECURITY", integration.Category)
+		assert.Equal(t, enabled, integration.Enabled)
+		assert.Equal(t, comment, integration.Comment)
+	}
+
+	createExternalAccessIntegration := func(t *testing.T) *sdk.ExternalAccessIntegration {
+		t.Helper()
+		request := sdk.NewCreateExternalAccessIntegrationRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), []sdk.SchemaObjectIdentifier{networkRule.ID()}, true)
+
+		integration, cleanup := testClientHelper().ExternalAccessIntegration.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		return integration
+	}
+
+	t.Run("create: basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID()}, true)
+
+		err := client.ExternalAccessIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, id))
+
+		integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assertExternalAccessIntegration(t, integration, id, true, "")
+	})
+
+	t.Run("create: complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		comment := random.Comment()
+		request := sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID(), networkRule2.ID()}, false).
+			WithAllowedApiAuthenticationIntegrations([]sdk.AccountObjectIdentifier{apiAuthenticationIntegration.ID()}).
+			WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secretId}).
+			WithComment(comment)
+
+		err := client.ExternalAccessIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, id))
+
+		integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assertExternalAccessIntegration(t, integration, id, false, comment)
+
+		details, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+
+		assert.Contains(t, details, sdk.ExternalAccessIntegrationProperty{Name: "ENABLED", Type: "Boolean", Value: "false", Default: "false"})
+		assert.Contains(t, details, sdk.ExternalAccessIntegrationProperty{Name: "COMMENT", Type: "String", Value: comment, Default: ""})
+
+		propertiesByName := make(map[string]sdk.ExternalAccessIntegrationProperty)
+		for _, property := range details {
+			propertiesByName[property.Name] = property
+		}
+
+		networkRules, err := sdk.ParseCommaSeparatedSchemaObjectIdentifierArray(propertiesByName["ALLOWED_NETWORK_RULES"].Value)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []sdk.SchemaObjectIdentifier{networkRule.ID(), networkRule2.ID()}, networkRules)
+
+		apiAuthenticationIntegrations, err := sdk.ParseCommaSeparatedAccountObjectIdentifierArray(propertiesByName["ALLOWED_API_AUTHENTICATION_INTEGRATIONS"].Value)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []sdk.AccountObjectIdentifier{apiAuthenticationIntegration.ID()}, apiAuthenticationIntegrations)
+
+		secrets, err := sdk.ParseCommaSeparatedSchemaObjectIdentifierArray(propertiesByName["ALLOWED_AUTHENTICATION_SECRETS"].Value)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []sdk.SchemaObjectIdentifier{secretId}, secrets)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		integration := createExternalAccessIntegration(t)
+		id := integration.ID()
+		comment := random.Comment()
+
+		err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).
+			WithSet(*sdk.NewExternalAccessIntegrationSetRequest().
+				WithAllowedNetworkRules([]sdk.SchemaObjectIdentifier{networkRule2.ID()}).
+				WithAllowedApiAuthenticationIntegrations([]sdk.AccountObjectIdentifier{apiAuthenticationIntegration.ID()}).
+				WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secretId}).
+				WithEnabled(false).
+				WithComment(comment),
+			),
+		)
+		require.NoError(t, err)
+
+		integration, err = client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertExternalAccessIntegration(t, integration, id, false, comment)
+
+		err = client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).
+			WithUnset(*sdk.NewExternalAccessIntegrationUnsetRequest().
+				WithAllowedApiAuthenticationIntegrations(true).
+				WithAllowedAuthenticationSecrets(true).
+				WithComment(true),
+			),
+		)
+		require.NoError(t, err)
+
+		integration, err = client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertExternalAccessIntegration(t, integration, id, false, "")
+
+		details, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, details, sdk.ExternalAccessIntegrationProperty{Name: "ALLOWED_AUTHENTICATION_SECRETS", Type: "List", Value: "", Default: "[]"})
+	})
+
+	t.Run("alter: set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		integration := createExternalAccessIntegration(t)
+		id := integration.ID()
+
+		tagValue := "abc"
+		err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).
+			WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: tagValue}}),
+		)
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIntegration)
+		require.NoError(t, err)
+		assert.Equal(t, tagValue, returnedTagValue)
+
+		err = client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).
+			WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}),
+		)
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIntegration)
+		require.Error(t, err)
+	})
+
+	t.Run("drop: existing and non-existing", func(t *testing.T) {
+		integration := createExternalAccessIntegration(t)
+		id := integration.ID()
+
+		err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+
+		err = client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id))
+		require.Error(t, err)
+
+		err = client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("show: with like", func(t *testing.T) {
+		integration1 := createExternalAccessIntegration(t)
+		integration2 := createExternalAccessIntegration(t)
+
+		integrations, err := client.ExternalAccessIntegrations.Show(ctx, sdk.NewShowExternalAccessIntegrationRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(integration1.Name)}),
+		)
+		require.NoError(t, err)
+
+		assert.Len(t, integrations, 1)
+		assert.Contains(t, integrations, *integration1)
+		assert.NotContains(t, integrations, *integration2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.ExternalAccessIntegrations.Describe(ctx, NonExistingAccountObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,228 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_HybridTables(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	numberDataType, err := datatypes.ParseDataType("NUMBER")
+	require.NoError(t, err)
+	varcharDataType, err := datatypes.ParseDataType("VARCHAR(100)")
+	require.NoError(t, err)
+
+	minimalColumnsAndConstraints := func() sdk.HybridTableColumnsConstraintsAndIndexesRequest {
+		return *sdk.NewHybridTableColumnsConstraintsAndIndexesRequest([]sdk.HybridTableColumnRequest{
+			*sdk.NewHybridTableColumnRequest("ID", numberDataType),
+			*sdk.NewHybridTableColumnRequest("NAME", varcharDataType),
+		}).WithOutOfLineConstraints([]sdk.HybridTableOutOfLineConstraintRequest{
+			*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.ColumnConstraintTypePrimaryKey, []sdk.Column{{Value: "ID"}}),
+		})
+	}
+
+	assertHybridTable := func(t *testing.T, hybridTable *sdk.HybridTable, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.NotEmpty(t, hybridTable.CreatedOn)
+		assert.Equal(t, id.Name(), hybridTable.Name)
+		assert.Equal(t, id.DatabaseName(), hybridTable.DatabaseName)
+		assert.Equal(t, id.SchemaName(), hybridTable.SchemaName)
+		assert.Equal(t, "ACCOUNTADMIN", hybridTable.Owner)
+		assert.Equal(t, "ROLE", hybridTable.OwnerRoleType)
+		assert.Equal(t, expectedComment, hybridTable.Comment)
+	}
+
+	cleanupHybridTableProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.HybridTables.Drop(ctx, sdk.NewDropHybridTableRequest(id).WithIfExists(true))
+			require.NoError(t, err)
+		}
+	}
+
+	createHybridTable := func(t *testing.T) *sdk.HybridTable {
+		t.Helper()
+		hybridTable, cleanup := testClientHelper().HybridTable.CreateWithRequest(t,
+			sdk.NewCreateHybridTableRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), minimalColumnsAndConstraints()),
+		)
+		t.Cleanup(cleanup)
+		return hybridTable
+	}
+
+	t.Run("create: complete case", func(t *testing.T) {
+		referencedTable := createHybridTable(t)
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		request := sdk.NewCreateHybridTableRequest(id, *sdk.NewHybridTableColumnsConstraintsAndIndexesRequest([]sdk.HybridTableColumnRequest{
+			*sdk.NewHybridTableColumnRequest("ID", numberDataType).WithNotNull(true),
+			*sdk.NewHybridTableColumnRequest("CUSTOMER_ID", numberDataType).WithComment("column comment"),
+			*sdk.NewHybridTableColumnRequest("EMAIL", varcharDataType),
+			*sdk.NewHybridTableColumnRequest("NAME", varcharDataType).WithDefault("'unknown'"),
+		}).
+			WithOutOfLineConstraints([]sdk.HybridTableOutOfLineConstraintRequest{
+				*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.ColumnConstraintTypePrimaryKey, []sdk.Column{{Value: "ID"}}).WithConstraint("PK"),
+				*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.ColumnConstraintTypeUnique, []sdk.Column{{Value: "EMAIL"}}),
+				*sdk.NewHybridTableOutOfLineConstraintRequest(sdk.ColumnConstraintTypeForeignKey, []sdk.Column{{Value: "CUSTOMER_ID"}}).
+					WithForeignKey(*sdk.NewHybridTableForeignKeyRequest(referencedTable.ID()).WithColumns([]sdk.Column{{Value: "ID"}})),
+			}).
+			WithOutOfLineIndexes([]sdk.HybridTableOutOfLineIndexRequest{
+				*sdk.NewHybridTableOutOfLineIndexRequest("IDX_NAME", []sdk.Column{{Value: "NAME"}}).WithInclude([]sdk.Column{{Value: "CUSTOMER_ID"}}),
+			}),
+		).
+			WithOrReplace(true).
+			WithComment(comment)
+
+		err := client.HybridTables.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupHybridTableProvider(id))
+
+		hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertHybridTable(t, hybridTable, id, comment)
+
+		details, err := client.HybridTables.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details, 4)
+		assert.Equal(t, "ID", details[0].Name)
+		assert.True(t, details[0].PrimaryKey)
+		assert.False(t, details[0].IsNullable)
+		assert.Equal(t, "column comment", details[1].Comment)
+		assert.True(t, details[2].UniqueKey)
+
+		indexes, err := client.HybridTables.ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithIn(id))
+		require.NoError(t, err)
+		index, err := collections.FindFirst(indexes, func(index sdk.HybridTableIndex) bool { return index.Name == "IDX_NAME" })
+		require.NoError(t, err)
+		assert.Equal(t, []string{"NAME"}, index.Columns)
+		assert.Equal(t, []string{"CUSTOMER_ID"}, index.IncludedColumns)
+		assert.Equal(t, id.Name(), index.Table)
+	})
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.HybridTables.Create(ctx, sdk.NewCreateHybridTableRequest(id, minimalColumnsAndConstraints()).WithIfNotExists(true))
+		require.NoError(t, err)
+		t.Cleanup(cleanupHybridTableProvider(id))
+
+		hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertHybridTable(t, hybridTable, id, "")
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.HybridTables.Create(ctx, sdk.NewCreateHybridTableRequest(id, minimalColumnsAndConstraints()))
+		require.NoError(t, err)
+
+		err = client.HybridTables.Drop(ctx, sdk.NewDropHybridTableRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.HybridTables.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.HybridTables.Drop(ctx, sdk.NewDropHybridTableRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		hybridTable := createHybridTable(t)
+		id := hybridTable.ID()
+		comment := random.Comment()
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithSet(*sdk.NewHybridTableSetRequest().WithComment(comment)))
+		require.NoError(t, err)
+
+		alteredHybridTable, err := client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertHybridTable(t, alteredHybridTable, id, comment)
+
+		err = client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithUnset(*sdk.NewHybridTableUnsetRequest().WithComment(true)))
+		require.NoError(t, err)
+
+		alteredHybridTable, err = client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertHybridTable(t, alteredHybridTable, id, "")
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.HybridTables.Create(ctx, sdk.NewCreateHybridTableRequest(id, minimalColumnsAndConstraints()))
+		require.NoError(t, err)
+
+		err = client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithRenameTo(newId))
+		if err != nil {
+			t.Cleanup(cleanupHybridTableProvider(id))
+		} else {
+			t.Cleanup(cleanupHybridTableProvider(newId))
+		}
+		require.NoError(t, err)
+
+		_, err = client.HybridTables.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		hybridTable, err := client.HybridTables.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertHybridTable(t, hybridTable, newId, "")
+	})
+
+	t.Run("create and drop index", func(t *testing.T) {
+		hybridTable := createHybridTable(t)
+		id := hybridTable.ID()
+		indexId := sdk.NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), "IDX_NAME")
+
+		err := client.HybridTables.CreateIndex(ctx, sdk.NewCreateIndexHybridTableRequest(indexId.Name(), id, []sdk.Column{{Value: "NAME"}}).WithIfNotExists(true))
+		require.NoError(t, err)
+
+		indexes, err := client.HybridTables.ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithIn(id))
+		require.NoError(t, err)
+		index, err := collections.FindFirst(indexes, func(index sdk.HybridTableIndex) bool { return index.Name == indexId.Name() })
+		require.NoError(t, err)
+		assert.Equal(t, []string{"NAME"}, index.Columns)
+		assert.Empty(t, index.IncludedColumns)
+		assert.False(t, index.IsUnique)
+		assert.Equal(t, indexId, index.ID())
+
+		err = client.HybridTables.DropIndex(ctx, sdk.NewDropIndexHybridTableRequest(indexId))
+		require.NoError(t, err)
+
+		indexes, err = client.HybridTables.ShowIndexes(ctx, sdk.NewShowIndexesHybridTableRequest().WithIn(id))
+		require.NoError(t, err)
+		_, err = collections.FindFirst(indexes, func(index sdk.HybridTableIndex) bool { return index.Name == indexId.Name() })
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		hybridTable1 := createHybridTable(t)
+		hybridTable2 := createHybridTable(t)
+
+		returnedHybridTables, err := client.HybridTables.Show(ctx, sdk.NewShowHybridTableRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(hybridTable1.Name)}).
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedHybridTables, 1)
+		assert.Contains(t, returnedHybridTables, *hybridTable1)
+		assert.NotContains(t, returnedHybridTables, *hybridTable2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.HybridTables.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
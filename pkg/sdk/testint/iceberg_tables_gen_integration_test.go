@@ -0,0 +1,245 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_IcebergTables(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	awsBaseUrl := testenvs.GetOrSkipTest(t, testenvs.AwsExternalBucketUrl)
+	awsRoleARN := testenvs.GetOrSkipTest(t, testenvs.AwsExternalRoleArn)
+
+	externalVolumeId, externalVolumeCleanup := testClientHelper().ExternalVolume.CreateWithStorageLocation(t, sdk.ExternalVolumeStorageLocation{
+		S3StorageLocationParams: &sdk.S3StorageLocationParams{
+			Name:              "s3_iceberg_storage_location",
+			StorageProvider:   sdk.S3StorageProviderS3,
+			StorageAwsRoleArn: awsRoleARN,
+			StorageBaseUrl:    awsBaseUrl,
+		},
+	})
+	t.Cleanup(externalVolumeCleanup)
+
+	numberDataType, err := datatypes.ParseDataType("NUMBER(38, 0)")
+	require.NoError(t, err)
+	varcharDataType, err := datatypes.ParseDataType("VARCHAR")
+	require.NoError(t, err)
+
+	snowflakeCatalogId := sdk.NewAccountObjectIdentifier("SNOWFLAKE")
+
+	assertIcebergTable := func(t *testing.T, icebergTable *sdk.IcebergTable, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, icebergTable.CreatedOn)
+		assert.Equal(t, id.Name(), icebergTable.Name)
+		assert.Equal(t, id.DatabaseName(), icebergTable.DatabaseName)
+		assert.Equal(t, id.SchemaName(), icebergTable.SchemaName)
+		assert.Equal(t, externalVolumeId.Name(), icebergTable.ExternalVolumeName)
+		assert.Equal(t, "SNOWFLAKE", icebergTable.CatalogName)
+		assert.Equal(t, "MANAGED", icebergTable.IcebergTableType)
+		assert.NotEmpty(t, icebergTable.BaseLocation)
+		assert.NotEmpty(t, icebergTable.Owner)
+		assert.Equal(t, comment, icebergTable.Comment)
+	}
+
+	createIcebergTable := func(t *testing.T) *sdk.IcebergTable {
+		t.Helper()
+		icebergTable, cleanup := testClientHelper().IcebergTable.CreateManaged(t, externalVolumeId)
+		t.Cleanup(cleanup)
+		return icebergTable
+	}
+
+	t.Run("create: basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateIcebergTableRequest(id).
+			WithColumns([]sdk.IcebergTableColumnRequest{*sdk.NewIcebergTableColumnRequest("id", numberDataType)}).
+			WithExternalVolume(externalVolumeId).
+			WithCatalog(snowflakeCatalogId).
+			WithBaseLocation(id.Name())
+
+		err := client.IcebergTables.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, id))
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assertIcebergTable(t, icebergTable, id, "")
+	})
+
+	t.Run("create: complete", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+		request := sdk.NewCreateIcebergTableRequest(id).
+			WithColumns([]sdk.IcebergTableColumnRequest{
+				*sdk.NewIcebergTableColumnRequest("id", numberDataType).WithNotNull(true),
+				*sdk.NewIcebergTableColumnRequest("name", varcharDataType).WithComment("column comment"),
+			}).
+			WithExternalVolume(externalVolumeId).
+			WithCatalog(snowflakeCatalogId).
+			WithBaseLocation(id.Name()).
+			WithStorageSerializationPolicy(sdk.StorageSerializationPolicyOptimized).
+			WithComment(comment).
+			WithTag([]sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}})
+
+		err := client.IcebergTables.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, id))
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assertIcebergTable(t, icebergTable, id, comment)
+
+		details, err := client.IcebergTables.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details, 2)
+		assert.Equal(t, "ID", details[0].Name)
+		assert.False(t, details[0].IsNullable)
+		assert.Equal(t, "NAME", details[1].Name)
+		assert.True(t, details[1].IsNullable)
+		assert.Equal(t, "column comment", details[1].Comment)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIcebergTable)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", returnedTagValue)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		icebergTable := createIcebergTable(t)
+		id := icebergTable.ID()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, newId))
+
+		_, err = client.IcebergTables.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotFound)
+
+		_, err = client.IcebergTables.ShowByID(ctx, newId)
+		require.NoError(t, err)
+	})
+
+	t.Run("alter: add and drop column", func(t *testing.T) {
+		icebergTable := createIcebergTable(t)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAddColumn(*sdk.NewIcebergTableColumnRequest("extra", varcharDataType)))
+		require.NoError(t, err)
+
+		details, err := client.IcebergTables.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details, 2)
+		assert.Equal(t, "EXTRA", details[1].Name)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithDropColumn([]sdk.Column{{Value: "EXTRA"}}))
+		require.NoError(t, err)
+
+		details, err = client.IcebergTables.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details, 1)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		icebergTable := createIcebergTable(t)
+		id := icebergTable.ID()
+		comment := random.Comment()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(
+			*sdk.NewIcebergTableSetRequest().
+				WithReplaceInvalidCharacters(true).
+				WithComment(comment),
+		))
+		require.NoError(t, err)
+
+		icebergTable, err = client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertIcebergTable(t, icebergTable, id, comment)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnset(
+			*sdk.NewIcebergTableUnsetRequest().
+				WithComment(true),
+		))
+		require.NoError(t, err)
+
+		icebergTable, err = client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertIcebergTable(t, icebergTable, id, "")
+	})
+
+	t.Run("alter: set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		icebergTable := createIcebergTable(t)
+		id := icebergTable.ID()
+
+		tagValue := "abc"
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: tagValue}}),
+		)
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIcebergTable)
+		require.NoError(t, err)
+		assert.Equal(t, tagValue, returnedTagValue)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}),
+		)
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIcebergTable)
+		require.Error(t, err)
+	})
+
+	t.Run("drop: existing and non-existing", func(t *testing.T) {
+		icebergTable := createIcebergTable(t)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Drop(ctx, sdk.NewDropIcebergTableRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.IcebergTables.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+
+		err = client.IcebergTables.Drop(ctx, sdk.NewDropIcebergTableRequest(id))
+		require.Error(t, err)
+
+		err = client.IcebergTables.Drop(ctx, sdk.NewDropIcebergTableRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		icebergTable1 := createIcebergTable(t)
+		icebergTable2 := createIcebergTable(t)
+
+		icebergTables, err := client.IcebergTables.Show(ctx, sdk.NewShowIcebergTableRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(icebergTable1.Name)}).
+			WithIn(sdk.In{Schema: icebergTable1.ID().SchemaId()}),
+		)
+		require.NoError(t, err)
+
+		assert.Len(t, icebergTables, 1)
+		assert.Contains(t, icebergTables, *icebergTable1)
+		assert.NotContains(t, icebergTables, *icebergTable2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.IcebergTables.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,203 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_JoinPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)"
+
+	assertJoinPolicy := func(t *testing.T, joinPolicy *sdk.JoinPolicy, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.NotEmpty(t, joinPolicy.CreatedOn)
+		assert.Equal(t, id.Name(), joinPolicy.Name)
+		assert.Equal(t, id.SchemaName(), joinPolicy.SchemaName)
+		assert.Equal(t, id.DatabaseName(), joinPolicy.DatabaseName)
+		assert.Equal(t, "ACCOUNTADMIN", joinPolicy.Owner)
+		assert.Equal(t, expectedComment, joinPolicy.Comment)
+		assert.Equal(t, "JOIN_POLICY", joinPolicy.Kind)
+		assert.Equal(t, "", joinPolicy.Options)
+		assert.Equal(t, "ROLE", joinPolicy.OwnerRoleType)
+	}
+
+	assertJoinPolicyDescription := func(t *testing.T, joinPolicyDescription *sdk.JoinPolicyDescription, id sdk.SchemaObjectIdentifier, expectedBody string) {
+		t.Helper()
+		assert.Equal(t, id.Name(), joinPolicyDescription.Name)
+		assert.Equal(t, "()", joinPolicyDescription.Signature)
+		assert.Equal(t, "JOIN_CONSTRAINT", joinPolicyDescription.ReturnType)
+		assert.Equal(t, expectedBody, joinPolicyDescription.Body)
+	}
+
+	cleanupJoinPolicyProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.JoinPolicies.Drop(ctx, sdk.NewDropJoinPolicyRequest(id).WithIfExists(true))
+			require.NoError(t, err)
+		}
+	}
+
+	createJoinPolicy := func(t *testing.T) *sdk.JoinPolicy {
+		t.Helper()
+		joinPolicy, cleanup := testClientHelper().JoinPolicy.CreateJoinPolicyWithOptions(t, sdk.NewCreateJoinPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body))
+		t.Cleanup(cleanup)
+		return joinPolicy
+	}
+
+	t.Run("create: complete case", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		request := sdk.NewCreateJoinPolicyRequest(id, body).
+			WithOrReplace(true).
+			WithComment(comment)
+
+		err := client.JoinPolicies.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupJoinPolicyProvider(id))
+
+		joinPolicy, err := client.JoinPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertJoinPolicy(t, joinPolicy, id, comment)
+
+		joinPolicyDescription, err := client.JoinPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assertJoinPolicyDescription(t, joinPolicyDescription, id, body)
+	})
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.JoinPolicies.Create(ctx, sdk.NewCreateJoinPolicyRequest(id, body).WithIfNotExists(true))
+		require.NoError(t, err)
+		t.Cleanup(cleanupJoinPolicyProvider(id))
+
+		joinPolicy, err := client.JoinPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertJoinPolicy(t, joinPolicy, id, "")
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.JoinPolicies.Create(ctx, sdk.NewCreateJoinPolicyRequest(id, body))
+		require.NoError(t, err)
+
+		err = client.JoinPolicies.Drop(ctx, sdk.NewDropJoinPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.JoinPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.JoinPolicies.Drop(ctx, sdk.NewDropJoinPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		joinPolicy := createJoinPolicy(t)
+		id := joinPolicy.ID()
+		comment := random.Comment()
+
+		err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetComment(comment))
+		require.NoError(t, err)
+
+		alteredJoinPolicy, err := client.JoinPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, comment, alteredJoinPolicy.Comment)
+
+		err = client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		alteredJoinPolicy, err = client.JoinPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, alteredJoinPolicy.Comment)
+	})
+
+	t.Run("alter: set body", func(t *testing.T) {
+		joinPolicy := createJoinPolicy(t)
+		id := joinPolicy.ID()
+		newBody := "JOIN_CONSTRAINT(JOIN_REQUIRED => FALSE)"
+
+		err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetBody(newBody))
+		require.NoError(t, err)
+
+		joinPolicyDescription, err := client.JoinPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assertJoinPolicyDescription(t, joinPolicyDescription, id, newBody)
+	})
+
+	t.Run("alter: set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		joinPolicy := createJoinPolicy(t)
+		id := joinPolicy.ID()
+
+		err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}}))
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeJoinPolicy)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", *returnedTagValue)
+
+		err = client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}))
+		require.NoError(t, err)
+
+		returnedTagValue, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeJoinPolicy)
+		require.NoError(t, err)
+		assert.Nil(t, returnedTagValue)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.JoinPolicies.Create(ctx, sdk.NewCreateJoinPolicyRequest(id, body))
+		require.NoError(t, err)
+
+		err = client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithRenameTo(newId))
+		if err != nil {
+			t.Cleanup(cleanupJoinPolicyProvider(id))
+		} else {
+			t.Cleanup(cleanupJoinPolicyProvider(newId))
+		}
+		require.NoError(t, err)
+
+		_, err = client.JoinPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		joinPolicy, err := client.JoinPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertJoinPolicy(t, joinPolicy, newId, "")
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		joinPolicy1 := createJoinPolicy(t)
+		joinPolicy2 := createJoinPolicy(t)
+
+		returnedJoinPolicies, err := client.JoinPolicies.Show(ctx, sdk.NewShowJoinPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(joinPolicy1.Name)}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: testClientHelper().Ids.SchemaId()}}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedJoinPolicies, 1)
+		assert.Contains(t, returnedJoinPolicies, *joinPolicy1)
+		assert.NotContains(t, returnedJoinPolicies, *joinPolicy2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.JoinPolicies.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,203 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ProjectionPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "PROJECTION_CONSTRAINT(ALLOW => false)"
+
+	assertProjectionPolicy := func(t *testing.T, projectionPolicy *sdk.ProjectionPolicy, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.NotEmpty(t, projectionPolicy.CreatedOn)
+		assert.Equal(t, id.Name(), projectionPolicy.Name)
+		assert.Equal(t, id.SchemaName(), projectionPolicy.SchemaName)
+		assert.Equal(t, id.DatabaseName(), projectionPolicy.DatabaseName)
+		assert.Equal(t, "ACCOUNTADMIN", projectionPolicy.Owner)
+		assert.Equal(t, expectedComment, projectionPolicy.Comment)
+		assert.Equal(t, "PROJECTION_POLICY", projectionPolicy.Kind)
+		assert.Equal(t, "", projectionPolicy.Options)
+		assert.Equal(t, "ROLE", projectionPolicy.OwnerRoleType)
+	}
+
+	assertProjectionPolicyDescription := func(t *testing.T, projectionPolicyDescription *sdk.ProjectionPolicyDescription, id sdk.SchemaObjectIdentifier, expectedBody string) {
+		t.Helper()
+		assert.Equal(t, id.Name(), projectionPolicyDescription.Name)
+		assert.Equal(t, "()", projectionPolicyDescription.Signature)
+		assert.Equal(t, "PROJECTION_CONSTRAINT", projectionPolicyDescription.ReturnType)
+		assert.Equal(t, expectedBody, projectionPolicyDescription.Body)
+	}
+
+	cleanupProjectionPolicyProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id).WithIfExists(true))
+			require.NoError(t, err)
+		}
+	}
+
+	createProjectionPolicy := func(t *testing.T) *sdk.ProjectionPolicy {
+		t.Helper()
+		projectionPolicy, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicyWithOptions(t, sdk.NewCreateProjectionPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body))
+		t.Cleanup(cleanup)
+		return projectionPolicy
+	}
+
+	t.Run("create: complete case", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		request := sdk.NewCreateProjectionPolicyRequest(id, body).
+			WithOrReplace(true).
+			WithComment(comment)
+
+		err := client.ProjectionPolicies.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupProjectionPolicyProvider(id))
+
+		projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, projectionPolicy, id, comment)
+
+		projectionPolicyDescription, err := client.ProjectionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assertProjectionPolicyDescription(t, projectionPolicyDescription, id, body)
+	})
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, body).WithIfNotExists(true))
+		require.NoError(t, err)
+		t.Cleanup(cleanupProjectionPolicyProvider(id))
+
+		projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, projectionPolicy, id, "")
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, body))
+		require.NoError(t, err)
+
+		err = client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		projectionPolicy := createProjectionPolicy(t)
+		id := projectionPolicy.ID()
+		comment := random.Comment()
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetComment(comment))
+		require.NoError(t, err)
+
+		alteredProjectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, comment, alteredProjectionPolicy.Comment)
+
+		err = client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		alteredProjectionPolicy, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, alteredProjectionPolicy.Comment)
+	})
+
+	t.Run("alter: set body", func(t *testing.T) {
+		projectionPolicy := createProjectionPolicy(t)
+		id := projectionPolicy.ID()
+		newBody := "PROJECTION_CONSTRAINT(ALLOW => true)"
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetBody(newBody))
+		require.NoError(t, err)
+
+		projectionPolicyDescription, err := client.ProjectionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assertProjectionPolicyDescription(t, projectionPolicyDescription, id, newBody)
+	})
+
+	t.Run("alter: set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		projectionPolicy := createProjectionPolicy(t)
+		id := projectionPolicy.ID()
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}}))
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeProjectionPolicy)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", *returnedTagValue)
+
+		err = client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}))
+		require.NoError(t, err)
+
+		returnedTagValue, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeProjectionPolicy)
+		require.NoError(t, err)
+		assert.Nil(t, returnedTagValue)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, body))
+		require.NoError(t, err)
+
+		err = client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithRenameTo(newId))
+		if err != nil {
+			t.Cleanup(cleanupProjectionPolicyProvider(id))
+		} else {
+			t.Cleanup(cleanupProjectionPolicyProvider(newId))
+		}
+		require.NoError(t, err)
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, projectionPolicy, newId, "")
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		projectionPolicy1 := createProjectionPolicy(t)
+		projectionPolicy2 := createProjectionPolicy(t)
+
+		returnedProjectionPolicies, err := client.ProjectionPolicies.Show(ctx, sdk.NewShowProjectionPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(projectionPolicy1.Name)}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: testClientHelper().Ids.SchemaId()}}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedProjectionPolicies, 1)
+		assert.Contains(t, returnedProjectionPolicies, *projectionPolicy1)
+		assert.NotContains(t, returnedProjectionPolicies, *projectionPolicy2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.ProjectionPolicies.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
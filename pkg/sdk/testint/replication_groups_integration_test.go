@@ -0,0 +1,323 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ReplicationGroupsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	share, shareCleanup := testClientHelper().Share.CreateShare(t)
+	t.Cleanup(shareCleanup)
+
+	secondaryAccountId := secondaryTestClientHelper().Account.GetAccountIdentifier(t)
+
+	t.Run("complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		objectTypes := []sdk.PluralObjectType{
+			sdk.PluralObjectTypeDatabases,
+			sdk.PluralObjectTypeShares,
+		}
+		allowedAccounts := []sdk.AccountIdentifier{secondaryAccountId}
+		replicationSchedule := "10 MINUTE"
+
+		err := client.ReplicationGroups.Create(ctx, id, objectTypes, allowedAccounts, &sdk.CreateReplicationGroupOptions{
+			IfNotExists:         sdk.Bool(true),
+			AllowedDatabases:    []sdk.AccountObjectIdentifier{database.ID()},
+			AllowedShares:       []sdk.AccountObjectIdentifier{share.ID()},
+			IgnoreEditionCheck:  sdk.Bool(true),
+			ReplicationSchedule: sdk.String(replicationSchedule),
+		})
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ReplicationGroup.DropReplicationGroupFunc(t, id))
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, id.Name(), replicationGroup.Name)
+		assert.Equal(t, "REPLICATION", replicationGroup.Type)
+		assert.True(t, replicationGroup.IsPrimary)
+		slices.Sort(objectTypes)
+		slices.Sort(replicationGroup.ObjectTypes)
+		assert.Equal(t, objectTypes, replicationGroup.ObjectTypes)
+		assert.Contains(t, replicationGroup.AllowedAccounts, secondaryAccountId)
+		assert.Equal(t, replicationSchedule, replicationGroup.ReplicationSchedule)
+		assert.Equal(t, sdk.ReplicationGroupSecondaryStateNull, replicationGroup.SecondaryState)
+		assert.NotEmpty(t, replicationGroup.Owner)
+
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{database.ID()}, databases)
+
+		shares, err := client.ReplicationGroups.ShowShares(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{share.ID()}, shares)
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		replicationGroup, replicationGroupCleanup := testClientHelper().ReplicationGroup.CreateReplicationGroup(t)
+		t.Cleanup(replicationGroupCleanup)
+
+		assert.Equal(t, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, replicationGroup.ObjectTypes)
+		assert.Empty(t, replicationGroup.ReplicationSchedule)
+	})
+}
+
+func TestInt_ReplicationGroupsAlterSource(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	t.Run("rename", func(t *testing.T) {
+		replicationGroup, replicationGroupCleanup := testClientHelper().ReplicationGroup.CreateReplicationGroup(t)
+		t.Cleanup(replicationGroupCleanup)
+		newId := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			NewName: newId,
+		})
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ReplicationGroup.DropReplicationGroupFunc(t, newId))
+
+		_, err = client.ReplicationGroups.ShowByID(ctx, replicationGroup.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+
+		renamed, err := client.ReplicationGroups.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assert.Equal(t, newId.Name(), renamed.Name)
+	})
+
+	t.Run("set and unset replication schedule", func(t *testing.T) {
+		replicationGroup, replicationGroupCleanup := testClientHelper().ReplicationGroup.CreateReplicationGroup(t)
+		t.Cleanup(replicationGroupCleanup)
+		replicationSchedule := "USING CRON 0 0 10-20 * TUE,THU UTC"
+
+		err := client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			Set: &sdk.ReplicationGroupSet{
+				ReplicationSchedule: sdk.String(replicationSchedule),
+			},
+		})
+		require.NoError(t, err)
+
+		replicationGroup, err = client.ReplicationGroups.ShowByID(ctx, replicationGroup.ID())
+		require.NoError(t, err)
+		assert.Equal(t, replicationSchedule, replicationGroup.ReplicationSchedule)
+
+		err = client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			Unset: &sdk.ReplicationGroupUnset{
+				ReplicationSchedule: sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+
+		replicationGroup, err = client.ReplicationGroups.ShowByID(ctx, replicationGroup.ID())
+		require.NoError(t, err)
+		assert.Empty(t, replicationGroup.ReplicationSchedule)
+	})
+
+	t.Run("add and remove databases", func(t *testing.T) {
+		replicationGroup, replicationGroupCleanup := testClientHelper().ReplicationGroup.CreateReplicationGroup(t)
+		t.Cleanup(replicationGroupCleanup)
+
+		database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+		t.Cleanup(databaseCleanup)
+
+		err := client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			Set: &sdk.ReplicationGroupSet{
+				ObjectTypes: []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases},
+			},
+		})
+		require.NoError(t, err)
+
+		err = client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			Add: &sdk.ReplicationGroupAdd{
+				AllowedDatabases: []sdk.AccountObjectIdentifier{database.ID()},
+			},
+		})
+		require.NoError(t, err)
+
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, replicationGroup.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{database.ID()}, databases)
+
+		err = client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			Remove: &sdk.ReplicationGroupRemove{
+				AllowedDatabases: []sdk.AccountObjectIdentifier{database.ID()},
+			},
+		})
+		require.NoError(t, err)
+
+		databases, err = client.ReplicationGroups.ShowDatabases(ctx, replicationGroup.ID())
+		require.NoError(t, err)
+		assert.Empty(t, databases)
+	})
+
+	t.Run("add and remove shares", func(t *testing.T) {
+		replicationGroup, replicationGroupCleanup := testClientHelper().ReplicationGroup.CreateReplicationGroup(t)
+		t.Cleanup(replicationGroupCleanup)
+
+		share, shareCleanup := testClientHelper().Share.CreateShare(t)
+		t.Cleanup(shareCleanup)
+
+		err := client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			Set: &sdk.ReplicationGroupSet{
+				ObjectTypes: []sdk.PluralObjectType{sdk.PluralObjectTypeShares},
+			},
+		})
+		require.NoError(t, err)
+
+		err = client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			Add: &sdk.ReplicationGroupAdd{
+				AllowedShares: []sdk.AccountObjectIdentifier{share.ID()},
+			},
+		})
+		require.NoError(t, err)
+
+		shares, err := client.ReplicationGroups.ShowShares(ctx, replicationGroup.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{share.ID()}, shares)
+
+		err = client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{
+			Remove: &sdk.ReplicationGroupRemove{
+				AllowedShares: []sdk.AccountObjectIdentifier{share.ID()},
+			},
+		})
+		require.NoError(t, err)
+
+		shares, err = client.ReplicationGroups.ShowShares(ctx, replicationGroup.ID())
+		require.NoError(t, err)
+		assert.Empty(t, shares)
+	})
+}
+
+// TestInt_ReplicationGroupsCreateReplica covers creating a secondary replication group and refreshing it,
+// which replicates the databases from the primary group to the secondary account.
+func TestInt_ReplicationGroupsCreateReplica(t *testing.T) {
+	secondaryClient := testSecondaryClient(t)
+	ctx := testContext(t)
+
+	database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	replicationGroup, replicationGroupCleanup := testClientHelper().ReplicationGroup.CreateReplicationGroupWithOptions(t,
+		[]sdk.PluralObjectType{sdk.PluralObjectTypeDatabases},
+		[]sdk.AccountIdentifier{secondaryTestClientHelper().Account.GetAccountIdentifier(t)},
+		&sdk.CreateReplicationGroupOptions{
+			AllowedDatabases: []sdk.AccountObjectIdentifier{database.ID()},
+		},
+	)
+	t.Cleanup(replicationGroupCleanup)
+
+	// there is a delay between creating a replication group and it being available for replication
+	time.Sleep(1 * time.Second)
+
+	err := secondaryClient.ReplicationGroups.CreateReplica(ctx, replicationGroup.ID(), replicationGroup.ExternalID(), &sdk.CreateReplicaReplicationGroupOptions{
+		IfNotExists: sdk.Bool(true),
+	})
+	require.NoError(t, err)
+	// the replicated database stays in the secondary account after the secondary group is dropped
+	t.Cleanup(secondaryTestClientHelper().Database.DropDatabaseFunc(t, database.ID()))
+	t.Cleanup(func() {
+		assert.Eventually(t, func() bool {
+			return secondaryClient.ReplicationGroups.Drop(ctx, replicationGroup.ID(), &sdk.DropReplicationGroupOptions{IfExists: sdk.Bool(true)}) == nil
+		}, 10*time.Second, time.Second)
+	})
+
+	secondaryReplicationGroup, err := secondaryClient.ReplicationGroups.ShowByID(ctx, replicationGroup.ID())
+	require.NoError(t, err)
+	assert.False(t, secondaryReplicationGroup.IsPrimary)
+	assert.Equal(t, replicationGroup.ExternalID().FullyQualifiedName(), secondaryReplicationGroup.Primary.FullyQualifiedName())
+
+	t.Run("refresh replicates the databases", func(t *testing.T) {
+		err := secondaryClient.ReplicationGroups.AlterTarget(ctx, replicationGroup.ID(), &sdk.AlterTargetReplicationGroupOptions{
+			Refresh: sdk.Bool(true),
+		})
+		require.NoError(t, err)
+
+		replicatedDatabase, err := secondaryClient.Databases.ShowByID(ctx, database.ID())
+		require.NoError(t, err)
+		assert.NotNil(t, replicatedDatabase.Origin)
+		assert.Equal(t, database.ID().Name(), replicatedDatabase.Name)
+	})
+
+	t.Run("suspend and resume", func(t *testing.T) {
+		err := secondaryClient.ReplicationGroups.AlterTarget(ctx, replicationGroup.ID(), &sdk.AlterTargetReplicationGroupOptions{
+			Suspend: sdk.Bool(true),
+		})
+		require.NoError(t, err)
+
+		secondaryReplicationGroup, err := secondaryClient.ReplicationGroups.ShowByID(ctx, replicationGroup.ID())
+		require.NoError(t, err)
+		assert.Equal(t, sdk.ReplicationGroupSecondaryStateSuspended, secondaryReplicationGroup.SecondaryState)
+
+		err = secondaryClient.ReplicationGroups.AlterTarget(ctx, replicationGroup.ID(), &sdk.AlterTargetReplicationGroupOptions{
+			Resume: sdk.Bool(true),
+		})
+		require.NoError(t, err)
+
+		secondaryReplicationGroup, err = secondaryClient.ReplicationGroups.ShowByID(ctx, replicationGroup.ID())
+		require.NoError(t, err)
+		assert.Equal(t, sdk.ReplicationGroupSecondaryStateStarted, secondaryReplicationGroup.SecondaryState)
+	})
+
+	t.Run("show in the secondary account contains both groups", func(t *testing.T) {
+		replicationGroups, err := secondaryClient.ReplicationGroups.Show(ctx, nil)
+		require.NoError(t, err)
+		accountLocators := make([]string, 0)
+		for _, group := range replicationGroups {
+			if group.Name == replicationGroup.Name {
+				accountLocators = append(accountLocators, group.AccountLocator)
+			}
+		}
+		assert.Len(t, accountLocators, 2)
+	})
+}
+
+func TestInt_ReplicationGroupsDrop(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	replicationGroup, replicationGroupCleanup := testClientHelper().ReplicationGroup.CreateReplicationGroup(t)
+	t.Cleanup(replicationGroupCleanup)
+
+	err := client.ReplicationGroups.Drop(ctx, replicationGroup.ID(), nil)
+	require.NoError(t, err)
+
+	_, err = client.ReplicationGroups.ShowByID(ctx, replicationGroup.ID())
+	require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+
+	err = client.ReplicationGroups.DropSafely(ctx, replicationGroup.ID())
+	require.NoError(t, err)
+}
+
+func TestInt_ReplicationGroupsShow(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	replicationGroup, replicationGroupCleanup := testClientHelper().ReplicationGroup.CreateReplicationGroup(t)
+	t.Cleanup(replicationGroupCleanup)
+
+	t.Run("in account", func(t *testing.T) {
+		replicationGroups, err := client.ReplicationGroups.Show(ctx, &sdk.ShowReplicationGroupOptions{
+			InAccount: testClientHelper().Ids.AccountIdentifierWithLocator(),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, replicationGroups, *replicationGroup)
+	})
+
+	t.Run("non-existent", func(t *testing.T) {
+		_, err := client.ReplicationGroups.ShowByID(ctx, NonExistingAccountObjectIdentifier)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
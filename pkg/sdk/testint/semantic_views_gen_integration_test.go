@@ -0,0 +1,226 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SemanticViews(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	ordersTable, ordersTableCleanup := testClientHelper().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("CUSTOMER_ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("AMOUNT", sdk.DataTypeNumber),
+	})
+	t.Cleanup(ordersTableCleanup)
+
+	customersTable, customersTableCleanup := testClientHelper().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("NAME", sdk.DataTypeVARCHAR),
+	})
+	t.Cleanup(customersTableCleanup)
+
+	minimalTables := func() []sdk.SemanticViewTableRequest {
+		return []sdk.SemanticViewTableRequest{
+			*sdk.NewSemanticViewTableRequest(ordersTable.ID()).WithTableAlias("orders"),
+		}
+	}
+	minimalMetrics := func() []sdk.SemanticViewExpressionRequest {
+		return []sdk.SemanticViewExpressionRequest{
+			*sdk.NewSemanticViewExpressionRequest("orders.order_count", "COUNT(orders.id)"),
+		}
+	}
+
+	assertSemanticView := func(t *testing.T, semanticView *sdk.SemanticView, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.NotEmpty(t, semanticView.CreatedOn)
+		assert.Equal(t, id.Name(), semanticView.Name)
+		assert.Equal(t, id.DatabaseName(), semanticView.DatabaseName)
+		assert.Equal(t, id.SchemaName(), semanticView.SchemaName)
+		assert.Equal(t, "ACCOUNTADMIN", semanticView.Owner)
+		assert.Equal(t, "ROLE", semanticView.OwnerRoleType)
+		if expectedComment == "" {
+			assert.Nil(t, semanticView.Comment)
+		} else {
+			require.NotNil(t, semanticView.Comment)
+			assert.Equal(t, expectedComment, *semanticView.Comment)
+		}
+	}
+
+	cleanupSemanticViewProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.SemanticViews.Drop(ctx, sdk.NewDropSemanticViewRequest(id).WithIfExists(true))
+			require.NoError(t, err)
+		}
+	}
+
+	createSemanticView := func(t *testing.T) *sdk.SemanticView {
+		t.Helper()
+		semanticView, cleanup := testClientHelper().SemanticView.CreateSemanticViewWithRequest(t,
+			sdk.NewCreateSemanticViewRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), minimalTables()).WithMetrics(minimalMetrics()),
+		)
+		t.Cleanup(cleanup)
+		return semanticView
+	}
+
+	t.Run("create: complete case", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		request := sdk.NewCreateSemanticViewRequest(id, []sdk.SemanticViewTableRequest{
+			*sdk.NewSemanticViewTableRequest(ordersTable.ID()).
+				WithTableAlias("orders").
+				WithPrimaryKey([]sdk.Column{{Value: "ID"}}).
+				WithWithSynonyms([]sdk.SemanticViewSynonym{{Value: "sales"}}).
+				WithComment("orders table"),
+			*sdk.NewSemanticViewTableRequest(customersTable.ID()).
+				WithTableAlias("customers").
+				WithPrimaryKey([]sdk.Column{{Value: "ID"}}).
+				WithUniqueKeys([]sdk.SemanticViewUniqueKeyRequest{*sdk.NewSemanticViewUniqueKeyRequest([]sdk.Column{{Value: "NAME"}})}),
+		}).
+			WithOrReplace(true).
+			WithRelationships([]sdk.SemanticViewRelationshipRequest{
+				*sdk.NewSemanticViewRelationshipRequest("orders", []sdk.Column{{Value: "CUSTOMER_ID"}}, "customers").
+					WithRelationshipIdentifier("orders_to_customers").
+					WithRefColumns([]sdk.Column{{Value: "ID"}}),
+			}).
+			WithFacts([]sdk.SemanticViewExpressionRequest{
+				*sdk.NewSemanticViewExpressionRequest("orders.order_amount", "orders.amount").WithComment("amount of the order"),
+			}).
+			WithDimensions([]sdk.SemanticViewExpressionRequest{
+				*sdk.NewSemanticViewExpressionRequest("customers.customer_name", "customers.name").
+					WithWithSynonyms([]sdk.SemanticViewSynonym{{Value: "client name"}}),
+			}).
+			WithMetrics([]sdk.SemanticViewExpressionRequest{
+				*sdk.NewSemanticViewExpressionRequest("orders.total_amount", "SUM(orders.order_amount)"),
+			}).
+			WithComment(comment).
+			WithCopyGrants(true)
+
+		err := client.SemanticViews.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupSemanticViewProvider(id))
+
+		semanticView, err := client.SemanticViews.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSemanticView(t, semanticView, id, comment)
+
+		details, err := client.SemanticViews.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.NotEmpty(t, details)
+
+		objectNames := collections.Map(details, func(d sdk.SemanticViewDetails) string {
+			if d.ObjectName == nil {
+				return ""
+			}
+			return *d.ObjectName
+		})
+		assert.Contains(t, objectNames, "ORDERS")
+		assert.Contains(t, objectNames, "CUSTOMERS")
+		assert.Contains(t, objectNames, "ORDERS_TO_CUSTOMERS")
+		assert.Contains(t, objectNames, "ORDER_AMOUNT")
+		assert.Contains(t, objectNames, "CUSTOMER_NAME")
+		assert.Contains(t, objectNames, "TOTAL_AMOUNT")
+	})
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.SemanticViews.Create(ctx, sdk.NewCreateSemanticViewRequest(id, minimalTables()).WithMetrics(minimalMetrics()).WithIfNotExists(true))
+		require.NoError(t, err)
+		t.Cleanup(cleanupSemanticViewProvider(id))
+
+		semanticView, err := client.SemanticViews.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSemanticView(t, semanticView, id, "")
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.SemanticViews.Create(ctx, sdk.NewCreateSemanticViewRequest(id, minimalTables()).WithMetrics(minimalMetrics()))
+		require.NoError(t, err)
+
+		err = client.SemanticViews.Drop(ctx, sdk.NewDropSemanticViewRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.SemanticViews.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.SemanticViews.Drop(ctx, sdk.NewDropSemanticViewRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		semanticView := createSemanticView(t)
+		id := semanticView.ID()
+		comment := random.Comment()
+
+		err := client.SemanticViews.Alter(ctx, sdk.NewAlterSemanticViewRequest(id).WithSetComment(comment))
+		require.NoError(t, err)
+
+		alteredSemanticView, err := client.SemanticViews.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSemanticView(t, alteredSemanticView, id, comment)
+
+		err = client.SemanticViews.Alter(ctx, sdk.NewAlterSemanticViewRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		alteredSemanticView, err = client.SemanticViews.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSemanticView(t, alteredSemanticView, id, "")
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.SemanticViews.Create(ctx, sdk.NewCreateSemanticViewRequest(id, minimalTables()).WithMetrics(minimalMetrics()))
+		require.NoError(t, err)
+
+		err = client.SemanticViews.Alter(ctx, sdk.NewAlterSemanticViewRequest(id).WithRenameTo(newId))
+		if err != nil {
+			t.Cleanup(cleanupSemanticViewProvider(id))
+		} else {
+			t.Cleanup(cleanupSemanticViewProvider(newId))
+		}
+		require.NoError(t, err)
+
+		_, err = client.SemanticViews.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		semanticView, err := client.SemanticViews.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertSemanticView(t, semanticView, newId, "")
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		semanticView1 := createSemanticView(t)
+		semanticView2 := createSemanticView(t)
+
+		returnedSemanticViews, err := client.SemanticViews.Show(ctx, sdk.NewShowSemanticViewRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(semanticView1.Name)}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: testClientHelper().Ids.SchemaId()}}))
+		require.NoError(t, err)
+
+		assert.Len(t, returnedSemanticViews, 1)
+		assert.Contains(t, returnedSemanticViews, *semanticView1)
+		assert.NotContains(t, returnedSemanticViews, *semanticView2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.SemanticViews.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
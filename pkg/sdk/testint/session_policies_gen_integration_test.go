@@ -39,6 +39,7 @@ func TestInt_SessionPolicies(t *testing.T) {
 		assert.Equal(t, id.Name(), sessionPolicyDescription.Name)
 		assert.Equal(t, 240, sessionPolicyDescription.SessionIdleTimeoutMins)
 		assert.Equal(t, 240, sessionPolicyDescription.SessionUIIdleTimeoutMins)
+		assert.Equal(t, []string{"ALL"}, sessionPolicyDescription.AllowedSecondaryRoles)
 		assert.Equal(t, "", sessionPolicyDescription.Comment)
 	}
 
@@ -67,11 +68,15 @@ func TestInt_SessionPolicies(t *testing.T) {
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		comment := random.Comment()
 
+		role, roleCleanup := testClientHelper().Role.CreateRole(t)
+		t.Cleanup(roleCleanup)
+
 		request := sdk.NewCreateSessionPolicyRequest(id).
-			WithSessionIdleTimeoutMins(sdk.Int(5)).
-			WithSessionUiIdleTimeoutMins(sdk.Int(34)).
-			WithComment(&comment).
-			WithIfNotExists(sdk.Bool(true))
+			WithSessionIdleTimeoutMins(5).
+			WithSessionUiIdleTimeoutMins(34).
+			WithAllowedSecondaryRoles(*sdk.NewSessionPolicySecondaryRolesRequest().WithRoles([]sdk.AccountObjectIdentifier{role.ID()})).
+			WithComment(comment).
+			WithIfNotExists(true)
 
 		err := client.SessionPolicies.Create(ctx, request)
 		require.NoError(t, err)
@@ -81,6 +86,13 @@ func TestInt_SessionPolicies(t *testing.T) {
 
 		require.NoError(t, err)
 		assertSessionPolicy(t, sessionPolicy, id, comment)
+
+		sessionPolicyDescription, err := client.SessionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 5, sessionPolicyDescription.SessionIdleTimeoutMins)
+		assert.Equal(t, 34, sessionPolicyDescription.SessionUIIdleTimeoutMins)
+		assert.Equal(t, []string{role.ID().Name()}, sessionPolicyDescription.AllowedSecondaryRoles)
+		assert.Equal(t, comment, sessionPolicyDescription.Comment)
 	})
 
 	t.Run("create session_policy: no optionals", func(t *testing.T) {
@@ -123,7 +135,7 @@ func TestInt_SessionPolicies(t *testing.T) {
 		require.NoError(t, err)
 		t.Cleanup(cleanupSessionPolicyProvider(id))
 
-		alterRequest := sdk.NewAlterSessionPolicyRequest(id).WithSet(sdk.NewSessionPolicySetRequest().WithComment(sdk.String("new comment")))
+		alterRequest := sdk.NewAlterSessionPolicyRequest(id).WithSet(*sdk.NewSessionPolicySetRequest().WithComment("new comment"))
 		err = client.SessionPolicies.Alter(ctx, alterRequest)
 		require.NoError(t, err)
 
@@ -132,7 +144,7 @@ func TestInt_SessionPolicies(t *testing.T) {
 
 		assert.Equal(t, "new comment", alteredSessionPolicy.Comment)
 
-		alterRequest = sdk.NewAlterSessionPolicyRequest(id).WithUnset(sdk.NewSessionPolicyUnsetRequest().WithComment(sdk.Bool(true)))
+		alterRequest = sdk.NewAlterSessionPolicyRequest(id).WithUnset(*sdk.NewSessionPolicyUnsetRequest().WithComment(true))
 		err = client.SessionPolicies.Alter(ctx, alterRequest)
 		require.NoError(t, err)
 
@@ -142,6 +154,40 @@ func TestInt_SessionPolicies(t *testing.T) {
 		assert.Equal(t, "", alteredSessionPolicy.Comment)
 	})
 
+	t.Run("alter session_policy: set and unset allowed secondary roles", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.SessionPolicies.Create(ctx, sdk.NewCreateSessionPolicyRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(cleanupSessionPolicyProvider(id))
+
+		alterRequest := sdk.NewAlterSessionPolicyRequest(id).WithSet(*sdk.NewSessionPolicySetRequest().
+			WithSessionIdleTimeoutMins(10).
+			WithSessionUiIdleTimeoutMins(20).
+			WithAllowedSecondaryRoles(*sdk.NewSessionPolicySecondaryRolesRequest().WithNone(true)),
+		)
+		err = client.SessionPolicies.Alter(ctx, alterRequest)
+		require.NoError(t, err)
+
+		sessionPolicyDescription, err := client.SessionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 10, sessionPolicyDescription.SessionIdleTimeoutMins)
+		assert.Equal(t, 20, sessionPolicyDescription.SessionUIIdleTimeoutMins)
+		assert.Empty(t, sessionPolicyDescription.AllowedSecondaryRoles)
+
+		alterRequest = sdk.NewAlterSessionPolicyRequest(id).WithUnset(*sdk.NewSessionPolicyUnsetRequest().
+			WithSessionIdleTimeoutMins(true).
+			WithSessionUiIdleTimeoutMins(true).
+			WithAllowedSecondaryRoles(true),
+		)
+		err = client.SessionPolicies.Alter(ctx, alterRequest)
+		require.NoError(t, err)
+
+		sessionPolicyDescription, err = client.SessionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assertSessionPolicyDescription(t, sessionPolicyDescription, id)
+	})
+
 	t.Run("alter session_policy: rename", func(t *testing.T) {
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 
@@ -149,7 +195,7 @@ func TestInt_SessionPolicies(t *testing.T) {
 		require.NoError(t, err)
 
 		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
-		alterRequest := sdk.NewAlterSessionPolicyRequest(id).WithRenameTo(&newId)
+		alterRequest := sdk.NewAlterSessionPolicyRequest(id).WithRenameTo(newId)
 
 		err = client.SessionPolicies.Alter(ctx, alterRequest)
 		if err != nil {
@@ -181,6 +227,21 @@ func TestInt_SessionPolicies(t *testing.T) {
 		assert.Contains(t, returnedSessionPolicies, *sessionPolicy2)
 	})
 
+	t.Run("show session_policy: with like and in", func(t *testing.T) {
+		sessionPolicy1 := createSessionPolicy(t)
+		sessionPolicy2 := createSessionPolicy(t)
+
+		showRequest := sdk.NewShowSessionPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(sessionPolicy1.Name)}).
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()})
+		returnedSessionPolicies, err := client.SessionPolicies.Show(ctx, showRequest)
+		require.NoError(t, err)
+
+		assert.Len(t, returnedSessionPolicies, 1)
+		assert.Contains(t, returnedSessionPolicies, *sessionPolicy1)
+		assert.NotContains(t, returnedSessionPolicies, *sessionPolicy2)
+	})
+
 	t.Run("describe session_policy", func(t *testing.T) {
 		sessionPolicy := createSessionPolicy(t)
 
@@ -0,0 +1,68 @@
+//go:build !account_level_tests
+
+package testint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ShowResultCache(t *testing.T) {
+	ctx := testContext(t)
+
+	config := *testClient(t).GetConfig()
+	client, err := sdk.NewClient(&config)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	client.EnableShowResultCache()
+
+	table, tableCleanup := testClientHelper().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	t.Run("serves ShowByID from a single SHOW per container", func(t *testing.T) {
+		returnedTable, err := client.Tables.ShowByID(ctx, table.ID())
+		require.NoError(t, err)
+		assert.Equal(t, table.Name, returnedTable.Name)
+
+		// the table created by a different client is not visible until the cache is invalidated
+		otherTable, otherTableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(otherTableCleanup)
+
+		_, err = client.Tables.ShowByID(ctx, otherTable.ID())
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		// any statement executed through the client invalidates the cache
+		_, err = client.ExecUnsafe(ctx, fmt.Sprintf("ALTER TABLE %s SET COMMENT = 'cached'", table.ID().FullyQualifiedName()))
+		require.NoError(t, err)
+
+		returnedOtherTable, err := client.Tables.ShowByID(ctx, otherTable.ID())
+		require.NoError(t, err)
+		assert.Equal(t, otherTable.Name, returnedOtherTable.Name)
+
+		returnedTable, err = client.Tables.ShowByID(ctx, table.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "cached", returnedTable.Comment)
+	})
+
+	t.Run("does not cache queries with other clauses", func(t *testing.T) {
+		otherTable, otherTableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(otherTableCleanup)
+
+		tables, err := client.Tables.Show(ctx, sdk.NewShowTableRequest().
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: otherTable.ID().SchemaId()}}).
+			WithLike(sdk.Like{Pattern: sdk.String(otherTable.Name)}).
+			WithStartsWith(otherTable.Name))
+		require.NoError(t, err)
+		require.Len(t, tables, 1)
+	})
+
+	t.Run("ShowByID of object in non-existing container", func(t *testing.T) {
+		_, err := client.Tables.ShowByID(ctx, sdk.NewSchemaObjectIdentifier("non-existing-database", "non-existing-schema", "non-existing-schema-object"))
+		require.ErrorIs(t, err, sdk.ErrDoesNotExistOrOperationCannotBePerformed)
+	})
+}
@@ -195,3 +195,26 @@ func TestInt_BcrBundles(t *testing.T) {
 		require.ErrorContains(t, err, "Invalid Change Bundle 'non-existing-bundle'")
 	})
 }
+
+func TestInt_TaskDependentsEnable(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	rootTask, rootTaskCleanup := testClientHelper().Task.CreateWithSchedule(t)
+	t.Cleanup(rootTaskCleanup)
+
+	childTask, childTaskCleanup := testClientHelper().Task.CreateWithAfter(t, rootTask.ID())
+	t.Cleanup(childTaskCleanup)
+
+	err := client.SystemFunctions.TaskDependentsEnable(ctx, rootTask.ID())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		testClientHelper().Task.Alter(t, sdk.NewAlterTaskRequest(rootTask.ID()).WithSuspend(true))
+	})
+
+	for _, id := range []sdk.SchemaObjectIdentifier{rootTask.ID(), childTask.ID()} {
+		task, err := testClientHelper().Task.Show(t, id)
+		require.NoError(t, err)
+		require.Equal(t, sdk.TaskStateStarted, task.State)
+	}
+}
@@ -538,6 +538,100 @@ func TestInt_Table(t *testing.T) {
 		assert.Empty(t, tableDetails[0].PolicyName)
 	})
 
+	t.Run("alter table: set and unset projection policy", func(t *testing.T) {
+		projectionPolicyId, projectionPolicyCleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(projectionPolicyCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		columns := []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("COLUMN_1", sdk.DataTypeVARCHAR),
+		}
+
+		err := client.Tables.Create(ctx, sdk.NewCreateTableRequest(id, columns))
+		require.NoError(t, err)
+		t.Cleanup(cleanupTableProvider(id))
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).
+			WithColumnAction(sdk.NewTableColumnActionRequest().WithSetProjectionPolicy(sdk.NewTableColumnAlterSetProjectionPolicyActionRequest("COLUMN_1", projectionPolicyId))))
+		require.NoError(t, err)
+
+		policyReferences, err := testClientHelper().PolicyReferences.GetPolicyReferences(t, id, sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		assert.Equal(t, sdk.PolicyKindProjectionPolicy, policyReferences[0].PolicyKind)
+		assert.Equal(t, projectionPolicyId.Name(), policyReferences[0].PolicyName)
+		assert.Equal(t, "COLUMN_1", *policyReferences[0].RefColumnName)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).
+			WithColumnAction(sdk.NewTableColumnActionRequest().WithUnsetProjectionPolicy(sdk.NewTableColumnAlterUnsetProjectionPolicyActionRequest("COLUMN_1"))))
+		require.NoError(t, err)
+
+		policyReferences, err = testClientHelper().PolicyReferences.GetPolicyReferences(t, id, sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+
+	t.Run("alter table: set and unset aggregation policy", func(t *testing.T) {
+		aggregationPolicyId, aggregationPolicyCleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(aggregationPolicyCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		columns := []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("COLUMN_1", sdk.DataTypeVARCHAR),
+		}
+
+		err := client.Tables.Create(ctx, sdk.NewCreateTableRequest(id, columns))
+		require.NoError(t, err)
+		t.Cleanup(cleanupTableProvider(id))
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).
+			WithSetAggregationPolicy(sdk.NewTableSetAggregationPolicyRequest(aggregationPolicyId).WithEntityKey([]string{"COLUMN_1"})))
+		require.NoError(t, err)
+
+		policyReferences, err := testClientHelper().PolicyReferences.GetPolicyReferences(t, id, sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		assert.Equal(t, sdk.PolicyKindAggregationPolicy, policyReferences[0].PolicyKind)
+		assert.Equal(t, aggregationPolicyId.Name(), policyReferences[0].PolicyName)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetAggregationPolicy(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		policyReferences, err = testClientHelper().PolicyReferences.GetPolicyReferences(t, id, sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+
+	t.Run("alter table: set and unset join policy", func(t *testing.T) {
+		joinPolicyId, joinPolicyCleanup := testClientHelper().JoinPolicy.CreateJoinPolicy(t)
+		t.Cleanup(joinPolicyCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		columns := []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("COLUMN_1", sdk.DataTypeVARCHAR),
+		}
+
+		err := client.Tables.Create(ctx, sdk.NewCreateTableRequest(id, columns))
+		require.NoError(t, err)
+		t.Cleanup(cleanupTableProvider(id))
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetJoinPolicy(sdk.NewTableSetJoinPolicyRequest(joinPolicyId)))
+		require.NoError(t, err)
+
+		policyReferences, err := testClientHelper().PolicyReferences.GetPolicyReferences(t, id, sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		assert.Equal(t, sdk.PolicyKindJoinPolicy, policyReferences[0].PolicyKind)
+		assert.Equal(t, joinPolicyId.Name(), policyReferences[0].PolicyName)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetJoinPolicy(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		policyReferences, err = testClientHelper().PolicyReferences.GetPolicyReferences(t, id, sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+
 	t.Run("alter table: drop columns", func(t *testing.T) {
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		columns := []sdk.TableColumnRequest{
@@ -15,10 +15,12 @@ type pluginFrameworkPocProviderModelV0 struct {
 	ClientRequestMfaToken              types.String `tfsdk:"client_request_mfa_token"`
 	ClientStoreTemporaryCredential     types.String `tfsdk:"client_store_temporary_credential"`
 	ClientTimeout                      types.Int64  `tfsdk:"client_timeout"`
+	Connections                        types.List   `tfsdk:"connections"`
 	DisableConsoleLogin                types.String `tfsdk:"disable_console_login"`
 	DisableQueryContextCache           types.Bool   `tfsdk:"disable_query_context_cache"`
 	DisableTelemetry                   types.Bool   `tfsdk:"disable_telemetry"`
 	DriverTracing                      types.String `tfsdk:"driver_tracing"`
+	EnableShowResultCache              types.Bool   `tfsdk:"enable_show_result_cache"`
 	ExternalBrowserTimeout             types.Int64  `tfsdk:"external_browser_timeout"`
 	Host                               types.String `tfsdk:"host"`
 	IncludeRetryReason                 types.String `tfsdk:"include_retry_reason"`
@@ -27,8 +29,10 @@ type pluginFrameworkPocProviderModelV0 struct {
 	JwtExpireTimeout                   types.Int64  `tfsdk:"jwt_expire_timeout"`
 	KeepSessionAlive                   types.Bool   `tfsdk:"keep_session_alive"`
 	LoginTimeout                       types.Int64  `tfsdk:"login_timeout"`
+	MaxRetries                         types.Int64  `tfsdk:"max_retries"`
 	MaxRetryCount                      types.Int64  `tfsdk:"max_retry_count"`
 	OcspFailOpen                       types.String `tfsdk:"ocsp_fail_open"`
+	OidcTokenFilePath                  types.String `tfsdk:"oidc_token_file_path"`
 	OktaUrl                            types.String `tfsdk:"okta_url"`
 	OrganizationName                   types.String `tfsdk:"organization_name"`
 	Params                             types.Map    `tfsdk:"params"`
@@ -41,9 +45,12 @@ type pluginFrameworkPocProviderModelV0 struct {
 	PrivateKeyPassphrase               types.String `tfsdk:"private_key_passphrase"`
 	Profile                            types.String `tfsdk:"profile"`
 	Protocol                           types.String `tfsdk:"protocol"`
+	QueryTag                           types.List   `tfsdk:"query_tag"`
 	RequestTimeout                     types.Int64  `tfsdk:"request_timeout"`
+	RetryBackoff                       types.Int64  `tfsdk:"retry_backoff"`
 	Role                               types.String `tfsdk:"role"`
 	SkipTomlFilePermissionVerification types.Bool   `tfsdk:"skip_toml_file_permission_verification"`
+	SqlPreview                         types.Bool   `tfsdk:"sql_preview"`
 	TmpDirectoryPath                   types.String `tfsdk:"tmp_directory_path"`
 	Token                              types.String `tfsdk:"token"`
 	TokenAccessor                      types.List   `tfsdk:"token_accessor"`
@@ -51,6 +58,8 @@ type pluginFrameworkPocProviderModelV0 struct {
 	User                               types.String `tfsdk:"user"`
 	ValidateDefaultParameters          types.String `tfsdk:"validate_default_parameters"`
 	Warehouse                          types.String `tfsdk:"warehouse"`
+	WorkloadIdentityEntraResource      types.String `tfsdk:"workload_identity_entra_resource"`
+	WorkloadIdentityProvider           types.String `tfsdk:"workload_identity_provider"`
 }
 
 var existingSchema = provider.GetProviderSchema()
@@ -86,6 +95,12 @@ var pluginFrameworkPocProviderSchemaV0 = map[string]schema.Attribute{
 		Optional:    true,
 		Sensitive:   false,
 	},
+	// commented out manually
+	//"connections": schema.ListAttribute{
+	//	Description: existingSchema["connections"].Description,
+	//	Optional:    true,
+	//	Sensitive:   false,
+	//},
 	"disable_console_login": schema.StringAttribute{
 		Description: existingSchema["disable_console_login"].Description,
 		Optional:    true,
@@ -106,6 +121,11 @@ var pluginFrameworkPocProviderSchemaV0 = map[string]schema.Attribute{
 		Optional:    true,
 		Sensitive:   false,
 	},
+	"enable_show_result_cache": schema.BoolAttribute{
+		Description: existingSchema["enable_show_result_cache"].Description,
+		Optional:    true,
+		Sensitive:   false,
+	},
 	"external_browser_timeout": schema.Int64Attribute{
 		Description: existingSchema["external_browser_timeout"].Description,
 		Optional:    true,
@@ -146,6 +166,11 @@ var pluginFrameworkPocProviderSchemaV0 = map[string]schema.Attribute{
 		Optional:    true,
 		Sensitive:   false,
 	},
+	"max_retries": schema.Int64Attribute{
+		Description: existingSchema["max_retries"].Description,
+		Optional:    true,
+		Sensitive:   false,
+	},
 	"max_retry_count": schema.Int64Attribute{
 		Description: existingSchema["max_retry_count"].Description,
 		Optional:    true,
@@ -156,6 +181,11 @@ var pluginFrameworkPocProviderSchemaV0 = map[string]schema.Attribute{
 		Optional:    true,
 		Sensitive:   false,
 	},
+	"oidc_token_file_path": schema.StringAttribute{
+		Description: existingSchema["oidc_token_file_path"].Description,
+		Optional:    true,
+		Sensitive:   false,
+	},
 	"okta_url": schema.StringAttribute{
 		Description: existingSchema["okta_url"].Description,
 		Optional:    true,
@@ -218,11 +248,22 @@ var pluginFrameworkPocProviderSchemaV0 = map[string]schema.Attribute{
 		Optional:    true,
 		Sensitive:   false,
 	},
+	// commented out manually
+	//"query_tag": schema.ListAttribute{
+	//	Description: existingSchema["query_tag"].Description,
+	//	Optional:    true,
+	//	Sensitive:   false,
+	//},
 	"request_timeout": schema.Int64Attribute{
 		Description: existingSchema["request_timeout"].Description,
 		Optional:    true,
 		Sensitive:   false,
 	},
+	"retry_backoff": schema.Int64Attribute{
+		Description: existingSchema["retry_backoff"].Description,
+		Optional:    true,
+		Sensitive:   false,
+	},
 	"role": schema.StringAttribute{
 		Description: existingSchema["role"].Description,
 		Optional:    true,
@@ -233,6 +274,11 @@ var pluginFrameworkPocProviderSchemaV0 = map[string]schema.Attribute{
 		Optional:    true,
 		Sensitive:   false,
 	},
+	"sql_preview": schema.BoolAttribute{
+		Description: existingSchema["sql_preview"].Description,
+		Optional:    true,
+		Sensitive:   false,
+	},
 	"tmp_directory_path": schema.StringAttribute{
 		Description: existingSchema["tmp_directory_path"].Description,
 		Optional:    true,
@@ -269,4 +315,14 @@ var pluginFrameworkPocProviderSchemaV0 = map[string]schema.Attribute{
 		Optional:    true,
 		Sensitive:   false,
 	},
+	"workload_identity_entra_resource": schema.StringAttribute{
+		Description: existingSchema["workload_identity_entra_resource"].Description,
+		Optional:    true,
+		Sensitive:   false,
+	},
+	"workload_identity_provider": schema.StringAttribute{
+		Description: existingSchema["workload_identity_provider"].Description,
+		Optional:    true,
+		Sensitive:   false,
+	},
 }
@@ -97,7 +97,10 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	},
 	resources.AccountRole: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Roles.ShowByID)
+	}, resources.AggregationPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.AggregationPolicies.ShowByID)
 	},
+
 	resources.Alert: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Alerts.ShowByID)
 	},
@@ -113,18 +116,30 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.ApiIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ApiIntegrations.ShowByID)
 	},
+	resources.Application: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Applications.ShowByID)
+	},
+	resources.ApplicationPackage: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.ApplicationPackages.ShowByID)
+	},
 	resources.AuthenticationPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.AuthenticationPolicies.ShowByID)
 	},
 	resources.PrimaryConnection: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Connections.ShowByID)
 	},
+	resources.CatalogIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.CatalogIntegrations.ShowByID)
+	},
 	resources.ComputePool: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ComputePools.ShowByID)
 	},
 	resources.CortexSearchService: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.CortexSearchServices.ShowByID)
 	},
+	resources.DataMetricFunction: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.DataMetricFunctions.ShowByID)
+	},
 	resources.Database: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Databases.ShowByID)
 	},
@@ -137,6 +152,12 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.EmailNotificationIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.NotificationIntegrations.ShowByID)
 	},
+	resources.EventTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.EventTables.ShowByID)
+	},
+	resources.ExternalAccessIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.ExternalAccessIntegrations.ShowByID)
+	},
 	resources.ExternalFunction: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ExternalFunctions.ShowByID)
 	},
@@ -173,12 +194,21 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.GitRepository: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.GitRepositories.ShowByID)
 	},
+	resources.HybridTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.HybridTables.ShowByID)
+	},
+	resources.IcebergTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.IcebergTables.ShowByID)
+	},
 	resources.ImageRepository: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ImageRepositories.ShowByID)
 	},
 	resources.JobService: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Services.ShowByID)
 	},
+	resources.JoinPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.JoinPolicies.ShowByID)
+	},
 	resources.LegacyServiceUser: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Users.ShowByID)
 	},
@@ -229,6 +259,12 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	},
 	resources.ProcedureSql: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Procedures.ShowByID)
+	}, resources.ProjectionPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.ProjectionPolicies.ShowByID)
+	},
+
+	resources.ReplicationGroup: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.ReplicationGroups.ShowByID)
 	},
 	resources.ResourceMonitor: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ResourceMonitors.ShowByID)
@@ -263,6 +299,9 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.SecretWithGenericString: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Secrets.ShowByID)
 	},
+	resources.SemanticView: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.SemanticViews.ShowByID)
+	},
 	resources.Service: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Services.ShowByID)
 	},
@@ -272,6 +311,9 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.ServiceUser: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Users.ShowByID)
 	},
+	resources.SessionPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.SessionPolicies.ShowByID)
+	},
 	resources.Share: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Shares.ShowByID)
 	},
@@ -308,6 +350,9 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.Task: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Tasks.ShowByID)
 	},
+	resources.TaskGraph: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Tasks.ShowByID)
+	},
 	resources.User: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Users.ShowByID)
 	},
@@ -408,7 +453,7 @@ func CheckAccountRolePrivilegesRevoked(t *testing.T) func(*terraform.State) erro
 
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "snowflake_grant_privileges_to_account_role" {
+			if rs.Type != "snowflake_grant_privileges_to_account_role" && rs.Type != "snowflake_account_role_grants" {
 				continue
 			}
 
@@ -438,7 +483,7 @@ func CheckDatabaseRolePrivilegesRevoked(t *testing.T) func(*terraform.State) err
 
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "snowflake_grant_privileges_to_database_role" {
+			if rs.Type != "snowflake_grant_privileges_to_database_role" && rs.Type != "snowflake_database_role_grants" {
 				continue
 			}
 
@@ -542,6 +587,32 @@ func CheckUserAuthenticationPolicyAttachmentDestroy(t *testing.T) func(*terrafor
 	}
 }
 
+// CheckUserSessionPolicyAttachmentDestroy is a custom checks that should be later incorporated into generic CheckDestroy
+func CheckUserSessionPolicyAttachmentDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "snowflake_user_session_policy_attachment" {
+				continue
+			}
+			policyReferences, err := testClient().PolicyReferences.GetPolicyReferences(t, sdk.NewAccountObjectIdentifierFromFullyQualifiedName(rs.Primary.Attributes["user_name"]), sdk.PolicyEntityDomainUser)
+			if err != nil {
+				if strings.Contains(err.Error(), "does not exist or not authorized") {
+					// Note: this can happen if the Policy Reference or the User has been deleted as well; in this case, ignore the error
+					continue
+				}
+				return err
+			}
+			for _, policyReference := range policyReferences {
+				if policyReference.PolicyKind == sdk.PolicyKindSessionPolicy {
+					return fmt.Errorf("user session policy attachment %v still exists", policyReference.PolicyName)
+				}
+			}
+		}
+		return nil
+	}
+}
+
 // CheckResourceTagUnset is a custom check that should be later incorporated into generic CheckDestroy
 func CheckResourceTagUnset(t *testing.T) func(*terraform.State) error {
 	t.Helper()
@@ -679,3 +750,76 @@ func CheckUserProgrammaticAccessTokenDestroy(t *testing.T) func(*terraform.State
 		return nil
 	}
 }
+
+func CheckDataMetricFunctionAttachmentDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+	return func(s *terraform.State) error {
+		client := TestAccProvider.Meta().(*provider.Context).Client
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "snowflake_data_metric_function_attachment" {
+				continue
+			}
+			objectType, err := sdk.ToObjectType(rs.Primary.Attributes["object_type"])
+			if err != nil {
+				return err
+			}
+			domain, err := sdk.DataMetricFunctionRefEntityDomainForObjectType(objectType)
+			if err != nil {
+				return err
+			}
+			objectId, err := sdk.ParseSchemaObjectIdentifier(rs.Primary.Attributes["object_name"])
+			if err != nil {
+				return err
+			}
+			functionId, err := sdk.ParseSchemaObjectIdentifier(rs.Primary.Attributes["function_name"])
+			if err != nil {
+				return err
+			}
+			references, err := client.DataMetricFunctionReferences.GetForEntity(context.Background(), sdk.NewGetForEntityDataMetricFunctionReferenceRequest(objectId, domain))
+			if err != nil {
+				// the object itself could be already dropped
+				if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+					continue
+				}
+				return err
+			}
+			for _, reference := range references {
+				if sdk.NewSchemaObjectIdentifier(reference.MetricDatabaseName, reference.MetricSchemaName, reference.MetricName).FullyQualifiedName() == functionId.FullyQualifiedName() {
+					return fmt.Errorf("data metric function %s is still attached to %s %s", functionId.FullyQualifiedName(), objectType, objectId.FullyQualifiedName())
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func CheckStageFileDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+	return func(s *terraform.State) error {
+		client := TestAccProvider.Meta().(*provider.Context).Client
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "snowflake_stage_file" {
+				continue
+			}
+			stageId, err := sdk.ParseSchemaObjectIdentifier(rs.Primary.Attributes["stage"])
+			if err != nil {
+				return err
+			}
+			location := strings.TrimPrefix(rs.Primary.Attributes["location"], "@"+stageId.FullyQualifiedName()+"/")
+			files, err := client.StageFiles.List(context.Background(), sdk.NewStageLocation(stageId, location), nil)
+			if err != nil {
+				// the stage itself could be already dropped
+				if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+					continue
+				}
+				return err
+			}
+			for _, file := range files {
+				if strings.HasSuffix(file.Name, "/"+location) {
+					return fmt.Errorf("file %s still exists in stage %s", location, stageId.FullyQualifiedName())
+				}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,91 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AggregationPolicies(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+
+	aggregationPolicyModel := model.AggregationPolicy("test", id.DatabaseName(), id.SchemaName(), id.Name(), body).
+		WithComment(comment)
+
+	dataSourceModel := datasourcemodel.AggregationPolicies("test").
+		WithLike(id.Name()).
+		WithInDatabase(id.DatabaseId()).
+		WithDependsOn(aggregationPolicyModel.ResourceReference())
+
+	dataSourceWithoutDescribe := datasourcemodel.AggregationPolicies("test").
+		WithLike(id.Name()).
+		WithWithDescribe(false).
+		WithDependsOn(aggregationPolicyModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.AggregationPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, aggregationPolicyModel, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.#", "1")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "aggregation_policies.0.show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.show_output.0.database_name", id.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.show_output.0.schema_name", id.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.show_output.0.kind", string(sdk.PolicyKindAggregationPolicy))),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.describe_output.0.signature", "()")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.describe_output.0.return_type", "AGGREGATION_CONSTRAINT")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "aggregation_policies.0.describe_output.0.body", body)),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, aggregationPolicyModel, dataSourceWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "aggregation_policies.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "aggregation_policies.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "aggregation_policies.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_AggregationPolicies_emptyIn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, datasourcemodel.AggregationPolicies("test").WithEmptyIn()),
+				ExpectError: regexp.MustCompile("Invalid combination of arguments"),
+			},
+		},
+	})
+}
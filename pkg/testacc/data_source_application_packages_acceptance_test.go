@@ -0,0 +1,50 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApplicationPackages(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	applicationPackageModel := model.ApplicationPackage("test", id.Name()).
+		WithComment(comment)
+
+	dataSourceModel := datasourcemodel.ApplicationPackages("test").
+		WithLike(id.Name()).
+		WithDependsOn(applicationPackageModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ApplicationPackage),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, applicationPackageModel, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "application_packages.#", "1")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "application_packages.0.show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "application_packages.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "application_packages.0.show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "application_packages.0.show_output.0.comment", comment)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,51 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Applications(t *testing.T) {
+	application := createApp(t)
+
+	dataSourceModel := datasourcemodel.Applications("test").
+		WithLike(application.Name)
+
+	dataSourceWithoutDescribe := datasourcemodel.Applications("test").
+		WithLike(application.Name).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "applications.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "applications.0.show_output.0.name", application.Name)),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "applications.0.show_output.0.version", application.Version)),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "applications.0.describe_output.0.property")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "applications.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "applications.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
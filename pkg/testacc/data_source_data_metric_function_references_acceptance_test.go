@@ -0,0 +1,58 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DataMetricFunctionReferences(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	functionId := sdk.NewSchemaObjectIdentifier("SNOWFLAKE", "CORE", "NULL_COUNT")
+	tableId := table.ID()
+	cron := "5 * * * * UTC"
+
+	attachmentModel := model.DataMetricFunctionAttachment("test", functionId.FullyQualifiedName(), tableId.FullyQualifiedName(), string(sdk.ObjectTypeTable), []string{"ID"}).
+		WithDataMetricScheduleUsingCron(cron)
+
+	dataSourceModel := datasourcemodel.DataMetricFunctionReferences("test", tableId.FullyQualifiedName(), string(sdk.ObjectTypeTable)).
+		WithDependsOn(attachmentModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDataMetricFunctionAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, attachmentModel, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.metric_database_name", functionId.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.metric_schema_name", functionId.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.metric_name", functionId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.ref_entity_database_name", tableId.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.ref_entity_schema_name", tableId.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.ref_entity_name", tableId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.ref_entity_domain", "table")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.ref_arguments.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.ref_arguments.0.name", "ID")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.schedule", cron)),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "data_metric_function_references.0.schedule_status", string(sdk.DataMetricScheduleStatusStarted))),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTables(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	eventTableModel := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithComment(comment)
+
+	dataSourceModel := datasourcemodel.EventTables("test").
+		WithLike(id.Name()).
+		WithInDatabase(id.DatabaseId()).
+		WithDependsOn(eventTableModel.ResourceReference())
+
+	dataSourceWithoutDescribe := datasourcemodel.EventTables("test").
+		WithLike(id.Name()).
+		WithWithDescribe(false).
+		WithDependsOn(eventTableModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.EventTable),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, eventTableModel, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.#", "1")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.database_name", id.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.schema_name", id.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.describe_output.0.name", "TIMESTAMP")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "event_tables.0.describe_output.0.kind", "COLUMN")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "event_tables.0.describe_output.0.type")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, eventTableModel, dataSourceWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "event_tables.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "event_tables.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "event_tables.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_EventTables_emptyIn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, datasourcemodel.EventTables("test").WithEmptyIn()),
+				ExpectError: regexp.MustCompile("Invalid combination of arguments"),
+			},
+		},
+	})
+}
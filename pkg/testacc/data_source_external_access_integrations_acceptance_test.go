@@ -0,0 +1,70 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegrations(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	externalAccessIntegrationModel := model.ExternalAccessIntegration("test", id.Name(), []string{networkRule.ID().FullyQualifiedName()}, true).
+		WithComment(comment)
+
+	dataSourceModel := datasourcemodel.ExternalAccessIntegrations("test").
+		WithLike(id.Name()).
+		WithDependsOn(externalAccessIntegrationModel.ResourceReference())
+
+	dataSourceWithoutDescribe := datasourcemodel.ExternalAccessIntegrations("test").
+		WithLike(id.Name()).
+		WithWithDescribe(false).
+		WithDependsOn(externalAccessIntegrationModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, externalAccessIntegrationModel, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.type", "EXTERNAL_ACCESS")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.category", "SECURITY")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.enabled", "true")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.describe_output.0.enabled.0.value", "true")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_access_integrations.0.describe_output.0.comment.0.value", comment)),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "external_access_integrations.0.describe_output.0.allowed_network_rules.0.value")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, externalAccessIntegrationModel, dataSourceWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "external_access_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
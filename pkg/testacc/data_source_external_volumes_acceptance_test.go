@@ -0,0 +1,54 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalVolumes(t *testing.T) {
+	externalVolumeId, externalVolumeCleanup := testClient().ExternalVolume.Create(t)
+	t.Cleanup(externalVolumeCleanup)
+
+	dataSourceModel := datasourcemodel.ExternalVolumes("test").
+		WithLike(externalVolumeId.Name())
+	dataSourceModelWithoutDescribe := datasourcemodel.ExternalVolumes("test").
+		WithLike(externalVolumeId.Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "external_volumes.#", "1")),
+					resourceshowoutputassert.ExternalVolumesDatasourceShowOutput(t, "snowflake_external_volumes.test").
+						HasName(externalVolumeId.Name()),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "external_volumes.0.describe_output.#")),
+					assert.Check(resource.TestCheckTypeSetElemNestedAttrs(dataSourceModel.DatasourceReference(), "external_volumes.0.describe_output.*", map[string]string{"name": "ALLOW_WRITES"})),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceModelWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "external_volumes.#", "1")),
+					resourceshowoutputassert.ExternalVolumesDatasourceShowOutput(t, "snowflake_external_volumes.test").
+						HasName(externalVolumeId.Name()),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "external_volumes.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
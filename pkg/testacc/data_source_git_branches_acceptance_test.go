@@ -0,0 +1,56 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"strconv"
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testvars"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GitBranches(t *testing.T) {
+	origin := testvars.ExampleGitRepositoryOrigin
+
+	apiIntegrationId, apiIntegrationCleanup := testClient().ApiIntegration.CreateApiIntegrationForGitRepository(t, origin)
+	t.Cleanup(apiIntegrationCleanup)
+
+	gitRepository, gitRepositoryCleanup := testClient().GitRepository.Create(t, testClient().Ids.RandomSchemaObjectIdentifier(), origin, apiIntegrationId)
+	t.Cleanup(gitRepositoryCleanup)
+
+	branches := testClient().GitRepository.ShowGitBranches(t, gitRepository.ID())
+	masterCommitHash := testClient().GitRepository.BranchCommitHash(t, gitRepository.ID(), "master")
+
+	gitBranchesModel := datasourcemodel.GitBranches("test", gitRepository.ID().FullyQualifiedName())
+	gitBranchesWithLikeModel := datasourcemodel.GitBranches("test", gitRepository.ID().FullyQualifiedName()).
+		WithLike("master")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, gitBranchesModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(gitBranchesModel.DatasourceReference(), "branches.#", strconv.Itoa(len(branches))),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, gitBranchesWithLikeModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(gitBranchesWithLikeModel.DatasourceReference(), "branches.#", "1"),
+					resource.TestCheckResourceAttr(gitBranchesWithLikeModel.DatasourceReference(), "branches.0.show_output.0.name", "master"),
+					resource.TestCheckResourceAttrSet(gitBranchesWithLikeModel.DatasourceReference(), "branches.0.show_output.0.path"),
+					resource.TestCheckResourceAttr(gitBranchesWithLikeModel.DatasourceReference(), "branches.0.show_output.0.commit_hash", masterCommitHash),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testvars"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GitCommits(t *testing.T) {
+	origin := testvars.ExampleGitRepositoryOrigin
+
+	apiIntegrationId, apiIntegrationCleanup := testClient().ApiIntegration.CreateApiIntegrationForGitRepository(t, origin)
+	t.Cleanup(apiIntegrationCleanup)
+
+	gitRepository, gitRepositoryCleanup := testClient().GitRepository.Create(t, testClient().Ids.RandomSchemaObjectIdentifier(), origin, apiIntegrationId)
+	t.Cleanup(gitRepositoryCleanup)
+
+	masterCommitHash := testClient().GitRepository.BranchCommitHash(t, gitRepository.ID(), "master")
+
+	gitCommitsModel := datasourcemodel.GitCommits("test", gitRepository.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, gitCommitsModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(gitCommitsModel.DatasourceReference(), "commits.*", map[string]string{
+						"commit_hash": masterCommitHash,
+					}),
+					resource.TestCheckTypeSetElemAttr(gitCommitsModel.DatasourceReference(), "commits.*.branches.*", "master"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testvars"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GitTags(t *testing.T) {
+	origin := testvars.ProviderGitRepositoryOrigin
+	tagName := "v2.0.0"
+
+	apiIntegrationId, apiIntegrationCleanup := testClient().ApiIntegration.CreateApiIntegrationForGitRepository(t, origin)
+	t.Cleanup(apiIntegrationCleanup)
+
+	gitRepository, gitRepositoryCleanup := testClient().GitRepository.Create(t, testClient().Ids.RandomSchemaObjectIdentifier(), origin, apiIntegrationId)
+	t.Cleanup(gitRepositoryCleanup)
+
+	gitTagsWithLikeModel := datasourcemodel.GitTags("test", gitRepository.ID().FullyQualifiedName()).
+		WithLike(tagName)
+	gitTagsWithNonMatchingLikeModel := datasourcemodel.GitTags("test", gitRepository.ID().FullyQualifiedName()).
+		WithLike("non-existing-tag")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, gitTagsWithLikeModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(gitTagsWithLikeModel.DatasourceReference(), "tags.#", "1"),
+					resource.TestCheckResourceAttr(gitTagsWithLikeModel.DatasourceReference(), "tags.0.show_output.0.name", tagName),
+					resource.TestCheckResourceAttrSet(gitTagsWithLikeModel.DatasourceReference(), "tags.0.show_output.0.path"),
+					resource.TestCheckResourceAttrSet(gitTagsWithLikeModel.DatasourceReference(), "tags.0.show_output.0.commit_hash"),
+					resource.TestCheckResourceAttrSet(gitTagsWithLikeModel.DatasourceReference(), "tags.0.show_output.0.author"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, gitTagsWithNonMatchingLikeModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(gitTagsWithNonMatchingLikeModel.DatasourceReference(), "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
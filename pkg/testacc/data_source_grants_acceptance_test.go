@@ -512,6 +512,39 @@ func TestAcc_Grants_FutureIn_Schema(t *testing.T) {
 	})
 }
 
+func TestAcc_Grants_FutureIn_DatabaseIncludingSchemas(t *testing.T) {
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	schema, schemaCleanup := testClient().Schema.CreateSchemaInDatabase(t, database.ID())
+	t.Cleanup(schemaCleanup)
+
+	configVariables := config.Variables{
+		"database": config.StringVariable(database.ID().Name()),
+		"schema":   config.StringVariable(schema.ID().Name()),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: ConfigurationDirectory("TestAcc_Grants/FutureIn/DatabaseIncludingSchemas"),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					checkAtLeastOneFutureGrantPresent(),
+					resource.TestCheckResourceAttr("data.snowflake_grants.test", "grants.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_grants.test", "grants.0.privilege", "INSERT"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_Grants_FutureIn_Invalid_NoAttribute(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
@@ -0,0 +1,91 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_JoinPolicies(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	body := "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)"
+
+	joinPolicyModel := model.JoinPolicy("test", id.DatabaseName(), id.SchemaName(), id.Name(), body).
+		WithComment(comment)
+
+	dataSourceModel := datasourcemodel.JoinPolicies("test").
+		WithLike(id.Name()).
+		WithInDatabase(id.DatabaseId()).
+		WithDependsOn(joinPolicyModel.ResourceReference())
+
+	dataSourceWithoutDescribe := datasourcemodel.JoinPolicies("test").
+		WithLike(id.Name()).
+		WithWithDescribe(false).
+		WithDependsOn(joinPolicyModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.JoinPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, joinPolicyModel, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.#", "1")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "join_policies.0.show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.show_output.0.database_name", id.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.show_output.0.schema_name", id.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.show_output.0.kind", string(sdk.PolicyKindJoinPolicy))),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.describe_output.0.signature", "()")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.describe_output.0.return_type", "JOIN_CONSTRAINT")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "join_policies.0.describe_output.0.body", body)),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, joinPolicyModel, dataSourceWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "join_policies.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "join_policies.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceWithoutDescribe.DatasourceReference(), "join_policies.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_JoinPolicies_emptyIn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, datasourcemodel.JoinPolicies("test").WithEmptyIn()),
+				ExpectError: regexp.MustCompile("Invalid combination of arguments"),
+			},
+		},
+	})
+}
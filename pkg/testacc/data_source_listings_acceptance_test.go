@@ -0,0 +1,58 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Listings(t *testing.T) {
+	listing, listingCleanup := testClient().Listing.Create(t)
+	t.Cleanup(listingCleanup)
+
+	dataSourceModel := datasourcemodel.Listings("test").
+		WithLike(listing.ID().Name())
+	dataSourceModelWithoutDescribe := datasourcemodel.Listings("test").
+		WithLike(listing.ID().Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.#", "1")),
+					resourceshowoutputassert.ListingsDatasourceShowOutput(t, "snowflake_listings.test").
+						HasName(listing.ID().Name()).
+						HasState(sdk.ListingStateDraft),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.0.describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.0.describe_output.0.name", listing.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "listings.0.describe_output.0.state", string(sdk.ListingStateDraft))),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "listings.0.describe_output.0.manifest_yaml")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceModelWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "listings.#", "1")),
+					resourceshowoutputassert.ListingsDatasourceShowOutput(t, "snowflake_listings.test").
+						HasName(listing.ID().Name()),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "listings.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
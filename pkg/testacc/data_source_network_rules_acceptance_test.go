@@ -0,0 +1,79 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NetworkRules(t *testing.T) {
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	dataSourceModel := datasourcemodel.NetworkRules("test").
+		WithLike(networkRule.ID().Name()).
+		WithInDatabase(networkRule.ID().DatabaseId())
+	dataSourceModelWithoutDescribe := datasourcemodel.NetworkRules("test").
+		WithLike(networkRule.ID().Name()).
+		WithInDatabase(networkRule.ID().DatabaseId()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "network_rules.0.show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.show_output.0.name", networkRule.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.show_output.0.database_name", networkRule.ID().DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.show_output.0.schema_name", networkRule.ID().SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.show_output.0.type", string(sdk.NetworkRuleTypeHostPort))),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.show_output.0.mode", string(sdk.NetworkRuleModeEgress))),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.describe_output.0.name", networkRule.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.describe_output.0.type", string(sdk.NetworkRuleTypeHostPort))),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "network_rules.0.describe_output.0.mode", string(sdk.NetworkRuleModeEgress))),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceModelWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "network_rules.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "network_rules.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "network_rules.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_NetworkRules_emptyIn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, datasourcemodel.NetworkRules("test").WithEmptyIn()),
+				ExpectError: regexp.MustCompile("Invalid combination of arguments"),
+			},
+		},
+	})
+}
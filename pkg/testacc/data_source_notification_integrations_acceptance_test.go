@@ -0,0 +1,54 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NotificationIntegrations(t *testing.T) {
+	notificationIntegration, notificationIntegrationCleanup := testClient().NotificationIntegration.Create(t)
+	t.Cleanup(notificationIntegrationCleanup)
+
+	dataSourceModel := datasourcemodel.NotificationIntegrations("test").
+		WithLike(notificationIntegration.ID().Name())
+	dataSourceModelWithoutDescribe := datasourcemodel.NotificationIntegrations("test").
+		WithLike(notificationIntegration.ID().Name()).
+		WithWithDescribe(false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, dataSourceModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.0.show_output.0.name", notificationIntegration.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.0.show_output.0.notification_type", "EMAIL")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.0.show_output.0.category", "NOTIFICATION")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModel.DatasourceReference(), "notification_integrations.0.show_output.0.enabled", "true")),
+					assert.Check(resource.TestCheckResourceAttrSet(dataSourceModel.DatasourceReference(), "notification_integrations.0.describe_output.#")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, dataSourceModelWithoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "notification_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "notification_integrations.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(dataSourceModelWithoutDescribe.DatasourceReference(), "notification_integrations.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OrganizationAccounts_Complete(t *testing.T) {
+	testClient().EnsureValidNonProdOrganizationAccountIsUsed(t)
+
+	currentOrganizationAccount := testClient().OrganizationAccount.ShowCurrent(t)
+
+	provider := providermodel.SnowflakeProvider().WithWarehouse(testClient().Ids.WarehouseId().FullyQualifiedName())
+	organizationAccountsModel := datasourcemodel.OrganizationAccounts("test")
+	organizationAccountsWithNameModel := datasourcemodel.OrganizationAccounts("test").WithLike(currentOrganizationAccount.AccountName)
+	organizationAccountsWithNonExistingNameModel := datasourcemodel.OrganizationAccounts("test").WithLike("non-existing")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, provider, organizationAccountsModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(organizationAccountsModel.DatasourceReference(), "organization_accounts.#"),
+				),
+			},
+			{
+				Config: config.FromModels(t, provider, organizationAccountsWithNameModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(organizationAccountsWithNameModel.DatasourceReference(), "organization_accounts.#", "1")),
+					resourceshowoutputassert.OrganizationAccountDatasourceShowOutput(t, "snowflake_organization_accounts.test").
+						HasOrganizationName(currentOrganizationAccount.OrganizationName).
+						HasAccountName(currentOrganizationAccount.AccountName).
+						HasSnowflakeRegion(currentOrganizationAccount.SnowflakeRegion).
+						HasEdition(currentOrganizationAccount.Edition).
+						HasAccountLocator(currentOrganizationAccount.AccountLocator).
+						HasIsOrganizationAccount(true),
+				),
+			},
+			{
+				Config: config.FromModels(t, provider, organizationAccountsWithNonExistingNameModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(organizationAccountsWithNonExistingNameModel.DatasourceReference(), "organization_accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StageFiles_Complete(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	testClient().Stage.PutInLocationWithContent(t, sdk.NewStageLocation(stage.ID(), "libs").ToSql(), "handler.py", "def handler(): return 1")
+	testClient().Stage.PutInLocationWithContent(t, sdk.NewStageLocation(stage.ID(), "libs").ToSql(), "requirements.txt", "requests")
+	testClient().Stage.PutInLocationWithContent(t, stage.Location(), "readme.md", "# readme")
+
+	stageFilesModel := datasourcemodel.StageFiles("test", stage.ID().FullyQualifiedName())
+	stageFilesInPathModel := datasourcemodel.StageFiles("test", stage.ID().FullyQualifiedName()).
+		WithPath("libs")
+	stageFilesWithPatternModel := datasourcemodel.StageFiles("test", stage.ID().FullyQualifiedName()).
+		WithPath("libs").
+		WithPattern(".*[.]py")
+	stageFilesWithNonMatchingPatternModel := datasourcemodel.StageFiles("test", stage.ID().FullyQualifiedName()).
+		WithPattern(".*[.]java")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, stageFilesModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stageFilesModel.DatasourceReference(), "files.#", "3"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, stageFilesInPathModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stageFilesInPathModel.DatasourceReference(), "files.#", "2"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, stageFilesWithPatternModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stageFilesWithPatternModel.DatasourceReference(), "files.#", "1"),
+					resource.TestCheckResourceAttrSet(stageFilesWithPatternModel.DatasourceReference(), "files.0.name"),
+					resource.TestCheckResourceAttr(stageFilesWithPatternModel.DatasourceReference(), "files.0.size", "23"),
+					resource.TestCheckResourceAttrSet(stageFilesWithPatternModel.DatasourceReference(), "files.0.md5"),
+					resource.TestCheckResourceAttrSet(stageFilesWithPatternModel.DatasourceReference(), "files.0.last_modified"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, stageFilesWithNonMatchingPatternModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stageFilesWithNonMatchingPatternModel.DatasourceReference(), "files.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -15,12 +15,16 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testprofiles"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAcc_CompleteUsageTracking_Datasource(t *testing.T) {
@@ -72,3 +76,47 @@ func TestAcc_CompleteUsageTracking_Datasource(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_QueryTag_Datasource(t *testing.T) {
+	t.Setenv(string(testenvs.ConfigureClientOnce), "")
+
+	schemaId := testClient().Ids.RandomDatabaseObjectIdentifier()
+	schemaModel := model.Schema("test", schemaId.DatabaseName(), schemaId.Name())
+	schemasModel := datasourcemodel.Schemas("test").
+		WithLike(schemaId.Name()).
+		WithInDatabase(schemaId.DatabaseId()).
+		WithDependsOn(schemaModel.ResourceReference())
+
+	queryTagConfig := tracking.QueryTagConfig{Tag: "finops", Workspace: "default", Module: "module.test"}
+	providerModel := providermodel.SnowflakeProvider().
+		WithProfile(testprofiles.Default).
+		WithQueryTag(queryTagConfig.Tag, queryTagConfig.Workspace, queryTagConfig.Module)
+
+	expectedQueryTag, err := tracking.NewQueryTag(queryTagConfig, tracking.NewVersionedDatasourceMetadata(datasources.Schemas)).String()
+	require.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		PreCheck: func() { TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, providerModel, schemaModel, schemasModel),
+				Check: assertThat(t,
+					assert.Check(func(state *terraform.State) error {
+						query := fmt.Sprintf(`SHOW SCHEMAS LIKE '%s' IN DATABASE "%s"`, schemaId.Name(), schemaId.DatabaseName())
+						queryHistory := testClient().InformationSchema.GetQueryHistory(t, 100)
+						if _, err := collections.FindFirst(queryHistory, func(history helpers.QueryHistory) bool {
+							return strings.Contains(history.QueryText, query) && history.QueryTag == expectedQueryTag
+						}); err != nil {
+							return fmt.Errorf("query history does not contain query tag: %s for query containing: %s", expectedQueryTag, query)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
@@ -26,9 +27,11 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/oswrapper"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/snowflakedb/gosnowflake"
@@ -1195,3 +1198,158 @@ resource snowflake_execute "t" {
     revert        = "SELECT 1"
 }`
 }
+
+func TestAcc_Provider_EnableShowResultCache(t *testing.T) {
+	t.Setenv(string(testenvs.ConfigureClientOnce), "")
+	t.Setenv(snowflakeenvs.EnableShowResultCache, "true")
+
+	id := testClient().Ids.RandomDatabaseObjectIdentifier()
+	otherId := testClient().Ids.RandomDatabaseObjectIdentifier()
+	comment := random.Comment()
+
+	schemaModel := model.Schema("test", id.DatabaseName(), id.Name())
+	otherSchemaModel := model.Schema("other", otherId.DatabaseName(), otherId.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Schema),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, schemaModel, otherSchemaModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(schemaModel.ResourceReference(), "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(otherSchemaModel.ResourceReference(), "show_output.0.name", otherId.Name()),
+				),
+			},
+			{
+				Config: config.FromModels(t, schemaModel.WithComment(comment), otherSchemaModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(schemaModel.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(otherSchemaModel.ResourceReference(), plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(schemaModel.ResourceReference(), "show_output.0.comment", comment),
+				),
+			},
+			{
+				PreConfig: func() {
+					testClient().Schema.DropSchemaFunc(t, otherId)()
+				},
+				Config: config.FromModels(t, schemaModel, otherSchemaModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(otherSchemaModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_Provider_Connections(t *testing.T) {
+	t.Setenv(string(testenvs.ConfigureClientOnce), "")
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			if _, err := secondaryTestClient().Database.Show(t, id); !errors.Is(err, sdk.ErrObjectNotFound) {
+				return fmt.Errorf("database %s still exists in the secondary account", id.FullyQualifiedName())
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfigWithConnection(id, "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.test", "connection_name", "secondary"),
+					resource.TestCheckResourceAttr("data.snowflake_databases.test", "databases.#", "1"),
+					func(state *terraform.State) error {
+						if _, err := secondaryTestClient().Database.Show(t, id); err != nil {
+							return fmt.Errorf("database %s was not created in the secondary account: %w", id.FullyQualifiedName(), err)
+						}
+						if _, err := testClient().Database.Show(t, id); !errors.Is(err, sdk.ErrObjectNotFound) {
+							return fmt.Errorf("database %s was created in the primary account", id.FullyQualifiedName())
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:      providerConfigWithConnection(id, "unknown"),
+				ExpectError: regexp.MustCompile(`connection "unknown" is not defined in the provider configuration, defined connections: secondary`),
+			},
+		},
+	})
+}
+
+func providerConfigWithConnection(id sdk.AccountObjectIdentifier, connectionName string) string {
+	return fmt.Sprintf(`
+provider "snowflake" {
+	profile = "%[1]s"
+
+	connections {
+		name    = "secondary"
+		profile = "%[2]s"
+	}
+}
+
+resource "snowflake_database" "test" {
+	name            = "%[3]s"
+	connection_name = "%[4]s"
+}
+
+data "snowflake_databases" "test" {
+	like            = "%[3]s"
+	connection_name = "%[4]s"
+	depends_on      = [snowflake_database.test]
+}
+`, testprofiles.Default, testprofiles.Secondary, id.Name(), connectionName)
+}
+
+func TestAcc_Provider_SqlPreview(t *testing.T) {
+	t.Setenv(string(testenvs.ConfigureClientOnce), "")
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment, newComment := random.Comment(), random.Comment()
+
+	providerModel := providermodel.SnowflakeProvider().WithProfile(testprofiles.Default).WithSqlPreview(true)
+	accountRoleModel := model.AccountRole("test", id.Name()).WithComment(comment)
+	accountRoleModelWithNewComment := model.AccountRole("test", id.Name()).WithComment(newComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.AccountRole),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, providerModel, accountRoleModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(accountRoleModel.ResourceReference(), "planned_sql.#", "1"),
+					resource.TestCheckResourceAttr(accountRoleModel.ResourceReference(), "planned_sql.0", fmt.Sprintf(`CREATE ROLE %s COMMENT = '%s'`, id.FullyQualifiedName(), comment)),
+				),
+			},
+			{
+				Config: config.FromModels(t, providerModel, accountRoleModelWithNewComment),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(accountRoleModel.ResourceReference(), "planned_sql.#", "1"),
+					resource.TestCheckResourceAttr(accountRoleModel.ResourceReference(), "planned_sql.0", fmt.Sprintf(`ALTER ROLE %s SET COMMENT = '%s'`, id.FullyQualifiedName(), newComment)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,161 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AccountRoleGrants_basic(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	databaseId := testClient().Ids.DatabaseId()
+	schemaId := testClient().Ids.SchemaId()
+
+	// granted outside of Terraform; revoked by the resource
+	testClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, role.ID(), databaseId, []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}, false)
+
+	configVariables := func(grants ...config.Variable) config.Variables {
+		return config.Variables{
+			"account_role_name": config.StringVariable(role.ID().FullyQualifiedName()),
+			"grants":            config.ListVariable(grants...),
+		}
+	}
+	onAccount := roleGrantsConfigVariable([]string{string(sdk.GlobalPrivilegeCreateDatabase)}, sdk.ObjectTypeAccount, nil, false)
+	onDatabase := roleGrantsConfigVariable([]string{string(sdk.AccountObjectPrivilegeUsage)}, sdk.ObjectTypeDatabase, databaseId, false)
+	onDatabaseWithGrantOption := roleGrantsConfigVariable([]string{string(sdk.AccountObjectPrivilegeUsage)}, sdk.ObjectTypeDatabase, databaseId, true)
+	onSchema := roleGrantsConfigVariable([]string{string(sdk.SchemaPrivilegeUsage), string(sdk.SchemaPrivilegeCreateTable)}, sdk.ObjectTypeSchema, schemaId, false)
+
+	resourceName := "snowflake_account_role_grants.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckAccountRolePrivilegesRevoked(t),
+		Steps: []resource.TestStep{
+			// create
+			{
+				ConfigDirectory: ConfigurationDirectory("TestAcc_AccountRoleGrants/basic"),
+				ConfigVariables: configVariables(onAccount, onDatabase, onSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", helpers.EncodeResourceIdentifier(role.ID())),
+					resource.TestCheckResourceAttr(resourceName, "account_role_name", role.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "3"),
+					checkAccountRoleGrantedPrivileges(t, role.ID(),
+						"CREATE DATABASE ON ACCOUNT",
+						"CREATE TABLE ON SCHEMA",
+						"USAGE ON DATABASE",
+						"USAGE ON SCHEMA",
+					),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:         ConfigurationDirectory("TestAcc_AccountRoleGrants/basic"),
+				ConfigVariables:         configVariables(onAccount, onDatabase, onSchema),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclude.#", "exclude.0.%", "exclude.0.in_database", "exclude.0.object_name", "exclude.0.object_type"},
+			},
+			// remove the schema grant and change the grant option
+			{
+				ConfigDirectory: ConfigurationDirectory("TestAcc_AccountRoleGrants/basic"),
+				ConfigVariables: configVariables(onAccount, onDatabaseWithGrantOption),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					checkAccountRoleGrantedPrivileges(t, role.ID(),
+						"CREATE DATABASE ON ACCOUNT",
+						"USAGE ON DATABASE",
+					),
+				),
+			},
+			// grant externally
+			{
+				PreConfig: func() {
+					testClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, role.ID(), databaseId, []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}, false)
+				},
+				ConfigDirectory: ConfigurationDirectory("TestAcc_AccountRoleGrants/basic"),
+				ConfigVariables: configVariables(onAccount, onDatabaseWithGrantOption),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					checkAccountRoleGrantedPrivileges(t, role.ID(),
+						"CREATE DATABASE ON ACCOUNT",
+						"USAGE ON DATABASE",
+					),
+				),
+			},
+			// revoke everything
+			{
+				ConfigDirectory: ConfigurationDirectory("TestAcc_AccountRoleGrants/basic"),
+				ConfigVariables: configVariables(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
+					checkAccountRoleGrantedPrivileges(t, role.ID()),
+				),
+			},
+		},
+	})
+}
+
+func roleGrantsConfigVariable(privileges []string, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier, withGrantOption bool) config.Variable {
+	privilegesVariables := make([]config.Variable, len(privileges))
+	for i, privilege := range privileges {
+		privilegesVariables[i] = config.StringVariable(privilege)
+	}
+	variables := map[string]config.Variable{
+		"privileges":        config.ListVariable(privilegesVariables...),
+		"object_type":       config.StringVariable(objectType.String()),
+		"with_grant_option": config.BoolVariable(withGrantOption),
+	}
+	if objectId != nil {
+		variables["object_name"] = config.StringVariable(objectId.FullyQualifiedName())
+	}
+	return config.ObjectVariable(variables)
+}
+
+func checkAccountRoleGrantedPrivileges(t *testing.T, id sdk.AccountObjectIdentifier, expected ...string) resource.TestCheckFunc {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		grants, err := testClient().Grant.ShowGrantsToAccountRole(t, id)
+		if err != nil {
+			return err
+		}
+		return checkGrantedPrivileges(grants, expected)
+	}
+}
+
+func checkGrantedPrivileges(grants []sdk.Grant, expected []string) error {
+	actual := make([]string, 0)
+	for _, grant := range grants {
+		actual = append(actual, fmt.Sprintf("%s ON %s", grant.Privilege, grant.GrantedOn))
+	}
+	slices.Sort(actual)
+	if !slices.Equal(actual, expected) {
+		return fmt.Errorf("expected granted privileges %v, got %v", expected, actual)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AggregationPolicy_basic(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+	body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+	changedBody := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 10)"
+
+	modelBasic := model.AggregationPolicy("test", id.DatabaseName(), id.SchemaName(), id.Name(), body)
+
+	modelComplete := model.AggregationPolicy("test", id.DatabaseName(), id.SchemaName(), id.Name(), changedBody).
+		WithComment(comment)
+
+	modelRenamed := model.AggregationPolicy("test", newId.DatabaseName(), newId.SchemaName(), newId.Name(), changedBody).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.AggregationPolicy),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.AggregationPolicyResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasBodyString(body).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.database_name", id.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.schema_name", id.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.kind", string(sdk.PolicyKindAggregationPolicy))),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", "")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.signature", "()")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.return_type", "AGGREGATION_CONSTRAINT")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.body", body)),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedAggregationPolicyResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasBodyString(body).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// set optionals and change body
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AggregationPolicyResource(t, modelComplete.ResourceReference()).
+						HasBodyString(changedBody).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.body", changedBody)),
+				),
+			},
+			// change externally
+			{
+				PreConfig: func() {
+					testClient().AggregationPolicy.Alter(t, sdk.NewAlterAggregationPolicyRequest(id).WithSetBody(body))
+					testClient().AggregationPolicy.Alter(t, sdk.NewAlterAggregationPolicyRequest(id).WithSetComment(changedComment))
+				},
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AggregationPolicyResource(t, modelComplete.ResourceReference()).
+						HasBodyString(changedBody).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.body", changedBody)),
+				),
+			},
+			// rename
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AggregationPolicyResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, model.AggregationPolicy("test", newId.DatabaseName(), newId.SchemaName(), newId.Name(), changedBody)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AggregationPolicyResource(t, modelRenamed.ResourceReference()).
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(modelRenamed.ResourceReference(), "show_output.0.comment", "")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Application_basic(t *testing.T) {
+	stageId := createApplicationFilesStage(t)
+
+	applicationPackage, cleanupApplicationPackage := testClient().ApplicationPackage.CreateApplicationPackage(t)
+	t.Cleanup(cleanupApplicationPackage)
+
+	testClient().ApplicationPackage.AddApplicationPackageVersion(t, applicationPackage.ID(), stageId, "V1")
+	testClient().ApplicationPackage.AddApplicationPackageVersion(t, applicationPackage.ID(), stageId, "V2")
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	modelBasic := model.Application("test", id.Name()).
+		WithApplicationPackage(applicationPackage.ID().FullyQualifiedName()).
+		WithVersion("V1")
+
+	modelComplete := model.Application("test", id.Name()).
+		WithApplicationPackage(applicationPackage.ID().FullyQualifiedName()).
+		WithVersion("V1").
+		WithPatch(0).
+		WithDebugMode("true").
+		WithComment(comment)
+
+	modelUpgraded := model.Application("test", id.Name()).
+		WithApplicationPackage(applicationPackage.ID().FullyQualifiedName()).
+		WithVersion("V2").
+		WithPatch(0).
+		WithDebugMode("false").
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Application),
+		Steps: []resource.TestStep{
+			// create with a version
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.ApplicationResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasApplicationPackageString(applicationPackage.ID().FullyQualifiedName()).
+						HasVersionString("V1").
+						HasPatchString("-1").
+						HasDebugModeString("default").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.source", applicationPackage.Name)),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.version", "V1")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.patch", "0")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.property")),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedApplicationResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasApplicationPackageString(applicationPackage.ID().FullyQualifiedName()).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ApplicationResource(t, modelComplete.ResourceReference()).
+						HasPatchString("0").
+						HasDebugModeString("true").
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+				),
+			},
+			// upgrade to a different version
+			{
+				Config: accconfig.FromModels(t, modelUpgraded),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelUpgraded.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ApplicationResource(t, modelUpgraded.ResourceReference()).
+						HasVersionString("V2").
+						HasDebugModeString("false").
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelUpgraded.ResourceReference(), "show_output.0.version", "V2")),
+					assert.Check(resource.TestCheckResourceAttr(modelUpgraded.ResourceReference(), "show_output.0.comment", changedComment)),
+				),
+			},
+		},
+	})
+}
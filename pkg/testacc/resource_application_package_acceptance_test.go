@@ -0,0 +1,195 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// createApplicationFilesStage creates a stage containing the minimal set of files needed to add a version to an application package.
+func createApplicationFilesStage(t *testing.T) sdk.SchemaObjectIdentifier {
+	t.Helper()
+
+	stage, cleanupStage := testClient().Stage.CreateStage(t)
+	t.Cleanup(cleanupStage)
+
+	testClient().Stage.PutOnStage(t, stage.ID(), "TestAcc_GrantApplicationRole/manifest.yml")
+	testClient().Stage.PutOnStage(t, stage.ID(), "TestAcc_GrantApplicationRole/setup.sql")
+
+	return stage.ID()
+}
+
+func TestAcc_ApplicationPackage_basic(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	modelBasic := model.ApplicationPackage("test", id.Name())
+
+	modelComplete := model.ApplicationPackage("test", id.Name()).
+		WithDistribution(string(sdk.DistributionInternal)).
+		WithComment(comment)
+
+	modelCompleteWithDifferentValues := model.ApplicationPackage("test", id.Name()).
+		WithDistribution(string(sdk.DistributionInternal)).
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ApplicationPackage),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.ApplicationPackageResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDistributionString("").
+						HasEnableReleaseChannelsString("default").
+						HasCommentString("").
+						HasVersionEmpty().
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.distribution", string(sdk.DistributionInternal))),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedApplicationPackageResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDistributionString(string(sdk.DistributionInternal)).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ApplicationPackageResource(t, modelComplete.ResourceReference()).
+						HasDistributionString(string(sdk.DistributionInternal)).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+				),
+			},
+			// change values
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ApplicationPackageResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "show_output.0.comment", changedComment)),
+				),
+			},
+			// unset
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ApplicationPackageResource(t, modelBasic.ResourceReference()).
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", "")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ApplicationPackage_versions(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	stageId := createApplicationFilesStage(t)
+	using := "@" + stageId.FullyQualifiedName()
+
+	modelWithVersion := model.ApplicationPackage("test", id.Name()).
+		WithEnableReleaseChannels("false").
+		WithVersion("V1", using).
+		WithDefaultReleaseDirective("V1", 0)
+
+	modelWithPatch := model.ApplicationPackage("test", id.Name()).
+		WithEnableReleaseChannels("false").
+		WithVersion("V1", using, using).
+		WithDefaultReleaseDirective("V1", 1)
+
+	modelWithChangedVersion := model.ApplicationPackage("test", id.Name()).
+		WithEnableReleaseChannels("false").
+		WithVersion("V1", stageId.FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ApplicationPackage),
+		Steps: []resource.TestStep{
+			// create with a version and the default release directive
+			{
+				Config: accconfig.FromModels(t, modelWithVersion),
+				Check: assertThat(t,
+					resourceassert.ApplicationPackageResource(t, modelWithVersion.ResourceReference()).
+						HasNameString(id.Name()).
+						HasEnableReleaseChannelsString("false"),
+					assert.Check(resource.TestCheckResourceAttr(modelWithVersion.ResourceReference(), "version.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithVersion.ResourceReference(), "version.0.name", "V1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithVersion.ResourceReference(), "version.0.patch.#", "0")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithVersion.ResourceReference(), "default_release_directive.0.version", "V1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithVersion.ResourceReference(), "default_release_directive.0.patch", "0")),
+				),
+			},
+			// add a patch and move the default release directive to it
+			{
+				Config: accconfig.FromModels(t, modelWithPatch),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithPatch.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelWithPatch.ResourceReference(), "version.0.patch.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithPatch.ResourceReference(), "default_release_directive.0.patch", "1")),
+				),
+			},
+			// changing an existing version is not supported
+			{
+				Config:      accconfig.FromModels(t, modelWithChangedVersion),
+				ExpectError: regexp.MustCompile("changing using or label of the existing version V1 is not supported"),
+			},
+		},
+	})
+}
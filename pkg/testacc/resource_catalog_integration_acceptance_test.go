@@ -0,0 +1,170 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_CatalogIntegration_basic(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	catalogSource := string(sdk.CatalogIntegrationCatalogSourceObjectStore)
+	tableFormat := string(sdk.CatalogIntegrationTableFormatIceberg)
+
+	modelBasic := model.CatalogIntegration("test", id.Name(), catalogSource, true, tableFormat)
+
+	modelComplete := model.CatalogIntegration("test", id.Name(), catalogSource, true, tableFormat).
+		WithRefreshIntervalSeconds(60).
+		WithComment(comment)
+
+	modelCompleteWithDifferentValues := model.CatalogIntegration("test", id.Name(), catalogSource, true, tableFormat).
+		WithRefreshIntervalSeconds(120).
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.CatalogIntegration),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasCatalogSourceString(catalogSource).
+						HasTableFormatString(tableFormat).
+						HasEnabledString("true").
+						HasRefreshIntervalSecondsString("-1").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.category", "CATALOG")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.enabled", "true")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", "")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.catalog_source.0.value", catalogSource)),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.table_format.0.value", tableFormat)),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedCatalogIntegrationResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasCatalogSourceString(catalogSource).
+						HasTableFormatString(tableFormat).
+						HasEnabledString("true").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasRefreshIntervalSecondsString("60").
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.refresh_interval_seconds.0.value", "60")),
+				),
+			},
+			// change values
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasRefreshIntervalSecondsString("120").
+						HasCommentString(changedComment),
+				),
+			},
+			// change externally
+			{
+				PreConfig: func() {
+					testClient().CatalogIntegration.Alter(t, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(
+						*sdk.NewCatalogIntegrationSetRequest().
+							WithRefreshIntervalSeconds(90).
+							WithComment(comment)))
+				},
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasRefreshIntervalSecondsString("120").
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "describe_output.0.refresh_interval_seconds.0.value", "120")),
+				),
+			},
+			// unset refresh interval - recreates the integration
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.CatalogIntegrationResource(t, modelBasic.ResourceReference()).
+						HasRefreshIntervalSecondsString("-1").
+						HasCommentString(""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_CatalogIntegration_invalidCatalogSource(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	modelInvalid := model.CatalogIntegration("test", id.Name(), "invalid", true, string(sdk.CatalogIntegrationTableFormatIceberg))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, modelInvalid),
+				ExpectError: regexp.MustCompile("invalid catalog source: invalid"),
+			},
+		},
+	})
+}
@@ -0,0 +1,206 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func dataMetricFunctionTestArguments(t *testing.T) []sdk.DataMetricFunctionTableArgumentRequest {
+	t.Helper()
+
+	numberDataType, err := datatypes.ParseDataType("NUMBER(38, 0)")
+	require.NoError(t, err)
+
+	return []sdk.DataMetricFunctionTableArgumentRequest{
+		*sdk.NewDataMetricFunctionTableArgumentRequest("arg_t").
+			WithColumns([]sdk.DataMetricFunctionColumnRequest{*sdk.NewDataMetricFunctionColumnRequest("arg_c", numberDataType)}),
+	}
+}
+
+func TestAcc_DataMetricFunction_basic(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+	expression := "SELECT COUNT(*) FROM arg_t WHERE arg_c IS NULL"
+	arguments := dataMetricFunctionTestArguments(t)
+
+	modelBasic := model.DataMetricFunctionFromId("test", id, arguments, expression)
+	modelComplete := model.DataMetricFunctionFromId("test", id, arguments, expression).
+		WithIsSecure(r.BooleanTrue).
+		WithComment(comment)
+	modelCompleteWithDifferentValues := model.DataMetricFunctionFromId("test", id, arguments, expression).
+		WithIsSecure(r.BooleanFalse).
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DataMetricFunction),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasIsSecureString(r.BooleanDefault).
+						HasExpressionString(expression).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "argument.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "argument.0.table_name", "arg_t")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "argument.0.column.0.name", "arg_c")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.catalog_name", id.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.schema_name", id.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.is_secure", "false")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.language", "SQL")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.body", expression)),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.language", "SQL")),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedDataMetricFunctionResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasIsSecureString(r.BooleanFalse).
+						HasExpressionString(expression).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionResource(t, modelComplete.ResourceReference()).
+						HasIsSecureString(r.BooleanTrue).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.is_secure", "true")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.description", comment)),
+				),
+			},
+			// change values
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasIsSecureString(r.BooleanFalse).
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "show_output.0.is_secure", "false")),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "show_output.0.description", changedComment)),
+				),
+			},
+			// change externally
+			{
+				PreConfig: func() {
+					testClient().DataMetricFunctionClient.Set(t, id, *sdk.NewDataMetricFunctionSetRequest().WithComment(comment))
+				},
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "show_output.0.description", changedComment)),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionResource(t, modelBasic.ResourceReference()).
+						HasIsSecureString(r.BooleanDefault).
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.is_secure", "false")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DataMetricFunction_rename(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	expression := "SELECT COUNT(*) FROM arg_t WHERE arg_c IS NULL"
+	arguments := dataMetricFunctionTestArguments(t)
+
+	modelBasic := model.DataMetricFunctionFromId("test", id, arguments, expression)
+	modelRenamed := model.DataMetricFunctionFromId("test", newId, arguments, expression)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DataMetricFunction),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
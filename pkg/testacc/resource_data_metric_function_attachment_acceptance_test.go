@@ -0,0 +1,115 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DataMetricFunctionAttachment_basic(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	functionId := sdk.NewSchemaObjectIdentifier("SNOWFLAKE", "CORE", "NULL_COUNT")
+	tableId := table.ID()
+	cron, changedCron := "5 * * * * UTC", "10 * * * * UTC"
+	expectedId := helpers.EncodeResourceIdentifier(string(sdk.ObjectTypeTable), tableId.FullyQualifiedName(), functionId.FullyQualifiedName(), "ID")
+
+	attachmentModel := model.DataMetricFunctionAttachment("test", functionId.FullyQualifiedName(), tableId.FullyQualifiedName(), string(sdk.ObjectTypeTable), []string{"ID"}).
+		WithDataMetricScheduleUsingCron(cron)
+	attachmentModelSuspended := model.DataMetricFunctionAttachment("test", functionId.FullyQualifiedName(), tableId.FullyQualifiedName(), string(sdk.ObjectTypeTable), []string{"ID"}).
+		WithDataMetricScheduleUsingCron(changedCron).
+		WithScheduleStatus(string(sdk.DataMetricScheduleStatusSuspended))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDataMetricFunctionAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, attachmentModel),
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, attachmentModel.ResourceReference()).
+						HasObjectTypeString(string(sdk.ObjectTypeTable)).
+						HasObjectNameString(tableId.FullyQualifiedName()).
+						HasFunctionNameString(functionId.FullyQualifiedName()).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusStarted)),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "id", expectedId)),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "on.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "on.0", "ID")),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "data_metric_schedule.0.using_cron", cron)),
+				),
+			},
+			// import
+			{
+				ResourceName:            attachmentModel.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_metric_schedule"},
+			},
+			// change schedule and suspend
+			{
+				Config: accconfig.FromModels(t, attachmentModelSuspended),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(attachmentModelSuspended.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, attachmentModelSuspended.ResourceReference()).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusSuspended)),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModelSuspended.ResourceReference(), "data_metric_schedule.0.using_cron", changedCron)),
+				),
+			},
+			// resume externally
+			{
+				PreConfig: func() {
+					testClient().DataMetricFunctionClient.AlterOnObject(t, sdk.NewAlterOnObjectDataMetricFunctionRequest(sdk.ObjectTypeTable, tableId).
+						WithModify(*sdk.NewDataMetricFunctionModifyOnObjectRequest(functionId, []sdk.Column{{Value: "ID"}}, sdk.ViewDataMetricScheduleStatusOperationResume)))
+				},
+				Config: accconfig.FromModels(t, attachmentModelSuspended),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(attachmentModelSuspended.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, attachmentModelSuspended.ResourceReference()).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusSuspended)),
+				),
+			},
+			// drop externally
+			{
+				PreConfig: func() {
+					testClient().DataMetricFunctionClient.AlterOnObject(t, sdk.NewAlterOnObjectDataMetricFunctionRequest(sdk.ObjectTypeTable, tableId).
+						WithDrop(*sdk.NewDataMetricFunctionOnObjectRequest(functionId, []sdk.Column{{Value: "ID"}})))
+				},
+				Config: accconfig.FromModels(t, attachmentModelSuspended),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(attachmentModelSuspended.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, attachmentModelSuspended.ResourceReference()).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusSuspended)),
+					assert.Check(resource.TestCheckResourceAttr(attachmentModelSuspended.ResourceReference(), "id", expectedId)),
+				),
+			},
+		},
+	})
+}
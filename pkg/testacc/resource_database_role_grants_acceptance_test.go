@@ -0,0 +1,112 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DatabaseRoleGrants_basic(t *testing.T) {
+	databaseRole, databaseRoleCleanup := testClient().DatabaseRole.CreateDatabaseRole(t)
+	t.Cleanup(databaseRoleCleanup)
+
+	databaseId := testClient().Ids.DatabaseId()
+	schemaId := testClient().Ids.SchemaId()
+
+	// granted outside of Terraform; revoked by the resource
+	testClient().Grant.GrantPrivilegesOnDatabaseToDatabaseRole(t, databaseRole.ID(), databaseId, []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}, false)
+
+	configVariables := func(grants ...config.Variable) config.Variables {
+		return config.Variables{
+			"database_role_name": config.StringVariable(databaseRole.ID().FullyQualifiedName()),
+			"grants":             config.ListVariable(grants...),
+		}
+	}
+	onDatabase := roleGrantsConfigVariable([]string{string(sdk.AccountObjectPrivilegeCreateSchema)}, sdk.ObjectTypeDatabase, databaseId, false)
+	onSchema := roleGrantsConfigVariable([]string{string(sdk.SchemaPrivilegeUsage)}, sdk.ObjectTypeSchema, schemaId, false)
+	onSchemaWithGrantOption := roleGrantsConfigVariable([]string{string(sdk.SchemaPrivilegeUsage)}, sdk.ObjectTypeSchema, schemaId, true)
+
+	resourceName := "snowflake_database_role_grants.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDatabaseRolePrivilegesRevoked(t),
+		Steps: []resource.TestStep{
+			// create
+			{
+				ConfigDirectory: ConfigurationDirectory("TestAcc_DatabaseRoleGrants/basic"),
+				ConfigVariables: configVariables(onDatabase, onSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", helpers.EncodeResourceIdentifier(databaseRole.ID())),
+					resource.TestCheckResourceAttr(resourceName, "database_role_name", databaseRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					checkDatabaseRoleGrantedPrivileges(t, databaseRole.ID(),
+						"CREATE SCHEMA ON DATABASE",
+						"USAGE ON DATABASE",
+						"USAGE ON SCHEMA",
+					),
+				),
+			},
+			// import
+			{
+				ConfigDirectory:         ConfigurationDirectory("TestAcc_DatabaseRoleGrants/basic"),
+				ConfigVariables:         configVariables(onDatabase, onSchema),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclude.#", "exclude.0.%", "exclude.0.in_database", "exclude.0.object_name", "exclude.0.object_type"},
+			},
+			// remove the database grant and change the grant option
+			{
+				ConfigDirectory: ConfigurationDirectory("TestAcc_DatabaseRoleGrants/basic"),
+				ConfigVariables: configVariables(onSchemaWithGrantOption),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grant.0.with_grant_option", "true"),
+					checkDatabaseRoleGrantedPrivileges(t, databaseRole.ID(),
+						"USAGE ON DATABASE",
+						"USAGE ON SCHEMA",
+					),
+				),
+			},
+			// revoke everything
+			{
+				ConfigDirectory: ConfigurationDirectory("TestAcc_DatabaseRoleGrants/basic"),
+				ConfigVariables: configVariables(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
+					checkDatabaseRoleGrantedPrivileges(t, databaseRole.ID(),
+						"USAGE ON DATABASE",
+					),
+				),
+			},
+		},
+	})
+}
+
+func checkDatabaseRoleGrantedPrivileges(t *testing.T, id sdk.DatabaseObjectIdentifier, expected ...string) resource.TestCheckFunc {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		grants, err := testClient().Grant.ShowGrantsToDatabaseRole(t, id)
+		if err != nil {
+			return err
+		}
+		return checkGrantedPrivileges(grants, expected)
+	}
+}
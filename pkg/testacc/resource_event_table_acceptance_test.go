@@ -0,0 +1,246 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTable_basic(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	modelBasic := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name())
+
+	modelComplete := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithClusterBy("TIMESTAMP").
+		WithChangeTracking(true).
+		WithDataRetentionTimeInDays(2).
+		WithMaxDataExtensionTimeInDays(10).
+		WithComment(comment)
+
+	modelCompleteWithDifferentValues := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithClusterBy("TIMESTAMP", "START_TIMESTAMP").
+		WithChangeTracking(false).
+		WithDataRetentionTimeInDays(3).
+		WithMaxDataExtensionTimeInDays(20).
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.EventTable),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.EventTableResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasClusterByEmpty().
+						HasChangeTrackingString("false").
+						HasDataRetentionTimeInDaysString("1").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.database_name", id.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.schema_name", id.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", "")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.name", "TIMESTAMP")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.kind", "COLUMN")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "parameters.0.data_retention_time_in_days.0.value", "1")),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedEventTableResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasChangeTrackingString("false").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.EventTableResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasChangeTrackingString("true").
+						HasDataRetentionTimeInDaysString("2").
+						HasMaxDataExtensionTimeInDaysString("10").
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "cluster_by.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "cluster_by.0", "TIMESTAMP")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "parameters.0.data_retention_time_in_days.0.value", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "parameters.0.max_data_extension_time_in_days.0.value", "10")),
+				),
+			},
+			// change values
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.EventTableResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasChangeTrackingString("false").
+						HasDataRetentionTimeInDaysString("3").
+						HasMaxDataExtensionTimeInDaysString("20").
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "cluster_by.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "cluster_by.1", "START_TIMESTAMP")),
+				),
+			},
+			// import complete state
+			{
+				Config:            accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ResourceName:      modelCompleteWithDifferentValues.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// unset
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.EventTableResource(t, modelBasic.ResourceReference()).
+						HasClusterByEmpty().
+						HasChangeTrackingString("false").
+						HasDataRetentionTimeInDaysString("1").
+						HasCommentString(""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_EventTable_rename(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	modelBasic := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name())
+	modelRenamed := model.EventTable("test", newId.DatabaseName(), newId.SchemaName(), newId.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.EventTable),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.EventTableResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.EventTableResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_EventTable_rowAccessPolicy(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	rowAccessPolicy, rowAccessPolicyCleanup := testClient().RowAccessPolicy.CreateRowAccessPolicyWithDataType(t, testdatatypes.DataTypeTimestampNTZ)
+	t.Cleanup(rowAccessPolicyCleanup)
+
+	rowAccessPolicy2, rowAccessPolicy2Cleanup := testClient().RowAccessPolicy.CreateRowAccessPolicyWithDataType(t, testdatatypes.DataTypeTimestampNTZ)
+	t.Cleanup(rowAccessPolicy2Cleanup)
+
+	modelWithPolicy := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithRowAccessPolicy(rowAccessPolicy.ID(), "TIMESTAMP")
+	modelWithChangedPolicy := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithRowAccessPolicy(rowAccessPolicy2.ID(), "TIMESTAMP")
+	modelWithoutPolicy := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.EventTable),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelWithPolicy),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelWithPolicy.ResourceReference(), "row_access_policy.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithPolicy.ResourceReference(), "row_access_policy.0.policy_name", rowAccessPolicy.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(modelWithPolicy.ResourceReference(), "row_access_policy.0.on.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithPolicy.ResourceReference(), "row_access_policy.0.on.0", "TIMESTAMP")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, modelWithChangedPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithChangedPolicy.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelWithChangedPolicy.ResourceReference(), "row_access_policy.0.policy_name", rowAccessPolicy2.ID().FullyQualifiedName())),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, modelWithoutPolicy),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelWithoutPolicy.ResourceReference(), "row_access_policy.#", "0")),
+				),
+			},
+		},
+	})
+}
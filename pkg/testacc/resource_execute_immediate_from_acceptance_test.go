@@ -0,0 +1,121 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testvars"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExecuteImmediateFrom_basic(t *testing.T) {
+	origin := testvars.ProviderGitRepositoryOrigin
+
+	apiIntegrationId, apiIntegrationCleanup := testClient().ApiIntegration.CreateApiIntegrationForGitRepository(t, origin)
+	t.Cleanup(apiIntegrationCleanup)
+
+	gitRepository, gitRepositoryCleanup := testClient().GitRepository.Create(t, testClient().Ids.RandomSchemaObjectIdentifier(), origin, apiIntegrationId)
+	t.Cleanup(gitRepositoryCleanup)
+
+	commitHash := testClient().GitRepository.BranchCommitHash(t, gitRepository.ID(), "main")
+
+	tableId := testClient().Ids.RandomSchemaObjectIdentifier()
+	t.Cleanup(testClient().Table.DropFunc(t, tableId))
+	changedTableId := testClient().Ids.RandomSchemaObjectIdentifier()
+	t.Cleanup(testClient().Table.DropFunc(t, changedTableId))
+
+	modelBranch := model.ExecuteImmediateFrom("test", testvars.ExecuteImmediateFromCreateTableFilePath, gitRepository.ID().FullyQualifiedName()).
+		WithBranch("main").
+		WithRevertFilePath(testvars.ExecuteImmediateFromDropTableFilePath).
+		WithUsing(map[string]string{"table_name": tableId.FullyQualifiedName()})
+	modelCommit := model.ExecuteImmediateFrom("test", testvars.ExecuteImmediateFromCreateTableFilePath, gitRepository.ID().FullyQualifiedName()).
+		WithCommit(commitHash).
+		WithRevertFilePath(testvars.ExecuteImmediateFromDropTableFilePath).
+		WithUsing(map[string]string{"table_name": tableId.FullyQualifiedName()})
+	modelChangedUsing := model.ExecuteImmediateFrom("test", testvars.ExecuteImmediateFromCreateTableFilePath, gitRepository.ID().FullyQualifiedName()).
+		WithCommit(commitHash).
+		WithRevertFilePath(testvars.ExecuteImmediateFromDropTableFilePath).
+		WithUsing(map[string]string{"table_name": changedTableId.FullyQualifiedName()})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckTableExistence(t, changedTableId, false),
+		Steps: []resource.TestStep{
+			// create from the branch
+			{
+				Config: accconfig.FromModels(t, modelBranch),
+				Check: assertThat(t,
+					resourceassert.ExecuteImmediateFromResource(t, modelBranch.ResourceReference()).
+						HasGitRepositoryString(gitRepository.ID().FullyQualifiedName()).
+						HasBranchString("main").
+						HasFilePathString(testvars.ExecuteImmediateFromCreateTableFilePath).
+						HasRevertFilePathString(testvars.ExecuteImmediateFromDropTableFilePath).
+						HasCommitHashString(commitHash),
+					assert.Check(resource.TestCheckResourceAttr(modelBranch.ResourceReference(), "using.table_name", tableId.FullyQualifiedName())),
+					assert.Check(testAccCheckTableExistence(t, tableId, true)),
+				),
+			},
+			// switch to the same commit - the file is not executed again
+			{
+				Config: accconfig.FromModels(t, modelCommit),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCommit.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExecuteImmediateFromResource(t, modelCommit.ResourceReference()).
+						HasBranchString("").
+						HasCommitString(commitHash).
+						HasCommitHashString(commitHash),
+				),
+			},
+			// change the variables - the file is executed again
+			{
+				Config: accconfig.FromModels(t, modelChangedUsing),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelChangedUsing.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExecuteImmediateFromResource(t, modelChangedUsing.ResourceReference()).
+						HasCommitHashString(commitHash),
+					assert.Check(resource.TestCheckResourceAttr(modelChangedUsing.ResourceReference(), "using.table_name", changedTableId.FullyQualifiedName())),
+					assert.Check(testAccCheckTableExistence(t, changedTableId, true)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableExistence(t *testing.T, id sdk.SchemaObjectIdentifier, shouldExist bool) func(state *terraform.State) error {
+	t.Helper()
+	return func(state *terraform.State) error {
+		_, err := testClient().Table.Show(t, id)
+		if shouldExist {
+			if err != nil {
+				return fmt.Errorf("error while retrieving table %s, err = %w", id.FullyQualifiedName(), err)
+			}
+		} else {
+			if err == nil {
+				return fmt.Errorf("table %s still exists", id.FullyQualifiedName())
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,217 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegration_basic(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	networkRule2, networkRule2Cleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRule2Cleanup)
+
+	secretId, secretCleanup := testClient().Secret.CreateRandomPasswordSecret(t)
+	t.Cleanup(secretCleanup)
+
+	apiAuthenticationIntegration, apiAuthenticationIntegrationCleanup := testClient().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(apiAuthenticationIntegrationCleanup)
+
+	modelBasic := model.ExternalAccessIntegration("test", id.Name(), []string{networkRule.ID().FullyQualifiedName()}, true)
+
+	modelComplete := model.ExternalAccessIntegration("test", id.Name(), []string{networkRule.ID().FullyQualifiedName(), networkRule2.ID().FullyQualifiedName()}, false).
+		WithAllowedApiAuthenticationIntegrations([]string{apiAuthenticationIntegration.ID().FullyQualifiedName()}).
+		WithAllowedAuthenticationSecrets([]string{secretId.FullyQualifiedName()}).
+		WithComment(comment)
+
+	modelCompleteWithDifferentValues := model.ExternalAccessIntegration("test", id.Name(), []string{networkRule2.ID().FullyQualifiedName()}, true).
+		WithAllowedApiAuthenticationIntegrations([]string{apiAuthenticationIntegration.ID().FullyQualifiedName()}).
+		WithAllowedAuthenticationSecrets([]string{secretId.FullyQualifiedName()}).
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasAllowedNetworkRules(networkRule.ID().FullyQualifiedName()).
+						HasAllowedApiAuthenticationIntegrations().
+						HasAllowedAuthenticationSecrets().
+						HasEnabledString("true").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.type", "EXTERNAL_ACCESS")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.category", "SECURITY")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.enabled", "true")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", "")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.enabled.0.value", "true")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.allowed_network_rules.0.value")),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedExternalAccessIntegrationResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasAllowedNetworkRules(networkRule.ID().FullyQualifiedName()).
+						HasEnabledString("true").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasAllowedNetworkRules(networkRule.ID().FullyQualifiedName(), networkRule2.ID().FullyQualifiedName()).
+						HasAllowedApiAuthenticationIntegrations(apiAuthenticationIntegration.ID().FullyQualifiedName()).
+						HasAllowedAuthenticationSecrets(secretId.FullyQualifiedName()).
+						HasEnabledString("false").
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.enabled", "false")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.enabled.0.value", "false")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.comment.0.value", comment)),
+				),
+			},
+			// change values
+			{
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasAllowedNetworkRules(networkRule2.ID().FullyQualifiedName()).
+						HasEnabledString("true").
+						HasCommentString(changedComment),
+				),
+			},
+			// change externally
+			{
+				PreConfig: func() {
+					testClient().ExternalAccessIntegration.Alter(t, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(
+						*sdk.NewExternalAccessIntegrationSetRequest().
+							WithAllowedNetworkRules([]sdk.SchemaObjectIdentifier{networkRule.ID()}).
+							WithEnabled(false).
+							WithComment(comment)))
+				},
+				Config: accconfig.FromModels(t, modelCompleteWithDifferentValues),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCompleteWithDifferentValues.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelCompleteWithDifferentValues.ResourceReference()).
+						HasAllowedNetworkRules(networkRule2.ID().FullyQualifiedName()).
+						HasEnabledString("true").
+						HasCommentString(changedComment),
+					assert.Check(resource.TestCheckResourceAttr(modelCompleteWithDifferentValues.ResourceReference(), "show_output.0.enabled", "true")),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelBasic.ResourceReference()).
+						HasAllowedNetworkRules(networkRule.ID().FullyQualifiedName()).
+						HasAllowedApiAuthenticationIntegrations().
+						HasAllowedAuthenticationSecrets().
+						HasEnabledString("true").
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", "")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ExternalAccessIntegration_rename(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	newId := testClient().Ids.RandomAccountObjectIdentifier()
+
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	modelBasic := model.ExternalAccessIntegration("test", id.Name(), []string{networkRule.ID().FullyQualifiedName()}, true)
+	modelRenamed := model.ExternalAccessIntegration("test", newId.Name(), []string{networkRule.ID().FullyQualifiedName()}, true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// external access integrations cannot be renamed, so the resource is recreated
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
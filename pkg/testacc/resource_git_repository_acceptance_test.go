@@ -11,6 +11,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 
@@ -397,3 +398,77 @@ func TestAcc_GitRepository_complete(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_GitRepository_fetch(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	origin := testvars.ExampleGitRepositoryOrigin
+
+	apiIntegrationId, apiIntegrationCleanup := testClient().ApiIntegration.CreateApiIntegrationForGitRepository(t, origin)
+	t.Cleanup(apiIntegrationCleanup)
+
+	modelWithFetchTrigger := model.GitRepository("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegrationId.FullyQualifiedName(), origin).
+		WithFetchTrigger("1")
+	modelWithChangedFetchTrigger := model.GitRepository("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegrationId.FullyQualifiedName(), origin).
+		WithFetchTrigger("2")
+	modelWithFetchOnApply := model.GitRepository("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegrationId.FullyQualifiedName(), origin).
+		WithFetchTrigger("2").
+		WithFetchOnApply(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.GitRepository),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelWithFetchTrigger),
+				Check: assertThat(t,
+					resourceassert.GitRepositoryResource(t, modelWithFetchTrigger.ResourceReference()).
+						HasFetchTriggerString("1"),
+				),
+			},
+			// no changes
+			{
+				Config: accconfig.FromModels(t, modelWithFetchTrigger),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// change the trigger
+			{
+				Config: accconfig.FromModels(t, modelWithChangedFetchTrigger),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithChangedFetchTrigger.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectComputed(modelWithChangedFetchTrigger.ResourceReference(), "show_output", true),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.GitRepositoryResource(t, modelWithChangedFetchTrigger.ResourceReference()).
+						HasFetchTriggerString("2"),
+				),
+			},
+			// fetch on every apply
+			{
+				Config: accconfig.FromModels(t, modelWithFetchOnApply),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithFetchOnApply.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithFetchOnApply.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+				Check: assertThat(t,
+					resourceassert.GitRepositoryResource(t, modelWithFetchOnApply.ResourceReference()).
+						HasFetchOnApplyString("true"),
+				),
+			},
+		},
+	})
+}
@@ -839,6 +839,75 @@ func TestAcc_GrantPrivilegesToAccountRole_OnSchemaObject_OnAll_InDatabase(t *tes
 	})
 }
 
+func TestAcc_GrantPrivilegesToAccountRole_OnSchemaObject_OnAll_DetectsNewObjects(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+	schemaId := sdk.NewDatabaseObjectIdentifier(database.ID().Name(), "PUBLIC")
+
+	_, tableCleanup := testClient().Table.CreateInSchema(t, schemaId)
+	t.Cleanup(tableCleanup)
+
+	configVariables := config.Variables{
+		"name": config.StringVariable(role.ID().FullyQualifiedName()),
+		"privileges": config.ListVariable(
+			config.StringVariable(string(sdk.SchemaObjectPrivilegeInsert)),
+			config.StringVariable(string(sdk.SchemaObjectPrivilegeUpdate)),
+		),
+		"database":           config.StringVariable(database.ID().FullyQualifiedName()),
+		"object_type_plural": config.StringVariable(sdk.PluralObjectTypeTables.String()),
+		"with_grant_option":  config.BoolVariable(false),
+	}
+
+	resourceName := "snowflake_grant_privileges_to_account_role.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckAccountRolePrivilegesRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: ConfigurationDirectory("TestAcc_GrantPrivilegesToAccountRole/OnSchemaObject_OnAll_InDatabase"),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "2"),
+					checkAccountRoleGrantedPrivileges(t, role.ID(),
+						"INSERT ON TABLE",
+						"UPDATE ON TABLE",
+					),
+				),
+			},
+			// the table created after the grant does not have the privileges, so they are granted again
+			{
+				PreConfig: func() {
+					_, newTableCleanup := testClient().Table.CreateInSchema(t, schemaId)
+					t.Cleanup(newTableCleanup)
+				},
+				ConfigDirectory: ConfigurationDirectory("TestAcc_GrantPrivilegesToAccountRole/OnSchemaObject_OnAll_InDatabase"),
+				ConfigVariables: configVariables,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "2"),
+					checkAccountRoleGrantedPrivileges(t, role.ID(),
+						"INSERT ON TABLE",
+						"INSERT ON TABLE",
+						"UPDATE ON TABLE",
+						"UPDATE ON TABLE",
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_GrantPrivilegesToAccountRole_OnSchemaObject_OnAllPipes(t *testing.T) {
 	role, roleCleanup := testClient().Role.CreateRole(t)
 	t.Cleanup(roleCleanup)
@@ -0,0 +1,176 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_HybridTable_basic(t *testing.T) {
+	numberDataType, err := datatypes.ParseDataType("NUMBER(38, 0)")
+	require.NoError(t, err)
+	varcharDataType, err := datatypes.ParseDataType("VARCHAR(100)")
+	require.NoError(t, err)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	columns := []sdk.HybridTableColumnRequest{
+		*sdk.NewHybridTableColumnRequest("id", numberDataType),
+		*sdk.NewHybridTableColumnRequest("name", varcharDataType).WithComment("column comment"),
+	}
+	primaryKey := []sdk.Column{{Value: "id"}}
+	index := *sdk.NewHybridTableOutOfLineIndexRequest("idx_name", []sdk.Column{{Value: "name"}})
+	changedIndex := *sdk.NewHybridTableOutOfLineIndexRequest("idx_name", []sdk.Column{{Value: "name"}}).WithInclude([]sdk.Column{{Value: "id"}})
+
+	modelBasic := model.HybridTableFromId("test", id, columns, primaryKey)
+
+	modelWithIndex := model.HybridTableFromId("test", id, columns, primaryKey).
+		WithIndexes(index).
+		WithComment(comment)
+
+	modelWithChangedIndex := model.HybridTableFromId("test", id, columns, primaryKey).
+		WithIndexes(changedIndex).
+		WithComment(comment)
+
+	modelRenamed := model.HybridTableFromId("test", newId, columns, primaryKey).
+		WithIndexes(changedIndex).
+		WithComment(changedComment)
+
+	modelChangedColumns := model.HybridTableFromId("test", newId, columns[:1], primaryKey)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.HybridTable),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "primary_key.0.columns.0", "id")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "index.#", "0")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.name", "id")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.primary_key", "true")),
+				),
+			},
+			// import
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedHybridTableResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasCommentString(""),
+				),
+			},
+			// add index and set comment
+			{
+				Config: accconfig.FromModels(t, modelWithIndex),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithIndex.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelWithIndex.ResourceReference()).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelWithIndex.ResourceReference(), "index.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithIndex.ResourceReference(), "index.0.name", "idx_name")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithIndex.ResourceReference(), "index.0.columns.0", "name")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithIndex.ResourceReference(), "show_output.0.comment", comment)),
+				),
+			},
+			// change index
+			{
+				Config: accconfig.FromModels(t, modelWithChangedIndex),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithChangedIndex.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelWithChangedIndex.ResourceReference(), "index.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithChangedIndex.ResourceReference(), "index.0.include.0", "id")),
+				),
+			},
+			// drop index externally
+			{
+				PreConfig: func() {
+					testClient().HybridTable.DropIndex(t, sdk.NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), "idx_name"))
+				},
+				Config: accconfig.FromModels(t, modelWithChangedIndex),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithChangedIndex.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelWithChangedIndex.ResourceReference(), "index.#", "1")),
+				),
+			},
+			// rename and change comment
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+						HasCommentString(changedComment),
+				),
+			},
+			// change columns - recreates the table
+			{
+				Config: accconfig.FromModels(t, modelChangedColumns),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelChangedColumns.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.HybridTableResource(t, modelChangedColumns.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(modelChangedColumns.ResourceReference(), "column.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelChangedColumns.ResourceReference(), "index.#", "0")),
+					assert.Check(resource.TestCheckResourceAttr(modelChangedColumns.ResourceReference(), "describe_output.#", "1")),
+				),
+			},
+		},
+	})
+}
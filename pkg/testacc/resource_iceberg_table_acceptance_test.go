@@ -0,0 +1,198 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_IcebergTable_basic(t *testing.T) {
+	awsBaseUrl := testenvs.GetOrSkipTest(t, testenvs.AwsExternalBucketUrl)
+	awsRoleARN := testenvs.GetOrSkipTest(t, testenvs.AwsExternalRoleArn)
+
+	externalVolumeId, externalVolumeCleanup := testClient().ExternalVolume.CreateWithStorageLocation(t, sdk.ExternalVolumeStorageLocation{
+		S3StorageLocationParams: &sdk.S3StorageLocationParams{
+			Name:              "s3_iceberg_storage_location",
+			StorageProvider:   sdk.S3StorageProviderS3,
+			StorageAwsRoleArn: awsRoleARN,
+			StorageBaseUrl:    awsBaseUrl,
+		},
+	})
+	t.Cleanup(externalVolumeCleanup)
+
+	numberDataType, err := datatypes.ParseDataType("NUMBER(38, 0)")
+	require.NoError(t, err)
+	varcharDataType, err := datatypes.ParseDataType("VARCHAR(100)")
+	require.NoError(t, err)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	idColumn := *sdk.NewIcebergTableColumnRequest("id", numberDataType)
+	nameColumn := *sdk.NewIcebergTableColumnRequest("name", varcharDataType).WithComment("column comment")
+
+	modelBasic := model.IcebergTableFromId("test", id).
+		WithExternalVolume(externalVolumeId.Name()).
+		WithCatalog("SNOWFLAKE").
+		WithBaseLocation(id.Name()).
+		WithColumns(idColumn)
+
+	modelComplete := model.IcebergTableFromId("test", id).
+		WithExternalVolume(externalVolumeId.Name()).
+		WithCatalog("SNOWFLAKE").
+		WithBaseLocation(id.Name()).
+		WithColumns(idColumn, nameColumn).
+		WithReplaceInvalidCharacters("true").
+		WithComment(comment)
+
+	modelRenamed := model.IcebergTableFromId("test", newId).
+		WithExternalVolume(externalVolumeId.Name()).
+		WithCatalog("SNOWFLAKE").
+		WithBaseLocation(id.Name()).
+		WithColumns(idColumn, nameColumn).
+		WithReplaceInvalidCharacters("true").
+		WithComment(changedComment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.IcebergTable),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasExternalVolumeString(externalVolumeId.Name()).
+						HasCatalogString("SNOWFLAKE").
+						HasBaseLocationString(id.Name()).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "column.0.name", "id")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.external_volume_name", externalVolumeId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.catalog_name", "SNOWFLAKE")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.iceberg_table_type", "MANAGED")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.name", "id")),
+				),
+			},
+			// import
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedIcebergTableResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasExternalVolumeString(externalVolumeId.Name()).
+						HasCatalogString("SNOWFLAKE").
+						HasCommentString(""),
+				),
+			},
+			// add column and set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelComplete.ResourceReference()).
+						HasReplaceInvalidCharactersString("true").
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.1.name", "name")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "column.1.comment", "column comment")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+				),
+			},
+			// change comment externally
+			{
+				PreConfig: func() {
+					testClient().IcebergTable.Alter(t, sdk.NewAlterIcebergTableRequest(id).WithSet(*sdk.NewIcebergTableSetRequest().WithComment(changedComment)))
+				},
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelComplete.ResourceReference()).
+						HasCommentString(comment),
+				),
+			},
+			// rename and change comment
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+						HasCommentString(changedComment),
+				),
+			},
+			// drop column
+			{
+				Config: accconfig.FromModels(t, modelRenamed.WithColumns(idColumn)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()),
+					assert.Check(resource.TestCheckResourceAttr(modelRenamed.ResourceReference(), "column.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelRenamed.ResourceReference(), "describe_output.#", "1")),
+				),
+			},
+			// change column type - recreates the table
+			{
+				Config: accconfig.FromModels(t, modelRenamed.WithColumns(*sdk.NewIcebergTableColumnRequest("id", varcharDataType))),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.IcebergTableResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()),
+					assert.Check(resource.TestCheckResourceAttr(modelRenamed.ResourceReference(), "column.#", "1")),
+				),
+			},
+		},
+	})
+}
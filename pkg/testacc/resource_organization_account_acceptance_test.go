@@ -0,0 +1,126 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Only one organization account can exist in an organization, so the existing organization account is imported instead of created.
+func TestAcc_OrganizationAccount_ImportAndUpdate(t *testing.T) {
+	testClient().EnsureValidNonProdOrganizationAccountIsUsed(t)
+
+	passwordPolicy, passwordPolicyCleanup := testClient().PasswordPolicy.CreatePasswordPolicy(t)
+	t.Cleanup(passwordPolicyCleanup)
+
+	sessionPolicy, sessionPolicyCleanup := testClient().SessionPolicy.CreateSessionPolicy(t)
+	t.Cleanup(sessionPolicyCleanup)
+
+	// Destroying the resource doesn't change the organization account, so the values set in the test are unset here.
+	t.Cleanup(func() {
+		testClient().OrganizationAccount.Alter(t, sdk.NewAlterOrganizationAccountRequest().WithUnset(*sdk.NewOrganizationAccountUnsetRequest().WithComment(true)))
+		testClient().OrganizationAccount.Alter(t, sdk.NewAlterOrganizationAccountRequest().WithUnset(*sdk.NewOrganizationAccountUnsetRequest().WithPasswordPolicy(true)))
+		testClient().OrganizationAccount.Alter(t, sdk.NewAlterOrganizationAccountRequest().WithUnset(*sdk.NewOrganizationAccountUnsetRequest().WithSessionPolicy(true)))
+	})
+
+	comment := random.Comment()
+
+	provider := providermodel.SnowflakeProvider().WithWarehouse(testClient().Ids.WarehouseId().FullyQualifiedName())
+
+	organizationAccount := testClient().OrganizationAccount.ShowCurrent(t)
+	organizationAccountName := organizationAccount.AccountName
+	edition := string(organizationAccount.Edition)
+
+	basicModel := model.OrganizationAccount("test", organizationAccountName, "ADMIN", edition, "admin@example.com").
+		WithAdminPassword(random.Password())
+
+	setModel := model.OrganizationAccount("test", organizationAccountName, "ADMIN", edition, "admin@example.com").
+		WithAdminPassword(random.Password()).
+		WithComment(comment).
+		WithPasswordPolicy(passwordPolicy.ID().FullyQualifiedName()).
+		WithSessionPolicy(sessionPolicy.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			// import
+			{
+				Config:             config.FromModels(t, provider, basicModel),
+				ResourceName:       basicModel.ResourceReference(),
+				ImportState:        true,
+				ImportStateId:      organizationAccountName,
+				ImportStatePersist: true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedOrganizationAccountResource(t, organizationAccountName).
+						HasNameString(organizationAccountName).
+						HasEditionString(edition).
+						HasRegionString(organizationAccount.SnowflakeRegion),
+				),
+			},
+			// set comment and policies
+			{
+				Config: config.FromModels(t, provider, setModel),
+				Check: assertThat(t,
+					resourceassert.OrganizationAccountResource(t, setModel.ResourceReference()).
+						HasNameString(organizationAccountName).
+						HasCommentString(comment).
+						HasPasswordPolicyString(passwordPolicy.ID().FullyQualifiedName()).
+						HasSessionPolicyString(sessionPolicy.ID().FullyQualifiedName()),
+					resourceshowoutputassert.OrganizationAccountShowOutput(t, setModel.ResourceReference()).
+						HasAccountName(organizationAccountName).
+						HasComment(comment).
+						HasIsOrganizationAccount(true),
+				),
+			},
+			// unset comment and policies
+			{
+				Config: config.FromModels(t, provider, basicModel),
+				Check: assertThat(t,
+					resourceassert.OrganizationAccountResource(t, basicModel.ResourceReference()).
+						HasNameString(organizationAccountName).
+						HasCommentString("").
+						HasPasswordPolicyEmpty().
+						HasSessionPolicyEmpty(),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_OrganizationAccount_PoliciesOnCreate(t *testing.T) {
+	passwordPolicyId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	organizationAccountModel := model.OrganizationAccount("test", testClient().Ids.Alpha(), "ADMIN", string(sdk.OrganizationAccountEditionEnterprise), "admin@example.com").
+		WithAdminPassword(random.Password()).
+		WithPasswordPolicy(passwordPolicyId.FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      config.FromModels(t, organizationAccountModel),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("password_policy cannot be set when the organization account is created"),
+			},
+		},
+	})
+}
@@ -0,0 +1,203 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ReplicationGroup_basic(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	secondaryAccountId := secondaryTestClient().Account.GetAccountIdentifier(t)
+
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	otherDatabase, otherDatabaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(otherDatabaseCleanup)
+
+	share, shareCleanup := testClient().Share.CreateShare(t)
+	t.Cleanup(shareCleanup)
+
+	modelBasic := model.ReplicationGroupWithObjectTypes("test", id.Name(), []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases}, secondaryAccountId).
+		WithAllowedDatabases(database.ID())
+
+	modelComplete := model.ReplicationGroupWithObjectTypes("test", id.Name(), []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases, sdk.PluralObjectTypeShares}, secondaryAccountId).
+		WithAllowedDatabases(database.ID(), otherDatabase.ID()).
+		WithAllowedShares(share.ID()).
+		WithReplicationScheduleInterval(10)
+
+	modelCron := model.ReplicationGroupWithObjectTypes("test", id.Name(), []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases}, secondaryAccountId).
+		WithAllowedDatabases(otherDatabase.ID()).
+		WithReplicationScheduleCron("0 0 10-20 * TUE,THU", "UTC")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ReplicationGroup),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.ReplicationGroupResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasIgnoreEditionCheckString("false").
+						HasReplicationScheduleEmpty().
+						HasFromReplicaEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "object_types.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(modelBasic.ResourceReference(), "object_types.*", string(sdk.PluralObjectTypeDatabases))),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_accounts.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(modelBasic.ResourceReference(), "allowed_accounts.*", secondaryAccountId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_databases.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(modelBasic.ResourceReference(), "allowed_databases.*", database.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_shares.#", "0")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.type", "REPLICATION")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.is_primary", "true")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.replication_schedule", "")),
+				),
+			},
+			// import
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedReplicationGroupResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.CheckImport(importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeResourceIdentifier(id), "allowed_databases.#", "1")),
+					assert.CheckImport(importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeResourceIdentifier(id), "allowed_accounts.#", "1")),
+				),
+			},
+			// add object types, databases, shares and replication schedule
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ReplicationGroupResource(t, modelComplete.ResourceReference()).
+						HasNameString(id.Name()),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "object_types.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "allowed_databases.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "allowed_shares.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(modelComplete.ResourceReference(), "allowed_shares.*", share.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "replication_schedule.0.interval", "10")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.replication_schedule", "10 MINUTE")),
+				),
+			},
+			// external change of the replication schedule
+			{
+				PreConfig: func() {
+					testClient().ReplicationGroup.AlterSource(t, id, &sdk.AlterSourceReplicationGroupOptions{
+						Set: &sdk.ReplicationGroupSet{ReplicationSchedule: sdk.String("20 MINUTE")},
+					})
+				},
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "replication_schedule.0.interval", "10")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.replication_schedule", "10 MINUTE")),
+				),
+			},
+			// remove shares and a database, switch to cron schedule
+			{
+				Config: accconfig.FromModels(t, modelCron),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCron.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelCron.ResourceReference(), "object_types.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelCron.ResourceReference(), "allowed_databases.#", "1")),
+					assert.Check(resource.TestCheckTypeSetElemAttr(modelCron.ResourceReference(), "allowed_databases.*", otherDatabase.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelCron.ResourceReference(), "allowed_shares.#", "0")),
+					assert.Check(resource.TestCheckResourceAttr(modelCron.ResourceReference(), "replication_schedule.0.cron.0.expression", "0 0 10-20 * TUE,THU")),
+					assert.Check(resource.TestCheckResourceAttr(modelCron.ResourceReference(), "replication_schedule.0.cron.0.time_zone", "UTC")),
+				),
+			},
+			// unset replication schedule
+			{
+				Config: accconfig.FromModels(t, model.ReplicationGroupWithObjectTypes("test", id.Name(), []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases}, secondaryAccountId).
+					WithAllowedDatabases(otherDatabase.ID())),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelCron.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ReplicationGroupResource(t, modelCron.ResourceReference()).
+						HasReplicationScheduleEmpty(),
+					assert.Check(resource.TestCheckResourceAttr(modelCron.ResourceReference(), "show_output.0.replication_schedule", "")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ReplicationGroup_fromReplica(t *testing.T) {
+	database, databaseCleanup := secondaryTestClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	primaryReplicationGroup, primaryReplicationGroupCleanup := secondaryTestClient().ReplicationGroup.CreateReplicationGroupWithOptions(t,
+		[]sdk.PluralObjectType{sdk.PluralObjectTypeDatabases},
+		[]sdk.AccountIdentifier{testClient().Account.GetAccountIdentifier(t)},
+		&sdk.CreateReplicationGroupOptions{
+			AllowedDatabases: []sdk.AccountObjectIdentifier{database.ID()},
+		},
+	)
+	t.Cleanup(primaryReplicationGroupCleanup)
+	// the database replicated by the secondary group stays in the account after the group is dropped
+	t.Cleanup(testClient().Database.DropDatabaseFunc(t, database.ID()))
+
+	primaryExternalId := sdk.NewExternalObjectIdentifier(secondaryTestClient().Account.GetAccountIdentifier(t), primaryReplicationGroup.ID())
+	modelReplica := model.ReplicationGroup("test", primaryReplicationGroup.ID().Name()).
+		WithFromReplica(primaryExternalId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ReplicationGroup),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelReplica),
+				Check: assertThat(t,
+					resourceassert.ReplicationGroupResource(t, modelReplica.ResourceReference()).
+						HasNameString(primaryReplicationGroup.ID().Name()).
+						HasFullyQualifiedNameString(primaryReplicationGroup.ID().FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelReplica.ResourceReference(), "from_replica.0.name", primaryReplicationGroup.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelReplica.ResourceReference(), "show_output.0.is_primary", "false")),
+					assert.Check(resource.TestCheckResourceAttr(modelReplica.ResourceReference(), "show_output.0.primary", primaryExternalId.FullyQualifiedName())),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,205 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SemanticView_basic(t *testing.T) {
+	ordersTable, ordersTableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("CUSTOMER_ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("AMOUNT", sdk.DataTypeNumber),
+	})
+	t.Cleanup(ordersTableCleanup)
+
+	customersTable, customersTableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("NAME", sdk.DataTypeVARCHAR),
+	})
+	t.Cleanup(customersTableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment, changedComment := random.Comment(), random.Comment()
+
+	basicTables := []sdk.SemanticViewTableRequest{
+		*sdk.NewSemanticViewTableRequest(ordersTable.ID()).WithTableAlias("orders"),
+	}
+	basicMetrics := []sdk.SemanticViewExpressionRequest{
+		*sdk.NewSemanticViewExpressionRequest("orders.order_count", "COUNT(orders.id)"),
+	}
+
+	completeTables := []sdk.SemanticViewTableRequest{
+		*sdk.NewSemanticViewTableRequest(ordersTable.ID()).
+			WithTableAlias("orders").
+			WithPrimaryKey([]sdk.Column{{Value: "ID"}}).
+			WithWithSynonyms([]sdk.SemanticViewSynonym{{Value: "sales"}}).
+			WithComment("orders table"),
+		*sdk.NewSemanticViewTableRequest(customersTable.ID()).
+			WithTableAlias("customers").
+			WithPrimaryKey([]sdk.Column{{Value: "ID"}}).
+			WithUniqueKeys([]sdk.SemanticViewUniqueKeyRequest{*sdk.NewSemanticViewUniqueKeyRequest([]sdk.Column{{Value: "NAME"}})}),
+	}
+	completeRelationships := []sdk.SemanticViewRelationshipRequest{
+		*sdk.NewSemanticViewRelationshipRequest("orders", []sdk.Column{{Value: "CUSTOMER_ID"}}, "customers").
+			WithRelationshipIdentifier("orders_to_customers").
+			WithRefColumns([]sdk.Column{{Value: "ID"}}),
+	}
+	completeFacts := []sdk.SemanticViewExpressionRequest{
+		*sdk.NewSemanticViewExpressionRequest("orders.order_amount", "orders.amount").WithComment("amount of the order"),
+	}
+	completeDimensions := []sdk.SemanticViewExpressionRequest{
+		*sdk.NewSemanticViewExpressionRequest("customers.customer_name", "customers.name").
+			WithWithSynonyms([]sdk.SemanticViewSynonym{{Value: "client name"}}),
+	}
+	completeMetrics := []sdk.SemanticViewExpressionRequest{
+		*sdk.NewSemanticViewExpressionRequest("orders.total_amount", "SUM(orders.order_amount)"),
+	}
+
+	modelBasic := model.SemanticViewFromId("test", id, basicTables).
+		WithMetrics(basicMetrics)
+
+	modelComplete := model.SemanticViewFromId("test", id, completeTables).
+		WithRelationships(completeRelationships).
+		WithFacts(completeFacts).
+		WithDimensions(completeDimensions).
+		WithMetrics(completeMetrics).
+		WithComment(comment)
+
+	modelRenamed := model.SemanticViewFromId("test", newId, completeTables).
+		WithRelationships(completeRelationships).
+		WithFacts(completeFacts).
+		WithDimensions(completeDimensions).
+		WithMetrics(completeMetrics).
+		WithComment(comment)
+
+	modelRenamedWithoutComment := model.SemanticViewFromId("test", newId, completeTables).
+		WithRelationships(completeRelationships).
+		WithFacts(completeFacts).
+		WithDimensions(completeDimensions).
+		WithMetrics(completeMetrics)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.SemanticView),
+		Steps: []resource.TestStep{
+			// create with only required attributes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.SemanticViewResource(t, modelBasic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "tables.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "metrics.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.database_name", id.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.schema_name", id.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.owner", snowflakeroles.Accountadmin.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", "")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.property")),
+				),
+			},
+			// import minimal state
+			{
+				Config:       accconfig.FromModels(t, modelBasic),
+				ResourceName: modelBasic.ResourceReference(),
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedSemanticViewResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			// change the definition and set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SemanticViewResource(t, modelComplete.ResourceReference()).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "tables.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "relationships.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "facts.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "dimensions.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "metrics.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+				),
+			},
+			// change comment externally
+			{
+				PreConfig: func() {
+					testClient().SemanticView.Alter(t, sdk.NewAlterSemanticViewRequest(id).WithSetComment(changedComment))
+				},
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SemanticViewResource(t, modelComplete.ResourceReference()).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment)),
+				),
+			},
+			// rename
+			{
+				Config: accconfig.FromModels(t, modelRenamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SemanticViewResource(t, modelRenamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, modelRenamedWithoutComment),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelRenamedWithoutComment.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.SemanticViewResource(t, modelRenamedWithoutComment.ResourceReference()).
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(modelRenamedWithoutComment.ResourceReference(), "show_output.0.comment", "")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,234 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func md5Hex(content string) string {
+	hash := md5.Sum([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+func TestAcc_StageFile_Content(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	fileName := "spec.yaml"
+	path := "specs/main"
+	content, changedContent, externalContent := "spec: {}", "spec:\n  containers: []", "spec: external"
+	fileLocation := sdk.NewStageLocation(stage.ID(), path+"/"+fileName).ToSql()
+
+	modelBasic := model.StageFile("test", fileName, stage.ID().FullyQualifiedName()).
+		WithPath(path).
+		WithContent(content)
+	modelChangedContent := model.StageFile("test", fileName, stage.ID().FullyQualifiedName()).
+		WithPath(path).
+		WithContent(changedContent)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckStageFileDestroy(t),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelBasic.ResourceReference()).
+						HasStageString(stage.ID().FullyQualifiedName()).
+						HasPathString(path).
+						HasFileNameString(fileName).
+						HasContentString(content).
+						HasAutoCompressString("false").
+						HasContentMd5String(md5Hex(content)).
+						HasLocationString(fileLocation).
+						HasSizeString("8"),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "md5")),
+					assert.Check(resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "last_modified")),
+					assert.Check(func(_ *terraform.State) error {
+						files := testClient().Stage.ListFiles(t, stage.ID(), path)
+						require.Len(t, files, 1)
+						return nil
+					}),
+				),
+			},
+			// import
+			{
+				Config:                  accconfig.FromModels(t, modelBasic),
+				ResourceName:            modelBasic.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_md5"},
+			},
+			// change the content
+			{
+				Config: accconfig.FromModels(t, modelChangedContent),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelChangedContent.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelChangedContent.ResourceReference()).
+						HasContentString(changedContent).
+						HasContentMd5String(md5Hex(changedContent)).
+						HasLocationString(fileLocation),
+				),
+			},
+			// detect the external change
+			{
+				PreConfig: func() {
+					testClient().Stage.PutInLocationWithContent(t, sdk.NewStageLocation(stage.ID(), path).ToSql(), fileName, externalContent)
+				},
+				Config: accconfig.FromModels(t, modelChangedContent),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelChangedContent.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelChangedContent.ResourceReference()).
+						HasContentMd5String(md5Hex(changedContent)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StageFile_Source(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	fileName := "handler.py"
+	content, changedContent := "def handler(): return 1", "def handler(): return 2"
+	source := testhelpers.TestFile(t, fileName, []byte(content))
+
+	modelBasic := model.StageFile("test", fileName, stage.ID().FullyQualifiedName()).
+		WithSource(source)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckStageFileDestroy(t),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelBasic.ResourceReference()).
+						HasSourceString(source).
+						HasNoContent().
+						HasPathString("").
+						HasContentMd5String(md5Hex(content)).
+						HasLocationString(sdk.NewStageLocation(stage.ID(), fileName).ToSql()),
+				),
+			},
+			// change the local file
+			{
+				PreConfig: func() {
+					require.NoError(t, os.WriteFile(source, []byte(changedContent), 0o600))
+				},
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelBasic.ResourceReference()).
+						HasContentMd5String(md5Hex(changedContent)),
+				),
+			},
+			// no changes
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_StageFile_AutoCompress(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	fileName := "data.csv"
+	modelCompressed := model.StageFile("test", fileName, stage.ID().FullyQualifiedName()).
+		WithContent("id,name\n1,a\n").
+		WithAutoCompress(true)
+	modelUncompressed := model.StageFile("test", fileName, stage.ID().FullyQualifiedName()).
+		WithContent("id,name\n1,a\n")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckStageFileDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelCompressed),
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelCompressed.ResourceReference()).
+						HasAutoCompressString("true").
+						HasLocationString(sdk.NewStageLocation(stage.ID(), fileName+".gz").ToSql()),
+				),
+			},
+			{
+				Config:                  accconfig.FromModels(t, modelCompressed),
+				ResourceName:            modelCompressed.ResourceReference(),
+				ImportState:             true,
+				ImportStateId:           helpers.EncodeResourceIdentifier(stage.ID().FullyQualifiedName(), "", fileName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_md5"},
+			},
+			{
+				Config: accconfig.FromModels(t, modelUncompressed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelUncompressed.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StageFileResource(t, modelUncompressed.ResourceReference()).
+						HasAutoCompressString("false").
+						HasLocationString(sdk.NewStageLocation(stage.ID(), fileName).ToSql()),
+					assert.Check(func(_ *terraform.State) error {
+						files := testClient().Stage.ListFiles(t, stage.ID(), "")
+						require.Len(t, files, 1)
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
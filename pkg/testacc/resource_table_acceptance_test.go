@@ -1472,6 +1472,112 @@ resource "snowflake_table" "test_table" {
 `, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), maskingPolicyId.DatabaseName(), maskingPolicyId.SchemaName(), maskingPolicyId.Name())
 }
 
+func TestAcc_Table_Policies(t *testing.T) {
+	aggregationPolicyId, aggregationPolicyCleanup := testClient().AggregationPolicy.CreateAggregationPolicy(t)
+	t.Cleanup(aggregationPolicyCleanup)
+
+	joinPolicyId, joinPolicyCleanup := testClient().JoinPolicy.CreateJoinPolicy(t)
+	t.Cleanup(joinPolicyCleanup)
+
+	projectionPolicyId, projectionPolicyCleanup := testClient().ProjectionPolicy.CreateProjectionPolicy(t)
+	t.Cleanup(projectionPolicyCleanup)
+
+	tableId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Table),
+		Steps: []resource.TestStep{
+			{
+				Config: tableWithPolicies(tableId, aggregationPolicyId, joinPolicyId, projectionPolicyId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "name", tableId.Name()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.policy_name", aggregationPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.entity_key.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.entity_key.0", "column1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "join_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "join_policy.0.policy_name", joinPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.projection_policy", projectionPolicyId.FullyQualifiedName()),
+				),
+			},
+			// unset externally
+			{
+				PreConfig: func() {
+					testClient().Table.Alter(t, sdk.NewAlterTableRequest(tableId).WithUnsetJoinPolicy(sdk.Bool(true)))
+				},
+				Config: tableWithPolicies(tableId, aggregationPolicyId, joinPolicyId, projectionPolicyId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.test_table", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "join_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "join_policy.0.policy_name", joinPolicyId.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: tableWithoutPolicies(tableId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.test_table", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "join_policy.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.projection_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func tableWithPolicies(tableId sdk.SchemaObjectIdentifier, aggregationPolicyId sdk.SchemaObjectIdentifier, joinPolicyId sdk.SchemaObjectIdentifier, projectionPolicyId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+
+	column {
+		name              = "column1"
+		type              = "VARCHAR(16)"
+		projection_policy = %[6]s
+	}
+
+	aggregation_policy {
+		policy_name = %[4]s
+		entity_key  = ["column1"]
+	}
+
+	join_policy {
+		policy_name = %[5]s
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), strconv.Quote(aggregationPolicyId.FullyQualifiedName()), strconv.Quote(joinPolicyId.FullyQualifiedName()), strconv.Quote(projectionPolicyId.FullyQualifiedName()))
+}
+
+func tableWithoutPolicies(tableId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+
+	column {
+		name = "column1"
+		type = "VARCHAR(16)"
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name())
+}
+
 // proves https://github.com/Snowflake-Labs/terraform-provider-snowflake/issues/2356 issue is fixed.
 func TestAcc_Table_DefaultDataRetentionTime(t *testing.T) {
 	database, databaseCleanup := testClient().Database.CreateDatabaseWithParametersSet(t)
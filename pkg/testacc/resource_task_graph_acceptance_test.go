@@ -0,0 +1,181 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_TaskGraph_basic(t *testing.T) {
+	rootId := testClient().Ids.RandomSchemaObjectIdentifier()
+	firstId := testClient().Ids.RandomSchemaObjectIdentifier()
+	secondId := testClient().Ids.RandomSchemaObjectIdentifier()
+	thirdId := testClient().Ids.RandomSchemaObjectIdentifier()
+	finalizerId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	root := []sdk.Task{{Name: rootId.Name(), Definition: "SELECT 1", Schedule: "5 MINUTE"}}
+	task := func(id sdk.SchemaObjectIdentifier, after ...sdk.SchemaObjectIdentifier) sdk.Task {
+		return sdk.Task{Name: id.Name(), Definition: "SELECT 1", Predecessors: after}
+	}
+
+	modelBasic := model.TaskGraph("test", rootId.DatabaseName(), rootId.SchemaName(), root, true).
+		WithTasks(
+			task(firstId, rootId),
+			task(secondId, firstId),
+		)
+	// the order of the first and the second task is swapped, so both of them have to be updated in the right order
+	modelSwappedWithFinalizer := model.TaskGraph("test", rootId.DatabaseName(), rootId.SchemaName(), root, true).
+		WithTasks(
+			task(secondId, rootId),
+			task(firstId, secondId),
+			task(thirdId, firstId, secondId),
+		).
+		WithFinalizer(sdk.Task{Name: finalizerId.Name(), Definition: "SELECT 2", Comment: "finalizer"})
+	modelSuspended := model.TaskGraph("test", rootId.DatabaseName(), rootId.SchemaName(), root, false).
+		WithTasks(
+			task(secondId, rootId),
+			task(firstId, secondId),
+		)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.TaskGraph),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: assertThat(t,
+					resourceassert.TaskGraphResource(t, modelBasic.ResourceReference()).
+						HasDatabaseString(rootId.DatabaseName()).
+						HasSchemaString(rootId.SchemaName()).
+						HasStartedString(r.BooleanTrue).
+						HasFullyQualifiedNameString(rootId.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "id", helpers.EncodeResourceIdentifier(rootId))),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "root.0.schedule.0.minutes", "5")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "task.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "finalizer.#", "0")),
+					objectassert.Task(t, rootId).
+						HasState(sdk.TaskStateStarted).
+						HasSchedule("5 MINUTE"),
+					objectassert.Task(t, firstId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(rootId),
+					objectassert.Task(t, secondId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(firstId),
+				),
+			},
+			// import
+			{
+				Config:            accconfig.FromModels(t, modelBasic),
+				ResourceName:      modelBasic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// swap the dependencies, add a task and the finalizer
+			{
+				Config: accconfig.FromModels(t, modelSwappedWithFinalizer),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelSwappedWithFinalizer.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.TaskGraphResource(t, modelSwappedWithFinalizer.ResourceReference()).
+						HasStartedString(r.BooleanTrue),
+					assert.Check(resource.TestCheckResourceAttr(modelSwappedWithFinalizer.ResourceReference(), "task.#", "3")),
+					assert.Check(resource.TestCheckResourceAttr(modelSwappedWithFinalizer.ResourceReference(), "finalizer.0.name", finalizerId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(modelSwappedWithFinalizer.ResourceReference(), "finalizer.0.comment", "finalizer")),
+					objectassert.Task(t, rootId).
+						HasState(sdk.TaskStateStarted).
+						HasTaskRelations(sdk.TaskRelations{Predecessors: []sdk.SchemaObjectIdentifier{}, FinalizerTask: &finalizerId}),
+					objectassert.Task(t, secondId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(rootId),
+					objectassert.Task(t, firstId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(secondId),
+					objectassert.Task(t, thirdId).
+						HasState(sdk.TaskStateStarted).
+						HasPredecessorsInAnyOrder(firstId, secondId),
+					objectassert.Task(t, finalizerId).
+						HasState(sdk.TaskStateStarted).
+						HasDefinition("SELECT 2"),
+				),
+			},
+			// remove a task and the finalizer, and suspend the graph
+			{
+				Config: accconfig.FromModels(t, modelSuspended),
+				Check: assertThat(t,
+					resourceassert.TaskGraphResource(t, modelSuspended.ResourceReference()).
+						HasStartedString(r.BooleanFalse),
+					assert.Check(resource.TestCheckResourceAttr(modelSuspended.ResourceReference(), "task.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(modelSuspended.ResourceReference(), "finalizer.#", "0")),
+					objectassert.Task(t, rootId).
+						HasState(sdk.TaskStateSuspended),
+					assert.Check(testAccCheckTaskDropped(t, thirdId)),
+					assert.Check(testAccCheckTaskDropped(t, finalizerId)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_TaskGraph_cycle(t *testing.T) {
+	rootId := testClient().Ids.RandomSchemaObjectIdentifier()
+	firstId := testClient().Ids.RandomSchemaObjectIdentifier()
+	secondId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	modelWithCycle := model.TaskGraph("test", rootId.DatabaseName(), rootId.SchemaName(), []sdk.Task{{Name: rootId.Name(), Definition: "SELECT 1"}}, false).
+		WithTasks(
+			sdk.Task{Name: firstId.Name(), Definition: "SELECT 1", Predecessors: []sdk.SchemaObjectIdentifier{rootId, secondId}},
+			sdk.Task{Name: secondId.Name(), Definition: "SELECT 1", Predecessors: []sdk.SchemaObjectIdentifier{firstId}},
+		)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.TaskGraph),
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, modelWithCycle),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("task graph contains a cycle between the tasks"),
+			},
+		},
+	})
+}
+
+func testAccCheckTaskDropped(t *testing.T, id sdk.SchemaObjectIdentifier) resource.TestCheckFunc {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		if _, err := testClient().Task.Show(t, id); !errors.Is(err, sdk.ErrObjectNotFound) {
+			return fmt.Errorf("expected task %s to be dropped, got err: %w", id.FullyQualifiedName(), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,68 @@
+//go:build !account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAcc_UserSessionPolicyAttachment(t *testing.T) {
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	sessionPolicy, sessionPolicyCleanup := testClient().SessionPolicy.CreateSessionPolicy(t)
+	t.Cleanup(sessionPolicyCleanup)
+
+	newSessionPolicy, newSessionPolicyCleanup := testClient().SessionPolicy.CreateSessionPolicy(t)
+	t.Cleanup(newSessionPolicyCleanup)
+
+	userId := user.ID()
+	sessionPolicyId := sessionPolicy.ID()
+	newSessionPolicyId := newSessionPolicy.ID()
+
+	attachmentModel := model.UserSessionPolicyAttachment("test", sessionPolicyId.FullyQualifiedName(), userId.Name())
+	attachmentModelWithNewPolicy := model.UserSessionPolicyAttachment("test", newSessionPolicyId.FullyQualifiedName(), userId.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             CheckUserSessionPolicyAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			// CREATE
+			{
+				Config: accconfig.FromModels(t, attachmentModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "user_name", userId.Name()),
+					resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "session_policy_name", sessionPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(attachmentModel.ResourceReference(), "id", fmt.Sprintf("%s|%s", userId.FullyQualifiedName(), sessionPolicyId.FullyQualifiedName())),
+				),
+			},
+			// UPDATE
+			{
+				Config: accconfig.FromModels(t, attachmentModelWithNewPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(attachmentModelWithNewPolicy.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(attachmentModelWithNewPolicy.ResourceReference(), "user_name", userId.Name()),
+					resource.TestCheckResourceAttr(attachmentModelWithNewPolicy.ResourceReference(), "session_policy_name", newSessionPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(attachmentModelWithNewPolicy.ResourceReference(), "id", fmt.Sprintf("%s|%s", userId.FullyQualifiedName(), newSessionPolicyId.FullyQualifiedName())),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      attachmentModelWithNewPolicy.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,17 @@
+resource "snowflake_account_role_grants" "test" {
+  account_role_name = var.account_role_name
+
+  dynamic "grant" {
+    for_each = var.grants
+    content {
+      privileges        = grant.value.privileges
+      object_type       = grant.value.object_type
+      object_name       = grant.value.object_name
+      with_grant_option = grant.value.with_grant_option
+    }
+  }
+
+  exclude {
+    in_database = "SNOWFLAKE"
+  }
+}
@@ -0,0 +1,12 @@
+variable "database_role_name" {
+  type = string
+}
+
+variable "grants" {
+  type = list(object({
+    privileges        = list(string)
+    object_type       = string
+    object_name       = optional(string)
+    with_grant_option = optional(bool, false)
+  }))
+}
@@ -0,0 +1,7 @@
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
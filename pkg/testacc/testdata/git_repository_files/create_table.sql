@@ -0,0 +1 @@
+CREATE TABLE IF NOT EXISTS {{ table_name }} (ID NUMBER);